@@ -0,0 +1,38 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/diki/cmd/diki-pki-plugin/app"
+	"github.com/gardener/diki/pkg/plugin/protocol"
+)
+
+// servePluginEnv makes the test binary serve the plugin protocol instead of running the tests,
+// so that the conformance test can run the plugin as an executable.
+const servePluginEnv = "DIKI_PKI_PLUGIN_TEST_SERVE"
+
+func TestMain(m *testing.M) {
+	if os.Getenv(servePluginEnv) == "true" {
+		if err := protocol.Serve(context.Background(), os.Stdin, os.Stdout, app.NewHandler()); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+func TestApp(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "PKI Plugin App Suite")
+}
@@ -0,0 +1,230 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package app contains the reference plugin of diki, which checks X.509 certificate files.
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/gardener/diki/pkg/plugin/protocol"
+)
+
+const (
+	// ProviderID is the id of the provider implemented by the plugin.
+	ProviderID = "pki"
+	// ProviderName is the user-friendly name of the provider implemented by the plugin.
+	ProviderName = "Public Key Infrastructure"
+	// RulesetID is the id of the ruleset implemented by the plugin.
+	RulesetID = "certificates"
+	// RulesetName is the user-friendly name of the ruleset implemented by the plugin.
+	RulesetName = "Certificates"
+)
+
+// supportedVersions are the versions of the ruleset sorted from newest to oldest.
+var supportedVersions = []string{"v0.1.0"}
+
+type providerArgs struct {
+	// Certificates are the paths of PEM encoded certificate files.
+	Certificates []string `json:"certificates"`
+}
+
+// Handler implements the diki plugin protocol for the certificates ruleset.
+type Handler struct{}
+
+var _ protocol.Handler = &Handler{}
+
+// NewHandler creates a new Handler.
+func NewHandler() *Handler {
+	return &Handler{}
+}
+
+// Describe returns the description of the plugin and validates the configuration if it is set.
+func (h *Handler) Describe(_ context.Context, conf *protocol.ProviderConfig) (protocol.Description, error) {
+	if conf != nil {
+		if _, err := parseConfig(*conf); err != nil {
+			return protocol.Description{}, err
+		}
+	}
+
+	return protocol.Description{
+		ProtocolVersion: protocol.Version,
+		ID:              ProviderID,
+		Name:            ProviderName,
+		Rulesets: []protocol.RulesetDescription{
+			{ID: RulesetID, Name: RulesetName, Versions: supportedVersions},
+		},
+	}, nil
+}
+
+// RunAll runs all configured rulesets.
+func (h *Handler) RunAll(ctx context.Context, conf protocol.ProviderConfig, progress protocol.ProgressFunc) (protocol.Result, error) {
+	c, err := parseConfig(conf)
+	if err != nil {
+		return protocol.Result{}, err
+	}
+
+	result := protocol.Result{StartTime: time.Now().UTC()}
+	for _, rs := range c.rulesets {
+		rulesetResult, err := c.run(ctx, rs, rules, progress)
+		if err != nil {
+			return protocol.Result{}, err
+		}
+		result.Rulesets = append(result.Rulesets, rulesetResult)
+	}
+	result.EndTime = time.Now().UTC()
+	return result, nil
+}
+
+// RunRuleset runs a single configured ruleset.
+func (h *Handler) RunRuleset(ctx context.Context, conf protocol.ProviderConfig, rulesetID, rulesetVersion string, progress protocol.ProgressFunc) (protocol.Result, error) {
+	c, err := parseConfig(conf)
+	if err != nil {
+		return protocol.Result{}, err
+	}
+	rs, err := c.ruleset(rulesetID, rulesetVersion)
+	if err != nil {
+		return protocol.Result{}, err
+	}
+
+	rulesetResult, err := c.run(ctx, rs, rules, progress)
+	if err != nil {
+		return protocol.Result{}, err
+	}
+	return protocol.Result{Rulesets: []protocol.RulesetResult{rulesetResult}, StartTime: rulesetResult.StartTime, EndTime: rulesetResult.EndTime}, nil
+}
+
+// RunRule runs a single rule of a configured ruleset.
+func (h *Handler) RunRule(ctx context.Context, conf protocol.ProviderConfig, rulesetID, rulesetVersion, ruleID string, progress protocol.ProgressFunc) (protocol.Result, error) {
+	c, err := parseConfig(conf)
+	if err != nil {
+		return protocol.Result{}, err
+	}
+	rs, err := c.ruleset(rulesetID, rulesetVersion)
+	if err != nil {
+		return protocol.Result{}, err
+	}
+
+	idx := slices.IndexFunc(rules, func(r certificateRule) bool { return r.id == ruleID })
+	if idx < 0 {
+		return protocol.Result{}, fmt.Errorf("rule with id %s does not exist in ruleset %s", ruleID, rulesetID)
+	}
+
+	rulesetResult, err := c.run(ctx, rs, rules[idx:idx+1], progress)
+	if err != nil {
+		return protocol.Result{}, err
+	}
+	return protocol.Result{Rulesets: []protocol.RulesetResult{rulesetResult}, StartTime: rulesetResult.StartTime, EndTime: rulesetResult.EndTime}, nil
+}
+
+// config is the parsed configuration of the provider.
+type config struct {
+	args     providerArgs
+	rulesets []protocol.RulesetConfig
+}
+
+func parseConfig(conf protocol.ProviderConfig) (*config, error) {
+	if conf.ID != ProviderID {
+		return nil, fmt.Errorf("provider id %s is not supported, expected %s", conf.ID, ProviderID)
+	}
+
+	c := &config{rulesets: conf.Rulesets}
+	if len(conf.Args) > 0 {
+		if err := json.Unmarshal(conf.Args, &c.args); err != nil {
+			return nil, fmt.Errorf("failed to parse provider args: %w", err)
+		}
+	}
+
+	var err error
+	if len(c.args.Certificates) == 0 {
+		err = errors.Join(err, errors.New("args.certificates must not be empty"))
+	}
+	for _, rs := range c.rulesets {
+		if rs.ID != RulesetID {
+			err = errors.Join(err, fmt.Errorf("ruleset with id %s is not supported", rs.ID))
+			continue
+		}
+		if !slices.Contains(supportedVersions, rs.Version) {
+			err = errors.Join(err, fmt.Errorf("version %s of ruleset %s is not supported", rs.Version, rs.ID))
+		}
+		for _, opt := range rs.RuleOptions {
+			if _, optErr := parseRuleOptions(opt); optErr != nil {
+				err = errors.Join(err, optErr)
+			}
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *config) ruleset(rulesetID, rulesetVersion string) (protocol.RulesetConfig, error) {
+	idx := slices.IndexFunc(c.rulesets, func(rs protocol.RulesetConfig) bool {
+		return rs.ID == rulesetID && rs.Version == rulesetVersion
+	})
+	if idx < 0 {
+		return protocol.RulesetConfig{}, fmt.Errorf("ruleset with id %s and version %s is not configured", rulesetID, rulesetVersion)
+	}
+	return c.rulesets[idx], nil
+}
+
+// run runs the given rules of a ruleset against the configured certificates.
+func (c *config) run(ctx context.Context, rs protocol.RulesetConfig, rulesToRun []certificateRule, progress protocol.ProgressFunc) (protocol.RulesetResult, error) {
+	result := protocol.RulesetResult{
+		ID:        rs.ID,
+		Name:      RulesetName,
+		Version:   rs.Version,
+		Rules:     make([]protocol.RuleResult, 0, len(rulesToRun)),
+		StartTime: time.Now().UTC(),
+	}
+	certificates := loadCertificates(c.args.Certificates)
+
+	for _, r := range rulesToRun {
+		if err := ctx.Err(); err != nil {
+			return protocol.RulesetResult{}, err
+		}
+
+		event := protocol.Progress{RulesetID: rs.ID, RulesetVersion: rs.Version, RuleID: r.id, RuleName: r.name}
+		event.Event = protocol.ProgressEventRuleStarted
+		progress(event)
+
+		ruleResult := protocol.RuleResult{ID: r.id, Name: r.name, Severity: r.severity, Checks: []protocol.CheckResult{}, StartTime: time.Now().UTC()}
+		opts, skipped := ruleOptionsFor(rs, r.id)
+		if !skipped {
+			ruleResult.Checks = r.run(certificates, opts, time.Now())
+		}
+		ruleResult.EndTime = time.Now().UTC()
+
+		event.Event = protocol.ProgressEventRuleFinished
+		event.Result = &ruleResult
+		progress(event)
+		result.Rules = append(result.Rules, ruleResult)
+	}
+
+	result.EndTime = time.Now().UTC()
+	return result, nil
+}
+
+// ruleOptionsFor returns the options of a rule and whether it is skipped.
+// Skipped rules are reported without checks, as diki reports them as accepted.
+func ruleOptionsFor(rs protocol.RulesetConfig, ruleID string) (ruleOptions, bool) {
+	for _, opt := range rs.RuleOptions {
+		if opt.RuleID != ruleID {
+			continue
+		}
+		if opt.Skip != nil && opt.Skip.Enabled {
+			return ruleOptions{}, true
+		}
+		// options are validated when the configuration is parsed
+		opts, _ := parseRuleOptions(opt)
+		return opts, false
+	}
+	return defaultRuleOptions(), false
+}
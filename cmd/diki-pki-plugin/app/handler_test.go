@@ -0,0 +1,159 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/diki/cmd/diki-pki-plugin/app"
+	"github.com/gardener/diki/pkg/plugin/conformance"
+	"github.com/gardener/diki/pkg/plugin/protocol"
+)
+
+var _ = Describe("handler", func() {
+	var (
+		ctx      = context.TODO()
+		handler  *app.Handler
+		dir      string
+		strong   string
+		weak     string
+		missing  string
+		progress []protocol.Progress
+		record   protocol.ProgressFunc
+	)
+
+	writeCertificate := func(name string, key crypto.Signer, notBefore, notAfter time.Time) string {
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(1),
+			Subject:      pkix.Name{CommonName: name},
+			NotBefore:    notBefore,
+			NotAfter:     notAfter,
+		}
+		der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+		Expect(err).NotTo(HaveOccurred())
+
+		path := filepath.Join(dir, name+".pem")
+		Expect(os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600)).To(Succeed())
+		return path
+	}
+
+	providerConfig := func(ruleOptions ...protocol.RuleOptionsConfig) protocol.ProviderConfig {
+		args, err := json.Marshal(map[string]any{"certificates": []string{strong, weak, missing}})
+		Expect(err).NotTo(HaveOccurred())
+		return protocol.ProviderConfig{
+			ID:   app.ProviderID,
+			Args: args,
+			Rulesets: []protocol.RulesetConfig{
+				{ID: app.RulesetID, Version: "v0.1.0", RuleOptions: ruleOptions},
+			},
+		}
+	}
+
+	statuses := func(rs protocol.RulesetResult) map[string][]string {
+		res := map[string][]string{}
+		for _, r := range rs.Rules {
+			for _, check := range r.Checks {
+				res[r.ID] = append(res[r.ID], check.Status+" "+check.Target["subject"])
+			}
+		}
+		return res
+	}
+
+	BeforeEach(func() {
+		handler = app.NewHandler()
+		dir = GinkgoT().TempDir()
+		progress = nil
+		record = func(p protocol.Progress) {
+			progress = append(progress, p)
+		}
+
+		rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).NotTo(HaveOccurred())
+		ecdsaKey, err := ecdsa.GenerateKey(elliptic.P224(), rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+
+		now := time.Now()
+		strong = writeCertificate("strong", rsaKey, now.Add(-time.Hour), now.Add(365*24*time.Hour))
+		weak = writeCertificate("weak", ecdsaKey, now.Add(-time.Hour), now.Add(24*time.Hour))
+		missing = filepath.Join(dir, "missing.pem")
+	})
+
+	It("should conform to the plugin protocol", func() {
+		client := &protocol.Client{
+			Command: os.Args[0],
+			Env:     map[string]string{servePluginEnv: "true"},
+		}
+
+		Expect(conformance.Check(ctx, client, providerConfig())).To(Succeed())
+	})
+
+	It("should check the configured certificates", func() {
+		result, err := handler.RunAll(ctx, providerConfig(), record)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Rulesets).To(HaveLen(1))
+		Expect(result.Rulesets[0].Name).To(Equal("Certificates"))
+		Expect(statuses(result.Rulesets[0])).To(Equal(map[string][]string{
+			"1000": {"Passed CN=strong", "Passed CN=weak", "Errored "},
+			"1001": {"Passed CN=strong", "Failed CN=weak", "Errored "},
+			"1002": {"Passed CN=strong", "Failed CN=weak", "Errored "},
+		}))
+		Expect(progress).To(HaveLen(6))
+		Expect(progress[0].Event).To(Equal(protocol.ProgressEventRuleStarted))
+		Expect(progress[1].Event).To(Equal(protocol.ProgressEventRuleFinished))
+		Expect(progress[1].Result.ID).To(Equal("1000"))
+	})
+
+	It("should apply the rule options", func() {
+		conf := providerConfig(
+			protocol.RuleOptionsConfig{RuleID: "1000", Skip: &protocol.RuleSkipConfig{Enabled: true, Justification: "foo"}},
+			protocol.RuleOptionsConfig{RuleID: "1001", Args: json.RawMessage(`{"minValidity":"1h"}`)},
+		)
+
+		result, err := handler.RunRuleset(ctx, conf, app.RulesetID, "v0.1.0", record)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Rulesets[0].Rules[0].Checks).To(BeEmpty())
+		Expect(statuses(result.Rulesets[0])["1001"]).To(Equal([]string{"Passed CN=strong", "Passed CN=weak", "Errored "}))
+	})
+
+	It("should run a single rule", func() {
+		result, err := handler.RunRule(ctx, providerConfig(), app.RulesetID, "v0.1.0", "1002", record)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Rulesets).To(HaveLen(1))
+		Expect(result.Rulesets[0].Rules).To(HaveLen(1))
+		Expect(result.Rulesets[0].Rules[0].ID).To(Equal("1002"))
+		Expect(result.Rulesets[0].Rules[0].Severity).To(Equal("High"))
+	})
+
+	It("should reject invalid configurations", func() {
+		conf := providerConfig(protocol.RuleOptionsConfig{RuleID: "1001", Args: json.RawMessage(`{"minValidity":"foo"}`)})
+		conf.Args = nil
+		conf.Rulesets = append(conf.Rulesets, protocol.RulesetConfig{ID: app.RulesetID, Version: "v0.0.1"})
+
+		_, err := handler.Describe(ctx, &conf)
+
+		Expect(err).To(MatchError(ContainSubstring("args.certificates must not be empty")))
+		Expect(err).To(MatchError(ContainSubstring("failed to parse minValidity of rule 1001")))
+		Expect(err).To(MatchError(ContainSubstring("version v0.0.1 of ruleset certificates is not supported")))
+	})
+})
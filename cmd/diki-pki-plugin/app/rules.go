@@ -0,0 +1,184 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/gardener/diki/pkg/plugin/protocol"
+)
+
+const (
+	defaultMinValidity   = 30 * 24 * time.Hour
+	defaultMinRSAKeySize = 2048
+	minECDSAKeySize      = 256
+)
+
+// ruleOptions are the options of the rules which can be set in the rule options of the ruleset.
+type ruleOptions struct {
+	// MinValidity is the minimal remaining validity of the certificates checked by rule 1001.
+	MinValidity time.Duration
+	// MinRSAKeySize is the minimal size of RSA keys checked by rule 1002.
+	MinRSAKeySize int
+}
+
+type ruleOptionsArgs struct {
+	MinValidity   string `json:"minValidity"`
+	MinRSAKeySize int    `json:"minRSAKeySize"`
+}
+
+func defaultRuleOptions() ruleOptions {
+	return ruleOptions{MinValidity: defaultMinValidity, MinRSAKeySize: defaultMinRSAKeySize}
+}
+
+func parseRuleOptions(opt protocol.RuleOptionsConfig) (ruleOptions, error) {
+	opts := defaultRuleOptions()
+	if len(opt.Args) == 0 {
+		return opts, nil
+	}
+
+	var args ruleOptionsArgs
+	if err := json.Unmarshal(opt.Args, &args); err != nil {
+		return ruleOptions{}, fmt.Errorf("failed to parse args of rule %s: %w", opt.RuleID, err)
+	}
+	if len(args.MinValidity) > 0 {
+		minValidity, err := time.ParseDuration(args.MinValidity)
+		if err != nil {
+			return ruleOptions{}, fmt.Errorf("failed to parse minValidity of rule %s: %w", opt.RuleID, err)
+		}
+		opts.MinValidity = minValidity
+	}
+	if args.MinRSAKeySize > 0 {
+		opts.MinRSAKeySize = args.MinRSAKeySize
+	}
+	return opts, nil
+}
+
+// certificateFile contains the certificates of a file or the error with which loading it failed.
+type certificateFile struct {
+	path         string
+	certificates []*x509.Certificate
+	err          error
+}
+
+func loadCertificates(paths []string) []certificateFile {
+	files := make([]certificateFile, 0, len(paths))
+	for _, path := range paths {
+		file := certificateFile{path: path}
+		file.certificates, file.err = parseCertificates(path)
+		files = append(files, file)
+	}
+	return files
+}
+
+func parseCertificates(path string) ([]*x509.Certificate, error) {
+	data, err := os.ReadFile(path) // #nosec G304
+	if err != nil {
+		return nil, err
+	}
+
+	var certificates []*x509.Certificate
+	for block, rest := pem.Decode(data); block != nil; block, rest = pem.Decode(rest) {
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		certificate, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		certificates = append(certificates, certificate)
+	}
+	if len(certificates) == 0 {
+		return nil, fmt.Errorf("file %s does not contain PEM encoded certificates", path)
+	}
+	return certificates, nil
+}
+
+// certificateRule checks every configured certificate.
+type certificateRule struct {
+	id, name, severity string
+	check              func(certificate *x509.Certificate, opts ruleOptions, now time.Time) (string, string)
+}
+
+// rules are the rules of the certificates ruleset.
+var rules = []certificateRule{
+	{
+		id:       "1000",
+		name:     "Certificates must be within their validity period.",
+		severity: "High",
+		check: func(certificate *x509.Certificate, _ ruleOptions, now time.Time) (string, string) {
+			switch {
+			case now.Before(certificate.NotBefore):
+				return "Failed", "Certificate is not yet valid."
+			case now.After(certificate.NotAfter):
+				return "Failed", "Certificate has expired."
+			default:
+				return "Passed", "Certificate is within its validity period."
+			}
+		},
+	},
+	{
+		id:       "1001",
+		name:     "Certificates must remain valid for the minimal validity.",
+		severity: "Medium",
+		check: func(certificate *x509.Certificate, opts ruleOptions, now time.Time) (string, string) {
+			if certificate.NotAfter.Sub(now) < opts.MinValidity {
+				return "Failed", fmt.Sprintf("Certificate expires in less than %s.", opts.MinValidity)
+			}
+			return "Passed", fmt.Sprintf("Certificate remains valid for at least %s.", opts.MinValidity)
+		},
+	},
+	{
+		id:       "1002",
+		name:     "Certificates must use sufficiently strong keys.",
+		severity: "High",
+		check: func(certificate *x509.Certificate, opts ruleOptions, _ time.Time) (string, string) {
+			switch key := certificate.PublicKey.(type) {
+			case *rsa.PublicKey:
+				if size := key.N.BitLen(); size < opts.MinRSAKeySize {
+					return "Failed", fmt.Sprintf("Certificate uses RSA key of size %d which is less than %d.", size, opts.MinRSAKeySize)
+				}
+				return "Passed", "Certificate uses sufficiently strong RSA key."
+			case *ecdsa.PublicKey:
+				if size := key.Curve.Params().BitSize; size < minECDSAKeySize {
+					return "Failed", fmt.Sprintf("Certificate uses ECDSA key of size %d which is less than %d.", size, minECDSAKeySize)
+				}
+				return "Passed", "Certificate uses sufficiently strong ECDSA key."
+			case ed25519.PublicKey:
+				return "Passed", "Certificate uses Ed25519 key."
+			default:
+				return "Warning", fmt.Sprintf("Certificate uses unknown key type %T.", key)
+			}
+		},
+	},
+}
+
+// run checks the certificates of all files. Files which cannot be loaded are reported as errored.
+func (r certificateRule) run(files []certificateFile, opts ruleOptions, now time.Time) []protocol.CheckResult {
+	var checks []protocol.CheckResult
+	for _, file := range files {
+		if file.err != nil {
+			checks = append(checks, protocol.CheckResult{Status: "Errored", Message: file.err.Error(), Target: map[string]string{"file": file.path}})
+			continue
+		}
+		for _, certificate := range file.certificates {
+			status, message := r.check(certificate, opts, now)
+			checks = append(checks, protocol.CheckResult{
+				Status:  status,
+				Message: message,
+				Target:  map[string]string{"file": file.path, "subject": certificate.Subject.String()},
+			})
+		}
+	}
+	return checks
+}
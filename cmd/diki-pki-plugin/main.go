@@ -0,0 +1,26 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/gardener/diki/cmd/diki-pki-plugin/app"
+	"github.com/gardener/diki/pkg/plugin/protocol"
+)
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	err := protocol.Serve(ctx, os.Stdin, os.Stdout, app.NewHandler())
+	stop()
+
+	if err != nil {
+		log.Fatal(err)
+	}
+}
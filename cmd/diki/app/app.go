@@ -5,48 +5,47 @@
 package app
 
 import (
+	"bytes"
+	"cmp"
 	"context"
+	"crypto/rand"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"log/slog"
+	"maps"
 	"os"
 	"path/filepath"
 	"slices"
+	"time"
 
 	"github.com/spf13/cobra"
 	"gopkg.in/yaml.v3"
-	"k8s.io/apimachinery/pkg/util/validation/field"
 	cliflag "k8s.io/component-base/cli/flag"
 	"k8s.io/component-base/version"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
 	"github.com/gardener/diki/cmd/internal/slogr"
 	"github.com/gardener/diki/pkg/config"
+	kubeutils "github.com/gardener/diki/pkg/kubernetes/utils"
 	"github.com/gardener/diki/pkg/metadata"
 	"github.com/gardener/diki/pkg/provider"
+	"github.com/gardener/diki/pkg/registry"
 	"github.com/gardener/diki/pkg/report"
 	"github.com/gardener/diki/pkg/rule"
-	"github.com/gardener/diki/pkg/ruleset"
+	"github.com/gardener/diki/pkg/runner"
+	"github.com/gardener/diki/pkg/snapshot"
 )
 
 // NewDikiCommand creates a new command that is used to start Diki.
-func NewDikiCommand(providerOptions map[string]provider.ProviderOption) *cobra.Command {
+// The providers and rulesets which can be run and shown are the ones of the registry.
+func NewDikiCommand(r *registry.Registry) *cobra.Command {
 	handler := slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelInfo})
 	logger := slog.New(handler)
 	slog.SetDefault(logger)
 
-	providerCreateFuncs := map[string]provider.ProviderFromConfigFunc{}
-	for providerID, providerOption := range providerOptions {
-		providerCreateFuncs[providerID] = providerOption.ProviderFromConfigFunc
-	}
-
-	metadataFuncs := map[string]provider.MetadataFunc{}
-	for providerID, providerOption := range providerOptions {
-		metadataFuncs[providerID] = providerOption.MetadataFunc
-	}
-
 	rootCmd := &cobra.Command{
 		Use:   "diki",
 		Short: "Diki a \"compliance checker\" of sorts, a detective control framework.",
@@ -81,13 +80,27 @@ e.g. to check compliance of your hyperscaler accounts.`,
 		Short: "Run some rulesets and rules.",
 		Long:  "Run allows running rulesets and rules for the given provider(s).",
 		RunE: func(c *cobra.Command, _ []string) error {
-			return runCmd(c.Context(), providerCreateFuncs, opts, logger)
+			return runCmd(c.Context(), r, opts, logger)
 		},
 	}
 
 	addRunFlags(runCmd, &opts)
+	addSignFlags(runCmd, &opts.signOptions)
 	rootCmd.AddCommand(runCmd)
 
+	var collectOpts collectOptions
+	collectCmd := &cobra.Command{
+		Use:   "collect",
+		Short: "Collect a snapshot for offline evaluation.",
+		Long:  "Collect runs the configured rulesets and writes everything they read from the checked clusters to a snapshot archive, which can be evaluated later with \"diki run --from-snapshot\" without cluster access.",
+		RunE: func(c *cobra.Command, _ []string) error {
+			return collectCmd(c.Context(), r, collectOpts, logger)
+		},
+	}
+
+	addCollectFlags(collectCmd, &collectOpts)
+	rootCmd.AddCommand(collectCmd)
+
 	var reportOpts reportOptions
 	reportCmd := &cobra.Command{
 		Use:   "report",
@@ -112,6 +125,7 @@ e.g. to check compliance of your hyperscaler accounts.`,
 	}
 
 	addReportGenerateFlags(generateCmd, &generateOpts)
+	addSignFlags(generateCmd, &generateOpts.signOptions)
 	reportCmd.AddCommand(generateCmd)
 
 	var diffOpts diffOptions
@@ -140,6 +154,84 @@ e.g. to check compliance of your hyperscaler accounts.`,
 	addReportGenerateDiffFlags(generateDiffCmd, &generateDiffOpts)
 	generateCmd.AddCommand(generateDiffCmd)
 
+	var applyExceptionsOpts applyExceptionsOptions
+	applyExceptionsCmd := &cobra.Command{
+		Use:   "apply-exceptions",
+		Short: "Apply exceptions accepts known findings of a report.",
+		Long:  "Apply exceptions sets the status of failed and warning checks matched by exceptions to accepted and reverts checks accepted by expired exceptions.",
+		RunE: func(_ *cobra.Command, args []string) error {
+			return applyExceptionsCmd(args, reportOpts, applyExceptionsOpts, logger)
+		},
+	}
+
+	addReportApplyExceptionsFlags(applyExceptionsCmd, &applyExceptionsOpts)
+	reportCmd.AddCommand(applyExceptionsCmd)
+
+	var exceptionsOpts exceptionsOptions
+	exceptionsCmd := &cobra.Command{
+		Use:   "exceptions",
+		Short: "Report exceptions lists all accepted and skipped checks of a report.",
+		Long:  "Report exceptions lists all accepted and skipped checks and skipped rules of a report grouped by their justification.",
+		RunE: func(_ *cobra.Command, args []string) error {
+			return exceptionsCmd(args, reportOpts, exceptionsOpts, logger)
+		},
+	}
+
+	addReportExceptionsFlags(exceptionsCmd, &exceptionsOpts)
+	reportCmd.AddCommand(exceptionsCmd)
+
+	var verifyOpts verifyOptions
+	verifyCmd := &cobra.Command{
+		Use:   "verify",
+		Short: "Report verify checks the signature of a report.",
+		Long:  "Report verify checks that a report is signed by the expected key and that its content matches the signed digest.",
+		RunE: func(_ *cobra.Command, args []string) error {
+			return verifyCmd(args, verifyOpts, logger)
+		},
+	}
+
+	addReportVerifyFlags(verifyCmd, &verifyOpts)
+	reportCmd.AddCommand(verifyCmd)
+
+	var splitOpts splitOptions
+	splitCmd := &cobra.Command{
+		Use:   "split",
+		Short: "Report split creates a separate report for every owner of findings.",
+		Long:  "Report split attributes the checks of a report to owners and creates a separate report for every owner together with an index.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return splitCmd(cmd.Context(), args, splitOpts, logger)
+		},
+	}
+
+	addReportSplitFlags(splitCmd, &splitOpts)
+	reportCmd.AddCommand(splitCmd)
+
+	var redactOpts redactOptions
+	redactCmd := &cobra.Command{
+		Use:   "redact",
+		Short: "Report redact pseudonymises reports for sharing.",
+		Long:  "Report redact replaces target values and metadata fields of reports with pseudonyms which are consistent for all reports redacted with the same key.",
+		RunE: func(_ *cobra.Command, args []string) error {
+			return redactCmd(args, redactOpts, logger)
+		},
+	}
+
+	addReportRedactFlags(redactCmd, &redactOpts)
+	reportCmd.AddCommand(redactCmd)
+
+	var profileOpts profileOptions
+	profileCmd := &cobra.Command{
+		Use:   "profile",
+		Short: "Report profile lists the slowest rules of a run.",
+		Long:  "Report profile lists the slowest rules of a run together with their retries and created ops pods and can export the run timeline as Chrome trace events.",
+		RunE: func(_ *cobra.Command, args []string) error {
+			return profileCmd(args, reportOpts, profileOpts, logger)
+		},
+	}
+
+	addReportProfileFlags(profileCmd, &profileOpts)
+	reportCmd.AddCommand(profileCmd)
+
 	showCmd := &cobra.Command{
 		Use:   "show",
 		Short: "Show metadata information for different diki internals, i.e. providers.",
@@ -156,7 +248,7 @@ e.g. to check compliance of your hyperscaler accounts.`,
 		Short: "Show detailed information for providers.",
 		Long:  "Show detailed information for providers.",
 		RunE: func(_ *cobra.Command, args []string) error {
-			return showProviderCmd(args, metadataFuncs)
+			return showProviderCmd(args, r)
 		},
 	}
 
@@ -177,14 +269,36 @@ func addRunFlags(cmd *cobra.Command, opts *runOptions) {
 	cmd.PersistentFlags().StringVar(&opts.rulesetID, "ruleset-id", "", "The id of the ruleset that should be run. If provided --ruleset-version should also be set. If both flags are empty all rulesets for the provider will be run.")
 	cmd.PersistentFlags().StringVar(&opts.rulesetVersion, "ruleset-version", "", "The version of the ruleset that should be run. If provided --ruleset-id should also be set. If both flags are empty all rulesets for the provider will be run.")
 	cmd.PersistentFlags().StringVar(&opts.ruleID, "rule-id", "", "If set only the rule with the provided id will be run.")
+	cmd.PersistentFlags().StringVar(&opts.evidenceDir, "evidence-dir", "", "If set rules collect the raw data behind their checks. The redacted and compressed evidence is written to this directory and referenced from the report.")
+	cmd.PersistentFlags().IntVar(&opts.evidenceMaxSize, "evidence-max-size", report.DefaultEvidenceMaxSize, "Maximum size in bytes of a single evidence. Larger evidence is omitted from the evidence bundle.")
+	cmd.PersistentFlags().StringVar(&opts.provenanceConfig, "provenance-config", string(report.ProvenanceConfigRedacted), "How the provider and ruleset configuration is recorded in the report provenance. Mode can be one of 'redacted', 'full' or 'hash'.")
+	cmd.PersistentFlags().StringVar(&opts.fromSnapshot, "from-snapshot", "", "If set rules are evaluated against the snapshot archive or directory written by 'diki collect' instead of the live clusters. The configuration of the snapshot is used if --config is not set.")
+}
+
+func addCollectFlags(cmd *cobra.Command, opts *collectOptions) {
+	cmd.PersistentFlags().StringVar(&opts.configFile, "config", "", "Configuration file for diki containing info about providers and rulesets.")
+	cmd.PersistentFlags().StringVar(&opts.outputPath, "output", "", "Path of the written snapshot archive or directory.")
+	cmd.PersistentFlags().StringVar(&opts.format, "format", "archive", "Format of the written snapshot. Format can be one of 'archive' or 'directory'. Snapshot directories can be used as test fixtures.")
+	cmd.PersistentFlags().StringVar(&opts.provider, "provider", "", "If set only the rulesets of this provider are collected.")
 }
 
 func addReportGenerateFlags(cmd *cobra.Command, opts *generateOptions) {
 	cmd.PersistentFlags().Var(cliflag.NewMapStringString(&opts.distinctBy), "distinct-by", "If set generates a merged report. The keys are the IDs for the providers which the merged report will include and the values are distinct metadata attributes to be used as IDs for the different reports.")
 	cmd.PersistentFlags().StringVar(&opts.format, "format", "html", "Format for the output report. Format can be one of 'html' or 'json'.")
+	cmd.PersistentFlags().StringVar(&opts.groupBy, "group-by", "", "If set groups the checks of the generated report by a target property. Property can be one of 'namespace', 'node' or 'workload'.")
 	cmd.PersistentFlags().StringVar(&opts.minStatus, "min-status", "Passed", "If set specifies the minimal status that will be included in the generated report. Ordered from lowest to highest priority, Status can be one of 'Passed', 'Skipped', 'Accepted', 'Warning', 'Failed', 'Errored' or 'NotImplemented'")
 }
 
+func addSignFlags(cmd *cobra.Command, opts *signOptions) {
+	cmd.PersistentFlags().StringVar(&opts.signingKey, "signing-key", "", "If set the written report is signed with the PEM encoded ed25519 or ECDSA private key from this file. The signature is written next to the report with a '.sig' suffix.")
+	cmd.PersistentFlags().StringVar(&opts.signatureFormat, "signature-format", string(report.SignatureFormatRaw), "Format of the report signature. Format can be one of 'raw' or 'dsse'.")
+}
+
+func addReportVerifyFlags(cmd *cobra.Command, opts *verifyOptions) {
+	cmd.PersistentFlags().StringVar(&opts.publicKey, "public-key", "", "PEM encoded ed25519 or ECDSA public key file of the expected signer.")
+	cmd.PersistentFlags().StringVar(&opts.signaturePath, "signature", "", "Signature file of the report. Defaults to the report path with a '.sig' suffix.")
+}
+
 func addReportDiffFlags(cmd *cobra.Command, opts *diffOptions) {
 	cmd.PersistentFlags().StringVar(&opts.oldReport, "old", "", "Old report path.")
 	cmd.PersistentFlags().StringVar(&opts.newReport, "new", "", "New report path.")
@@ -195,7 +309,36 @@ func addReportGenerateDiffFlags(cmd *cobra.Command, opts *generateDiffOptions) {
 	cmd.PersistentFlags().Var(cliflag.NewMapStringString(&opts.identityAttributes), "identity-attributes", "The keys are the IDs of the providers that will be present in the generated difference report and the values are metadata attributes to be used as identifiers.")
 }
 
-func showProviderCmd(args []string, metadataFuncs map[string]provider.MetadataFunc) error {
+func addReportApplyExceptionsFlags(cmd *cobra.Command, opts *applyExceptionsOptions) {
+	cmd.PersistentFlags().StringVar(&opts.exceptionsFile, "exceptions", "", "Exceptions file containing the accepted findings.")
+}
+
+func addReportExceptionsFlags(cmd *cobra.Command, opts *exceptionsOptions) {
+	cmd.PersistentFlags().StringVar(&opts.format, "format", "json", "Format for the output exceptions register. Format can be one of 'json' or 'csv'.")
+}
+
+func addReportSplitFlags(cmd *cobra.Command, opts *splitOptions) {
+	cmd.PersistentFlags().StringVar(&opts.ownersFile, "owners", "", "Owners file describing how findings are attributed to owners.")
+	cmd.PersistentFlags().StringVar(&opts.kubeconfigPath, "kubeconfig", "", "If set namespace and node metadata of the cluster are used to attribute findings to owners.")
+	cmd.PersistentFlags().StringVar(&opts.outputDir, "output-dir", "", "Directory in which the owner reports and the index are written.")
+	cmd.PersistentFlags().StringVar(&opts.format, "format", "html", "Format for the owner reports. Format can be one of 'html' or 'json'.")
+}
+
+func addReportProfileFlags(cmd *cobra.Command, opts *profileOptions) {
+	cmd.PersistentFlags().IntVar(&opts.top, "top", 10, "Number of the slowest rules to list. If not positive all rules are listed.")
+	cmd.PersistentFlags().StringVar(&opts.format, "format", "text", "Format for the output profile. Format can be one of 'text' or 'json'.")
+	cmd.PersistentFlags().StringVar(&opts.traceOutput, "trace-output", "", "If set the timeline of the run is written to this file in the Chrome trace event format.")
+}
+
+func addReportRedactFlags(cmd *cobra.Command, opts *redactOptions) {
+	cmd.PersistentFlags().StringSliceVar(&opts.targetKeys, "target-keys", report.DefaultRedactedTargetKeys, "Target keys whose values are replaced with pseudonyms.")
+	cmd.PersistentFlags().StringSliceVar(&opts.metadataKeys, "metadata-keys", nil, "Report and provider metadata keys whose values are replaced with pseudonyms.")
+	cmd.PersistentFlags().StringVar(&opts.keyFile, "key-file", "", "File containing the secret key from which pseudonyms are derived. Reports redacted with the same key have the same pseudonyms. If not set a random key is used and pseudonyms are only consistent within a single execution.")
+	cmd.PersistentFlags().StringVar(&opts.outputDir, "output-dir", "", "Directory in which the redacted reports are written with their original file names.")
+	cmd.PersistentFlags().StringVar(&opts.mappingOutput, "mapping-output", "", "If set the mapping of pseudonyms to original values is written to this file. It must not be shared.")
+}
+
+func showProviderCmd(args []string, r *registry.Registry) error {
 	if len(args) > 1 {
 		return errors.New("command 'show provider' accepts at most one provider")
 	}
@@ -203,8 +346,8 @@ func showProviderCmd(args []string, metadataFuncs map[string]provider.MetadataFu
 	if len(args) == 0 {
 		var providersMetadata []metadata.Provider
 
-		for providerID := range metadataFuncs {
-			providersMetadata = append(providersMetadata, metadata.Provider{ID: providerID, Name: metadataFuncs[providerID]().Name})
+		for _, providerType := range r.ProviderTypes() {
+			providersMetadata = append(providersMetadata, metadata.Provider{ID: providerType.ID(), Name: providerType.Name()})
 		}
 
 		if bytes, err := json.Marshal(providersMetadata); err != nil {
@@ -215,12 +358,12 @@ func showProviderCmd(args []string, metadataFuncs map[string]provider.MetadataFu
 		return nil
 	}
 
-	metadataFunc, ok := metadataFuncs[args[0]]
+	providerType, ok := r.ProviderType(args[0])
 	if !ok {
 		return fmt.Errorf("unknown provider: %s", args[0])
 	}
 
-	if bytes, err := json.Marshal(metadataFunc()); err != nil {
+	if bytes, err := json.Marshal(providerType.Metadata()); err != nil {
 		return err
 	} else {
 		fmt.Println(string(bytes))
@@ -282,8 +425,10 @@ func diffCmd(rootOpts reportOptions, opts diffOptions) error {
 	}
 
 	var (
-		oldReport report.Report
-		newReport report.Report
+		oldReport       report.Report
+		newReport       report.Report
+		oldReportDigest string
+		newReportDigest string
 	)
 
 	if len(opts.oldReport) > 0 {
@@ -295,6 +440,7 @@ func diffCmd(rootOpts reportOptions, opts diffOptions) error {
 		if err := json.Unmarshal(oldReportfileData, &oldReport); err != nil {
 			return fmt.Errorf("failed to unmarshal data: %w", err)
 		}
+		oldReportDigest = report.Digest(oldReportfileData)
 	}
 
 	if len(opts.newReport) > 0 {
@@ -306,12 +452,14 @@ func diffCmd(rootOpts reportOptions, opts diffOptions) error {
 		if err := json.Unmarshal(newReportfileData, &newReport); err != nil {
 			return fmt.Errorf("failed to unmarshal data: %w", err)
 		}
+		newReportDigest = report.Digest(newReportfileData)
 	}
 
 	diff, err := report.CreateDifference(oldReport, newReport, opts.title)
 	if err != nil {
 		return fmt.Errorf("failed to create diff: %w", err)
 	}
+	diff.OldReportDigest, diff.NewReportDigest = oldReportDigest, newReportDigest
 
 	jsonDiff, err := json.Marshal(diff)
 	if err != nil {
@@ -343,7 +491,10 @@ func generateCmd(args []string, rootOpts reportOptions, opts generateOptions, lo
 		}
 	}
 
-	var reports []*report.Report
+	var (
+		reports []*report.Report
+		digests []string
+	)
 	for _, arg := range args {
 		fileData, err := os.ReadFile(filepath.Clean(arg))
 		if err != nil {
@@ -358,6 +509,15 @@ func generateCmd(args []string, rootOpts reportOptions, opts generateOptions, lo
 
 		rep.SetMinStatus(minStatus)
 		reports = append(reports, rep)
+		digests = append(digests, report.Digest(fileData))
+	}
+
+	if len(opts.signingKey) > 0 && len(rootOpts.outputPath) == 0 {
+		return errors.New("--output is required when --signing-key is set")
+	}
+
+	if err := validateSignOptions(opts.signOptions); err != nil {
+		return err
 	}
 
 	var writer io.Writer = os.Stdout
@@ -383,9 +543,29 @@ func generateCmd(args []string, rootOpts reportOptions, opts generateOptions, lo
 			return err
 		}
 
+		mergedReport.InputDigests = digests
 		outputReport = mergedReport
 	}
 
+	if len(opts.groupBy) > 0 {
+		var (
+			pivotReport *report.PivotReport
+			err         error
+		)
+		switch rep := outputReport.(type) {
+		case *report.MergedReport:
+			pivotReport, err = rep.Pivot(report.PivotKey(opts.groupBy))
+		case *report.Report:
+			pivotReport, err = rep.Pivot(report.PivotKey(opts.groupBy))
+		}
+		if err != nil {
+			return err
+		}
+
+		outputReport = pivotReport
+	}
+
+	var output bytes.Buffer
 	switch opts.format {
 	case "html":
 		htmlRenderer, err := report.NewHTMLRenderer()
@@ -393,123 +573,611 @@ func generateCmd(args []string, rootOpts reportOptions, opts generateOptions, lo
 			return fmt.Errorf("failed to initialize renderer: %w", err)
 		}
 
-		return htmlRenderer.Render(writer, outputReport)
+		if err := htmlRenderer.Render(io.MultiWriter(writer, &output), outputReport); err != nil {
+			return err
+		}
 	case "json":
 		data, err := json.Marshal(outputReport)
 		if err != nil {
 			return err
 		}
 
-		_, err = writer.Write(data)
-		return err
+		if _, err := io.MultiWriter(writer, &output).Write(data); err != nil {
+			return err
+		}
 	default:
 		return fmt.Errorf("not supported output format %s. Choose one of 'html' or 'json'", opts.format)
 	}
+
+	return signReport(output.Bytes(), rootOpts.outputPath, opts.signOptions)
 }
 
-func runCmd(ctx context.Context, providerCreateFuncs map[string]provider.ProviderFromConfigFunc, opts runOptions, logger *slog.Logger) error {
-	// Set logger for controller-runtime clients
-	logr := slogr.NewLogr(logger)
-	logf.SetLogger(logr)
+func applyExceptionsCmd(args []string, rootOpts reportOptions, opts applyExceptionsOptions, logger *slog.Logger) error {
+	if len(args) != 1 {
+		return errors.New("apply-exceptions command requires a single filepath argument")
+	}
 
-	dikiConfig, err := readConfig(opts.configFile)
+	if len(opts.exceptionsFile) == 0 {
+		return errors.New("--exceptions is not set but required")
+	}
+
+	exceptions, err := readExceptions(opts.exceptionsFile)
 	if err != nil {
 		return err
 	}
 
-	outputPath := opts.outputPath
-	if len(outputPath) == 0 && dikiConfig.Output != nil && len(dikiConfig.Output.Path) > 0 {
-		outputPath = dikiConfig.Output.Path
+	fileData, err := os.ReadFile(filepath.Clean(args[0]))
+	if err != nil {
+		return fmt.Errorf("failed to read file %s: %w", args[0], err)
+	}
+
+	rep := &report.Report{}
+	if err := json.Unmarshal(fileData, rep); err != nil {
+		return fmt.Errorf("failed to unmarshal data: %w", err)
+	}
+
+	rep.ApplyExceptions(exceptions.Exceptions, time.Now())
+	for _, exception := range rep.ExpiredExceptions {
+		logger.Warn("exception has expired", "provider", exception.ProviderID, "ruleset", exception.RulesetID, "rule_id", exception.RuleID, "owner", exception.Owner, "ticket", exception.Ticket, "expires_at", exception.ExpiresAt)
+	}
+
+	if len(rootOpts.outputPath) > 0 {
+		return rep.WriteToFile(rootOpts.outputPath)
 	}
 
-	providers, err := getProvidersFromConfig(dikiConfig, providerCreateFuncs)
+	data, err := json.Marshal(rep)
 	if err != nil {
 		return err
 	}
 
-	if opts.all {
-		var providerResults []provider.ProviderResult
-		for _, p := range providers {
-			res, err := p.RunAll(ctx)
-			if err != nil {
-				return err
+	fmt.Print(string(data))
+	return nil
+}
+
+func exceptionsCmd(args []string, rootOpts reportOptions, opts exceptionsOptions, logger *slog.Logger) error {
+	if len(args) != 1 {
+		return errors.New("exceptions command requires a single filepath argument")
+	}
+
+	if opts.format != "json" && opts.format != "csv" {
+		return fmt.Errorf("not supported output format %s. Choose one of 'json' or 'csv'", opts.format)
+	}
+
+	fileData, err := os.ReadFile(filepath.Clean(args[0]))
+	if err != nil {
+		return fmt.Errorf("failed to read file %s: %w", args[0], err)
+	}
+
+	rep := &report.Report{}
+	if err := json.Unmarshal(fileData, rep); err != nil {
+		return fmt.Errorf("failed to unmarshal data: %w", err)
+	}
+
+	var writer io.Writer = os.Stdout
+	if len(rootOpts.outputPath) > 0 {
+		file, err := os.OpenFile(rootOpts.outputPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err := file.Close(); err != nil {
+				logger.Error(err.Error())
 			}
-			providerResults = append(providerResults, res)
+		}()
+		writer = file
+	}
+
+	register := report.NewExceptionsRegister(rep)
+	if opts.format == "csv" {
+		return register.WriteCSV(writer)
+	}
+
+	data, err := json.Marshal(register)
+	if err != nil {
+		return err
+	}
+
+	_, err = writer.Write(data)
+	return err
+}
+
+func profileCmd(args []string, rootOpts reportOptions, opts profileOptions, logger *slog.Logger) error {
+	if len(args) != 1 {
+		return errors.New("profile command requires a single filepath argument")
+	}
+
+	if opts.format != "text" && opts.format != "json" {
+		return fmt.Errorf("not supported output format %s. Choose one of 'text' or 'json'", opts.format)
+	}
+
+	fileData, err := os.ReadFile(filepath.Clean(args[0]))
+	if err != nil {
+		return fmt.Errorf("failed to read file %s: %w", args[0], err)
+	}
+
+	rep := &report.Report{}
+	if err := json.Unmarshal(fileData, rep); err != nil {
+		return fmt.Errorf("failed to unmarshal data: %w", err)
+	}
+
+	profile, err := report.NewProfile(rep, opts.top)
+	if err != nil {
+		return err
+	}
+
+	if len(opts.traceOutput) > 0 {
+		var trace bytes.Buffer
+		if err := report.WriteChromeTrace(&trace, rep); err != nil {
+			return err
 		}
+		if err := os.WriteFile(opts.traceOutput, trace.Bytes(), 0600); err != nil {
+			return fmt.Errorf("failed to write trace file %s: %w", opts.traceOutput, err)
+		}
+	}
 
-		if len(outputPath) > 0 {
-			var reportOpts []report.ReportOption
-			if dikiConfig.Output != nil && len(dikiConfig.Output.MinStatus) > 0 {
-				reportOpts = append(reportOpts, report.MinStatus(dikiConfig.Output.MinStatus))
-			}
-			if len(dikiConfig.Metadata) > 0 {
-				reportOpts = append(reportOpts, report.Metadata(dikiConfig.Metadata))
-			}
-			rep := report.FromProviderResults(providerResults, reportOpts...)
-			return rep.WriteToFile(outputPath)
+	var writer io.Writer = os.Stdout
+	if len(rootOpts.outputPath) > 0 {
+		file, err := os.OpenFile(rootOpts.outputPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+		if err != nil {
+			return err
 		}
+		defer func() {
+			if err := file.Close(); err != nil {
+				logger.Error(err.Error())
+			}
+		}()
+		writer = file
+	}
+
+	if opts.format == "text" {
+		return profile.WriteTable(writer)
+	}
+
+	data, err := json.Marshal(profile)
+	if err != nil {
+		return err
+	}
+
+	_, err = writer.Write(data)
+	return err
+}
+
+func verifyCmd(args []string, opts verifyOptions, logger *slog.Logger) error {
+	if len(args) != 1 {
+		return errors.New("verify command requires a single filepath argument")
+	}
+
+	if len(opts.publicKey) == 0 {
+		return errors.New("--public-key is not set but required")
+	}
+
+	verifier, err := report.NewVerifierFromFile(opts.publicKey)
+	if err != nil {
+		return err
+	}
+
+	data, err := os.ReadFile(filepath.Clean(args[0]))
+	if err != nil {
+		return fmt.Errorf("failed to read file %s: %w", args[0], err)
+	}
+
+	signaturePath := cmp.Or(opts.signaturePath, args[0]+signatureSuffix)
+	signature, err := os.ReadFile(filepath.Clean(signaturePath))
+	if err != nil {
+		return fmt.Errorf("failed to read file %s: %w", signaturePath, err)
+	}
+
+	if err := verifier.Verify(data, signature); err != nil {
+		return fmt.Errorf("failed to verify report %s: %w", args[0], err)
+	}
+
+	logger.Info("report signature verified", "report", args[0], "key_id", verifier.KeyID(), "digest", report.Digest(data))
+	return nil
+}
+
+const signatureSuffix = ".sig"
+
+// writeSignedReport writes the report to the given file path
+// and signs it if a signing key is configured.
+func writeSignedReport(rep *report.Report, outputPath string, opts signOptions) error {
+	data, err := json.Marshal(rep)
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(outputPath, data, 0600); err != nil {
+		return err
+	}
+
+	return signReport(data, outputPath, opts)
+}
+
+// validateSignOptions checks that the signing key can be loaded
+// and the signature format is supported before any report is created.
+func validateSignOptions(opts signOptions) error {
+	if len(opts.signingKey) == 0 {
 		return nil
 	}
 
-	p, ok := providers[opts.provider]
-	if !ok {
-		return fmt.Errorf("unknown provider: %s", opts.provider)
+	if format := report.SignatureFormat(opts.signatureFormat); format != report.SignatureFormatRaw && format != report.SignatureFormatDSSE {
+		return fmt.Errorf("not supported signature format %s. Choose one of 'raw' or 'dsse'", opts.signatureFormat)
 	}
 
-	switch {
-	case opts.rulesetID == "" && opts.rulesetVersion == "":
-		// run all rulesets for the provider
-		res, err := p.RunAll(ctx)
+	_, err := report.NewSignerFromFile(opts.signingKey)
+	return err
+}
+
+// signReport writes the signature of the report data next to the report file
+// if a signing key is configured.
+func signReport(data []byte, outputPath string, opts signOptions) error {
+	if len(opts.signingKey) == 0 {
+		return nil
+	}
+
+	signer, err := report.NewSignerFromFile(opts.signingKey)
+	if err != nil {
+		return err
+	}
+
+	signature, err := signer.Sign(data, filepath.Base(outputPath), report.SignatureFormat(opts.signatureFormat))
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(outputPath+signatureSuffix, signature, 0600)
+}
+
+func splitCmd(ctx context.Context, args []string, opts splitOptions, logger *slog.Logger) error {
+	if len(args) != 1 {
+		return errors.New("split command requires a single filepath argument")
+	}
+
+	if len(opts.ownersFile) == 0 {
+		return errors.New("--owners is not set but required")
+	}
+
+	if len(opts.outputDir) == 0 {
+		return errors.New("--output-dir is not set but required")
+	}
+
+	if opts.format != "html" && opts.format != "json" {
+		return fmt.Errorf("not supported output format %s. Choose one of 'html' or 'json'", opts.format)
+	}
+
+	owners, err := readOwners(opts.ownersFile)
+	if err != nil {
+		return err
+	}
+
+	resolver := &report.OwnerResolver{Owners: *owners}
+	if len(opts.kubeconfigPath) > 0 {
+		if err := setClusterOwners(ctx, opts.kubeconfigPath, resolver); err != nil {
+			return err
+		}
+	}
+
+	fileData, err := os.ReadFile(filepath.Clean(args[0]))
+	if err != nil {
+		return fmt.Errorf("failed to read file %s: %w", args[0], err)
+	}
+
+	rep := &report.Report{}
+	if err := json.Unmarshal(fileData, rep); err != nil {
+		return fmt.Errorf("failed to unmarshal data: %w", err)
+	}
+
+	if err := os.MkdirAll(opts.outputDir, 0700); err != nil {
+		return err
+	}
+
+	htmlRenderer, err := report.NewHTMLRenderer()
+	if err != nil {
+		return fmt.Errorf("failed to initialize renderer: %w", err)
+	}
+
+	ownerReports := rep.SplitByOwner(resolver)
+	ownerFileNames := report.OwnerFileNames(slices.Collect(maps.Keys(ownerReports)), opts.format)
+	ownerFileName := func(owner string) string {
+		return ownerFileNames[owner]
+	}
+
+	for owner, ownerReport := range ownerReports {
+		if err := writeReport(filepath.Join(opts.outputDir, ownerFileName(owner)), opts.format, ownerReport, htmlRenderer, logger); err != nil {
+			return err
+		}
+	}
+
+	index := report.NewOwnersIndex(ownerReports, ownerFileName)
+	return writeReport(filepath.Join(opts.outputDir, report.OwnersIndexName+"."+opts.format), opts.format, index, htmlRenderer, logger)
+}
+
+func redactCmd(args []string, opts redactOptions, logger *slog.Logger) error {
+	if len(args) == 0 {
+		return errors.New("redact command requires a minimum of one filepath argument")
+	}
+
+	if len(opts.outputDir) == 0 {
+		return errors.New("--output-dir is not set but required")
+	}
+
+	fileNames := map[string]struct{}{}
+	for _, arg := range args {
+		if _, ok := fileNames[filepath.Base(arg)]; ok {
+			return fmt.Errorf("multiple reports with file name %s", filepath.Base(arg))
+		}
+		fileNames[filepath.Base(arg)] = struct{}{}
+	}
+
+	var key []byte
+	if len(opts.keyFile) > 0 {
+		data, err := os.ReadFile(filepath.Clean(opts.keyFile))
 		if err != nil {
+			return fmt.Errorf("failed to read file %s: %w", opts.keyFile, err)
+		}
+		if key = bytes.TrimSpace(data); len(key) == 0 {
+			return fmt.Errorf("key file %s is empty", opts.keyFile)
+		}
+	} else {
+		key = make([]byte, 32)
+		if _, err := rand.Read(key); err != nil {
 			return err
 		}
-		providerResults := []provider.ProviderResult{res}
+	}
 
-		if len(outputPath) > 0 {
-			var reportOpts []report.ReportOption
-			if dikiConfig.Output != nil && len(dikiConfig.Output.MinStatus) > 0 {
-				reportOpts = append(reportOpts, report.MinStatus(dikiConfig.Output.MinStatus))
-			}
-			if len(dikiConfig.Metadata) > 0 {
-				reportOpts = append(reportOpts, report.Metadata(dikiConfig.Metadata))
+	if err := os.MkdirAll(opts.outputDir, 0700); err != nil {
+		return err
+	}
+
+	redactor := report.NewRedactor(key, opts.targetKeys, opts.metadataKeys)
+	for _, arg := range args {
+		fileData, err := os.ReadFile(filepath.Clean(arg))
+		if err != nil {
+			return fmt.Errorf("failed to read file %s: %w", arg, err)
+		}
+
+		rep := &report.Report{}
+		if err := json.Unmarshal(fileData, rep); err != nil {
+			return fmt.Errorf("failed to unmarshal data: %w", err)
+		}
+
+		redactor.Redact(rep)
+		outputPath := filepath.Join(opts.outputDir, filepath.Base(arg))
+		if err := rep.WriteToFile(outputPath); err != nil {
+			return err
+		}
+		logger.Info("redacted report written", "report", arg, "output", outputPath)
+	}
+
+	if len(opts.mappingOutput) > 0 {
+		data, err := json.Marshal(redactor.Pseudonyms())
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(opts.mappingOutput, data, 0600)
+	}
+	return nil
+}
+
+// writeReport writes the report to the given file path in html or json format.
+func writeReport(filePath, format string, rep any, htmlRenderer *report.HTMLRenderer, logger *slog.Logger) error {
+	file, err := os.OpenFile(filepath.Clean(filePath), os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := file.Close(); err != nil {
+			logger.Error(err.Error())
+		}
+	}()
+
+	if format == "html" {
+		return htmlRenderer.Render(file, rep)
+	}
+
+	data, err := json.Marshal(rep)
+	if err != nil {
+		return err
+	}
+
+	_, err = file.Write(data)
+	return err
+}
+
+// setClusterOwners sets the owners of namespaces and nodes of the cluster
+// determined by the configured labels and annotations.
+func setClusterOwners(ctx context.Context, kubeconfigPath string, resolver *report.OwnerResolver) error {
+	restConfig, err := kubeutils.RESTConfigFromFile(kubeconfigPath)
+	if err != nil {
+		return fmt.Errorf("failed to create rest config: %w", err)
+	}
+
+	c, err := client.New(restConfig, client.Options{})
+	if err != nil {
+		return err
+	}
+
+	if len(resolver.Owners.NamespaceLabel) > 0 || len(resolver.Owners.NamespaceAnnotation) > 0 {
+		namespaces, err := kubeutils.GetNamespaces(ctx, c)
+		if err != nil {
+			return err
+		}
+
+		resolver.NamespaceOwners = map[string]string{}
+		for name, namespace := range namespaces {
+			if owner, ok := namespace.Labels[resolver.Owners.NamespaceLabel]; ok && len(resolver.Owners.NamespaceLabel) > 0 {
+				resolver.NamespaceOwners[name] = owner
+			} else if owner, ok := namespace.Annotations[resolver.Owners.NamespaceAnnotation]; ok && len(resolver.Owners.NamespaceAnnotation) > 0 {
+				resolver.NamespaceOwners[name] = owner
 			}
-			rep := report.FromProviderResults(providerResults, reportOpts...)
-			return rep.WriteToFile(outputPath)
 		}
-		return nil
-	case opts.rulesetID != "" && opts.rulesetVersion == "":
-		return errors.New("--ruleset-version should be set along with --ruleset-id")
-	case opts.rulesetID == "" && opts.rulesetVersion != "":
-		return errors.New("--ruleset-id should be set along with --ruleset-version")
 	}
 
-	if opts.ruleID == "" {
-		// run the whole ruleset
-		res, err := p.RunRuleset(ctx, opts.rulesetID, opts.rulesetVersion)
+	if len(resolver.Owners.NodeLabel) > 0 {
+		nodes, err := kubeutils.GetNodes(ctx, c, 300)
 		if err != nil {
 			return err
 		}
-		providerResults := []provider.ProviderResult{{ProviderID: p.ID(), ProviderName: p.Name(), Metadata: p.Metadata(), RulesetResults: []ruleset.RulesetResult{res}}}
 
-		if len(outputPath) > 0 {
-			var reportOpts []report.ReportOption
-			if dikiConfig.Output != nil && len(dikiConfig.Output.MinStatus) > 0 {
-				reportOpts = append(reportOpts, report.MinStatus(dikiConfig.Output.MinStatus))
+		resolver.NodeOwners = map[string]string{}
+		for _, node := range nodes {
+			if owner, ok := node.Labels[resolver.Owners.NodeLabel]; ok {
+				resolver.NodeOwners[node.Name] = owner
 			}
-			if len(dikiConfig.Metadata) > 0 {
-				reportOpts = append(reportOpts, report.Metadata(dikiConfig.Metadata))
+		}
+	}
+	return nil
+}
+
+func runCmd(ctx context.Context, r *registry.Registry, opts runOptions, logger *slog.Logger) error {
+	// Set logger for controller-runtime clients
+	logr := slogr.NewLogr(logger)
+	logf.SetLogger(logr)
+
+	if err := validateSignOptions(opts.signOptions); err != nil {
+		return err
+	}
+
+	runnerOpts := []runner.Option{
+		runner.WithRegistry(r),
+		runner.WithLogger(logger),
+		runner.WithProvenanceConfigMode(report.ProvenanceConfigMode(opts.provenanceConfig)),
+	}
+
+	var (
+		dikiConfig *config.DikiConfig
+		err        error
+	)
+	if len(opts.fromSnapshot) > 0 {
+		s, err := snapshot.Load(opts.fromSnapshot)
+		if err != nil {
+			return fmt.Errorf("failed to read snapshot: %w", err)
+		}
+		if dikiConfig, err = readSnapshotConfig(s, opts.configFile); err != nil {
+			return err
+		}
+		runnerOpts = append(runnerOpts, runner.WithSnapshot(s))
+	} else if dikiConfig, err = readConfig(opts.configFile); err != nil {
+		return err
+	}
+
+	outputPath := opts.outputPath
+	if len(outputPath) == 0 && dikiConfig.Output != nil && len(dikiConfig.Output.Path) > 0 {
+		outputPath = dikiConfig.Output.Path
+	}
+
+	if len(opts.signingKey) > 0 && len(outputPath) == 0 {
+		return errors.New("--output is required when --signing-key is set")
+	}
+
+	if len(opts.evidenceDir) > 0 {
+		if len(outputPath) == 0 {
+			return errors.New("--evidence-dir requires an output path for the report")
+		}
+		evidenceBundle, err := report.NewEvidenceBundle(opts.evidenceDir, opts.evidenceMaxSize)
+		if err != nil {
+			return fmt.Errorf("failed to create evidence bundle: %w", err)
+		}
+		runnerOpts = append(runnerOpts, runner.WithEvidenceBundle(evidenceBundle))
+	}
+
+	if !opts.all {
+		switch {
+		case opts.provider == "":
+			return errors.New("--provider should be set when --all is not set")
+		case opts.rulesetID != "" && opts.rulesetVersion == "":
+			return errors.New("--ruleset-version should be set along with --ruleset-id")
+		case opts.rulesetID == "" && opts.rulesetVersion != "":
+			return errors.New("--ruleset-id should be set along with --ruleset-version")
+		case opts.rulesetID != "" && opts.ruleID != "":
+			return runRule(ctx, *dikiConfig, opts, runnerOpts)
+		}
+		runnerOpts = append(runnerOpts, runner.WithProvider(opts.provider), runner.WithRuleset(opts.rulesetID, opts.rulesetVersion))
+	}
+
+	rep, err := runner.Run(ctx, *dikiConfig, runnerOpts...)
+	if err != nil {
+		return err
+	}
+
+	if len(outputPath) > 0 {
+		return writeSignedReport(rep, outputPath, opts.signOptions)
+	}
+	return nil
+}
+
+// readSnapshotConfig returns the configuration from the file, if set, or the configuration of the snapshot.
+func readSnapshotConfig(s *snapshot.Snapshot, configFile string) (*config.DikiConfig, error) {
+	if len(configFile) > 0 {
+		return readConfig(configFile)
+	}
+	return s.DikiConfig()
+}
+
+func collectCmd(ctx context.Context, r *registry.Registry, opts collectOptions, logger *slog.Logger) error {
+	// Set logger for controller-runtime clients
+	logr := slogr.NewLogr(logger)
+	logf.SetLogger(logr)
+
+	if len(opts.outputPath) == 0 {
+		return errors.New("--output must be set")
+	}
+	if opts.format != "archive" && opts.format != "directory" {
+		return fmt.Errorf("not supported output format %s. Choose one of 'archive' or 'directory'", opts.format)
+	}
+
+	dikiConfig, err := readConfig(opts.configFile)
+	if err != nil {
+		return err
+	}
+
+	s := snapshot.New()
+	s.Manifest.DikiVersion = version.Get().GitVersion
+	if err := s.AddConfig(dikiConfig); err != nil {
+		return err
+	}
+
+	providers, err := r.NewProviders(dikiConfig, logger)
+	if err != nil {
+		return err
+	}
+	defer func(providers []provider.Provider) {
+		if err := provider.Close(providers...); err != nil {
+			logger.Error("failed to close providers", "error", err)
+		}
+	}(slices.Collect(maps.Values(providers)))
+	if len(opts.provider) > 0 {
+		p, ok := providers[opts.provider]
+		if !ok {
+			return fmt.Errorf("unknown provider: %s", opts.provider)
+		}
+		providers = map[string]provider.Provider{p.ID(): p}
+	}
+
+	ctx = snapshot.ContextWithSnapshot(ctx, s)
+	for _, p := range providers {
+		if _, err := p.RunAll(ctx); err != nil {
+			return err
+		}
+		if versioner, ok := p.(provider.ClusterVersioner); ok {
+			if _, err := versioner.ClusterVersions(ctx); err != nil {
+				logger.Error("failed to get cluster versions", "provider", p.ID(), "error", err)
 			}
-			rep := report.FromProviderResults(providerResults, reportOpts...)
-			return rep.WriteToFile(outputPath)
 		}
-		return nil
 	}
 
-	return runRule(ctx, p, opts.rulesetID, opts.rulesetVersion, opts.ruleID)
+	writeFn := s.WriteFile
+	if opts.format == "directory" {
+		writeFn = s.WriteDir
+	}
+	if err := writeFn(opts.outputPath); err != nil {
+		return fmt.Errorf("failed to write snapshot: %w", err)
+	}
+	logger.Info("collected snapshot", "path", opts.outputPath, "clusters", len(s.Clusters()))
+	return nil
 }
 
-func runRule(ctx context.Context, p provider.Provider, rulesetID, rulesetVersion, ruleID string) error {
-	res, err := p.RunRule(ctx, rulesetID, rulesetVersion, ruleID)
+func runRule(ctx context.Context, dikiConfig config.DikiConfig, opts runOptions, runnerOpts []runner.Option) error {
+	res, err := runner.RunRule(ctx, dikiConfig, opts.provider, opts.rulesetID, opts.rulesetVersion, opts.ruleID, runnerOpts...)
 	if err != nil {
 		return err
 	}
@@ -528,25 +1196,78 @@ type reportOptions struct {
 }
 
 type runOptions struct {
-	outputPath     string
-	configFile     string
-	all            bool
-	provider       string
-	rulesetID      string
-	rulesetVersion string
-	ruleID         string
+	signOptions
+	outputPath       string
+	configFile       string
+	all              bool
+	provider         string
+	rulesetID        string
+	rulesetVersion   string
+	ruleID           string
+	evidenceDir      string
+	evidenceMaxSize  int
+	provenanceConfig string
+	fromSnapshot     string
+}
+
+type collectOptions struct {
+	configFile string
+	outputPath string
+	format     string
+	provider   string
 }
 
 type generateOptions struct {
+	signOptions
 	distinctBy map[string]string
 	format     string
 	minStatus  string
+	groupBy    string
+}
+
+type signOptions struct {
+	signingKey      string
+	signatureFormat string
+}
+
+type verifyOptions struct {
+	publicKey     string
+	signaturePath string
 }
 
 type generateDiffOptions struct {
 	identityAttributes map[string]string
 }
 
+type applyExceptionsOptions struct {
+	exceptionsFile string
+}
+
+type exceptionsOptions struct {
+	format string
+}
+
+type profileOptions struct {
+	top         int
+	format      string
+	traceOutput string
+}
+
+type splitOptions struct {
+	ownersFile     string
+	kubeconfigPath string
+	outputDir      string
+	format         string
+}
+
+type redactOptions struct {
+	targetKeys    []string
+	metadataKeys  []string
+	keyFile       string
+	outputDir     string
+	mappingOutput string
+}
+
 type diffOptions struct {
 	oldReport string
 	newReport string
@@ -569,24 +1290,38 @@ func readConfig(filePath string) (*config.DikiConfig, error) {
 	return c, nil
 }
 
-func getProvidersFromConfig(c *config.DikiConfig, providerCreateFuncs map[string]provider.ProviderFromConfigFunc) (map[string]provider.Provider, error) {
-	providers := map[string]provider.Provider{}
-	rootPath := field.NewPath("providers")
+func readExceptions(filePath string) (*report.Exceptions, error) {
+	data, err := os.ReadFile(filepath.Clean(filePath))
+	if err != nil {
+		return nil, err
+	}
 
-	for providerIdx, providerConfig := range c.Providers {
-		if providerFunc, ok := providerCreateFuncs[providerConfig.ID]; ok {
-			p, err := providerFunc(providerConfig, rootPath.Index(providerIdx))
-			if err != nil {
-				return nil, err
-			}
-			if _, ok := providers[p.ID()]; ok {
-				return nil, fmt.Errorf("provider with id %s was already registered", p.ID())
-			}
-			providers[p.ID()] = p
-		} else {
-			return nil, fmt.Errorf("unknown provider identifier: %s", providerConfig.ID)
-		}
+	exceptions := &report.Exceptions{}
+	if err := yaml.Unmarshal(data, exceptions); err != nil {
+		return nil, err
+	}
+
+	if err := exceptions.Validate().ToAggregate(); err != nil {
+		return nil, err
+	}
+
+	return exceptions, nil
+}
+
+func readOwners(filePath string) (*report.Owners, error) {
+	data, err := os.ReadFile(filepath.Clean(filePath))
+	if err != nil {
+		return nil, err
+	}
+
+	owners := &report.Owners{}
+	if err := yaml.Unmarshal(data, owners); err != nil {
+		return nil, err
+	}
+
+	if err := owners.Validate().ToAggregate(); err != nil {
+		return nil, err
 	}
 
-	return providers, nil
+	return owners, nil
 }
@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ClusterAccessConfig describes how a provider accesses a Kubernetes cluster.
+// The credentials are taken from a kubeconfig file, from the service account
+// of the pod in which diki runs, or from a token file for an explicitly set server.
+type ClusterAccessConfig struct {
+	// KubeconfigPath is the path to a kubeconfig file. Exec plugins configured in the kubeconfig are supported.
+	KubeconfigPath string `json:"kubeconfigPath,omitempty" yaml:"kubeconfigPath,omitempty"`
+	// Context is the kubeconfig context which is used instead of the current context.
+	Context string `json:"context,omitempty" yaml:"context,omitempty"`
+	// InCluster enables the use of the service account credentials of the pod in which diki runs.
+	InCluster bool `json:"inCluster,omitempty" yaml:"inCluster,omitempty"`
+	// Server is the address of the Kubernetes API server. It overrides the server of the kubeconfig or in-cluster config.
+	Server string `json:"server,omitempty" yaml:"server,omitempty"`
+	// TokenFile is the path to a file containing a bearer token. The file is reread periodically.
+	TokenFile string `json:"tokenFile,omitempty" yaml:"tokenFile,omitempty"`
+	// CAFile is the path to a file containing the certificate authority of the API server.
+	CAFile string `json:"caFile,omitempty" yaml:"caFile,omitempty"`
+	// Impersonate configures the user which is impersonated in all requests.
+	Impersonate *ImpersonationConfig `json:"impersonate,omitempty" yaml:"impersonate,omitempty"`
+	// QPS is the maximum number of queries per second to the API server. Defaults to 20.
+	QPS *float32 `json:"qps,omitempty" yaml:"qps,omitempty"`
+	// Burst is the maximum burst of queries to the API server. Defaults to 40.
+	Burst *int `json:"burst,omitempty" yaml:"burst,omitempty"`
+}
+
+// ImpersonationConfig describes a user which is impersonated.
+type ImpersonationConfig struct {
+	// User is the name of the impersonated user.
+	User string `json:"user" yaml:"user"`
+	// UID is the uid of the impersonated user.
+	UID string `json:"uid,omitempty" yaml:"uid,omitempty"`
+	// Groups are the groups of the impersonated user.
+	Groups []string `json:"groups,omitempty" yaml:"groups,omitempty"`
+}
+
+// NewClusterAccessConfig returns the cluster access described by access or,
+// if access is not set, a cluster access using the kubeconfig at kubeconfigPath.
+// It allows providers to keep supporting their kubeconfig path arguments next to cluster access blocks.
+// Both must not be set at the same time.
+func NewClusterAccessConfig(access *ClusterAccessConfig, kubeconfigPath string, fldPath, kubeconfigPathFldPath *field.Path) (ClusterAccessConfig, field.ErrorList) {
+	switch {
+	case access != nil && len(kubeconfigPath) > 0:
+		return ClusterAccessConfig{}, field.ErrorList{field.Forbidden(kubeconfigPathFldPath, "must not be set together with "+fldPath.String())}
+	case access != nil:
+		return *access, access.Validate(fldPath)
+	case len(kubeconfigPath) == 0:
+		return ClusterAccessConfig{}, field.ErrorList{field.Required(fldPath, "either "+fldPath.String()+" or "+kubeconfigPathFldPath.String()+" must be set")}
+	default:
+		return ClusterAccessConfig{KubeconfigPath: kubeconfigPath}, nil
+	}
+}
+
+// Validate validates the cluster access configuration.
+func (c *ClusterAccessConfig) Validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	switch {
+	case len(c.KubeconfigPath) > 0 && c.InCluster:
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("inCluster"), "must not be set together with kubeconfigPath"))
+	case len(c.KubeconfigPath) == 0 && !c.InCluster && len(c.Server) == 0:
+		allErrs = append(allErrs, field.Required(fldPath, "one of kubeconfigPath, inCluster or server must be set"))
+	}
+
+	if len(c.Context) > 0 && len(c.KubeconfigPath) == 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("context"), "must not be set without kubeconfigPath"))
+	}
+
+	if c.Impersonate != nil && len(c.Impersonate.User) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("impersonate", "user"), "must not be empty"))
+	}
+
+	if c.QPS != nil && *c.QPS <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("qps"), *c.QPS, "must be greater than 0"))
+	}
+
+	if c.Burst != nil && *c.Burst <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("burst"), *c.Burst, "must be greater than 0"))
+	}
+
+	return allErrs
+}
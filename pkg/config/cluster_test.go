@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package config_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/diki/pkg/config"
+)
+
+var _ = Describe("ClusterAccessConfig", func() {
+	var fldPath = field.NewPath("cluster")
+
+	Describe("#Validate", func() {
+		It("should allow a kubeconfig with a context", func() {
+			access := config.ClusterAccessConfig{KubeconfigPath: "/tmp/kubeconfig", Context: "foo", QPS: ptr.To[float32](10), Burst: ptr.To(20)}
+			Expect(access.Validate(fldPath)).To(BeEmpty())
+		})
+
+		It("should allow in-cluster credentials and a server with a token file", func() {
+			Expect((&config.ClusterAccessConfig{InCluster: true}).Validate(fldPath)).To(BeEmpty())
+			Expect((&config.ClusterAccessConfig{Server: "https://foo", TokenFile: "/tmp/token"}).Validate(fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid invalid cluster access configurations", func() {
+			access := config.ClusterAccessConfig{
+				KubeconfigPath: "/tmp/kubeconfig",
+				InCluster:      true,
+				Impersonate:    &config.ImpersonationConfig{Groups: []string{"foo"}},
+				QPS:            ptr.To[float32](0),
+				Burst:          ptr.To(-1),
+			}
+
+			Expect(access.Validate(fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeForbidden),
+					"Field":  Equal("cluster.inCluster"),
+					"Detail": Equal("must not be set together with kubeconfigPath"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("cluster.impersonate.user"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("cluster.qps"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("cluster.burst"),
+				})),
+			))
+		})
+
+		It("should require a source of the credentials", func() {
+			Expect((&config.ClusterAccessConfig{Context: "foo"}).Validate(fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeRequired),
+					"Field":  Equal("cluster"),
+					"Detail": Equal("one of kubeconfigPath, inCluster or server must be set"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeForbidden),
+					"Field":  Equal("cluster.context"),
+					"Detail": Equal("must not be set without kubeconfigPath"),
+				})),
+			))
+		})
+	})
+
+	Describe("#NewClusterAccessConfig", func() {
+		kubeconfigPathFldPath := field.NewPath("kubeconfigPath")
+
+		It("should use the kubeconfig path when the cluster access is not set", func() {
+			access, errs := config.NewClusterAccessConfig(nil, "/tmp/kubeconfig", fldPath, kubeconfigPathFldPath)
+			Expect(errs).To(BeEmpty())
+			Expect(access).To(Equal(config.ClusterAccessConfig{KubeconfigPath: "/tmp/kubeconfig"}))
+		})
+
+		It("should validate the cluster access", func() {
+			_, errs := config.NewClusterAccessConfig(&config.ClusterAccessConfig{}, "", fldPath, kubeconfigPathFldPath)
+			Expect(errs.ToAggregate()).To(MatchError("cluster: Required value: one of kubeconfigPath, inCluster or server must be set"))
+		})
+
+		It("should forbid setting both the cluster access and the kubeconfig path", func() {
+			_, errs := config.NewClusterAccessConfig(&config.ClusterAccessConfig{InCluster: true}, "/tmp/kubeconfig", fldPath, kubeconfigPathFldPath)
+			Expect(errs.ToAggregate()).To(MatchError("kubeconfigPath: Forbidden: must not be set together with cluster"))
+		})
+
+		It("should require either the cluster access or the kubeconfig path", func() {
+			_, errs := config.NewClusterAccessConfig(nil, "", fldPath, kubeconfigPathFldPath)
+			Expect(errs.ToAggregate()).To(MatchError("cluster: Required value: either cluster or kubeconfigPath must be set"))
+		})
+	})
+})
@@ -4,6 +4,13 @@
 
 package config
 
+import (
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
 // DikiConfig is used to represent Diki configuration file.
 type DikiConfig struct {
 	// Providers is a list of all known providers.
@@ -12,6 +19,8 @@ type DikiConfig struct {
 	Metadata map[string]any `yaml:"metadata,omitempty"`
 	// Output describes options related to diki's output configuration.
 	Output *OutputConfig `yaml:"output,omitempty"`
+	// Acceptances describes options related to accepted rule checks.
+	Acceptances *AcceptancesConfig `yaml:"acceptances,omitempty"`
 }
 
 // ProviderConfig is used to describe and configure a provider.
@@ -26,6 +35,20 @@ type ProviderConfig struct {
 	Rulesets []RulesetConfig `yaml:"rulesets"`
 	// Args are provider specific arguments that each provider should be able to parse.
 	Args any `yaml:"args"`
+	// Plugin configures an executable which implements the provider.
+	// If it is set, the provider is not looked up among the built-in providers.
+	Plugin *PluginConfig `yaml:"plugin,omitempty"`
+}
+
+// PluginConfig describes an executable which implements a provider
+// by speaking the diki plugin protocol over its standard input and output.
+type PluginConfig struct {
+	// Command is the path of the plugin executable.
+	Command string `yaml:"command"`
+	// Args are passed to the plugin executable.
+	Args []string `yaml:"args,omitempty"`
+	// Env are environment variables which are added to the environment of the plugin.
+	Env map[string]string `yaml:"env,omitempty"`
 }
 
 // RulesetConfig is used to describe and configure a ruleset.
@@ -58,6 +81,48 @@ type RuleOptionSkipConfig struct {
 	Enabled bool `yaml:"enabled"`
 	// Justification represents the reason why a rule is skipped.
 	Justification string `yaml:"justification"`
+	// Owner is the person or team responsible for the skip.
+	Owner string `yaml:"owner,omitempty"`
+	// ExpiresAt is the time after which the skip is no longer valid.
+	ExpiresAt *time.Time `yaml:"expiresAt,omitempty"`
+}
+
+// Validate validates the rule options of the provider configuration
+// which are common to all providers.
+func (c *ProviderConfig) Validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for rulesetIdx, ruleset := range c.Rulesets {
+		ruleOptionsPath := fldPath.Child("rulesets").Index(rulesetIdx).Child("ruleOptions")
+		for optionIdx, opt := range ruleset.RuleOptions {
+			if opt.Skip != nil {
+				allErrs = append(allErrs, opt.Skip.Validate(ruleOptionsPath.Index(optionIdx).Child("skip"))...)
+			}
+		}
+	}
+	return allErrs
+}
+
+// Validate validates the skip configuration. Skips which expire must have an owner.
+func (c *RuleOptionSkipConfig) Validate(fldPath *field.Path) field.ErrorList {
+	return ValidateAcceptance(c.Owner, c.ExpiresAt, fldPath)
+}
+
+// ValidateAcceptance validates the owner and expiration time of an acceptance,
+// e.g. of a rule skip or of the accepted objects in rule options. Acceptances which expire must have an owner.
+func ValidateAcceptance(owner string, expiresAt *time.Time, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	switch {
+	case len(owner) > 0 && len(strings.TrimSpace(owner)) == 0:
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("owner"), owner, "must not be blank"))
+	case len(owner) == 0 && expiresAt != nil:
+		allErrs = append(allErrs, field.Required(fldPath.Child("owner"), "must be set when expiresAt is set"))
+	}
+
+	if expiresAt != nil && expiresAt.IsZero() {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("expiresAt"), expiresAt, "must not be zero"))
+	}
+	return allErrs
 }
 
 // OutputConfig represents output configurations.
@@ -68,3 +133,10 @@ type OutputConfig struct {
 	// MinStatus is the minimal status that diki will report.
 	MinStatus string `yaml:"minStatus"`
 }
+
+// AcceptancesConfig represents options related to accepted rule checks.
+type AcceptancesConfig struct {
+	// ExpirationGracePeriod is the period after the expiration of an acceptance during
+	// which the affected checks are reported with Warning status instead of Failed.
+	ExpirationGracePeriod time.Duration `yaml:"expirationGracePeriod"`
+}
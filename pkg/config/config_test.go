@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package config_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/diki/pkg/config"
+)
+
+var _ = Describe("ProviderConfig", func() {
+	var (
+		fldPath   = field.NewPath("providers").Index(0)
+		expiresAt = time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+		zero      = time.Time{}
+	)
+
+	Describe("#Validate", func() {
+		It("should allow skips with and without acceptance properties", func() {
+			providerConfig := config.ProviderConfig{
+				Rulesets: []config.RulesetConfig{{
+					RuleOptions: []config.RuleOptionsConfig{
+						{RuleID: "1"},
+						{RuleID: "2", Skip: &config.RuleOptionSkipConfig{Enabled: true}},
+						{RuleID: "3", Skip: &config.RuleOptionSkipConfig{Enabled: true, Owner: "foo", ExpiresAt: &expiresAt}},
+					},
+				}},
+			}
+
+			Expect(providerConfig.Validate(fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid invalid skip owners and expiries", func() {
+			providerConfig := config.ProviderConfig{
+				Rulesets: []config.RulesetConfig{
+					{},
+					{
+						RuleOptions: []config.RuleOptionsConfig{
+							{RuleID: "1", Skip: &config.RuleOptionSkipConfig{Enabled: true, Owner: "  "}},
+							{RuleID: "2", Skip: &config.RuleOptionSkipConfig{Enabled: true, ExpiresAt: &expiresAt}},
+							{RuleID: "3", Skip: &config.RuleOptionSkipConfig{Enabled: true, Owner: "foo", ExpiresAt: &zero}},
+						},
+					},
+				},
+			}
+
+			Expect(providerConfig.Validate(fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("providers[0].rulesets[1].ruleOptions[0].skip.owner"),
+					"Detail": Equal("must not be blank"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeRequired),
+					"Field":  Equal("providers[0].rulesets[1].ruleOptions[1].skip.owner"),
+					"Detail": Equal("must be set when expiresAt is set"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("providers[0].rulesets[1].ruleOptions[2].skip.expiresAt"),
+					"Detail": Equal("must not be zero"),
+				})),
+			))
+		})
+	})
+})
@@ -66,24 +66,42 @@ func GetSingleFileStats(
 	podExecutor pod.PodExecutor,
 	filePath string,
 ) (FileStats, error) {
-	stats := FileStats{}
-	delimiter := "\t"
-	statsRaw, err := podExecutor.Execute(ctx, "/bin/sh", fmt.Sprintf(`stat -Lc "%%a%[1]s%%u%[1]s%%g%[1]s%%F%[1]s%%n" %s`, delimiter, filePath))
+	fileStats, errs, err := GetFilesStats(ctx, podExecutor, []string{filePath})
 	if err != nil {
-		return stats, err
-	}
-	if len(statsRaw) == 0 {
-		return stats, fmt.Errorf("could not find file %s", filePath)
+		return FileStats{}, err
 	}
+	return fileStats[0], errs[0]
+}
 
-	stat := strings.Split(strings.TrimSpace(statsRaw), "\n")[0]
+// GetFilesStats returns file stats for the specified files by executing a single batch of commands.
+// The returned stats and errors are in the order of the file paths.
+func GetFilesStats(
+	ctx context.Context,
+	podExecutor pod.PodExecutor,
+	filePaths []string,
+) ([]FileStats, []error, error) {
+	commands := make([]string, 0, len(filePaths))
+	for _, filePath := range filePaths {
+		commands = append(commands, fileStatsCommand(filePath))
+	}
 
-	stats, err = NewFileStats(stat, delimiter)
+	results, err := pod.ExecuteBatch(ctx, podExecutor, commands)
 	if err != nil {
-		return stats, err
+		return nil, nil, err
 	}
 
-	return stats, nil
+	var (
+		fileStats = make([]FileStats, len(filePaths))
+		errs      = make([]error, len(filePaths))
+	)
+	for i, result := range results {
+		if result.Err != nil {
+			errs[i] = result.Err
+			continue
+		}
+		fileStats[i], errs[i] = parseSingleFileStats(filePaths[i], result.Stdout)
+	}
+	return fileStats, errs, nil
 }
 
 // GetFileStatsByDir returns file stats for files in a specific directory
@@ -92,41 +110,96 @@ func GetFileStatsByDir(
 	podExecutor pod.PodExecutor,
 	dirPath string,
 ) ([]FileStats, error) {
-	var fileStats []FileStats
-	delimiter := "\t"
-	statsRaw, err := podExecutor.Execute(ctx, "/bin/sh", fmt.Sprintf(`find %s -type f -exec stat -Lc "%%a%[2]s%%u%[2]s%%g%[2]s%%F%[2]s%%n" {} \;`, dirPath, delimiter))
+	fileStats, errs, err := GetFileStatsByDirs(ctx, podExecutor, []string{dirPath})
 	if err != nil {
-		return fileStats, err
+		return nil, err
 	}
-	if len(statsRaw) == 0 {
-		fileNum, err := podExecutor.Execute(ctx, "/bin/sh", fmt.Sprintf(`find %s -type f | wc -l`, dirPath))
-		if err != nil {
-			return fileStats, err
+	return fileStats[0], errs[0]
+}
+
+// GetFileStatsByDirs returns file stats for files in the specified directories by executing a single batch of commands.
+// Directories without file stats are checked for files with an additional batch.
+// The returned stats and errors are in the order of the directory paths.
+func GetFileStatsByDirs(
+	ctx context.Context,
+	podExecutor pod.PodExecutor,
+	dirPaths []string,
+) ([][]FileStats, []error, error) {
+	commands := make([]string, 0, len(dirPaths))
+	for _, dirPath := range dirPaths {
+		commands = append(commands, fmt.Sprintf(`find %s -type f -exec stat -Lc "%%a%[2]s%%u%[2]s%%g%[2]s%%F%[2]s%%n" {} \;`, dirPath, fileStatsDelimiter))
+	}
+
+	results, err := pod.ExecuteBatch(ctx, podExecutor, commands)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		fileStats  = make([][]FileStats, len(dirPaths))
+		errs       = make([]error, len(dirPaths))
+		emptyDirs  []int
+		countFiles []string
+	)
+	for i, result := range results {
+		if result.Err != nil {
+			errs[i] = result.Err
+			continue
+		}
+		if len(result.Stdout) == 0 {
+			emptyDirs = append(emptyDirs, i)
+			countFiles = append(countFiles, fmt.Sprintf(`find %s -type f | wc -l`, dirPaths[i]))
+			continue
 		}
 
-		if fileNum != "0\n" {
-			return fileStats, fmt.Errorf("could not find files in %s", dirPath)
+		for _, fileStatString := range strings.Split(strings.TrimSpace(result.Stdout), "\n") {
+			fileStat, err := NewFileStats(fileStatString, fileStatsDelimiter)
+			if err != nil {
+				continue
+			}
+			fileStats[i] = append(fileStats[i], fileStat)
 		}
-		return fileStats, nil
 	}
 
-	statsRawSlice := strings.Split(strings.TrimSpace(statsRaw), "\n")
-	for _, fileStatString := range statsRawSlice {
-		fileStat, err2 := NewFileStats(fileStatString, delimiter)
-		if err2 != nil {
-			err = errors.Join(err, err2)
-			continue
+	if len(countFiles) == 0 {
+		return fileStats, errs, nil
+	}
+
+	results, err = pod.ExecuteBatch(ctx, podExecutor, countFiles)
+	if err != nil {
+		return nil, nil, err
+	}
+	for j, result := range results {
+		i := emptyDirs[j]
+		switch {
+		case result.Err != nil:
+			errs[i] = result.Err
+		case result.Stdout != "0\n":
+			errs[i] = fmt.Errorf("could not find files in %s", dirPaths[i])
 		}
+	}
+	return fileStats, errs, nil
+}
+
+const fileStatsDelimiter = "\t"
+
+func fileStatsCommand(filePath string) string {
+	return fmt.Sprintf(`stat -Lc "%%a%[1]s%%u%[1]s%%g%[1]s%%F%[1]s%%n" %s`, fileStatsDelimiter, filePath)
+}
 
-		fileStats = append(fileStats, fileStat)
+func parseSingleFileStats(filePath, statsRaw string) (FileStats, error) {
+	if len(statsRaw) == 0 {
+		return FileStats{}, fmt.Errorf("could not find file %s", filePath)
 	}
 
-	return fileStats, nil
+	stat := strings.Split(strings.TrimSpace(statsRaw), "\n")[0]
+	return NewFileStats(stat, fileStatsDelimiter)
 }
 
 // GetMountedFilesStats returns file stats grouped by container name for all
 // mounted files in a pod with the exception of files mounted at `/dev/termination-log` destination.
 // Host sources can be excluded by setting excludeSources.
+// The mounts of all containers and the stats of all mounted directories are retrieved with batches of commands.
 func GetMountedFilesStats(
 	ctx context.Context,
 	podExecutorRootPath string,
@@ -134,23 +207,75 @@ func GetMountedFilesStats(
 	pod corev1.Pod,
 	excludeSources []string,
 ) (map[string][]FileStats, error) {
-	stats := map[string][]FileStats{}
-	var err error
+	var (
+		stats              = map[string][]FileStats{}
+		err                error
+		containers         []corev1.Container
+		containerIDs       []string
+		excludedSourcesSet = sets.New(excludeSources...)
+	)
 
 	for _, container := range slices.Concat(pod.Spec.Containers, pod.Spec.InitContainers) {
-		containerStats, err2 := getContainerMountedFileStatResults(ctx,
-			podExecutorRootPath,
-			podExecutor,
-			pod,
-			container,
-			excludeSources,
-		)
+		containerID, err2 := GetContainerID(pod, container.Name)
 		if err2 != nil {
 			err = errors.Join(err, err2)
+			continue
+		}
+		containers = append(containers, container)
+		containerIDs = append(containerIDs, containerID)
+	}
+	if len(containers) == 0 {
+		return stats, err
+	}
+
+	containersMounts, errs, err2 := GetContainersMounts(ctx, podExecutorRootPath, podExecutor, containerIDs)
+	if err2 != nil {
+		return stats, errors.Join(err, err2)
+	}
+
+	type containerMount struct {
+		container   string
+		destination string
+	}
+	var (
+		selectedMounts []containerMount
+		sources        []string
+	)
+	for i, container := range containers {
+		if errs[i] != nil {
+			err = errors.Join(err, errs[i])
+			continue
 		}
+		for _, mount := range containersMounts[i] {
+			if strings.HasPrefix(mount.Source, "/") &&
+				!matchHostPathSources(excludedSourcesSet, mount.Destination, container, pod) &&
+				isMountRequiredByContainer(mount.Destination, container) &&
+				mount.Destination != "/dev/termination-log" {
+				selectedMounts = append(selectedMounts, containerMount{container: container.Name, destination: mount.Destination})
+				sources = append(sources, mount.Source)
+			}
+		}
+	}
+	if len(selectedMounts) == 0 {
+		return stats, err
+	}
+
+	mountsFileStats, errs, err2 := GetFileStatsByDirs(ctx, podExecutor, sources)
+	if err2 != nil {
+		return stats, errors.Join(err, err2)
+	}
 
-		if len(containerStats) > 0 {
-			stats[container.Name] = containerStats
+	for i, mount := range selectedMounts {
+		if errs[i] != nil {
+			err = errors.Join(err, errs[i])
+			continue
+		}
+
+		for j := range mountsFileStats[i] {
+			mountsFileStats[i][j].Destination = mount.destination
+		}
+		if len(mountsFileStats[i]) > 0 {
+			stats[mount.container] = append(stats[mount.container], mountsFileStats[i]...)
 		}
 	}
 
@@ -197,62 +322,55 @@ func GetContainerMounts(
 	podExecutor pod.PodExecutor,
 	containerID string,
 ) ([]config.Mount, error) {
-	commandResult, err := podExecutor.Execute(ctx, "/bin/sh", fmt.Sprintf(`%s/usr/local/bin/nerdctl --namespace k8s.io inspect --mode=native %s | jq -r .[0].Spec.mounts`, podExecutorRootPath, containerID))
+	commandResult, err := podExecutor.Execute(ctx, "/bin/sh", containerMountsCommand(podExecutorRootPath, containerID))
 	if err != nil {
 		return nil, err
 	}
-
-	var mounts []config.Mount
-	err = json.Unmarshal([]byte(commandResult), &mounts)
-	if err != nil {
-		return nil, err
-	}
-
-	return mounts, nil
+	return parseContainerMounts(commandResult)
 }
 
-func getContainerMountedFileStatResults(
+// GetContainersMounts returns the container mounts of multiple containers by executing a single batch of commands.
+// The returned mounts and errors are in the order of the container IDs.
+func GetContainersMounts(
 	ctx context.Context,
 	podExecutorRootPath string,
 	podExecutor pod.PodExecutor,
-	pod corev1.Pod,
-	container corev1.Container,
-	excludedSources []string,
-) ([]FileStats, error) {
-	var (
-		stats []FileStats
-		err   error
-	)
+	containerIDs []string,
+) ([][]config.Mount, []error, error) {
+	commands := make([]string, 0, len(containerIDs))
+	for _, containerID := range containerIDs {
+		commands = append(commands, containerMountsCommand(podExecutorRootPath, containerID))
+	}
 
-	containerID, err := GetContainerID(pod, container.Name)
+	results, err := pod.ExecuteBatch(ctx, podExecutor, commands)
 	if err != nil {
-		return stats, err
+		return nil, nil, err
 	}
 
-	mounts, err := GetContainerMounts(ctx, podExecutorRootPath, podExecutor, containerID)
-	if err != nil {
-		return stats, err
+	var (
+		mounts = make([][]config.Mount, len(containerIDs))
+		errs   = make([]error, len(containerIDs))
+	)
+	for i, result := range results {
+		if result.Err != nil {
+			errs[i] = result.Err
+			continue
+		}
+		mounts[i], errs[i] = parseContainerMounts(result.Stdout)
 	}
-	excludedSourcesSet := sets.New(excludedSources...)
+	return mounts, errs, nil
+}
 
-	for _, mount := range mounts {
-		if strings.HasPrefix(mount.Source, "/") &&
-			!matchHostPathSources(excludedSourcesSet, mount.Destination, container, pod) &&
-			isMountRequiredByContainer(mount.Destination, container) &&
-			mount.Destination != "/dev/termination-log" {
-			mountFileStats, err2 := GetFileStatsByDir(ctx, podExecutor, mount.Source)
-			if err2 != nil {
-				err = errors.Join(err, err2)
-				continue
-			}
+func containerMountsCommand(podExecutorRootPath, containerID string) string {
+	return fmt.Sprintf(`%s/usr/local/bin/nerdctl --namespace k8s.io inspect --mode=native %s | jq -r .[0].Spec.mounts`, podExecutorRootPath, containerID)
+}
 
-			for i := range mountFileStats {
-				mountFileStats[i].Destination = mount.Destination
-			}
-			stats = append(stats, mountFileStats...)
-		}
+func parseContainerMounts(commandResult string) ([]config.Mount, error) {
+	var mounts []config.Mount
+	if err := json.Unmarshal([]byte(commandResult), &mounts); err != nil {
+		return nil, err
 	}
-	return stats, err
+	return mounts, nil
 }
 
 func isMountRequiredByContainer(destination string, container corev1.Container) bool {
@@ -314,6 +432,23 @@ func ExceedFilePermissions(filePermissions, filePermissionsMax string) (bool, er
 	return fileModePermission&^fileModePermissionsMax != 0, nil
 }
 
+// MatchFilePermissionsCase returns the rule.CheckResult for a given file and its permissions
+// for the given maximum permissions.
+func MatchFilePermissionsCase(fileStats FileStats, expectedFilePermissionsMax string, target rule.Target) rule.CheckResult {
+	exceedFilePermissions, err := ExceedFilePermissions(fileStats.Permissions, expectedFilePermissionsMax)
+	if err != nil {
+		return rule.ErroredCheckResult(err.Error(), target)
+	}
+
+	if exceedFilePermissions {
+		detailedTarget := target.With("details", fmt.Sprintf("fileName: %s, permissions: %s, expectedPermissionsMax: %s", fileStats.Path, fileStats.Permissions, expectedFilePermissionsMax))
+		return rule.FailedCheckResult("File has too wide permissions", detailedTarget)
+	}
+
+	detailedTarget := target.With("details", fmt.Sprintf("fileName: %s, permissions: %s", fileStats.Path, fileStats.Permissions))
+	return rule.PassedCheckResult("File has expected permissions", detailedTarget)
+}
+
 // MatchFileOwnersCases returns []rule.CheckResult for a given file and its owners for a select expected values.
 func MatchFileOwnersCases(
 	fileStats FileStats,
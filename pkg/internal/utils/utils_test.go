@@ -89,6 +89,50 @@ var _ = Describe("utils", func() {
 				nil, MatchError("bar")),
 		)
 	})
+	Describe("#GetFilesStats", func() {
+		It("Should return stats and errors in the order of the files", func() {
+			fakePodExecutor := fakepod.NewFakePodExecutor(
+				[]string{"600\t0\t1000\tregular file\t/foo", "", "", "644\t0\t0\tregular file\t/baz"},
+				[]error{nil, errors.New("foo"), nil, nil},
+			)
+
+			result, errs, err := utils.GetFilesStats(context.TODO(), fakePodExecutor, []string{"/foo", "/bar", "/qux", "/baz"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal([]utils.FileStats{
+				{Path: "/foo", Permissions: "600", UserOwner: "0", GroupOwner: "1000", FileType: "regular file"},
+				{},
+				{},
+				{Path: "/baz", Permissions: "644", UserOwner: "0", GroupOwner: "0", FileType: "regular file"},
+			}))
+			Expect(errs).To(HaveLen(4))
+			Expect(errs[0]).ToNot(HaveOccurred())
+			Expect(errs[1]).To(MatchError("foo"))
+			Expect(errs[2]).To(MatchError("could not find file /qux"))
+			Expect(errs[3]).ToNot(HaveOccurred())
+		})
+	})
+	Describe("#GetFileStatsByDirs", func() {
+		It("Should count files only in directories without stats", func() {
+			fakePodExecutor := fakepod.NewFakePodExecutor(
+				[]string{"", "600\t0\t1000\tregular file\t/bar/file", "", "", "0\n", "1\n"},
+				[]error{nil, nil, errors.New("foo"), nil, nil, nil},
+			)
+
+			result, errs, err := utils.GetFileStatsByDirs(context.TODO(), fakePodExecutor, []string{"/foo", "/bar", "/baz", "/qux"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal([][]utils.FileStats{
+				nil,
+				{{Path: "/bar/file", Permissions: "600", UserOwner: "0", GroupOwner: "1000", FileType: "regular file"}},
+				nil,
+				nil,
+			}))
+			Expect(errs).To(HaveLen(4))
+			Expect(errs[0]).ToNot(HaveOccurred())
+			Expect(errs[1]).ToNot(HaveOccurred())
+			Expect(errs[2]).To(MatchError("foo"))
+			Expect(errs[3]).To(MatchError("could not find files in /qux"))
+		})
+	})
 	Describe("#GetMountedFilesStats", func() {
 		const (
 			mounts = `[
@@ -188,7 +232,7 @@ var _ = Describe("utils", func() {
 		})
 
 		It("Should return correct single stats", func() {
-			executeReturnString := []string{mounts, destinationStats, mounts}
+			executeReturnString := []string{mounts, mounts, destinationStats}
 			executeReturnError := []error{nil, nil, nil}
 			fakePodExecutor = fakepod.NewFakePodExecutor(executeReturnString, executeReturnError)
 			result, err := utils.GetMountedFilesStats(ctx, "", fakePodExecutor, pod, []string{"/lib/modules"})
@@ -201,7 +245,7 @@ var _ = Describe("utils", func() {
 			pod.Spec.Containers[0].VolumeMounts = append(pod.Spec.Containers[0].VolumeMounts, corev1.VolumeMount{
 				MountPath: "/foo",
 			})
-			executeReturnString := []string{mounts, destinationStats, fooStats, mounts}
+			executeReturnString := []string{mounts, mounts, destinationStats, fooStats}
 			executeReturnError := []error{nil, nil, nil, nil}
 			fakePodExecutor = fakepod.NewFakePodExecutor(executeReturnString, executeReturnError)
 			result, err := utils.GetMountedFilesStats(ctx, "", fakePodExecutor, pod, []string{"/lib/modules"})
@@ -214,7 +258,7 @@ var _ = Describe("utils", func() {
 			pod.Spec.InitContainers[0].VolumeMounts = append(pod.Spec.InitContainers[0].VolumeMounts, corev1.VolumeMount{
 				MountPath: "/foo",
 			})
-			executeReturnString := []string{mounts, destinationStats, mounts, fooStats}
+			executeReturnString := []string{mounts, mounts, destinationStats, fooStats}
 			executeReturnError := []error{nil, nil, nil, nil}
 			fakePodExecutor = fakepod.NewFakePodExecutor(executeReturnString, executeReturnError)
 			result, err := utils.GetMountedFilesStats(ctx, "", fakePodExecutor, pod, []string{"/lib/modules"})
@@ -227,7 +271,7 @@ var _ = Describe("utils", func() {
 			pod.Spec.Containers[0].VolumeMounts = append(pod.Spec.Containers[0].VolumeMounts, corev1.VolumeMount{
 				MountPath: "/foo",
 			})
-			executeReturnString := []string{mounts, destinationStats, "", "2\n", mounts}
+			executeReturnString := []string{mounts, mounts, destinationStats, "", "2\n"}
 			executeReturnError := []error{nil, nil, nil, nil, nil}
 			fakePodExecutor = fakepod.NewFakePodExecutor(executeReturnString, executeReturnError)
 			result, err := utils.GetMountedFilesStats(ctx, "", fakePodExecutor, pod, []string{"/lib/modules"})
@@ -240,7 +284,7 @@ var _ = Describe("utils", func() {
 			pod.Spec.Containers[0].VolumeMounts = append(pod.Spec.Containers[0].VolumeMounts, corev1.VolumeMount{
 				MountPath: "/foo",
 			})
-			executeReturnString := []string{mounts, destinationStats, "", "0\n", mounts}
+			executeReturnString := []string{mounts, mounts, destinationStats, "", "0\n"}
 			executeReturnError := []error{nil, nil, nil, nil, nil}
 			fakePodExecutor = fakepod.NewFakePodExecutor(executeReturnString, executeReturnError)
 			result, err := utils.GetMountedFilesStats(ctx, "", fakePodExecutor, pod, []string{"/lib/modules"})
@@ -297,8 +341,8 @@ var _ = Describe("utils", func() {
 			pod.Spec.Containers[0].VolumeMounts = append(pod.Spec.Containers[0].VolumeMounts, corev1.VolumeMount{
 				MountPath: "/foo",
 			})
-			executeReturnString := []string{mounts, destinationStats, fooStats, mounts}
-			executeReturnError := []error{nil, errors.New("command error"), nil, nil}
+			executeReturnString := []string{mounts, mounts, destinationStats, fooStats}
+			executeReturnError := []error{nil, nil, errors.New("command error"), nil}
 			fakePodExecutor = fakepod.NewFakePodExecutor(executeReturnString, executeReturnError)
 			result, err := utils.GetMountedFilesStats(ctx, "", fakePodExecutor, pod, []string{"/lib/modules"})
 
@@ -420,6 +464,23 @@ var _ = Describe("utils", func() {
 		)
 	})
 
+	Describe("#MatchFilePermissionsCase", func() {
+		DescribeTable("#MatchCases",
+			func(fileStats utils.FileStats, expectedFilePermissionsMax string, expectedResult rule.CheckResult) {
+				Expect(utils.MatchFilePermissionsCase(fileStats, expectedFilePermissionsMax, rule.NewTarget())).To(Equal(expectedResult))
+			},
+			Entry("should return passed when permissions do not exceed the maximum",
+				utils.FileStats{Permissions: "600", Path: "/foo/bar/file.txt"}, "644",
+				rule.PassedCheckResult("File has expected permissions", rule.NewTarget("details", "fileName: /foo/bar/file.txt, permissions: 600"))),
+			Entry("should return failed when permissions exceed the maximum",
+				utils.FileStats{Permissions: "664", Path: "/foo/bar/file.txt"}, "644",
+				rule.FailedCheckResult("File has too wide permissions", rule.NewTarget("details", "fileName: /foo/bar/file.txt, permissions: 664, expectedPermissionsMax: 644"))),
+			Entry("should return errored when permissions cannot be parsed",
+				utils.FileStats{Permissions: "foo", Path: "/foo/bar/file.txt"}, "644",
+				rule.ErroredCheckResult("strconv.ParseUint: parsing \"foo\": invalid syntax", rule.NewTarget())),
+		)
+	})
+
 	Describe("#MatchFileOwnersCases", func() {
 		var (
 			target = rule.NewTarget()
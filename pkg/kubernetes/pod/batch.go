@@ -0,0 +1,135 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package pod
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
+)
+
+// CommandResult is the result of a single command of a batch.
+type CommandResult struct {
+	Stdout   string
+	Stderr   string
+	ExitCode int
+	// Err is set if the command could not be executed,
+	// wrote to stderr or terminated with a non-zero exit code.
+	Err error
+}
+
+// BatchPodExecutor executes multiple commands inside a pod with a single request.
+type BatchPodExecutor interface {
+	// ExecuteBatch runs every command with /bin/sh and returns the results in the order of the commands.
+	// An error is only returned if the batch itself could not be executed.
+	ExecuteBatch(ctx context.Context, commands []string) ([]CommandResult, error)
+}
+
+// ExecuteBatch runs the commands with a single request if the executor implements [BatchPodExecutor].
+// Otherwise every command is executed separately with /bin/sh.
+func ExecuteBatch(ctx context.Context, podExecutor PodExecutor, commands []string) ([]CommandResult, error) {
+	if batchExecutor, ok := podExecutor.(BatchPodExecutor); ok {
+		return batchExecutor.ExecuteBatch(ctx, commands)
+	}
+
+	results := make([]CommandResult, 0, len(commands))
+	for _, command := range commands {
+		stdout, err := podExecutor.Execute(ctx, "/bin/sh", command)
+		results = append(results, CommandResult{Stdout: stdout, Err: err})
+	}
+	return results, nil
+}
+
+var (
+	_ BatchPodExecutor = &SimplePodExecutor{}
+	_ BatchPodExecutor = &ScriptBatchExecutor{}
+)
+
+// ExecuteBatch runs all commands in a single exec request.
+func (spe *SimplePodExecutor) ExecuteBatch(ctx context.Context, commands []string) ([]CommandResult, error) {
+	return NewScriptBatchExecutor(spe).ExecuteBatch(ctx, commands)
+}
+
+// ScriptBatchExecutor executes batches as a single /bin/sh script with a [PodExecutor].
+type ScriptBatchExecutor struct {
+	podExecutor PodExecutor
+}
+
+// NewScriptBatchExecutor creates a new ScriptBatchExecutor.
+func NewScriptBatchExecutor(podExecutor PodExecutor) *ScriptBatchExecutor {
+	return &ScriptBatchExecutor{podExecutor: podExecutor}
+}
+
+// ExecuteBatch runs all commands with a single call of the pod executor.
+func (sbe *ScriptBatchExecutor) ExecuteBatch(ctx context.Context, commands []string) ([]CommandResult, error) {
+	if len(commands) == 0 {
+		return nil, nil
+	}
+
+	delimiter := "diki-batch-" + utilrand.String(16)
+	output, err := sbe.podExecutor.Execute(ctx, "/bin/sh", batchScript(delimiter, commands))
+	if err != nil {
+		return nil, err
+	}
+	return parseBatchOutput(delimiter, commands, output)
+}
+
+// batchScript creates a script which runs every command in a subshell and
+// writes its stdout, exit code and stderr separated by delimiter lines.
+func batchScript(delimiter string, commands []string) string {
+	var script strings.Builder
+	script.WriteString("__diki_stderr=$(mktemp)\n")
+	for i, command := range commands {
+		fmt.Fprintf(&script, "(\n%s\n) </dev/null 2>\"$__diki_stderr\"\n", command)
+		fmt.Fprintf(&script, "printf '\\n%s %d %%d\\n' \"$?\"\n", delimiter, i)
+		script.WriteString("cat \"$__diki_stderr\"\n")
+		fmt.Fprintf(&script, "printf '\\n%s %d stderr\\n'\n", delimiter, i)
+	}
+	script.WriteString("rm -f \"$__diki_stderr\"\nexit 0\n")
+	return script.String()
+}
+
+func parseBatchOutput(delimiter string, commands []string, output string) ([]CommandResult, error) {
+	results := make([]CommandResult, 0, len(commands))
+	for i, command := range commands {
+		exitMarker := fmt.Sprintf("\n%s %d ", delimiter, i)
+		idx := strings.Index(output, exitMarker)
+		if idx < 0 {
+			return nil, fmt.Errorf("batch output does not contain the result of command %d", i)
+		}
+		result := CommandResult{Stdout: output[:idx]}
+		output = output[idx+len(exitMarker):]
+
+		exitCodeText, rest, ok := strings.Cut(output, "\n")
+		if !ok {
+			return nil, fmt.Errorf("batch output does not contain the exit code of command %d", i)
+		}
+		exitCode, err := strconv.Atoi(exitCodeText)
+		if err != nil {
+			return nil, fmt.Errorf("batch output contains invalid exit code of command %d: %w", i, err)
+		}
+		result.ExitCode = exitCode
+
+		stderrMarker := fmt.Sprintf("\n%s %d stderr\n", delimiter, i)
+		idx = strings.Index(rest, stderrMarker)
+		if idx < 0 {
+			return nil, fmt.Errorf("batch output does not contain the stderr of command %d", i)
+		}
+		result.Stderr = rest[:idx]
+		output = rest[idx+len(stderrMarker):]
+
+		switch {
+		case len(result.Stderr) > 0:
+			result.Err = fmt.Errorf("command /bin/sh %s stderr output: %s", command, result.Stderr)
+		case result.ExitCode != 0:
+			result.Err = fmt.Errorf("command /bin/sh %s terminated with exit code %d", command, result.ExitCode)
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
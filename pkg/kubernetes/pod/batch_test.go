@@ -0,0 +1,108 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package pod_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os/exec"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/diki/pkg/kubernetes/pod"
+	fakepod "github.com/gardener/diki/pkg/kubernetes/pod/fake"
+)
+
+// shellPodExecutor runs commands with the local shell.
+type shellPodExecutor struct {
+	calls int
+}
+
+func (e *shellPodExecutor) Execute(ctx context.Context, command string, commandArg string) (string, error) {
+	e.calls++
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, command)
+	cmd.Stdin = strings.NewReader(commandArg)
+	cmd.Stdout, cmd.Stderr = &stdout, &stderr
+	if err := cmd.Run(); err != nil {
+		return "", err
+	}
+	if stderr.Len() > 0 {
+		return "", errors.New(stderr.String())
+	}
+	return stdout.String(), nil
+}
+
+// batchShellPodExecutor runs batches of commands with the local shell.
+type batchShellPodExecutor struct {
+	*shellPodExecutor
+}
+
+func (e *batchShellPodExecutor) ExecuteBatch(ctx context.Context, commands []string) ([]pod.CommandResult, error) {
+	return pod.NewScriptBatchExecutor(e.shellPodExecutor).ExecuteBatch(ctx, commands)
+}
+
+var _ = Describe("batch", func() {
+	ctx := context.Background()
+
+	Describe("#ScriptBatchExecutor", func() {
+		It("should run all commands with a single execution", func() {
+			shellExecutor := &shellPodExecutor{}
+
+			results, err := pod.NewScriptBatchExecutor(shellExecutor).ExecuteBatch(ctx, []string{
+				"echo foo",
+				"printf 'bar\\nbaz'",
+				"echo error >&2",
+				"exit 3",
+				"cat",
+				"echo one\necho two",
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(shellExecutor.calls).To(Equal(1))
+
+			Expect(results).To(HaveLen(6))
+			Expect(results[0]).To(Equal(pod.CommandResult{Stdout: "foo\n"}))
+			Expect(results[1]).To(Equal(pod.CommandResult{Stdout: "bar\nbaz"}))
+			Expect(results[2].Stdout).To(BeEmpty())
+			Expect(results[2].Stderr).To(Equal("error\n"))
+			Expect(results[2].Err).To(MatchError("command /bin/sh echo error >&2 stderr output: error\n"))
+			Expect(results[3].ExitCode).To(Equal(3))
+			Expect(results[3].Err).To(MatchError("command /bin/sh exit 3 terminated with exit code 3"))
+			Expect(results[4]).To(Equal(pod.CommandResult{}))
+			Expect(results[5]).To(Equal(pod.CommandResult{Stdout: "one\ntwo\n"}))
+		})
+
+		It("should not execute empty batches", func() {
+			shellExecutor := &shellPodExecutor{}
+
+			results, err := pod.NewScriptBatchExecutor(shellExecutor).ExecuteBatch(ctx, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(results).To(BeEmpty())
+			Expect(shellExecutor.calls).To(Equal(0))
+		})
+	})
+
+	Describe("#ExecuteBatch", func() {
+		It("should use the batch execution of the executor", func() {
+			shellExecutor := &shellPodExecutor{}
+
+			results, err := pod.ExecuteBatch(ctx, &batchShellPodExecutor{shellExecutor}, []string{"echo foo", "echo bar"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(results).To(Equal([]pod.CommandResult{{Stdout: "foo\n"}, {Stdout: "bar\n"}}))
+			Expect(shellExecutor.calls).To(Equal(1))
+		})
+
+		It("should execute every command separately if the executor does not support batches", func() {
+			fakeExecutor := fakepod.NewFakePodExecutor([]string{"foo", ""}, []error{nil, errors.New("bar")})
+
+			results, err := pod.ExecuteBatch(ctx, fakeExecutor, []string{"echo foo", "echo bar"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(results).To(Equal([]pod.CommandResult{{Stdout: "foo"}, {Err: errors.New("bar")}}))
+		})
+	})
+})
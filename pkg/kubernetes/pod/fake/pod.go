@@ -18,6 +18,7 @@ type FakeSimplePodContext struct {
 	executeReturnString [][]string
 	executeReturnError  [][]error
 	createCount         int
+	podName             string
 }
 
 // NewFakeSimplePodContext creates a new FakeSimplePodContext.
@@ -28,6 +29,13 @@ func NewFakeSimplePodContext(executeReturnString [][]string, executeReturnError
 	}
 }
 
+// WithPodName configures the FakeSimplePodContext to return executors
+// which report the given pod name, like the executors of a node pod pool.
+func (mspc *FakeSimplePodContext) WithPodName(podName string) *FakeSimplePodContext {
+	mspc.podName = podName
+	return mspc
+}
+
 // Create returns the preset values.
 func (mspc *FakeSimplePodContext) Create(_ context.Context, _ func() *corev1.Pod) (pod.PodExecutor, error) {
 	if mspc.createCount >= len(mspc.executeReturnString) {
@@ -37,7 +45,11 @@ func (mspc *FakeSimplePodContext) Create(_ context.Context, _ func() *corev1.Pod
 		return nil, errors.New("not enough return errors have been faked")
 	}
 	mspc.createCount++
-	return NewFakePodExecutor(mspc.executeReturnString[mspc.createCount-1], mspc.executeReturnError[mspc.createCount-1]), nil
+	podExecutor := NewFakePodExecutor(mspc.executeReturnString[mspc.createCount-1], mspc.executeReturnError[mspc.createCount-1])
+	if len(mspc.podName) > 0 {
+		return &FakeNamedPodExecutor{FakePodExecutor: podExecutor, podName: mspc.podName}, nil
+	}
+	return podExecutor, nil
 }
 
 // Delete always returns nil.
@@ -72,3 +84,16 @@ func (mpe *FakePodExecutor) Execute(_ context.Context, _ string, _ string) (stri
 	mpe.executeCount++
 	return mpe.executeReturnString[mpe.executeCount-1], mpe.executeReturnError[mpe.executeCount-1]
 }
+
+// FakeNamedPodExecutor is a FakePodExecutor which reports the name of its pod.
+type FakeNamedPodExecutor struct {
+	*FakePodExecutor
+	podName string
+}
+
+var _ pod.NamedPodExecutor = &FakeNamedPodExecutor{}
+
+// PodName returns the preset pod name.
+func (mnpe *FakeNamedPodExecutor) PodName() string {
+	return mnpe.podName
+}
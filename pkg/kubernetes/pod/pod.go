@@ -31,12 +31,51 @@ type PodExecutor interface {
 	Execute(ctx context.Context, command string, commandArg string) (string, error)
 }
 
+// NamedPodExecutor executes commands inside a pod and knows the name of that pod.
+type NamedPodExecutor interface {
+	PodExecutor
+	// PodName returns the name of the pod which executes the commands.
+	PodName() string
+}
+
+// PodName returns the name of the pod which executes the commands of the executor.
+// The given name is returned if the executor does not implement [NamedPodExecutor].
+func PodName(podExecutor PodExecutor, name string) string {
+	if namedExecutor, ok := podExecutor.(NamedPodExecutor); ok {
+		return namedExecutor.PodName()
+	}
+	return name
+}
+
+// OpsPodCounter counts the ops pods created while it is carried by the request context.
+type OpsPodCounter interface {
+	AddOpsPod()
+}
+
+type opsPodCounterContextKey struct{}
+
+// ContextWithOpsPodCounter returns a context which carries the given [OpsPodCounter].
+func ContextWithOpsPodCounter(ctx context.Context, counter OpsPodCounter) context.Context {
+	return context.WithValue(ctx, opsPodCounterContextKey{}, counter)
+}
+
+func countOpsPod(ctx context.Context) {
+	if counter, ok := ctx.Value(opsPodCounterContextKey{}).(OpsPodCounter); ok && counter != nil {
+		counter.AddOpsPod()
+	}
+}
+
 // PodContext creates and deletes Pods.
 type PodContext interface {
 	Create(ctx context.Context, podConstructorFn func() *corev1.Pod) (PodExecutor, error)
 	Delete(ctx context.Context, name, namespace string) error
 }
 
+// PodHealthChecker reports whether a pod created by a [PodContext] can still execute commands.
+type PodHealthChecker interface {
+	Healthy(ctx context.Context, name, namespace string) (bool, error)
+}
+
 // SimplePodExecutor can execute commands in a pod.
 type SimplePodExecutor struct {
 	name      string
@@ -49,6 +88,11 @@ type SimplePodExecutor struct {
 	WaitTimeout time.Duration
 }
 
+var (
+	_ NamedPodExecutor = &SimplePodExecutor{}
+	_ PodHealthChecker = &SimplePodContext{}
+)
+
 // SimplePodContext can create and delete pods.
 type SimplePodContext struct {
 	client client.Client
@@ -88,6 +132,7 @@ func (spc *SimplePodContext) Create(ctx context.Context, podConstructorFn func()
 	if err := spc.client.Create(ctx, pod); err != nil {
 		return nil, err
 	}
+	countOpsPod(ctx)
 
 	name := pod.Name
 	namespace := pod.Namespace
@@ -118,6 +163,19 @@ func (spc *SimplePodContext) Delete(ctx context.Context, name, namespace string)
 	return spc.waitPodDeleted(ctx, name, namespace)
 }
 
+// Healthy returns false if the pod does not exist anymore, is being deleted or is not Running.
+func (spc *SimplePodContext) Healthy(ctx context.Context, name, namespace string) (bool, error) {
+	pod := &corev1.Pod{}
+	if err := spc.client.Get(ctx, client.ObjectKey{Name: name, Namespace: namespace}, pod); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return pod.DeletionTimestamp == nil && pod.Status.Phase == corev1.PodRunning, nil
+}
+
 // NewPodExecutor creates a new SimplePodExecutor.
 func NewPodExecutor(client client.Client, config *rest.Config, name, namespace string) (*SimplePodExecutor, error) {
 	return &SimplePodExecutor{
@@ -130,6 +188,11 @@ func NewPodExecutor(client client.Client, config *rest.Config, name, namespace s
 	}, nil
 }
 
+// PodName returns the name of the pod.
+func (spe *SimplePodExecutor) PodName() string {
+	return spe.name
+}
+
 // Execute runs a command is a pod.
 func (spe *SimplePodExecutor) Execute(ctx context.Context, command string, commandArg string) (string, error) {
 	client, err := corev1client.NewForConfig(spe.config)
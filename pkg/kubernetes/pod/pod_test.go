@@ -16,6 +16,7 @@ import (
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"github.com/gardener/diki/pkg/kubernetes/pod"
+	"github.com/gardener/diki/pkg/rule"
 )
 
 var _ = Describe("pod", func() {
@@ -56,6 +57,16 @@ var _ = Describe("pod", func() {
 			Expect(err).To(BeNil())
 		})
 
+		It("should count the created pod", func() {
+			spc, err := pod.NewSimplePodContext(fakeClient, fakeConfig, map[string]string{})
+			Expect(err).To(BeNil())
+
+			stats := &rule.RunStats{}
+			_, err = spc.Create(pod.ContextWithOpsPodCounter(ctx, stats), fakePodConstructor(name, namespace, ""))
+			Expect(err).To(BeNil())
+			Expect(stats.OpsPods()).To(Equal(1))
+		})
+
 		It("should create diki pod with correct labels", func() {
 			spc, err := pod.NewSimplePodContext(fakeClient, fakeConfig, map[string]string{
 				"foo":     "not-bar",
@@ -117,6 +128,25 @@ var _ = Describe("pod", func() {
 			err = fakeClient.Get(ctx, client.ObjectKeyFromObject(pod), pod)
 			Expect(err).To(MatchError("pods \"foo\" not found"))
 		})
+
+		It("should report the health of diki pods", func() {
+			spc, err := pod.NewSimplePodContext(fakeClient, fakeConfig, map[string]string{})
+			Expect(err).To(BeNil())
+
+			executor, err := spc.Create(ctx, fakePodConstructor(name, namespace, ""))
+			Expect(err).To(BeNil())
+			Expect(pod.PodName(executor, "bar")).To(Equal(name))
+			Expect(spc.Healthy(ctx, name, namespace)).To(BeTrue())
+
+			failedPod := &corev1.Pod{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Name: name, Namespace: namespace}, failedPod)).To(Succeed())
+			failedPod.Status.Phase = corev1.PodFailed
+			Expect(fakeClient.Status().Update(ctx, failedPod)).To(Succeed())
+			Expect(spc.Healthy(ctx, name, namespace)).To(BeFalse())
+
+			Expect(spc.Delete(ctx, name, namespace)).To(Succeed())
+			Expect(spc.Healthy(ctx, name, namespace)).To(BeFalse())
+		})
 	})
 })
 
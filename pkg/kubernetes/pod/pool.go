@@ -0,0 +1,279 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package pod
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
+
+	"github.com/gardener/diki/pkg/snapshot"
+)
+
+const (
+	// DefaultNodePodLifetime is the time after which pooled node pods terminate
+	// if they are not deleted when the pool is closed.
+	DefaultNodePodLifetime = time.Hour
+
+	nodeSelectorHostnameKey = "kubernetes.io/hostname"
+)
+
+// NodePodPool lazily creates one privileged pod per node which is shared
+// by all rules run with the same context. Pods are deleted when the pool is closed.
+type NodePodPool struct {
+	// PodLifetime is the time after which pooled pods terminate on their own.
+	PodLifetime time.Duration
+	mux         sync.Mutex
+	closed      bool
+	pods        map[nodePodKey]*nodePod
+}
+
+type nodePodKey struct {
+	cluster, namespace, node, image string
+}
+
+type nodePod struct {
+	mux        sync.Mutex
+	name       string
+	namespace  string
+	executor   NamedPodExecutor
+	podContext PodContext
+}
+
+type nodePodPoolContextKey struct{}
+
+// NewNodePodPool creates a new empty NodePodPool.
+func NewNodePodPool() *NodePodPool {
+	return &NodePodPool{
+		PodLifetime: DefaultNodePodLifetime,
+		pods:        map[nodePodKey]*nodePod{},
+	}
+}
+
+// ContextWithNodePodPool returns a context which carries the given [NodePodPool].
+func ContextWithNodePodPool(ctx context.Context, pool *NodePodPool) context.Context {
+	return context.WithValue(ctx, nodePodPoolContextKey{}, pool)
+}
+
+// NodePodPoolFromContext returns the [NodePodPool] of the context or nil if there is none.
+func NodePodPoolFromContext(ctx context.Context) *NodePodPool {
+	pool, _ := ctx.Value(nodePodPoolContextKey{}).(*NodePodPool)
+	return pool
+}
+
+// Close deletes all pods of the pool concurrently. Pods requested after the pool is closed are not pooled.
+func (p *NodePodPool) Close(ctx context.Context) error {
+	p.mux.Lock()
+	p.closed = true
+	pods := p.pods
+	p.pods = map[nodePodKey]*nodePod{}
+	p.mux.Unlock()
+
+	var (
+		err   error
+		errMu sync.Mutex
+		wg    sync.WaitGroup
+	)
+	for _, np := range pods {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			np.mux.Lock()
+			defer np.mux.Unlock()
+
+			if np.executor == nil {
+				return
+			}
+			if deleteErr := np.podContext.Delete(ctx, np.name, np.namespace); deleteErr != nil {
+				errMu.Lock()
+				err = errors.Join(err, fmt.Errorf("failed to delete pod %s/%s: %w", np.namespace, np.name, deleteErr))
+				errMu.Unlock()
+			}
+			np.executor = nil
+		}()
+	}
+	wg.Wait()
+	return err
+}
+
+// acquire returns the executor of the pooled pod for a key, creating the pod on first use.
+// Pods which are no longer healthy are replaced. Failed creations are not cached. It returns false if the pool is closed.
+func (p *NodePodPool) acquire(ctx context.Context, key nodePodKey, podContext PodContext, pod *corev1.Pod) (NamedPodExecutor, bool, error) {
+	p.mux.Lock()
+	if p.closed {
+		p.mux.Unlock()
+		return nil, false, nil
+	}
+	np, ok := p.pods[key]
+	if !ok {
+		np = &nodePod{}
+		p.pods[key] = np
+	}
+	p.mux.Unlock()
+
+	np.mux.Lock()
+	defer np.mux.Unlock()
+
+	if np.executor != nil {
+		if np.healthy(ctx) {
+			return np.executor, true, nil
+		}
+		np.evict()
+	}
+
+	pod.Name = fmt.Sprintf("diki-node-%s", utilrand.String(10))
+	setPrivilegedPodLifetime(pod, int64(p.PodLifetime.Seconds()))
+
+	executor, err := podContext.Create(ctx, func() *corev1.Pod { return pod })
+	if err != nil {
+		// the pod might have been created without becoming healthy
+		deleteCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		if deleteErr := podContext.Delete(deleteCtx, pod.Name, pod.Namespace); deleteErr != nil {
+			err = errors.Join(err, deleteErr)
+		}
+		return nil, true, err
+	}
+
+	np.name, np.namespace, np.executor, np.podContext = pod.Name, pod.Namespace, &namedPodExecutor{PodExecutor: executor, name: pod.Name}, podContext
+	return np.executor, true, nil
+}
+
+// healthy returns false if the pod context reports that the pod can no longer execute commands.
+// Pods of pod contexts which do not implement [PodHealthChecker] and pods whose health cannot be determined are considered healthy.
+func (np *nodePod) healthy(ctx context.Context) bool {
+	checker, ok := np.podContext.(PodHealthChecker)
+	if !ok {
+		return true
+	}
+	healthy, err := checker.Healthy(ctx, np.name, np.namespace)
+	return err != nil || healthy
+}
+
+// evict removes the executor of an unhealthy pod and deletes the pod, if it still exists.
+func (np *nodePod) evict() {
+	deleteCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	// the pod is replaced regardless of the deletion, which is only best effort
+	_ = np.podContext.Delete(deleteCtx, np.name, np.namespace)
+	np.executor = nil
+}
+
+var _ PodContext = &PooledPodContext{}
+
+// PooledPodContext creates privileged node pods through the [NodePodPool] of the request context, if there is one.
+// Other pods are created by the wrapped [PodContext].
+// Deleting a pod served from the pool is a no-op, since it is deleted when the pool is closed.
+// If the request context carries a [snapshot.Snapshot], the results of executed commands and the names of
+// the pooled pods are recorded in it or, when a snapshot is evaluated, served from it without creating pods.
+type PooledPodContext struct {
+	podContext PodContext
+	cluster    string
+	mux        sync.Mutex
+	pooled     map[string]struct{}
+}
+
+// NewPooledPodContext wraps a PodContext so that privileged node pods are reused.
+// All pod contexts created with the same cluster name share the pods of a pool.
+func NewPooledPodContext(podContext PodContext, cluster string) *PooledPodContext {
+	return &PooledPodContext{
+		podContext: podContext,
+		cluster:    cluster,
+		pooled:     map[string]struct{}{},
+	}
+}
+
+// Create returns an executor of the pooled pod of the node for privileged pods
+// which are bound to a node. Other pods are created by the wrapped PodContext.
+// The name of the pod which actually executes the commands is returned by [PodName].
+func (ppc *PooledPodContext) Create(ctx context.Context, podConstructorFn func() *corev1.Pod) (PodExecutor, error) {
+	pod := podConstructorFn()
+	node := pod.Spec.NodeSelector[nodeSelectorHostnameKey]
+
+	s := snapshot.FromContext(ctx)
+	if s.Replay() {
+		ppc.markPooled(pod.Namespace, pod.Name)
+		opsPodName, ok := s.OpsPod(ppc.cluster, node, pod.Namespace)
+		if !ok {
+			opsPodName = pod.Name
+		}
+		return &namedPodExecutor{PodExecutor: NewSnapshotPodExecutor(s, ppc.cluster, node), name: opsPodName}, nil
+	}
+
+	executor, err := ppc.create(ctx, pod)
+	if err != nil || s == nil {
+		return executor, err
+	}
+	opsPodName := PodName(executor, pod.Name)
+	if opsPodName != pod.Name {
+		s.AddOpsPod(ppc.cluster, node, pod.Namespace, opsPodName)
+	}
+	return &namedPodExecutor{PodExecutor: NewRecordingPodExecutor(executor, s, ppc.cluster, node), name: opsPodName}, nil
+}
+
+func (ppc *PooledPodContext) create(ctx context.Context, pod *corev1.Pod) (PodExecutor, error) {
+	pool := NodePodPoolFromContext(ctx)
+	node, ok := pod.Spec.NodeSelector[nodeSelectorHostnameKey]
+	if pool == nil || !ok || len(node) == 0 || pod.Labels[LabelComplianceRoleKey] != LabelComplianceRolePrivPod || len(pod.Spec.Containers) == 0 {
+		return ppc.podContext.Create(ctx, func() *corev1.Pod { return pod })
+	}
+
+	key := nodePodKey{cluster: ppc.cluster, namespace: pod.Namespace, node: node, image: pod.Spec.Containers[0].Image}
+	executor, pooled, err := pool.acquire(ctx, key, ppc.podContext, pod.DeepCopy())
+	if !pooled {
+		return ppc.podContext.Create(ctx, func() *corev1.Pod { return pod })
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ppc.markPooled(pod.Namespace, pod.Name)
+	return executor, nil
+}
+
+func (ppc *PooledPodContext) markPooled(namespace, name string) {
+	ppc.mux.Lock()
+	defer ppc.mux.Unlock()
+	ppc.pooled[namespace+"/"+name] = struct{}{}
+}
+
+// Delete deletes a pod by the wrapped PodContext unless it was served from the pool or a snapshot.
+func (ppc *PooledPodContext) Delete(ctx context.Context, name, namespace string) error {
+	ppc.mux.Lock()
+	_, ok := ppc.pooled[namespace+"/"+name]
+	delete(ppc.pooled, namespace+"/"+name)
+	ppc.mux.Unlock()
+
+	if ok {
+		return nil
+	}
+	return ppc.podContext.Delete(ctx, name, namespace)
+}
+
+// namedPodExecutor returns the name of the pod which executes the commands of a wrapped executor.
+type namedPodExecutor struct {
+	PodExecutor
+	name string
+}
+
+var (
+	_ NamedPodExecutor = &namedPodExecutor{}
+	_ BatchPodExecutor = &namedPodExecutor{}
+)
+
+// PodName returns the name of the pod.
+func (e *namedPodExecutor) PodName() string {
+	return e.name
+}
+
+// ExecuteBatch runs the commands with the wrapped executor.
+func (e *namedPodExecutor) ExecuteBatch(ctx context.Context, commands []string) ([]CommandResult, error) {
+	return ExecuteBatch(ctx, e.PodExecutor, commands)
+}
@@ -0,0 +1,242 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package pod_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sync"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/gardener/diki/pkg/kubernetes/pod"
+	"github.com/gardener/diki/pkg/snapshot"
+)
+
+type recordingPodContext struct {
+	mux       sync.Mutex
+	createErr error
+	created   []*corev1.Pod
+	deleted   []string
+	// unhealthy contains the pods which are reported as unhealthy in the form namespace/name
+	unhealthy map[string]bool
+}
+
+type namedPodExecutor struct {
+	name string
+}
+
+func (e *namedPodExecutor) Execute(_ context.Context, _ string, _ string) (string, error) {
+	return e.name, nil
+}
+
+func (rpc *recordingPodContext) Create(_ context.Context, podConstructorFn func() *corev1.Pod) (pod.PodExecutor, error) {
+	rpc.mux.Lock()
+	defer rpc.mux.Unlock()
+
+	p := podConstructorFn()
+	rpc.created = append(rpc.created, p)
+	if rpc.createErr != nil {
+		return nil, rpc.createErr
+	}
+	return &namedPodExecutor{name: p.Name}, nil
+}
+
+func (rpc *recordingPodContext) Healthy(_ context.Context, name, namespace string) (bool, error) {
+	rpc.mux.Lock()
+	defer rpc.mux.Unlock()
+
+	return !rpc.unhealthy[namespace+"/"+name], nil
+}
+
+func (rpc *recordingPodContext) Delete(_ context.Context, name, namespace string) error {
+	rpc.mux.Lock()
+	defer rpc.mux.Unlock()
+
+	rpc.deleted = append(rpc.deleted, namespace+"/"+name)
+	return nil
+}
+
+var _ = Describe("pool", func() {
+	var (
+		podContext *recordingPodContext
+		pool       *pod.NodePodPool
+		ctx        context.Context
+	)
+
+	BeforeEach(func() {
+		podContext = &recordingPodContext{}
+		pool = pod.NewNodePodPool()
+		ctx = pod.ContextWithNodePodPool(context.Background(), pool)
+	})
+
+	It("should create pods with the wrapped pod context when there is no pool in the context", func() {
+		ppc := pod.NewPooledPodContext(podContext, "foo")
+
+		_, err := ppc.Create(context.Background(), pod.NewPrivilegedPod("foo", "kube-system", "image", "node1", nil))
+		Expect(err).ToNot(HaveOccurred())
+		_, err = ppc.Create(context.Background(), pod.NewPrivilegedPod("bar", "kube-system", "image", "node1", nil))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ppc.Delete(context.Background(), "foo", "kube-system")).To(Succeed())
+
+		Expect(podContext.created).To(HaveLen(2))
+		Expect(podContext.deleted).To(Equal([]string{"kube-system/foo"}))
+	})
+
+	It("should reuse one pod per node", func() {
+		ppc := pod.NewPooledPodContext(podContext, "foo")
+
+		executor1, err := ppc.Create(ctx, pod.NewPrivilegedPod("foo", "kube-system", "image", "node1", nil))
+		Expect(err).ToNot(HaveOccurred())
+		executor2, err := pod.NewPooledPodContext(podContext, "foo").Create(ctx, pod.NewPrivilegedPod("bar", "kube-system", "image", "node1", nil))
+		Expect(err).ToNot(HaveOccurred())
+		executor3, err := ppc.Create(ctx, pod.NewPrivilegedPod("baz", "kube-system", "image", "node2", nil))
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(executor1).To(BeIdenticalTo(executor2))
+		Expect(executor1).ToNot(BeIdenticalTo(executor3))
+		Expect(podContext.created).To(HaveLen(2))
+		Expect(podContext.created[0].Name).To(HavePrefix("diki-node-"))
+		Expect(*podContext.created[0].Spec.ActiveDeadlineSeconds).To(Equal(int64(3600)))
+		Expect(podContext.created[0].Spec.Containers[0].Command).To(Equal([]string{"chroot", "/host", "/bin/bash", "-c", "nsenter -m -t $(pgrep -xo systemd) sleep 3600"}))
+	})
+
+	It("should return the names of the pooled pods which serve requested pods", func() {
+		ppc := pod.NewPooledPodContext(podContext, "foo")
+
+		executor1, err := ppc.Create(ctx, pod.NewPrivilegedPod("foo", "kube-system", "image", "node1", nil))
+		Expect(err).ToNot(HaveOccurred())
+		executor2, err := ppc.Create(ctx, pod.NewPrivilegedPod("bar", "kube-system", "image", "node1", nil))
+		Expect(err).ToNot(HaveOccurred())
+		executor3, err := ppc.Create(ctx, pod.NewPrivilegedPod("baz", "kube-system", "image", "", nil))
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(pod.PodName(executor1, "foo")).To(Equal(podContext.created[0].Name))
+		Expect(pod.PodName(executor2, "bar")).To(Equal(podContext.created[0].Name))
+		Expect(pod.PodName(executor3, "baz")).To(Equal("baz"))
+	})
+
+	It("should recreate pooled pods which were deleted during the run", func() {
+		ppc := pod.NewPooledPodContext(podContext, "foo")
+
+		executor1, err := ppc.Create(ctx, pod.NewPrivilegedPod("foo", "kube-system", "image", "node1", nil))
+		Expect(err).ToNot(HaveOccurred())
+		deletedName := podContext.created[0].Name
+		podContext.unhealthy = map[string]bool{"kube-system/" + deletedName: true}
+
+		executor2, err := ppc.Create(ctx, pod.NewPrivilegedPod("bar", "kube-system", "image", "node1", nil))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(podContext.created).To(HaveLen(2))
+		Expect(podContext.deleted).To(Equal([]string{"kube-system/" + deletedName}))
+		Expect(executor2).ToNot(BeIdenticalTo(executor1))
+		Expect(pod.PodName(executor2, "bar")).To(Equal(podContext.created[1].Name))
+		Expect(executor2.Execute(ctx, "/bin/sh", "hostname")).To(Equal(podContext.created[1].Name))
+
+		executor3, err := ppc.Create(ctx, pod.NewPrivilegedPod("baz", "kube-system", "image", "node1", nil))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(executor3).To(BeIdenticalTo(executor2))
+		Expect(podContext.created).To(HaveLen(2))
+
+		Expect(pool.Close(context.Background())).To(Succeed())
+		Expect(podContext.deleted).To(Equal([]string{"kube-system/" + deletedName, "kube-system/" + podContext.created[1].Name}))
+	})
+
+	It("should not reuse pods of other clusters", func() {
+		_, err := pod.NewPooledPodContext(podContext, "foo").Create(ctx, pod.NewPrivilegedPod("foo", "kube-system", "image", "node1", nil))
+		Expect(err).ToNot(HaveOccurred())
+		_, err = pod.NewPooledPodContext(podContext, "bar").Create(ctx, pod.NewPrivilegedPod("foo", "kube-system", "image", "node1", nil))
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(podContext.created).To(HaveLen(2))
+	})
+
+	It("should not pool pods which are not bound to a node", func() {
+		ppc := pod.NewPooledPodContext(podContext, "foo")
+
+		_, err := ppc.Create(ctx, pod.NewPrivilegedPod("foo", "kube-system", "image", "", nil))
+		Expect(err).ToNot(HaveOccurred())
+		_, err = ppc.Create(ctx, pod.NewPrivilegedPod("bar", "kube-system", "image", "", nil))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ppc.Delete(ctx, "foo", "kube-system")).To(Succeed())
+
+		Expect(podContext.created).To(HaveLen(2))
+		Expect(podContext.deleted).To(Equal([]string{"kube-system/foo"}))
+	})
+
+	It("should delete pooled pods only when the pool is closed", func() {
+		ppc := pod.NewPooledPodContext(podContext, "foo")
+
+		_, err := ppc.Create(ctx, pod.NewPrivilegedPod("foo", "kube-system", "image", "node1", nil))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ppc.Delete(ctx, "foo", "kube-system")).To(Succeed())
+		Expect(podContext.deleted).To(BeEmpty())
+
+		Expect(pool.Close(context.Background())).To(Succeed())
+		Expect(podContext.deleted).To(Equal([]string{"kube-system/" + podContext.created[0].Name}))
+
+		_, err = ppc.Create(ctx, pod.NewPrivilegedPod("bar", "kube-system", "image", "node1", nil))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(podContext.created).To(HaveLen(2))
+		Expect(podContext.created[1].Name).To(Equal("bar"))
+	})
+
+	It("should not cache failed pod creations", func() {
+		ppc := pod.NewPooledPodContext(podContext, "foo")
+
+		podContext.createErr = errors.New("foo")
+		_, err := ppc.Create(ctx, pod.NewPrivilegedPod("foo", "kube-system", "image", "node1", nil))
+		Expect(err).To(MatchError("foo"))
+		Expect(podContext.deleted).To(Equal([]string{"kube-system/" + podContext.created[0].Name}))
+
+		podContext.createErr = nil
+		_, err = ppc.Create(ctx, pod.NewPrivilegedPod("foo", "kube-system", "image", "node1", nil))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(podContext.created).To(HaveLen(2))
+	})
+
+	It("should record executed commands and serve them from the snapshot without creating pods", func() {
+		s := snapshot.New()
+		recordCtx := snapshot.ContextWithSnapshot(ctx, s)
+
+		executor, err := pod.NewPooledPodContext(podContext, "foo").Create(recordCtx, pod.NewPrivilegedPod("foo", "kube-system", "image", "node1", nil))
+		Expect(err).ToNot(HaveOccurred())
+		stdout, err := executor.Execute(recordCtx, "/bin/sh", "hostname")
+		Expect(err).ToNot(HaveOccurred())
+		results, err := pod.ExecuteBatch(recordCtx, executor, []string{"echo foo"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(podContext.created).To(HaveLen(1))
+		opsPodName, ok := s.OpsPod("foo", "node1", "kube-system")
+		Expect(ok).To(BeTrue())
+		Expect(opsPodName).To(Equal(podContext.created[0].Name))
+
+		var buf bytes.Buffer
+		Expect(s.Write(&buf)).To(Succeed())
+		read, err := snapshot.Read(&buf)
+		Expect(err).ToNot(HaveOccurred())
+		replayPool := pod.NewNodePodPool()
+		replayCtx := snapshot.ContextWithSnapshot(pod.ContextWithNodePodPool(context.Background(), replayPool), read)
+
+		ppc := pod.NewPooledPodContext(podContext, "foo")
+		executor, err = ppc.Create(replayCtx, pod.NewPrivilegedPod("bar", "kube-system", "image", "node1", nil))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pod.PodName(executor, "bar")).To(Equal(podContext.created[0].Name))
+		Expect(executor.Execute(replayCtx, "/bin/sh", "hostname")).To(Equal(stdout))
+		Expect(pod.ExecuteBatch(replayCtx, executor, []string{"echo foo"})).To(Equal(results))
+		_, err = executor.Execute(replayCtx, "/bin/sh", "echo bar")
+		Expect(err).To(MatchError(snapshot.ErrNotInSnapshot))
+		Expect(ppc.Delete(replayCtx, "bar", "kube-system")).To(Succeed())
+
+		executor, err = ppc.Create(replayCtx, pod.NewPrivilegedPod("baz", "kube-system", "image", "node2", nil))
+		Expect(err).ToNot(HaveOccurred())
+		_, err = executor.Execute(replayCtx, "/bin/sh", "hostname")
+		Expect(err).To(MatchError(snapshot.ErrNotInSnapshot))
+
+		Expect(podContext.created).To(HaveLen(1))
+		Expect(podContext.deleted).To(BeEmpty())
+	})
+})
@@ -5,6 +5,7 @@
 package pod
 
 import (
+	"fmt"
 	"maps"
 
 	corev1 "k8s.io/api/core/v1"
@@ -23,6 +24,8 @@ const (
 	LabelComplianceRolePrivPod = "diki-privileged-pod"
 
 	maxNameLength = 63
+
+	defaultPrivilegedPodLifetimeSeconds = 300
 )
 
 // NewPrivilegedPod creates a new privileged Pod.
@@ -43,12 +46,12 @@ func NewPrivilegedPod(name, namespace, image, nodeName string, additionalLabels
 			Labels:    labels,
 		},
 		Spec: corev1.PodSpec{
-			ActiveDeadlineSeconds: ptr.To[int64](300),
+			ActiveDeadlineSeconds: ptr.To[int64](defaultPrivilegedPodLifetimeSeconds),
 			Containers: []corev1.Container{
 				{
 					Name:    "container",
 					Image:   image,
-					Command: []string{"chroot", "/host", "/bin/bash", "-c", "nsenter -m -t $(pgrep -xo systemd) sleep 300"},
+					Command: privilegedPodCommand(defaultPrivilegedPodLifetimeSeconds),
 					SecurityContext: &corev1.SecurityContext{
 						Privileged: ptr.To(true),
 					},
@@ -98,3 +101,17 @@ func NewPrivilegedPod(name, namespace, image, nodeName string, additionalLabels
 		return pod
 	}
 }
+
+func privilegedPodCommand(lifetimeSeconds int64) []string {
+	return []string{"chroot", "/host", "/bin/bash", "-c", fmt.Sprintf("nsenter -m -t $(pgrep -xo systemd) sleep %d", lifetimeSeconds)}
+}
+
+// setPrivilegedPodLifetime sets the number of seconds after which a pod created by [NewPrivilegedPod] terminates.
+func setPrivilegedPodLifetime(pod *corev1.Pod, lifetimeSeconds int64) {
+	pod.Spec.ActiveDeadlineSeconds = ptr.To(lifetimeSeconds)
+	for i, container := range pod.Spec.Containers {
+		if container.Name == "container" {
+			pod.Spec.Containers[i].Command = privilegedPodCommand(lifetimeSeconds)
+		}
+	}
+}
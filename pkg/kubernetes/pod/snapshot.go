@@ -0,0 +1,89 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package pod
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gardener/diki/pkg/snapshot"
+)
+
+var (
+	_ BatchPodExecutor = &RecordingPodExecutor{}
+	_ BatchPodExecutor = &SnapshotPodExecutor{}
+)
+
+// RecordingPodExecutor records the results of the executed commands in a [snapshot.Snapshot].
+type RecordingPodExecutor struct {
+	podExecutor PodExecutor
+	snapshot    *snapshot.Snapshot
+	cluster     string
+	node        string
+}
+
+// NewRecordingPodExecutor creates a new RecordingPodExecutor for a pod running on a node.
+func NewRecordingPodExecutor(podExecutor PodExecutor, s *snapshot.Snapshot, cluster, node string) *RecordingPodExecutor {
+	return &RecordingPodExecutor{
+		podExecutor: podExecutor,
+		snapshot:    s,
+		cluster:     cluster,
+		node:        node,
+	}
+}
+
+// Execute runs a command with the wrapped executor and records its result.
+func (rpe *RecordingPodExecutor) Execute(ctx context.Context, command string, commandArg string) (string, error) {
+	stdout, err := rpe.podExecutor.Execute(ctx, command, commandArg)
+	rpe.snapshot.AddExecution(rpe.cluster, rpe.node, command, commandArg, stdout, err)
+	return stdout, err
+}
+
+// ExecuteBatch runs the commands with the wrapped executor and records the result of every command.
+func (rpe *RecordingPodExecutor) ExecuteBatch(ctx context.Context, commands []string) ([]CommandResult, error) {
+	results, err := ExecuteBatch(ctx, rpe.podExecutor, commands)
+	if err != nil {
+		return nil, err
+	}
+	for i, result := range results {
+		rpe.snapshot.AddExecution(rpe.cluster, rpe.node, "/bin/sh", commands[i], result.Stdout, result.Err)
+	}
+	return results, nil
+}
+
+// SnapshotPodExecutor serves the results of commands from a [snapshot.Snapshot] without executing them.
+type SnapshotPodExecutor struct {
+	snapshot *snapshot.Snapshot
+	cluster  string
+	node     string
+}
+
+// NewSnapshotPodExecutor creates a new SnapshotPodExecutor for the commands recorded on a node.
+func NewSnapshotPodExecutor(s *snapshot.Snapshot, cluster, node string) *SnapshotPodExecutor {
+	return &SnapshotPodExecutor{
+		snapshot: s,
+		cluster:  cluster,
+		node:     node,
+	}
+}
+
+// Execute returns the recorded result of a command.
+func (spe *SnapshotPodExecutor) Execute(_ context.Context, command string, commandArg string) (string, error) {
+	execution, ok := spe.snapshot.Execution(spe.cluster, spe.node, command, commandArg)
+	if !ok {
+		return "", fmt.Errorf("command %s %s on node %s is %w", command, commandArg, spe.node, snapshot.ErrNotInSnapshot)
+	}
+	return execution.Stdout, execution.Err()
+}
+
+// ExecuteBatch returns the recorded results of the commands.
+func (spe *SnapshotPodExecutor) ExecuteBatch(ctx context.Context, commands []string) ([]CommandResult, error) {
+	results := make([]CommandResult, 0, len(commands))
+	for _, command := range commands {
+		stdout, err := spe.Execute(ctx, "/bin/sh", command)
+		results = append(results, CommandResult{Stdout: stdout, Err: err})
+	}
+	return results, nil
+}
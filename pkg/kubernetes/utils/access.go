@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/gardener/diki/pkg/config"
+)
+
+// RESTConfigFromClusterAccess builds a [*rest.Config] from a [config.ClusterAccessConfig].
+// The server, token file and CA file of the cluster access override the ones of the kubeconfig or in-cluster config.
+func RESTConfigFromClusterAccess(access config.ClusterAccessConfig) (*rest.Config, error) {
+	var (
+		restConfig *rest.Config
+		err        error
+	)
+	switch {
+	case len(access.KubeconfigPath) > 0:
+		restConfig, err = clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+			&clientcmd.ClientConfigLoadingRules{ExplicitPath: access.KubeconfigPath},
+			&clientcmd.ConfigOverrides{CurrentContext: access.Context},
+		).ClientConfig()
+	case access.InCluster:
+		restConfig, err = rest.InClusterConfig()
+	default:
+		restConfig = &rest.Config{}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if len(access.Server) > 0 {
+		restConfig.Host = access.Server
+	}
+
+	if len(access.CAFile) > 0 {
+		restConfig.CAFile = access.CAFile
+		restConfig.CAData = nil
+	}
+
+	if len(access.TokenFile) > 0 {
+		restConfig.BearerTokenFile = access.TokenFile
+		restConfig.BearerToken = ""
+	}
+
+	if access.Impersonate != nil {
+		restConfig.Impersonate = rest.ImpersonationConfig{
+			UserName: access.Impersonate.User,
+			UID:      access.Impersonate.UID,
+			Groups:   access.Impersonate.Groups,
+		}
+	}
+
+	if access.QPS != nil {
+		restConfig.QPS = *access.QPS
+	}
+
+	if access.Burst != nil {
+		restConfig.Burst = *access.Burst
+	}
+
+	return restConfig, nil
+}
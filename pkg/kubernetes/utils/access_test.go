@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils_test
+
+import (
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/diki/pkg/config"
+	kubeutils "github.com/gardener/diki/pkg/kubernetes/utils"
+)
+
+var _ = Describe("#RESTConfigFromClusterAccess", func() {
+	var kubeconfigPath string
+
+	BeforeEach(func() {
+		kubeconfigPath = filepath.Join(GinkgoT().TempDir(), "kubeconfig.yaml")
+		Expect(clientcmd.WriteToFile(clientcmdapi.Config{
+			Clusters: map[string]*clientcmdapi.Cluster{
+				"foo": {Server: "https://foo", CertificateAuthorityData: []byte("foo-ca")},
+				"bar": {Server: "https://bar"},
+			},
+			AuthInfos: map[string]*clientcmdapi.AuthInfo{
+				"foo": {Token: "foo-token"},
+				"bar": {Exec: &clientcmdapi.ExecConfig{Command: "bar-login", APIVersion: "client.authentication.k8s.io/v1", InteractiveMode: clientcmdapi.NeverExecInteractiveMode}},
+			},
+			Contexts: map[string]*clientcmdapi.Context{
+				"foo": {Cluster: "foo", AuthInfo: "foo"},
+				"bar": {Cluster: "bar", AuthInfo: "bar"},
+			},
+			CurrentContext: "foo",
+		}, kubeconfigPath)).To(Succeed())
+	})
+
+	It("should use the current context of the kubeconfig", func() {
+		restConfig, err := kubeutils.RESTConfigFromClusterAccess(config.ClusterAccessConfig{KubeconfigPath: kubeconfigPath})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(restConfig.Host).To(Equal("https://foo"))
+		Expect(restConfig.BearerToken).To(Equal("foo-token"))
+		Expect(restConfig.CAData).To(Equal([]byte("foo-ca")))
+	})
+
+	It("should use the set context of the kubeconfig with its exec plugin", func() {
+		restConfig, err := kubeutils.RESTConfigFromClusterAccess(config.ClusterAccessConfig{KubeconfigPath: kubeconfigPath, Context: "bar"})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(restConfig.Host).To(Equal("https://bar"))
+		Expect(restConfig.ExecProvider).ToNot(BeNil())
+		Expect(restConfig.ExecProvider.Command).To(Equal("bar-login"))
+	})
+
+	It("should return an error when the context does not exist", func() {
+		_, err := kubeutils.RESTConfigFromClusterAccess(config.ClusterAccessConfig{KubeconfigPath: kubeconfigPath, Context: "baz"})
+		Expect(err).To(MatchError(`context "baz" does not exist`))
+	})
+
+	It("should override the kubeconfig with the cluster access settings", func() {
+		restConfig, err := kubeutils.RESTConfigFromClusterAccess(config.ClusterAccessConfig{
+			KubeconfigPath: kubeconfigPath,
+			Server:         "https://baz",
+			TokenFile:      "/var/run/token",
+			CAFile:         "/var/run/ca.crt",
+			Impersonate:    &config.ImpersonationConfig{User: "alice", Groups: []string{"auditors"}},
+			QPS:            ptr.To[float32](50),
+			Burst:          ptr.To(100),
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(restConfig.Host).To(Equal("https://baz"))
+		Expect(restConfig.BearerToken).To(BeEmpty())
+		Expect(restConfig.BearerTokenFile).To(Equal("/var/run/token"))
+		Expect(restConfig.CAData).To(BeEmpty())
+		Expect(restConfig.CAFile).To(Equal("/var/run/ca.crt"))
+		Expect(restConfig.Impersonate).To(Equal(rest.ImpersonationConfig{UserName: "alice", Groups: []string{"auditors"}}))
+		Expect(restConfig.QPS).To(Equal(float32(50)))
+		Expect(restConfig.Burst).To(Equal(100))
+	})
+
+	It("should build the config from the server and token file", func() {
+		restConfig, err := kubeutils.RESTConfigFromClusterAccess(config.ClusterAccessConfig{
+			Server:    "https://baz",
+			TokenFile: "/var/run/token",
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(restConfig).To(Equal(&rest.Config{Host: "https://baz", BearerTokenFile: "/var/run/token"}))
+	})
+})
@@ -0,0 +1,379 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	runsnapshot "github.com/gardener/diki/pkg/snapshot"
+)
+
+// DefaultObjectCachePageSize is the number of objects retrieved per request
+// when an [ObjectCache] snapshot is filled.
+const DefaultObjectCachePageSize int64 = 500
+
+// ObjectCache contains point-in-time snapshots of Kubernetes objects which are
+// shared by all rules run with the same context. Snapshots are filled lazily
+// on the first list request for a kind and cluster.
+type ObjectCache struct {
+	// PageSize is the number of objects retrieved per request.
+	PageSize  int64
+	mux       sync.Mutex
+	snapshots map[objectCacheKey]*objectSnapshot
+}
+
+type objectCacheKey struct {
+	cluster      string
+	gvk          schema.GroupVersionKind
+	metadataOnly bool
+}
+
+type objectSnapshot struct {
+	mux  sync.Mutex
+	list client.ObjectList
+}
+
+type objectCacheContextKey struct{}
+
+// NewObjectCache creates a new empty ObjectCache.
+func NewObjectCache() *ObjectCache {
+	return &ObjectCache{
+		PageSize:  DefaultObjectCachePageSize,
+		snapshots: map[objectCacheKey]*objectSnapshot{},
+	}
+}
+
+// ContextWithObjectCache returns a context which carries the given [ObjectCache].
+func ContextWithObjectCache(ctx context.Context, cache *ObjectCache) context.Context {
+	return context.WithValue(ctx, objectCacheContextKey{}, cache)
+}
+
+// ObjectCacheFromContext returns the [ObjectCache] of the context or nil if there is none.
+func ObjectCacheFromContext(ctx context.Context) *ObjectCache {
+	cache, _ := ctx.Value(objectCacheContextKey{}).(*ObjectCache)
+	return cache
+}
+
+// NewCachedClient wraps a client so that list requests are served from the [ObjectCache]
+// of the request context, if there is one. All clients created with the same cluster name share
+// the snapshots of the cache. Other requests and list requests with field selectors are passed to the wrapped client.
+// If the request context carries a [runsnapshot.Snapshot], read objects are recorded in it or served from it.
+func NewCachedClient(c client.Client, cluster string) client.Client {
+	return &cachedClient{Client: c, cluster: cluster}
+}
+
+type cachedClient struct {
+	client.Client
+	cluster string
+}
+
+// List lists objects from the snapshot of the cache in the context.
+// Namespace and label selectors are applied to the snapshot and all matching objects are returned at once.
+func (cc *cachedClient) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	replay := runsnapshot.FromContext(ctx).Replay()
+	cache := ObjectCacheFromContext(ctx)
+	if cache == nil {
+		if !replay {
+			return cc.Client.List(ctx, list, opts...)
+		}
+		cache = NewObjectCache()
+	}
+
+	listOpts := &client.ListOptions{}
+	listOpts.ApplyOptions(opts)
+	_, unstructured := list.(runtime.Unstructured)
+	if listOpts.FieldSelector != nil || unstructured {
+		if replay {
+			return errors.New("list requests with field selectors or unstructured objects cannot be served from a snapshot")
+		}
+		return cc.Client.List(ctx, list, opts...)
+	}
+
+	gvk, err := apiutil.GVKForObject(list, cc.Scheme())
+	if err != nil {
+		return err
+	}
+	_, metadataOnly := list.(*metav1.PartialObjectMetadataList)
+
+	snapshot, err := cache.snapshot(ctx, cc, gvk, metadataOnly)
+	if err != nil {
+		return err
+	}
+
+	items, err := meta.ExtractList(snapshot)
+	if err != nil {
+		return err
+	}
+
+	filtered := make([]runtime.Object, 0, len(items))
+	for _, item := range items {
+		accessor, err := meta.Accessor(item)
+		if err != nil {
+			return err
+		}
+		if len(listOpts.Namespace) > 0 && accessor.GetNamespace() != listOpts.Namespace {
+			continue
+		}
+		if listOpts.LabelSelector != nil && !listOpts.LabelSelector.Matches(labels.Set(accessor.GetLabels())) {
+			continue
+		}
+		filtered = append(filtered, item.DeepCopyObject())
+	}
+
+	if err := meta.SetList(list, filtered); err != nil {
+		return err
+	}
+	list.SetContinue("")
+	list.SetResourceVersion(snapshot.GetResourceVersion())
+	return nil
+}
+
+// Get passes get requests to the wrapped client. If the request context carries a
+// [runsnapshot.Snapshot], the result is recorded in it or served from it.
+func (cc *cachedClient) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	s := runsnapshot.FromContext(ctx)
+	if s == nil {
+		return cc.Client.Get(ctx, key, obj, opts...)
+	}
+
+	gvk, err := apiutil.GVKForObject(obj, cc.Scheme())
+	if err != nil {
+		return err
+	}
+	_, metadataOnly := obj.(*metav1.PartialObjectMetadata)
+
+	if s.Replay() {
+		return cc.getFromSnapshot(ctx, s, gvk, metadataOnly, key, obj)
+	}
+
+	err = cc.Client.Get(ctx, key, obj, opts...)
+	var statusErr apierrors.APIStatus
+	switch {
+	case err == nil:
+		data, marshalErr := json.Marshal(obj)
+		if marshalErr != nil {
+			return marshalErr
+		}
+		s.AddObject(cc.cluster, gvk, metadataOnly, key.Namespace, key.Name, data, nil)
+	case errors.As(err, &statusErr):
+		status := statusErr.Status()
+		s.AddObject(cc.cluster, gvk, metadataOnly, key.Namespace, key.Name, nil, &status)
+	}
+	return err
+}
+
+// getFromSnapshot serves a get request from the recorded get requests or
+// from the recorded list of the object kind.
+func (cc *cachedClient) getFromSnapshot(
+	ctx context.Context,
+	s *runsnapshot.Snapshot,
+	gvk schema.GroupVersionKind,
+	metadataOnly bool,
+	key client.ObjectKey,
+	obj client.Object,
+) error {
+	if object, ok := s.Object(cc.cluster, gvk, metadataOnly, key.Namespace, key.Name); ok {
+		if object.Status != nil {
+			return &apierrors.StatusError{ErrStatus: *object.Status}
+		}
+		return json.Unmarshal(object.Data, obj)
+	}
+
+	cache := ObjectCacheFromContext(ctx)
+	if cache == nil {
+		cache = NewObjectCache()
+	}
+	list, err := cache.snapshot(ctx, cc, gvk.GroupVersion().WithKind(gvk.Kind+"List"), metadataOnly)
+	if err != nil {
+		if errors.Is(err, runsnapshot.ErrNotInSnapshot) {
+			return fmt.Errorf("%s %s is %w", gvk.Kind, key, runsnapshot.ErrNotInSnapshot)
+		}
+		return err
+	}
+
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return err
+	}
+	for _, item := range items {
+		accessor, err := meta.Accessor(item)
+		if err != nil {
+			return err
+		}
+		if accessor.GetNamespace() == key.Namespace && accessor.GetName() == key.Name {
+			data, err := json.Marshal(item)
+			if err != nil {
+				return err
+			}
+			return json.Unmarshal(data, obj)
+		}
+	}
+
+	resource, _ := meta.UnsafeGuessKindToResource(gvk)
+	return apierrors.NewNotFound(resource.GroupResource(), key.Name)
+}
+
+// snapshot returns the snapshot of a list kind, filling it on first use.
+// Metadata-only snapshots are derived from full snapshots if available.
+// Failed requests are not cached.
+// If the context carries a [runsnapshot.Snapshot], filled snapshots are recorded in it or filled from it.
+func (oc *ObjectCache) snapshot(ctx context.Context, cc *cachedClient, gvk schema.GroupVersionKind, metadataOnly bool) (client.ObjectList, error) {
+	s := runsnapshot.FromContext(ctx)
+	if metadataOnly {
+		if full := oc.loadedSnapshot(objectCacheKey{cluster: cc.cluster, gvk: gvk}); full != nil {
+			return metadataListFrom(full, gvk)
+		}
+		if s.Replay() {
+			if _, ok := s.List(cc.cluster, gvk, true); !ok {
+				full, err := oc.snapshot(ctx, cc, gvk, false)
+				if err != nil {
+					return nil, err
+				}
+				return metadataListFrom(full, gvk)
+			}
+		}
+	}
+
+	oc.mux.Lock()
+	key := objectCacheKey{cluster: cc.cluster, gvk: gvk, metadataOnly: metadataOnly}
+	snapshot, ok := oc.snapshots[key]
+	if !ok {
+		snapshot = &objectSnapshot{}
+		oc.snapshots[key] = snapshot
+	}
+	oc.mux.Unlock()
+
+	snapshot.mux.Lock()
+	defer snapshot.mux.Unlock()
+
+	if snapshot.list != nil {
+		return snapshot.list, nil
+	}
+
+	list, err := newList(cc.Scheme(), gvk, metadataOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.Replay() {
+		data, ok := s.List(cc.cluster, gvk, metadataOnly)
+		if !ok {
+			return nil, fmt.Errorf("list of %s is %w", gvk, runsnapshot.ErrNotInSnapshot)
+		}
+		if err := json.Unmarshal(data, list); err != nil {
+			return nil, err
+		}
+		snapshot.list = list
+		return list, nil
+	}
+
+	var items []runtime.Object
+	for {
+		if err := cc.Client.List(ctx, list, client.Limit(oc.PageSize), client.Continue(list.GetContinue())); err != nil {
+			return nil, err
+		}
+
+		pageItems, err := meta.ExtractList(list)
+		if err != nil {
+			return nil, err
+		}
+		// pages are decoded into the same list, so its items have to be copied
+		for _, item := range pageItems {
+			items = append(items, item.DeepCopyObject())
+		}
+
+		if len(list.GetContinue()) == 0 {
+			break
+		}
+	}
+
+	if err := meta.SetList(list, items); err != nil {
+		return nil, err
+	}
+	if s != nil {
+		data, err := json.Marshal(list)
+		if err != nil {
+			return nil, err
+		}
+		s.AddList(cc.cluster, gvk, metadataOnly, data)
+	}
+	snapshot.list = list
+	return list, nil
+}
+
+func (oc *ObjectCache) loadedSnapshot(key objectCacheKey) client.ObjectList {
+	oc.mux.Lock()
+	snapshot, ok := oc.snapshots[key]
+	oc.mux.Unlock()
+	if !ok {
+		return nil
+	}
+
+	snapshot.mux.Lock()
+	defer snapshot.mux.Unlock()
+	return snapshot.list
+}
+
+func newList(scheme *runtime.Scheme, gvk schema.GroupVersionKind, metadataOnly bool) (client.ObjectList, error) {
+	if metadataOnly {
+		list := &metav1.PartialObjectMetadataList{}
+		list.SetGroupVersionKind(gvk)
+		return list, nil
+	}
+
+	obj, err := scheme.New(gvk)
+	if err != nil {
+		return nil, err
+	}
+	list, ok := obj.(client.ObjectList)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a list", gvk)
+	}
+	return list, nil
+}
+
+func metadataListFrom(full client.ObjectList, gvk schema.GroupVersionKind) (client.ObjectList, error) {
+	items, err := meta.ExtractList(full)
+	if err != nil {
+		return nil, err
+	}
+
+	list := &metav1.PartialObjectMetadataList{}
+	list.SetGroupVersionKind(gvk)
+	list.SetResourceVersion(full.GetResourceVersion())
+	list.Items = make([]metav1.PartialObjectMetadata, 0, len(items))
+	for _, item := range items {
+		objectMetaAccessor, ok := item.(metav1.ObjectMetaAccessor)
+		if !ok {
+			return nil, fmt.Errorf("%T does not have object metadata", item)
+		}
+		objectMeta, ok := objectMetaAccessor.GetObjectMeta().(*metav1.ObjectMeta)
+		if !ok {
+			return nil, fmt.Errorf("%T does not have object metadata", item)
+		}
+		list.Items = append(list.Items, metav1.PartialObjectMetadata{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: gvk.GroupVersion().String(),
+				Kind:       strings.TrimSuffix(gvk.Kind, "List"),
+			},
+			ObjectMeta: *objectMeta.DeepCopy(),
+		})
+	}
+	return list, nil
+}
@@ -0,0 +1,241 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/gardener/diki/pkg/kubernetes/utils"
+	"github.com/gardener/diki/pkg/snapshot"
+)
+
+var _ = Describe("cache", func() {
+	var (
+		fakeClient client.Client
+		listCalls  int
+		getCalls   int
+		listErr    error
+		ctx        context.Context
+		newPod     = func(name, namespace string, podLabels map[string]string) *corev1.Pod {
+			return &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: namespace,
+					Labels:    podLabels,
+				},
+			}
+		}
+	)
+
+	BeforeEach(func() {
+		listCalls, getCalls, listErr = 0, 0, nil
+		fakeClient = fakeclient.NewClientBuilder().
+			WithObjects(
+				newPod("foo", "foo", map[string]string{"app": "foo"}),
+				newPod("bar", "foo", map[string]string{"app": "bar"}),
+				newPod("foo", "bar", map[string]string{"app": "foo"}),
+			).
+			WithInterceptorFuncs(interceptor.Funcs{
+				List: func(ctx context.Context, c client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+					listCalls++
+					if listErr != nil {
+						return listErr
+					}
+					return c.List(ctx, list, opts...)
+				},
+				Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+					getCalls++
+					return c.Get(ctx, key, obj, opts...)
+				},
+			}).
+			Build()
+		ctx = utils.ContextWithObjectCache(context.Background(), utils.NewObjectCache())
+	})
+
+	It("should pass list requests to the client when there is no cache in the context", func() {
+		cachedClient := utils.NewCachedClient(fakeClient, "foo")
+
+		_, err := utils.GetPods(context.Background(), cachedClient, "", labels.NewSelector(), 300)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = utils.GetPods(context.Background(), cachedClient, "", labels.NewSelector(), 300)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(listCalls).To(Equal(2))
+	})
+
+	It("should serve filtered list requests from a single snapshot", func() {
+		cachedClient := utils.NewCachedClient(fakeClient, "foo")
+
+		pods, err := utils.GetPods(ctx, cachedClient, "", labels.NewSelector(), 300)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pods).To(HaveLen(3))
+
+		pods, err = utils.GetPods(ctx, cachedClient, "foo", labels.NewSelector(), 300)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pods).To(HaveLen(2))
+
+		pods, err = utils.GetPods(ctx, cachedClient, "", labels.SelectorFromSet(labels.Set{"app": "foo"}), 1)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pods).To(HaveLen(2))
+		Expect(pods[0].Labels).To(Equal(map[string]string{"app": "foo"}))
+		Expect(pods[1].Labels).To(Equal(map[string]string{"app": "foo"}))
+
+		Expect(listCalls).To(Equal(1))
+	})
+
+	It("should return a point-in-time view of the objects", func() {
+		cachedClient := utils.NewCachedClient(fakeClient, "foo")
+
+		_, err := utils.GetPods(ctx, cachedClient, "", labels.NewSelector(), 300)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(fakeClient.Create(ctx, newPod("baz", "foo", nil))).To(Succeed())
+
+		pods, err := utils.GetPods(ctx, cachedClient, "", labels.NewSelector(), 300)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pods).To(HaveLen(3))
+	})
+
+	It("should not share returned objects with the snapshot", func() {
+		cachedClient := utils.NewCachedClient(fakeClient, "foo")
+
+		pods, err := utils.GetPods(ctx, cachedClient, "", labels.NewSelector(), 300)
+		Expect(err).ToNot(HaveOccurred())
+		pods[0].Labels["app"] = "changed"
+
+		pods, err = utils.GetPods(ctx, cachedClient, "", labels.SelectorFromSet(labels.Set{"app": "changed"}), 300)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pods).To(BeEmpty())
+	})
+
+	It("should share snapshots between clients of the same cluster only", func() {
+		_, err := utils.GetPods(ctx, utils.NewCachedClient(fakeClient, "foo"), "", labels.NewSelector(), 300)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = utils.GetPods(ctx, utils.NewCachedClient(fakeClient, "foo"), "", labels.NewSelector(), 300)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(listCalls).To(Equal(1))
+
+		_, err = utils.GetPods(ctx, utils.NewCachedClient(fakeClient, "bar"), "", labels.NewSelector(), 300)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(listCalls).To(Equal(2))
+	})
+
+	It("should serve metadata-only requests", func() {
+		cachedClient := utils.NewCachedClient(fakeClient, "foo")
+		podListGVK := corev1.SchemeGroupVersion.WithKind("PodList")
+
+		objects, err := utils.GetObjectsMetadata(ctx, cachedClient, podListGVK, "foo", labels.NewSelector(), 300)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(objects).To(HaveLen(2))
+		Expect(listCalls).To(Equal(1))
+
+		_, err = utils.GetObjectsMetadata(ctx, cachedClient, podListGVK, "", labels.NewSelector(), 300)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(listCalls).To(Equal(1))
+	})
+
+	It("should derive metadata-only requests from full snapshots", func() {
+		cachedClient := utils.NewCachedClient(fakeClient, "foo")
+
+		_, err := utils.GetPods(ctx, cachedClient, "", labels.NewSelector(), 300)
+		Expect(err).ToNot(HaveOccurred())
+
+		objects, err := utils.GetObjectsMetadata(ctx, cachedClient, corev1.SchemeGroupVersion.WithKind("PodList"), "bar", labels.NewSelector(), 300)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(objects).To(HaveLen(1))
+		Expect(objects[0].Kind).To(Equal("Pod"))
+		Expect(objects[0].Name).To(Equal("foo"))
+		Expect(objects[0].Namespace).To(Equal("bar"))
+		Expect(listCalls).To(Equal(1))
+	})
+
+	It("should not cache failed requests", func() {
+		cachedClient := utils.NewCachedClient(fakeClient, "foo")
+
+		listErr = errors.New("foo")
+		_, err := utils.GetPods(ctx, cachedClient, "", labels.NewSelector(), 300)
+		Expect(err).To(MatchError("foo"))
+
+		listErr = nil
+		pods, err := utils.GetPods(ctx, cachedClient, "", labels.NewSelector(), 300)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pods).To(HaveLen(3))
+		Expect(listCalls).To(Equal(2))
+	})
+
+	Describe("snapshot", func() {
+		readBack := func(s *snapshot.Snapshot) *snapshot.Snapshot {
+			var buf bytes.Buffer
+			Expect(s.Write(&buf)).To(Succeed())
+			read, err := snapshot.Read(&buf)
+			Expect(err).ToNot(HaveOccurred())
+			return read
+		}
+
+		It("should serve recorded list and get requests from the snapshot", func() {
+			s := snapshot.New()
+			recordCtx := snapshot.ContextWithSnapshot(ctx, s)
+
+			_, err := utils.GetPods(recordCtx, utils.NewCachedClient(fakeClient, "foo"), "", labels.NewSelector(), 300)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(utils.NewCachedClient(fakeClient, "foo").Get(recordCtx, client.ObjectKey{Namespace: "foo", Name: "bar"}, &corev1.Pod{})).To(Succeed())
+			err = utils.NewCachedClient(fakeClient, "foo").Get(recordCtx, client.ObjectKey{Namespace: "foo", Name: "baz"}, &corev1.Pod{})
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+			Expect(listCalls).To(Equal(1))
+			Expect(getCalls).To(Equal(2))
+
+			replayCtx := utils.ContextWithObjectCache(snapshot.ContextWithSnapshot(context.Background(), readBack(s)), utils.NewObjectCache())
+			cachedClient := utils.NewCachedClient(fakeClient, "foo")
+
+			pods, err := utils.GetPods(replayCtx, cachedClient, "foo", labels.NewSelector(), 300)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pods).To(HaveLen(2))
+
+			objects, err := utils.GetObjectsMetadata(replayCtx, cachedClient, corev1.SchemeGroupVersion.WithKind("PodList"), "bar", labels.NewSelector(), 300)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(objects).To(HaveLen(1))
+
+			pod := &corev1.Pod{}
+			Expect(cachedClient.Get(replayCtx, client.ObjectKey{Namespace: "foo", Name: "bar"}, pod)).To(Succeed())
+			Expect(pod.Labels).To(Equal(map[string]string{"app": "bar"}))
+			err = cachedClient.Get(replayCtx, client.ObjectKey{Namespace: "foo", Name: "baz"}, pod)
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+
+			// objects which were only listed are served from the list
+			Expect(cachedClient.Get(replayCtx, client.ObjectKey{Namespace: "bar", Name: "foo"}, pod)).To(Succeed())
+			Expect(pod.Namespace).To(Equal("bar"))
+			err = cachedClient.Get(replayCtx, client.ObjectKey{Namespace: "bar", Name: "qux"}, pod)
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+
+			Expect(listCalls).To(Equal(1))
+			Expect(getCalls).To(Equal(2))
+		})
+
+		It("should not serve requests which are not part of the snapshot", func() {
+			replayCtx := snapshot.ContextWithSnapshot(ctx, readBack(snapshot.New()))
+			cachedClient := utils.NewCachedClient(fakeClient, "foo")
+
+			_, err := utils.GetPods(replayCtx, cachedClient, "", labels.NewSelector(), 300)
+			Expect(err).To(MatchError(snapshot.ErrNotInSnapshot))
+			err = cachedClient.Get(replayCtx, client.ObjectKey{Namespace: "foo", Name: "bar"}, &corev1.Pod{})
+			Expect(err).To(MatchError(snapshot.ErrNotInSnapshot))
+
+			Expect(listCalls).To(Equal(0))
+			Expect(getCalls).To(Equal(0))
+		})
+	})
+})
@@ -0,0 +1,377 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/diki/pkg/kubernetes/pod"
+	"github.com/gardener/diki/pkg/rule"
+)
+
+// ComponentSource resolves the running instances of a component,
+// e.g. the kube-apiserver of a cluster.
+type ComponentSource interface {
+	// Target returns the target which identifies the source.
+	// It is used for check results which cannot be attributed to a single instance.
+	Target() rule.Target
+	// Instances returns the instances of the component.
+	Instances(ctx context.Context) ([]ComponentInstance, error)
+}
+
+// ComponentInstance is a single instance of a component.
+type ComponentInstance struct {
+	// Target identifies the instance in check results.
+	Target rule.Target
+	// Namespace is the namespace of the instance. It is empty for instances which do not run in a cluster.
+	Namespace string
+	// NodeName is the name of the node on which the instance runs, if known.
+	NodeName string
+	// Command is the effective command line of the instance, i.e. the container command followed by the container args.
+	Command []string
+	// Volumes are the volumes of the pod of the instance.
+	Volumes []corev1.Volume
+	// VolumeMounts are the volume mounts of the container of the instance.
+	VolumeMounts []corev1.VolumeMount
+	// Pod is the pod of the instance when the instance runs in a pod of the cluster.
+	Pod *corev1.Pod
+	// OnHost reports whether the instance runs directly on the host, in which case its file paths are host paths.
+	OnHost bool
+	// Err is set when the instance was found but its command line could not be resolved.
+	Err error
+}
+
+// FlagValues returns the raw values of a command line flag of the instance.
+func (i ComponentInstance) FlagValues(flag string) []string {
+	return FindFlagValueRaw(i.Command, flag)
+}
+
+// VolumeForPath returns the volume which contains a file of the instance
+// together with the path of the file relative to the volume root.
+// When several volume mounts contain the file the most specific one is used.
+func (i ComponentInstance) VolumeForPath(filePath string) (corev1.Volume, string, error) {
+	var mounts []corev1.VolumeMount
+	for _, mount := range i.VolumeMounts {
+		if mount.MountPath == filePath || strings.HasPrefix(filePath, strings.TrimSuffix(mount.MountPath, "/")+"/") {
+			mounts = append(mounts, mount)
+		}
+	}
+	slices.SortFunc(mounts, func(a, b corev1.VolumeMount) int {
+		return cmp.Compare(len(b.MountPath), len(a.MountPath))
+	})
+
+	for _, mount := range mounts {
+		for _, volume := range i.Volumes {
+			if volume.Name == mount.Name {
+				relPath := strings.TrimPrefix(strings.TrimPrefix(filePath, mount.MountPath), "/")
+				if relPath == "" && mount.SubPath != "" {
+					relPath = mount.SubPath
+				}
+				return volume, relPath, nil
+			}
+		}
+	}
+	return corev1.Volume{}, "", fmt.Errorf("file %s is not mounted from a volume", filePath)
+}
+
+// HostPath returns the path on the node of a file of the instance.
+// The file must be mounted from a hostPath volume unless the instance runs on the host.
+func (i ComponentInstance) HostPath(filePath string) (string, error) {
+	if i.OnHost {
+		return filePath, nil
+	}
+
+	volume, relPath, err := i.VolumeForPath(filePath)
+	if err != nil {
+		return "", fmt.Errorf("file %s is not mounted from a hostPath volume", filePath)
+	}
+	if volume.HostPath == nil {
+		return "", fmt.Errorf("volume %s of file %s is not a hostPath volume", volume.Name, filePath)
+	}
+	return path.Join(volume.HostPath.Path, relPath), nil
+}
+
+// ReadComponentFile reads a file of a component instance. Files from ConfigMap and Secret volumes
+// are read with the client. Files on the node are read with the podExecutor, which must run on the node
+// of the instance with the node root file system as its root. The podExecutor can be nil
+// if only ConfigMap and Secret volumes are expected.
+func ReadComponentFile(ctx context.Context, c client.Client, podExecutor pod.PodExecutor, instance ComponentInstance, filePath string) ([]byte, error) {
+	if instance.OnHost {
+		return readNodeFile(ctx, podExecutor, filePath)
+	}
+
+	volumeMount, err := getVolumeMountFromContainerByPath(corev1.Container{VolumeMounts: slices.Clone(instance.VolumeMounts)}, filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	idx := slices.IndexFunc(instance.Volumes, func(v corev1.Volume) bool { return v.Name == volumeMount.Name })
+	if idx < 0 {
+		return nil, fmt.Errorf("%s does not contain volume with name: %s", strings.ToLower(instance.Target["kind"]), volumeMount.Name)
+	}
+
+	volume := instance.Volumes[idx]
+	if volume.HostPath != nil {
+		hostPath, err := instance.HostPath(filePath)
+		if err != nil {
+			return nil, err
+		}
+		return readNodeFile(ctx, podExecutor, hostPath)
+	}
+
+	fileName := strings.Replace(filePath, fmt.Sprintf("%s/", volumeMount.MountPath), "", 1)
+	return GetFileDataFromVolume(ctx, c, instance.Namespace, volume, fileName)
+}
+
+func readNodeFile(ctx context.Context, podExecutor pod.PodExecutor, filePath string) ([]byte, error) {
+	if podExecutor == nil {
+		return nil, fmt.Errorf("file %s can only be read from the node", filePath)
+	}
+
+	data, errs, err := ReadNodeFiles(ctx, podExecutor, []string{filePath})
+	if err != nil {
+		return nil, err
+	}
+	return data[0], errs[0]
+}
+
+var (
+	_ ComponentSource = &DeploymentSource{}
+	_ ComponentSource = &StatefulSetSource{}
+	_ ComponentSource = &DaemonSetSource{}
+	_ ComponentSource = &StaticPodSource{}
+	_ ComponentSource = &StaticPodManifestSource{}
+	_ ComponentSource = &ProcessSource{}
+)
+
+// DeploymentSource resolves a component from the pod template of a Deployment.
+type DeploymentSource struct {
+	Client        client.Client
+	Name          string
+	Namespace     string
+	ContainerName string
+}
+
+// Target implements [ComponentSource].
+func (s *DeploymentSource) Target() rule.Target {
+	return rule.NewTarget("name", s.Name, "namespace", s.Namespace, "kind", "Deployment")
+}
+
+// Instances implements [ComponentSource].
+func (s *DeploymentSource) Instances(ctx context.Context) ([]ComponentInstance, error) {
+	deployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: s.Name, Namespace: s.Namespace}}
+	if err := s.Client.Get(ctx, client.ObjectKeyFromObject(deployment), deployment); err != nil {
+		return nil, err
+	}
+	return podTemplateInstances("deployment", s.Target(), deployment.Namespace, deployment.Spec.Template.Spec, s.ContainerName)
+}
+
+// StatefulSetSource resolves a component from the pod template of a StatefulSet.
+type StatefulSetSource struct {
+	Client        client.Client
+	Name          string
+	Namespace     string
+	ContainerName string
+}
+
+// Target implements [ComponentSource].
+func (s *StatefulSetSource) Target() rule.Target {
+	return rule.NewTarget("name", s.Name, "namespace", s.Namespace, "kind", "StatefulSet")
+}
+
+// Instances implements [ComponentSource].
+func (s *StatefulSetSource) Instances(ctx context.Context) ([]ComponentInstance, error) {
+	statefulSet := &appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Name: s.Name, Namespace: s.Namespace}}
+	if err := s.Client.Get(ctx, client.ObjectKeyFromObject(statefulSet), statefulSet); err != nil {
+		return nil, err
+	}
+	return podTemplateInstances("statefulSet", s.Target(), statefulSet.Namespace, statefulSet.Spec.Template.Spec, s.ContainerName)
+}
+
+// DaemonSetSource resolves a component from the pod template of a DaemonSet.
+type DaemonSetSource struct {
+	Client        client.Client
+	Name          string
+	Namespace     string
+	ContainerName string
+}
+
+// Target implements [ComponentSource].
+func (s *DaemonSetSource) Target() rule.Target {
+	return rule.NewTarget("name", s.Name, "namespace", s.Namespace, "kind", "DaemonSet")
+}
+
+// Instances implements [ComponentSource].
+func (s *DaemonSetSource) Instances(ctx context.Context) ([]ComponentInstance, error) {
+	daemonSet := &appsv1.DaemonSet{ObjectMeta: metav1.ObjectMeta{Name: s.Name, Namespace: s.Namespace}}
+	if err := s.Client.Get(ctx, client.ObjectKeyFromObject(daemonSet), daemonSet); err != nil {
+		return nil, err
+	}
+	return podTemplateInstances("daemonSet", s.Target(), daemonSet.Namespace, daemonSet.Spec.Template.Spec, s.ContainerName)
+}
+
+// podTemplateInstances returns the single instance described by the pod template of a workload.
+// Only the volumes of the pod template are resolved when containerName is empty.
+func podTemplateInstances(kind string, target rule.Target, namespace string, podSpec corev1.PodSpec, containerName string) ([]ComponentInstance, error) {
+	if containerName == "" {
+		return []ComponentInstance{{Target: target, Namespace: namespace, Volumes: podSpec.Volumes}}, nil
+	}
+
+	idx := slices.IndexFunc(podSpec.Containers, func(c corev1.Container) bool { return c.Name == containerName })
+	if idx < 0 {
+		return nil, fmt.Errorf("%s: %s does not contain container: %s", kind, target["name"], containerName)
+	}
+
+	container := podSpec.Containers[idx]
+	return []ComponentInstance{
+		{
+			Target:       target,
+			Namespace:    namespace,
+			Command:      slices.Concat(container.Command, container.Args),
+			Volumes:      podSpec.Volumes,
+			VolumeMounts: container.VolumeMounts,
+		},
+	}, nil
+}
+
+// StaticPodSource resolves a component from the mirror pods of static pods,
+// e.g. the control plane pods of a kubeadm cluster. Every mirror pod is a separate instance.
+type StaticPodSource struct {
+	Client        client.Client
+	Namespace     string
+	Selector      labels.Selector
+	ContainerName string
+}
+
+// Target implements [ComponentSource].
+func (s *StaticPodSource) Target() rule.Target {
+	return rule.NewTarget("namespace", s.Namespace, "selector", s.Selector.String())
+}
+
+// Instances implements [ComponentSource].
+func (s *StaticPodSource) Instances(ctx context.Context) ([]ComponentInstance, error) {
+	mirrorPods, err := GetMirrorPods(ctx, s.Client, s.Namespace, s.Selector, 300)
+	if err != nil {
+		return nil, err
+	}
+	if len(mirrorPods) == 0 {
+		return nil, errors.New("pods not found")
+	}
+
+	instances := make([]ComponentInstance, 0, len(mirrorPods))
+	for _, p := range mirrorPods {
+		instance := podInstance(p, s.ContainerName)
+		instance.Pod = &p
+		instances = append(instances, instance)
+	}
+	return instances, nil
+}
+
+// podInstance returns the instance of a component running in a container of a pod.
+func podInstance(p corev1.Pod, containerName string) ComponentInstance {
+	instance := ComponentInstance{
+		Target:    rule.NewTarget("name", p.Name, "namespace", p.Namespace, "kind", "Pod"),
+		Namespace: p.Namespace,
+		NodeName:  p.Spec.NodeName,
+		Volumes:   p.Spec.Volumes,
+	}
+
+	container, found := GetContainerFromPod(&p, containerName)
+	if !found {
+		instance.Err = fmt.Errorf("pod does not contain container: %s", containerName)
+		return instance
+	}
+	instance.Command = slices.Concat(container.Command, container.Args)
+	instance.VolumeMounts = container.VolumeMounts
+	return instance
+}
+
+// StaticPodManifestSource resolves a component from a static pod manifest on a node.
+// The manifest is read with a PodExecutor which runs on the node with the node root file system as its root.
+type StaticPodManifestSource struct {
+	PodExecutor   pod.PodExecutor
+	NodeName      string
+	ManifestPath  string
+	ContainerName string
+}
+
+// Target implements [ComponentSource].
+func (s *StaticPodManifestSource) Target() rule.Target {
+	return rule.NewTarget("name", s.ManifestPath, "kind", "File", "node", s.NodeName)
+}
+
+// Instances implements [ComponentSource].
+func (s *StaticPodManifestSource) Instances(ctx context.Context) ([]ComponentInstance, error) {
+	manifest, err := s.PodExecutor.Execute(ctx, "/bin/sh", fmt.Sprintf("cat %s", s.ManifestPath))
+	if err != nil {
+		return nil, err
+	}
+
+	p := corev1.Pod{}
+	if _, _, err := serializer.NewCodecFactory(scheme.Scheme).UniversalDeserializer().Decode([]byte(manifest), nil, &p); err != nil {
+		return nil, err
+	}
+	if p.Spec.NodeName == "" {
+		p.Spec.NodeName = s.NodeName
+	}
+
+	instance := podInstance(p, s.ContainerName)
+	instance.Target = s.Target()
+	return []ComponentInstance{instance}, nil
+}
+
+// ProcessSource resolves a component from the arguments of the processes with a given name on a node,
+// e.g. the components of a k3s server which are embedded in a single process.
+// The processes are listed with a PodExecutor which runs in the host pid namespace of the node.
+type ProcessSource struct {
+	PodExecutor pod.PodExecutor
+	NodeName    string
+	ProcessName string
+}
+
+// Target implements [ComponentSource].
+func (s *ProcessSource) Target() rule.Target {
+	return rule.NewTarget("name", s.ProcessName, "kind", "Process", "node", s.NodeName)
+}
+
+// Instances implements [ComponentSource].
+func (s *ProcessSource) Instances(ctx context.Context) ([]ComponentInstance, error) {
+	output, err := s.PodExecutor.Execute(ctx, "/bin/sh", fmt.Sprintf("ps --no-headers -C %s -o pid=,args=", s.ProcessName))
+	if err != nil {
+		return nil, err
+	}
+
+	var instances []ComponentInstance
+	for line := range strings.SplitSeq(output, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		instances = append(instances, ComponentInstance{
+			Target:   s.Target().With("pid", fields[0]),
+			NodeName: s.NodeName,
+			Command:  fields[1:],
+			OnHost:   true,
+		})
+	}
+	if len(instances) == 0 {
+		return nil, fmt.Errorf("process %s is not running", s.ProcessName)
+	}
+	return instances, nil
+}
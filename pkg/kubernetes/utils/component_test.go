@@ -0,0 +1,395 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	fakepod "github.com/gardener/diki/pkg/kubernetes/pod/fake"
+	"github.com/gardener/diki/pkg/kubernetes/utils"
+	"github.com/gardener/diki/pkg/rule"
+)
+
+var _ = Describe("component", func() {
+	var (
+		fakeClient client.Client
+		ctx        = context.TODO()
+		podSpec    corev1.PodSpec
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().Build()
+		podSpec = corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name:    "kube-apiserver",
+					Command: []string{"kube-apiserver", "--foo=bar"},
+					Args:    []string{"--bar=baz"},
+					VolumeMounts: []corev1.VolumeMount{
+						{
+							Name:      "config",
+							MountPath: "/etc/config",
+						},
+					},
+				},
+			},
+			Volumes: []corev1.Volume{
+				{
+					Name: "config",
+					VolumeSource: corev1.VolumeSource{
+						ConfigMap: &corev1.ConfigMapVolumeSource{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "config"},
+						},
+					},
+				},
+			},
+		}
+	})
+
+	Describe("#DeploymentSource", func() {
+		var source *utils.DeploymentSource
+
+		BeforeEach(func() {
+			source = &utils.DeploymentSource{Client: fakeClient, Name: "kube-apiserver", Namespace: "foo", ContainerName: "kube-apiserver"}
+		})
+
+		It("should return the instance of the deployment", func() {
+			deployment := &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "kube-apiserver", Namespace: "foo"},
+				Spec:       appsv1.DeploymentSpec{Template: corev1.PodTemplateSpec{Spec: podSpec}},
+			}
+			Expect(fakeClient.Create(ctx, deployment)).To(Succeed())
+
+			instances, err := source.Instances(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(instances).To(Equal([]utils.ComponentInstance{
+				{
+					Target:       rule.NewTarget("name", "kube-apiserver", "namespace", "foo", "kind", "Deployment"),
+					Namespace:    "foo",
+					Command:      []string{"kube-apiserver", "--foo=bar", "--bar=baz"},
+					Volumes:      podSpec.Volumes,
+					VolumeMounts: podSpec.Containers[0].VolumeMounts,
+				},
+			}))
+			Expect(instances[0].FlagValues("bar")).To(Equal([]string{"baz"}))
+		})
+
+		It("should error when the deployment is not found", func() {
+			_, err := source.Instances(ctx)
+			Expect(err).To(MatchError("deployments.apps \"kube-apiserver\" not found"))
+			Expect(source.Target()).To(Equal(rule.NewTarget("name", "kube-apiserver", "namespace", "foo", "kind", "Deployment")))
+		})
+
+		It("should error when the container is not found", func() {
+			source.ContainerName = "bar"
+			deployment := &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "kube-apiserver", Namespace: "foo"},
+				Spec:       appsv1.DeploymentSpec{Template: corev1.PodTemplateSpec{Spec: podSpec}},
+			}
+			Expect(fakeClient.Create(ctx, deployment)).To(Succeed())
+
+			_, err := source.Instances(ctx)
+			Expect(err).To(MatchError("deployment: kube-apiserver does not contain container: bar"))
+		})
+	})
+
+	Describe("#StatefulSetSource", func() {
+		It("should return the instance of the statefulSet", func() {
+			podSpec.Containers[0].Name = "etcd"
+			statefulSet := &appsv1.StatefulSet{
+				ObjectMeta: metav1.ObjectMeta{Name: "etcd-main", Namespace: "foo"},
+				Spec:       appsv1.StatefulSetSpec{Template: corev1.PodTemplateSpec{Spec: podSpec}},
+			}
+			Expect(fakeClient.Create(ctx, statefulSet)).To(Succeed())
+
+			source := &utils.StatefulSetSource{Client: fakeClient, Name: "etcd-main", Namespace: "foo", ContainerName: "etcd"}
+			instances, err := source.Instances(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(instances).To(HaveLen(1))
+			Expect(instances[0].Target).To(Equal(rule.NewTarget("name", "etcd-main", "namespace", "foo", "kind", "StatefulSet")))
+			Expect(instances[0].Command).To(Equal([]string{"kube-apiserver", "--foo=bar", "--bar=baz"}))
+		})
+
+		It("should only resolve the volumes when the container name is not set", func() {
+			podSpec.Volumes = []corev1.Volume{{Name: "etcd-config-file"}}
+			statefulSet := &appsv1.StatefulSet{
+				ObjectMeta: metav1.ObjectMeta{Name: "etcd-main", Namespace: "foo"},
+				Spec:       appsv1.StatefulSetSpec{Template: corev1.PodTemplateSpec{Spec: podSpec}},
+			}
+			Expect(fakeClient.Create(ctx, statefulSet)).To(Succeed())
+
+			source := &utils.StatefulSetSource{Client: fakeClient, Name: "etcd-main", Namespace: "foo"}
+			instances, err := source.Instances(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(instances).To(Equal([]utils.ComponentInstance{
+				{
+					Target:    rule.NewTarget("name", "etcd-main", "namespace", "foo", "kind", "StatefulSet"),
+					Namespace: "foo",
+					Volumes:   []corev1.Volume{{Name: "etcd-config-file"}},
+				},
+			}))
+		})
+	})
+
+	Describe("#DaemonSetSource", func() {
+		It("should error when the container is not found", func() {
+			daemonSet := &appsv1.DaemonSet{
+				ObjectMeta: metav1.ObjectMeta{Name: "kube-proxy", Namespace: "kube-system"},
+				Spec:       appsv1.DaemonSetSpec{Template: corev1.PodTemplateSpec{Spec: podSpec}},
+			}
+			Expect(fakeClient.Create(ctx, daemonSet)).To(Succeed())
+
+			source := &utils.DaemonSetSource{Client: fakeClient, Name: "kube-proxy", Namespace: "kube-system", ContainerName: "kube-proxy"}
+			_, err := source.Instances(ctx)
+			Expect(err).To(MatchError("daemonSet: kube-proxy does not contain container: kube-proxy"))
+		})
+	})
+
+	Describe("#StaticPodSource", func() {
+		var (
+			source    *utils.StaticPodSource
+			newMirror func(name, nodeName string) *corev1.Pod
+		)
+
+		BeforeEach(func() {
+			source = &utils.StaticPodSource{
+				Client:        fakeClient,
+				Namespace:     "kube-system",
+				Selector:      labels.SelectorFromSet(labels.Set{"component": "kube-apiserver"}),
+				ContainerName: "kube-apiserver",
+			}
+			newMirror = func(name, nodeName string) *corev1.Pod {
+				p := &corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        name,
+						Namespace:   "kube-system",
+						Labels:      map[string]string{"component": "kube-apiserver"},
+						Annotations: map[string]string{corev1.MirrorPodAnnotationKey: "foo"},
+					},
+					Spec: *podSpec.DeepCopy(),
+				}
+				p.Spec.NodeName = nodeName
+				return p
+			}
+		})
+
+		It("should return an instance for every mirror pod sorted by name", func() {
+			Expect(fakeClient.Create(ctx, newMirror("kube-apiserver-node02", "node02"))).To(Succeed())
+			Expect(fakeClient.Create(ctx, newMirror("kube-apiserver-node01", "node01"))).To(Succeed())
+			nonMirrorPod := newMirror("kube-apiserver-foo", "node01")
+			nonMirrorPod.Annotations = nil
+			Expect(fakeClient.Create(ctx, nonMirrorPod)).To(Succeed())
+			missingContainerPod := newMirror("kube-apiserver-node03", "node03")
+			missingContainerPod.Spec.Containers[0].Name = "foo"
+			Expect(fakeClient.Create(ctx, missingContainerPod)).To(Succeed())
+
+			instances, err := source.Instances(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(instances).To(HaveLen(3))
+			Expect(instances[0].Target).To(Equal(rule.NewTarget("name", "kube-apiserver-node01", "namespace", "kube-system", "kind", "Pod")))
+			Expect(instances[0].NodeName).To(Equal("node01"))
+			Expect(instances[0].Command).To(Equal([]string{"kube-apiserver", "--foo=bar", "--bar=baz"}))
+			Expect(instances[0].Pod.Name).To(Equal("kube-apiserver-node01"))
+			Expect(instances[1].Target).To(Equal(rule.NewTarget("name", "kube-apiserver-node02", "namespace", "kube-system", "kind", "Pod")))
+			Expect(instances[2].Target).To(Equal(rule.NewTarget("name", "kube-apiserver-node03", "namespace", "kube-system", "kind", "Pod")))
+			Expect(instances[2].Err).To(MatchError("pod does not contain container: kube-apiserver"))
+		})
+
+		It("should error when no mirror pods are found", func() {
+			_, err := source.Instances(ctx)
+			Expect(err).To(MatchError("pods not found"))
+			Expect(source.Target()).To(Equal(rule.NewTarget("namespace", "kube-system", "selector", "component=kube-apiserver")))
+		})
+	})
+
+	Describe("#StaticPodManifestSource", func() {
+		const manifest = `apiVersion: v1
+kind: Pod
+metadata:
+  name: kube-apiserver
+  namespace: kube-system
+spec:
+  containers:
+  - name: kube-apiserver
+    command:
+    - kube-apiserver
+    - --foo=bar
+`
+
+		It("should return the instance of the manifest", func() {
+			source := &utils.StaticPodManifestSource{
+				PodExecutor:   fakepod.NewFakePodExecutor([]string{manifest}, []error{nil}),
+				NodeName:      "node01",
+				ManifestPath:  "/etc/kubernetes/manifests/kube-apiserver.yaml",
+				ContainerName: "kube-apiserver",
+			}
+
+			instances, err := source.Instances(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(instances).To(HaveLen(1))
+			Expect(instances[0].Target).To(Equal(rule.NewTarget("name", "/etc/kubernetes/manifests/kube-apiserver.yaml", "kind", "File", "node", "node01")))
+			Expect(instances[0].NodeName).To(Equal("node01"))
+			Expect(instances[0].Command).To(Equal([]string{"kube-apiserver", "--foo=bar"}))
+		})
+
+		It("should error when the manifest cannot be read", func() {
+			source := &utils.StaticPodManifestSource{
+				PodExecutor:  fakepod.NewFakePodExecutor([]string{""}, []error{errors.New("foo")}),
+				ManifestPath: "/etc/kubernetes/manifests/kube-apiserver.yaml",
+			}
+
+			_, err := source.Instances(ctx)
+			Expect(err).To(MatchError("foo"))
+		})
+	})
+
+	Describe("#ProcessSource", func() {
+		It("should return an instance for every process", func() {
+			source := &utils.ProcessSource{
+				PodExecutor: fakepod.NewFakePodExecutor([]string{"  42 k3s server --foo=bar\n 43 k3s server --foo=baz\n"}, []error{nil}),
+				NodeName:    "node01",
+				ProcessName: "k3s",
+			}
+
+			instances, err := source.Instances(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(instances).To(Equal([]utils.ComponentInstance{
+				{
+					Target:   rule.NewTarget("name", "k3s", "kind", "Process", "node", "node01", "pid", "42"),
+					NodeName: "node01",
+					Command:  []string{"k3s", "server", "--foo=bar"},
+					OnHost:   true,
+				},
+				{
+					Target:   rule.NewTarget("name", "k3s", "kind", "Process", "node", "node01", "pid", "43"),
+					NodeName: "node01",
+					Command:  []string{"k3s", "server", "--foo=baz"},
+					OnHost:   true,
+				},
+			}))
+		})
+
+		It("should error when the process is not running", func() {
+			source := &utils.ProcessSource{
+				PodExecutor: fakepod.NewFakePodExecutor([]string{""}, []error{nil}),
+				ProcessName: "k3s",
+			}
+
+			_, err := source.Instances(ctx)
+			Expect(err).To(MatchError("process k3s is not running"))
+		})
+	})
+
+	Describe("#ReadComponentFile", func() {
+		var instance utils.ComponentInstance
+
+		BeforeEach(func() {
+			instance = utils.ComponentInstance{
+				Target:    rule.NewTarget("name", "kube-apiserver-node01", "namespace", "kube-system", "kind", "Pod"),
+				Namespace: "kube-system",
+				VolumeMounts: []corev1.VolumeMount{
+					{
+						Name:      "config",
+						MountPath: "/etc/config",
+					},
+					{
+						Name:      "pki",
+						MountPath: "/etc/kubernetes/pki",
+					},
+				},
+				Volumes: []corev1.Volume{
+					podSpec.Volumes[0],
+					{
+						Name: "pki",
+						VolumeSource: corev1.VolumeSource{
+							HostPath: &corev1.HostPathVolumeSource{Path: "/etc/kubernetes/pki"},
+						},
+					},
+				},
+			}
+		})
+
+		It("should read files from configMap volumes", func() {
+			configMap := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "config", Namespace: "kube-system"},
+				Data:       map[string]string{"config.yaml": "foo"},
+			}
+			Expect(fakeClient.Create(ctx, configMap)).To(Succeed())
+
+			data, err := utils.ReadComponentFile(ctx, fakeClient, nil, instance, "/etc/config/config.yaml")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(data)).To(Equal("foo"))
+		})
+
+		It("should read files from hostPath volumes from the node", func() {
+			podExecutor := fakepod.NewFakePodExecutor([]string{"bar"}, []error{nil})
+
+			data, err := utils.ReadComponentFile(ctx, fakeClient, podExecutor, instance, "/etc/kubernetes/pki/ca.crt")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(data)).To(Equal("bar"))
+		})
+
+		It("should error when files on the node are read without a pod executor", func() {
+			_, err := utils.ReadComponentFile(ctx, fakeClient, nil, instance, "/etc/kubernetes/pki/ca.crt")
+			Expect(err).To(MatchError("file /etc/kubernetes/pki/ca.crt can only be read from the node"))
+		})
+
+		It("should error when the file is not mounted", func() {
+			_, err := utils.ReadComponentFile(ctx, fakeClient, nil, instance, "/foo/bar")
+			Expect(err).To(MatchError("cannot find volume with path /foo/bar"))
+		})
+
+		It("should error when the volume of the mount is missing", func() {
+			instance.Volumes = nil
+
+			_, err := utils.ReadComponentFile(ctx, fakeClient, nil, instance, "/etc/config/config.yaml")
+			Expect(err).To(MatchError("pod does not contain volume with name: config"))
+		})
+	})
+
+	Describe("#HostPath", func() {
+		It("should return the path of the file on the node", func() {
+			instance := utils.ComponentInstance{
+				VolumeMounts: []corev1.VolumeMount{
+					{Name: "etc", MountPath: "/etc/kubernetes"},
+					{Name: "pki", MountPath: "/etc/kubernetes/pki"},
+				},
+				Volumes: []corev1.Volume{
+					{Name: "etc", VolumeSource: corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{Path: "/etc/kubernetes"}}},
+					{Name: "pki", VolumeSource: corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{Path: "/var/lib/pki"}}},
+				},
+			}
+
+			Expect(instance.HostPath("/etc/kubernetes/pki/ca.crt")).To(Equal("/var/lib/pki/ca.crt"))
+			Expect(instance.HostPath("/etc/kubernetes/admin.conf")).To(Equal("/etc/kubernetes/admin.conf"))
+		})
+
+		It("should return the path unchanged for instances on the host", func() {
+			instance := utils.ComponentInstance{OnHost: true}
+
+			Expect(instance.HostPath("/etc/rancher/k3s/config.yaml")).To(Equal("/etc/rancher/k3s/config.yaml"))
+		})
+
+		It("should error when the volume is not a hostPath volume", func() {
+			instance := utils.ComponentInstance{
+				VolumeMounts: []corev1.VolumeMount{{Name: "config", MountPath: "/etc/config"}},
+				Volumes:      []corev1.Volume{{Name: "config", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}}},
+			}
+
+			_, err := instance.HostPath("/etc/config/config.yaml")
+			Expect(err).To(MatchError("volume config of file /etc/config/config.yaml is not a hostPath volume"))
+		})
+	})
+})
@@ -28,6 +28,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -133,6 +134,27 @@ func GetPods(ctx context.Context, c client.Client, namespace string, selector la
 	}
 }
 
+// GetMirrorPods returns the mirror pods of static pods for a given namespace sorted by name.
+// It retrieves pods by portions set by limit.
+func GetMirrorPods(ctx context.Context, c client.Client, namespace string, selector labels.Selector, limit int64) ([]corev1.Pod, error) {
+	pods, err := GetPods(ctx, c, namespace, selector, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	var mirrorPods []corev1.Pod
+	for _, p := range pods {
+		if _, ok := p.Annotations[corev1.MirrorPodAnnotationKey]; ok {
+			mirrorPods = append(mirrorPods, p)
+		}
+	}
+
+	slices.SortFunc(mirrorPods, func(a, b corev1.Pod) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+	return mirrorPods, nil
+}
+
 // GetServices returns all services for a given namespace, or all namespaces if it's set to empty string "".
 // It retrieves services by portions set by limit.
 func GetServices(ctx context.Context, c client.Client, namespace string, selector labels.Selector, limit int64) ([]corev1.Service, error) {
@@ -512,24 +534,29 @@ func GetCommandOptionFromDeployment(ctx context.Context, c client.Client, deploy
 	return optionSlice, nil
 }
 
-// GetKubeletCommand returns the used kubelet command
+// GetKubeletCommand returns the used kubelet command.
+// The main PID and the command of the kubelet service are retrieved with a single batch of commands.
 func GetKubeletCommand(ctx context.Context, podExecutor pod.PodExecutor) (string, error) {
-	kubeletPID, err := podExecutor.Execute(ctx, "/bin/sh", `systemctl show -P MainPID kubelet`)
+	results, err := pod.ExecuteBatch(ctx, podExecutor, []string{
+		`systemctl show -P MainPID kubelet`,
+		`ps --no-headers -p "$(systemctl show -P MainPID kubelet)" -o command`,
+	})
 	if err != nil {
 		return "", err
 	}
-	kubeletPID = strings.TrimSuffix(kubeletPID, "\n")
+	if results[0].Err != nil {
+		return "", results[0].Err
+	}
 
-	if kubeletPID == "0" {
+	if strings.TrimSuffix(results[0].Stdout, "\n") == "0" {
 		return "", errors.New("kubelet service is not running")
 	}
 
-	rawKubeletCommand, err := podExecutor.Execute(ctx, "/bin/sh", fmt.Sprintf("ps --no-headers -p %s -o command", kubeletPID))
-	if err != nil {
-		return "", err
+	if results[1].Err != nil {
+		return "", results[1].Err
 	}
 
-	return rawKubeletCommand, nil
+	return results[1].Stdout, nil
 }
 
 // GetContainerCommand iterates over the passed container names and tries to find a match in the pod containers list.
@@ -584,13 +611,13 @@ func GetKubeletConfig(ctx context.Context, podExecutor pod.PodExecutor, rawKubel
 	}
 	configPath := configPathSlice[0]
 
-	rawKubeletConfig, err := podExecutor.Execute(ctx, "/bin/sh", fmt.Sprintf("cat %s", configPath))
+	rawKubeletConfig, err := readNodeFile(ctx, podExecutor, configPath)
 	if err != nil {
 		return &config.KubeletConfig{}, err
 	}
 
 	kubeletConfig := &config.KubeletConfig{}
-	err = yaml.Unmarshal([]byte(rawKubeletConfig), kubeletConfig)
+	err = yaml.Unmarshal(rawKubeletConfig, kubeletConfig)
 	if err != nil {
 		return &config.KubeletConfig{}, err
 	}
@@ -600,14 +627,13 @@ func GetKubeletConfig(ctx context.Context, podExecutor pod.PodExecutor, rawKubel
 
 // GetKubeProxyConfig returns the kube-proxy config specified by it's path
 func GetKubeProxyConfig(ctx context.Context, podExecutor pod.PodExecutor, kubeProxyPath string) (*config.KubeProxyConfig, error) {
-
-	rawKubeProxyConfig, err := podExecutor.Execute(ctx, "/bin/sh", fmt.Sprintf("cat %s", kubeProxyPath))
+	rawKubeProxyConfig, err := readNodeFile(ctx, podExecutor, kubeProxyPath)
 	if err != nil {
 		return &config.KubeProxyConfig{}, err
 	}
 
 	kubeProxyConfig := &config.KubeProxyConfig{}
-	err = yaml.Unmarshal([]byte(rawKubeProxyConfig), kubeProxyConfig)
+	err = yaml.Unmarshal(rawKubeProxyConfig, kubeProxyConfig)
 	if err != nil {
 		return &config.KubeProxyConfig{}, err
 	}
@@ -615,6 +641,33 @@ func GetKubeProxyConfig(ctx context.Context, podExecutor pod.PodExecutor, kubePr
 	return kubeProxyConfig, nil
 }
 
+// ReadNodeFiles reads files on the node of the podExecutor by executing a single batch of commands.
+// The returned file contents and errors are in the order of the file paths.
+func ReadNodeFiles(ctx context.Context, podExecutor pod.PodExecutor, filePaths []string) ([][]byte, []error, error) {
+	commands := make([]string, 0, len(filePaths))
+	for _, filePath := range filePaths {
+		commands = append(commands, fmt.Sprintf("cat %s", filePath))
+	}
+
+	results, err := pod.ExecuteBatch(ctx, podExecutor, commands)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		data = make([][]byte, len(filePaths))
+		errs = make([]error, len(filePaths))
+	)
+	for i, result := range results {
+		if result.Err != nil {
+			errs[i] = result.Err
+			continue
+		}
+		data[i] = []byte(result.Stdout)
+	}
+	return data, errs, nil
+}
+
 // GetNodeConfigz returns the runtime kubelet config
 func GetNodeConfigz(ctx context.Context, coreV1RESTClient rest.Interface, nodeName string) (*config.KubeletConfig, error) {
 	request := coreV1RESTClient.Get().Resource("nodes").Name(nodeName).SubResource("proxy").Suffix("configz")
@@ -685,6 +738,20 @@ func RESTConfigFromFile(filePath string) (*rest.Config, error) {
 	return config, nil
 }
 
+// GetServerVersion returns the git version of the Kubernetes API server.
+func GetServerVersion(config *rest.Config) (string, error) {
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(config)
+	if err != nil {
+		return "", err
+	}
+
+	info, err := discoveryClient.ServerVersion()
+	if err != nil {
+		return "", err
+	}
+	return info.GitVersion, nil
+}
+
 // GetNodesAllocatablePodsNum return the number of free
 // allocatable spots of pods for all nodes.
 func GetNodesAllocatablePodsNum(pods []corev1.Pod, nodes []corev1.Node) map[string]int {
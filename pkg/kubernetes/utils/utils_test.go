@@ -1877,6 +1877,20 @@ readOnlyPort: 222
 		)
 	})
 
+	Describe("#ReadNodeFiles", func() {
+		It("should return file contents and errors in the order of the files", func() {
+			fakePodExecutor := fakepod.NewFakePodExecutor([]string{"foo", "", "baz"}, []error{nil, errors.New("bar"), nil})
+
+			data, errs, err := utils.ReadNodeFiles(context.TODO(), fakePodExecutor, []string{"/foo", "/bar", "/baz"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(data).To(Equal([][]byte{[]byte("foo"), nil, []byte("baz")}))
+			Expect(errs).To(HaveLen(3))
+			Expect(errs[0]).ToNot(HaveOccurred())
+			Expect(errs[1]).To(MatchError("bar"))
+			Expect(errs[2]).ToNot(HaveOccurred())
+		})
+	})
+
 	Describe("#GetNodesAllocatablePodsNum", func() {
 		It("should correct number of allocatable pods", func() {
 			pod1 := &corev1.Pod{}
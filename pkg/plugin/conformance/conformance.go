@@ -0,0 +1,225 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package conformance checks that a plugin executable implements the diki plugin protocol.
+// Plugin authors can call [Check] from the tests of their plugins.
+package conformance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+
+	"github.com/gardener/diki/pkg/plugin/protocol"
+	"github.com/gardener/diki/pkg/rule"
+)
+
+// unknownID is used to request rulesets and rules which plugins do not implement.
+const unknownID = "diki-conformance-unknown"
+
+// Check runs all methods of the plugin with the given configuration and returns the violations of the protocol.
+// The configuration has to select at least one ruleset.
+func Check(ctx context.Context, client *protocol.Client, conf protocol.ProviderConfig) error {
+	if len(conf.Rulesets) == 0 {
+		return errors.New("configuration has to contain at least one ruleset")
+	}
+
+	errs := checkDescribe(ctx, client, conf)
+	errs = append(errs, checkRunAll(ctx, client, conf)...)
+	for _, rs := range conf.Rulesets {
+		errs = append(errs, checkRunRuleset(ctx, client, conf, rs)...)
+	}
+	errs = append(errs, checkFailures(ctx, client, conf)...)
+	return errors.Join(errs...)
+}
+
+func checkDescribe(ctx context.Context, client *protocol.Client, conf protocol.ProviderConfig) []error {
+	var errs []error
+	for _, req := range []protocol.Request{
+		{Method: protocol.MethodDescribe},
+		{Method: protocol.MethodDescribe, Config: &conf},
+	} {
+		msg, err := client.Call(ctx, req, nil)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", req.Method, err))
+			continue
+		}
+
+		description := msg.Description
+		if description.ProtocolVersion != protocol.Version {
+			errs = append(errs, fmt.Errorf("%s: protocol version is %q instead of %s", req.Method, description.ProtocolVersion, protocol.Version))
+		}
+		if description.ID != conf.ID {
+			errs = append(errs, fmt.Errorf("%s: provider id is %q instead of %s", req.Method, description.ID, conf.ID))
+		}
+		if len(description.Name) == 0 {
+			errs = append(errs, fmt.Errorf("%s: provider name is empty", req.Method))
+		}
+		for _, rs := range description.Rulesets {
+			if len(rs.ID) == 0 || len(rs.Name) == 0 || len(rs.Versions) == 0 {
+				errs = append(errs, fmt.Errorf("%s: ruleset %q has to have an id, a name and versions", req.Method, rs.ID))
+			}
+		}
+		for _, rs := range conf.Rulesets {
+			if !slices.ContainsFunc(description.Rulesets, func(d protocol.RulesetDescription) bool {
+				return d.ID == rs.ID && slices.Contains(d.Versions, rs.Version)
+			}) {
+				errs = append(errs, fmt.Errorf("%s: configured ruleset %s with version %s is not described", req.Method, rs.ID, rs.Version))
+			}
+		}
+	}
+	return errs
+}
+
+func checkRunAll(ctx context.Context, client *protocol.Client, conf protocol.ProviderConfig) []error {
+	result, progress, err := run(ctx, client, protocol.Request{Method: protocol.MethodRunAll, Config: &conf})
+	if err != nil {
+		return []error{fmt.Errorf("%s: %w", protocol.MethodRunAll, err)}
+	}
+
+	errs := checkResult(protocol.MethodRunAll, result, progress)
+	for _, rs := range conf.Rulesets {
+		if !slices.ContainsFunc(result.Rulesets, func(r protocol.RulesetResult) bool { return r.ID == rs.ID && r.Version == rs.Version }) {
+			errs = append(errs, fmt.Errorf("%s: result of ruleset %s with version %s is missing", protocol.MethodRunAll, rs.ID, rs.Version))
+		}
+	}
+	if len(result.Rulesets) != len(conf.Rulesets) {
+		errs = append(errs, fmt.Errorf("%s: result contains %d rulesets instead of %d", protocol.MethodRunAll, len(result.Rulesets), len(conf.Rulesets)))
+	}
+	return errs
+}
+
+func checkRunRuleset(ctx context.Context, client *protocol.Client, conf protocol.ProviderConfig, rs protocol.RulesetConfig) []error {
+	result, progress, err := run(ctx, client, protocol.Request{Method: protocol.MethodRunRuleset, Config: &conf, RulesetID: rs.ID, RulesetVersion: rs.Version})
+	if err != nil {
+		return []error{fmt.Errorf("%s %s: %w", protocol.MethodRunRuleset, rs.ID, err)}
+	}
+
+	errs := checkResult(protocol.MethodRunRuleset, result, progress)
+	if len(result.Rulesets) != 1 || result.Rulesets[0].ID != rs.ID || result.Rulesets[0].Version != rs.Version {
+		return append(errs, fmt.Errorf("%s %s: result has to contain only ruleset %s with version %s", protocol.MethodRunRuleset, rs.ID, rs.ID, rs.Version))
+	}
+	if len(result.Rulesets[0].Rules) == 0 {
+		return append(errs, fmt.Errorf("%s %s: result does not contain rules", protocol.MethodRunRuleset, rs.ID))
+	}
+
+	ruleID := result.Rulesets[0].Rules[0].ID
+	result, progress, err = run(ctx, client, protocol.Request{Method: protocol.MethodRunRule, Config: &conf, RulesetID: rs.ID, RulesetVersion: rs.Version, RuleID: ruleID})
+	if err != nil {
+		return append(errs, fmt.Errorf("%s %s: %w", protocol.MethodRunRule, ruleID, err))
+	}
+
+	errs = append(errs, checkResult(protocol.MethodRunRule, result, progress)...)
+	if len(result.Rulesets) != 1 || result.Rulesets[0].ID != rs.ID || len(result.Rulesets[0].Rules) != 1 || result.Rulesets[0].Rules[0].ID != ruleID {
+		errs = append(errs, fmt.Errorf("%s %s: result has to contain only rule %s of ruleset %s", protocol.MethodRunRule, ruleID, ruleID, rs.ID))
+	}
+	return errs
+}
+
+// checkFailures checks that the plugin answers invalid requests with an error message.
+func checkFailures(ctx context.Context, client *protocol.Client, conf protocol.ProviderConfig) []error {
+	rs := conf.Rulesets[0]
+	invalidRequests := map[string]protocol.Request{
+		"unsupported protocol version": {ProtocolVersion: "v0", Method: protocol.MethodDescribe},
+		"unknown method":               {Method: protocol.Method(unknownID), Config: &conf},
+		"unknown ruleset":              {Method: protocol.MethodRunRuleset, Config: &conf, RulesetID: unknownID, RulesetVersion: rs.Version},
+		"unknown rule":                 {Method: protocol.MethodRunRule, Config: &conf, RulesetID: rs.ID, RulesetVersion: rs.Version, RuleID: unknownID},
+	}
+
+	var errs []error
+	for _, name := range slices.Sorted(maps.Keys(invalidRequests)) {
+		if _, err := client.Call(ctx, invalidRequests[name], nil); err == nil {
+			errs = append(errs, fmt.Errorf("request with %s did not fail", name))
+		}
+	}
+	return errs
+}
+
+// run sends a run request and records its progress.
+func run(ctx context.Context, client *protocol.Client, req protocol.Request) (protocol.Result, []protocol.Progress, error) {
+	var (
+		mu       sync.Mutex
+		progress []protocol.Progress
+	)
+	msg, err := client.Call(ctx, req, func(p protocol.Progress) {
+		mu.Lock()
+		defer mu.Unlock()
+		progress = append(progress, p)
+	})
+	if err != nil {
+		return protocol.Result{}, nil, err
+	}
+	return *msg.Result, progress, nil
+}
+
+// checkResult checks the statuses and severities of a result and that every rule of the result was reported by progress events.
+func checkResult(method protocol.Method, result protocol.Result, progress []protocol.Progress) []error {
+	var (
+		errs       []error
+		severities = []rule.SeverityLevel{rule.SeverityLow, rule.SeverityMedium, rule.SeverityHigh}
+	)
+	for _, rs := range result.Rulesets {
+		if len(rs.ID) == 0 || len(rs.Name) == 0 || len(rs.Version) == 0 {
+			errs = append(errs, fmt.Errorf("%s: ruleset result %q has to have an id, a name and a version", method, rs.ID))
+		}
+
+		var ruleIDs []string
+		for _, r := range rs.Rules {
+			if len(r.ID) == 0 {
+				errs = append(errs, fmt.Errorf("%s: rule result of ruleset %s has no id", method, rs.ID))
+			}
+			if slices.Contains(ruleIDs, r.ID) {
+				errs = append(errs, fmt.Errorf("%s: rule %s of ruleset %s is reported more than once", method, r.ID, rs.ID))
+			}
+			ruleIDs = append(ruleIDs, r.ID)
+
+			if len(r.Severity) > 0 && !slices.Contains(severities, rule.SeverityLevel(r.Severity)) {
+				errs = append(errs, fmt.Errorf("%s: rule %s reports unknown severity %s", method, r.ID, r.Severity))
+			}
+			for _, check := range r.Checks {
+				if !slices.Contains(rule.Statuses(), rule.Status(check.Status)) {
+					errs = append(errs, fmt.Errorf("%s: rule %s reports unknown status %s", method, r.ID, check.Status))
+				}
+			}
+			if !slices.ContainsFunc(progress, func(p protocol.Progress) bool {
+				return p.Event == protocol.ProgressEventRuleFinished && p.RulesetID == rs.ID && p.RulesetVersion == rs.Version && p.RuleID == r.ID
+			}) {
+				errs = append(errs, fmt.Errorf("%s: finish of rule %s of ruleset %s was not reported", method, r.ID, rs.ID))
+			}
+		}
+	}
+	return append(errs, checkProgress(method, progress)...)
+}
+
+// checkProgress checks that every started rule is finished once and that no rule is finished before it started.
+func checkProgress(method protocol.Method, progress []protocol.Progress) []error {
+	var (
+		errs    []error
+		started = map[string]bool{}
+	)
+	for _, p := range progress {
+		key := p.RulesetID + "/" + p.RulesetVersion + "/" + p.RuleID
+		switch p.Event {
+		case protocol.ProgressEventRuleStarted:
+			if started[key] {
+				errs = append(errs, fmt.Errorf("%s: rule %s was started twice", method, key))
+			}
+			started[key] = true
+		case protocol.ProgressEventRuleFinished:
+			if !started[key] {
+				errs = append(errs, fmt.Errorf("%s: rule %s was finished without being started", method, key))
+			}
+			delete(started, key)
+		default:
+			errs = append(errs, fmt.Errorf("%s: unknown progress event %q", method, p.Event))
+		}
+	}
+	for _, key := range slices.Sorted(maps.Keys(started)) {
+		errs = append(errs, fmt.Errorf("%s: rule %s was started but not finished", method, key))
+	}
+	return errs
+}
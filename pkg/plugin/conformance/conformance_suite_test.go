@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package conformance_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/diki/pkg/plugin/protocol"
+)
+
+// brokenPluginEnv makes the test binary serve a plugin which violates the protocol instead of running the tests.
+const brokenPluginEnv = "DIKI_BROKEN_PLUGIN"
+
+func TestMain(m *testing.M) {
+	if os.Getenv(brokenPluginEnv) == "true" {
+		if err := protocol.Serve(context.Background(), os.Stdin, os.Stdout, &brokenPlugin{}); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+func TestConformance(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Plugin Conformance Test Suite")
+}
+
+// brokenPlugin describes the wrong provider, does not report progress and ignores the selected ruleset and rule.
+type brokenPlugin struct{}
+
+func (b *brokenPlugin) Describe(context.Context, *protocol.ProviderConfig) (protocol.Description, error) {
+	return protocol.Description{
+		ProtocolVersion: protocol.Version,
+		ID:              "bar",
+		Name:            "Bar",
+		Rulesets:        []protocol.RulesetDescription{{ID: "foo", Name: "Foo", Versions: []string{"v1"}}},
+	}, nil
+}
+
+func (b *brokenPlugin) RunAll(context.Context, protocol.ProviderConfig, protocol.ProgressFunc) (protocol.Result, error) {
+	return b.result(), nil
+}
+
+func (b *brokenPlugin) RunRuleset(context.Context, protocol.ProviderConfig, string, string, protocol.ProgressFunc) (protocol.Result, error) {
+	return b.result(), nil
+}
+
+func (b *brokenPlugin) RunRule(context.Context, protocol.ProviderConfig, string, string, string, protocol.ProgressFunc) (protocol.Result, error) {
+	return b.result(), nil
+}
+
+func (b *brokenPlugin) result() protocol.Result {
+	return protocol.Result{Rulesets: []protocol.RulesetResult{{
+		ID:      "foo",
+		Name:    "Foo",
+		Version: "v1",
+		Rules: []protocol.RuleResult{
+			{ID: "1", Name: "One", Severity: "Critical", Checks: []protocol.CheckResult{{Status: "Passed"}}},
+			{ID: "2", Name: "Two", Checks: []protocol.CheckResult{{Status: "Great"}}},
+		},
+	}}}
+}
@@ -0,0 +1,52 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package conformance_test
+
+import (
+	"context"
+	"os"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/diki/pkg/plugin/conformance"
+	"github.com/gardener/diki/pkg/plugin/protocol"
+)
+
+var _ = Describe("#Check", func() {
+	var (
+		ctx    = context.TODO()
+		client = &protocol.Client{
+			Command: os.Args[0],
+			Env:     map[string]string{brokenPluginEnv: "true"},
+		}
+		conf = protocol.ProviderConfig{
+			ID:       "foo",
+			Rulesets: []protocol.RulesetConfig{{ID: "foo", Version: "v1"}},
+		}
+	)
+
+	It("should require a ruleset", func() {
+		Expect(conformance.Check(ctx, client, protocol.ProviderConfig{ID: "foo"})).To(MatchError("configuration has to contain at least one ruleset"))
+	})
+
+	It("should report the violations of the protocol", func() {
+		err := conformance.Check(ctx, client, conf)
+
+		for _, violation := range []string{
+			`describe: provider id is "bar" instead of foo`,
+			"runAll: rule 1 reports unknown severity Critical",
+			"runAll: rule 2 reports unknown status Great",
+			"runAll: finish of rule 1 of ruleset foo was not reported",
+			"runRule 1: result has to contain only rule 1 of ruleset foo",
+			"request with unknown rule did not fail",
+			"request with unknown ruleset did not fail",
+		} {
+			Expect(err).To(MatchError(ContainSubstring(violation)))
+		}
+		Expect(err).NotTo(MatchError(ContainSubstring("unsupported protocol version did not fail")))
+		Expect(err).NotTo(MatchError(ContainSubstring("unknown method did not fail")))
+	})
+})
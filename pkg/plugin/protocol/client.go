@@ -0,0 +1,151 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package protocol
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"maps"
+	"os"
+	"os/exec"
+	"slices"
+	"sync"
+	"time"
+)
+
+// waitDelay is the time a plugin has to exit after it was interrupted because its context was cancelled.
+const waitDelay = 10 * time.Second
+
+// Client sends requests to a plugin executable.
+type Client struct {
+	// Command is the path of the plugin executable.
+	Command string
+	// Args are passed to the plugin executable.
+	Args []string
+	// Env are added to the environment of diki, which is passed to the plugin.
+	Env map[string]string
+	// Logger logs the standard error of the plugin. It defaults to [slog.Default].
+	Logger *slog.Logger
+}
+
+// Call starts the plugin, sends it the request and waits for its final message.
+// Progress messages are passed to onProgress, which may be nil.
+// The protocol version of the request is set if it is empty.
+// Error messages of the plugin are returned as errors.
+func (c *Client) Call(ctx context.Context, req Request, onProgress ProgressFunc) (Message, error) {
+	if len(req.ProtocolVersion) == 0 {
+		req.ProtocolVersion = Version
+	}
+	input, err := json.Marshal(req)
+	if err != nil {
+		return Message{}, err
+	}
+
+	cmd := exec.CommandContext(ctx, c.Command, c.Args...) // #nosec G204
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Env = os.Environ()
+	for _, k := range slices.Sorted(maps.Keys(c.Env)) {
+		cmd.Env = append(cmd.Env, k+"="+c.Env[k])
+	}
+	// plugins are interrupted to give them a chance to clean up
+	cmd.Cancel = func() error {
+		return cmd.Process.Signal(os.Interrupt)
+	}
+	cmd.WaitDelay = waitDelay
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return Message{}, err
+	}
+	stderr, err := cmd.StderrPipe()
+	if err != nil {
+		return Message{}, err
+	}
+	if err := cmd.Start(); err != nil {
+		return Message{}, fmt.Errorf("failed to start plugin %s: %w", c.Command, err)
+	}
+
+	logger := c.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	var wg sync.WaitGroup
+	wg.Go(func() {
+		scanner := bufio.NewScanner(stderr)
+		for scanner.Scan() {
+			logger.Info("plugin output", "plugin", c.Command, "output", scanner.Text())
+		}
+	})
+
+	final, readErr := readMessages(stdout, onProgress)
+	if readErr != nil {
+		// the remaining output is discarded, so that the plugin is not blocked
+		_, _ = io.Copy(io.Discard, stdout)
+	}
+	wg.Wait()
+	waitErr := cmd.Wait()
+
+	switch {
+	case readErr != nil:
+		return Message{}, fmt.Errorf("failed to read messages of plugin %s: %w", c.Command, readErr)
+	case final == nil && waitErr != nil:
+		return Message{}, fmt.Errorf("plugin %s failed: %w", c.Command, waitErr)
+	case final == nil:
+		return Message{}, fmt.Errorf("plugin %s did not write a final message", c.Command)
+	case final.Type == MessageTypeError:
+		return Message{}, fmt.Errorf("plugin %s failed: %s", c.Command, final.Error.Message)
+	case waitErr != nil:
+		return Message{}, fmt.Errorf("plugin %s failed: %w", c.Command, waitErr)
+	}
+
+	if expected := finalMessageType(req.Method); final.Type != expected {
+		return Message{}, fmt.Errorf("plugin %s answered method %s with a message of type %s instead of %s", c.Command, req.Method, final.Type, expected)
+	}
+	return *final, nil
+}
+
+// readMessages reads the messages of a plugin until its output is closed and returns the final message.
+func readMessages(r io.Reader, onProgress ProgressFunc) (*Message, error) {
+	var final *Message
+	dec := json.NewDecoder(r)
+	for {
+		var msg Message
+		if err := dec.Decode(&msg); errors.Is(err, io.EOF) {
+			return final, nil
+		} else if err != nil {
+			return nil, err
+		}
+
+		if final != nil {
+			return nil, fmt.Errorf("message of type %s written after final message of type %s", msg.Type, final.Type)
+		}
+
+		switch {
+		case msg.Type == MessageTypeProgress && msg.Progress != nil:
+			if onProgress != nil {
+				onProgress(*msg.Progress)
+			}
+		case msg.Type == MessageTypeDescribe && msg.Description != nil,
+			msg.Type == MessageTypeResult && msg.Result != nil,
+			msg.Type == MessageTypeError && msg.Error != nil:
+			final = &msg
+		default:
+			return nil, fmt.Errorf("invalid message of type %q", msg.Type)
+		}
+	}
+}
+
+func finalMessageType(method Method) MessageType {
+	if method == MethodDescribe {
+		return MessageTypeDescribe
+	}
+	return MessageTypeResult
+}
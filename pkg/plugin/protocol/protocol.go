@@ -0,0 +1,196 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package protocol defines the protocol with which diki runs provider plugins.
+//
+// A provider plugin is an executable which is started once for every request.
+// Diki writes a single [Request] as JSON to the standard input of the plugin.
+// The plugin writes [Message]s as newline-delimited JSON to its standard output:
+// any number of progress messages, followed by exactly one describe, result or error message.
+// The standard error of the plugin is forwarded to the diki logs.
+//
+// Plugins written in Go can implement a [Handler] and use [Serve].
+package protocol
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Version is the version of the protocol which is described by this package.
+// Plugins answer requests of other versions with an error message.
+const Version = "v1"
+
+// Method is the operation requested from a plugin.
+type Method string
+
+const (
+	// MethodDescribe requests the [Description] of the plugin.
+	// If the request contains a provider configuration, the plugin validates it.
+	MethodDescribe Method = "describe"
+	// MethodRunAll requests a run of all configured rulesets.
+	MethodRunAll Method = "runAll"
+	// MethodRunRuleset requests a run of a single configured ruleset.
+	MethodRunRuleset Method = "runRuleset"
+	// MethodRunRule requests a run of a single rule of a configured ruleset.
+	MethodRunRule Method = "runRule"
+)
+
+// Request is the request which diki writes to the standard input of a plugin.
+type Request struct {
+	// ProtocolVersion is the version of the protocol spoken by diki.
+	ProtocolVersion string `json:"protocolVersion"`
+	// Method is the requested operation.
+	Method Method `json:"method"`
+	// Config is the configuration of the provider. It is set for all run methods.
+	Config *ProviderConfig `json:"config,omitempty"`
+	// RulesetID and RulesetVersion select the ruleset of the runRuleset and runRule methods.
+	RulesetID      string `json:"rulesetID,omitempty"`
+	RulesetVersion string `json:"rulesetVersion,omitempty"`
+	// RuleID selects the rule of the runRule method.
+	RuleID string `json:"ruleID,omitempty"`
+}
+
+// ProviderConfig is the configuration of the provider from the diki configuration.
+type ProviderConfig struct {
+	ID       string            `json:"id"`
+	Name     string            `json:"name,omitempty"`
+	Metadata map[string]string `json:"metadata,omitempty"`
+	Args     json.RawMessage   `json:"args,omitempty"`
+	Rulesets []RulesetConfig   `json:"rulesets,omitempty"`
+}
+
+// RulesetConfig is the configuration of a ruleset.
+type RulesetConfig struct {
+	ID          string              `json:"id"`
+	Name        string              `json:"name,omitempty"`
+	Version     string              `json:"version"`
+	RuleOptions []RuleOptionsConfig `json:"ruleOptions,omitempty"`
+	Args        json.RawMessage     `json:"args,omitempty"`
+}
+
+// RuleOptionsConfig is the configuration of a rule.
+// Diki reports the rules which are skipped as accepted, so plugins only have to report them without checks.
+type RuleOptionsConfig struct {
+	RuleID string          `json:"ruleID"`
+	Skip   *RuleSkipConfig `json:"skip,omitempty"`
+	Args   json.RawMessage `json:"args,omitempty"`
+}
+
+// RuleSkipConfig is the skip configuration of a rule.
+type RuleSkipConfig struct {
+	Enabled       bool       `json:"enabled"`
+	Justification string     `json:"justification,omitempty"`
+	Owner         string     `json:"owner,omitempty"`
+	ExpiresAt     *time.Time `json:"expiresAt,omitempty"`
+}
+
+// MessageType is the type of a [Message].
+type MessageType string
+
+const (
+	// MessageTypeProgress reports the progress of a run.
+	MessageTypeProgress MessageType = "progress"
+	// MessageTypeDescribe answers a describe request.
+	MessageTypeDescribe MessageType = "describe"
+	// MessageTypeResult answers a run request.
+	MessageTypeResult MessageType = "result"
+	// MessageTypeError reports that a request failed.
+	MessageTypeError MessageType = "error"
+)
+
+// Message is a message which a plugin writes to its standard output.
+// Exactly one of the fields matching the type is set.
+type Message struct {
+	Type        MessageType  `json:"type"`
+	Progress    *Progress    `json:"progress,omitempty"`
+	Description *Description `json:"description,omitempty"`
+	Result      *Result      `json:"result,omitempty"`
+	Error       *Error       `json:"error,omitempty"`
+}
+
+// Description describes a plugin and the rulesets it implements.
+type Description struct {
+	// ProtocolVersion is the version of the protocol spoken by the plugin.
+	ProtocolVersion string `json:"protocolVersion"`
+	// ID is the id of the provider implemented by the plugin.
+	ID string `json:"id"`
+	// Name is the user-friendly name of the provider.
+	Name     string               `json:"name"`
+	Rulesets []RulesetDescription `json:"rulesets"`
+}
+
+// RulesetDescription describes a ruleset implemented by a plugin.
+type RulesetDescription struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	// Versions are the supported versions of the ruleset sorted from newest to oldest.
+	Versions []string `json:"versions"`
+}
+
+// ProgressEvent is the event reported by a [Progress].
+type ProgressEvent string
+
+const (
+	// ProgressEventRuleStarted is reported before a rule is run.
+	ProgressEventRuleStarted ProgressEvent = "ruleStarted"
+	// ProgressEventRuleFinished is reported after a rule has finished.
+	ProgressEventRuleFinished ProgressEvent = "ruleFinished"
+)
+
+// Progress reports the start or end of a rule run.
+type Progress struct {
+	Event          ProgressEvent `json:"event"`
+	RulesetID      string        `json:"rulesetID"`
+	RulesetVersion string        `json:"rulesetVersion"`
+	RuleID         string        `json:"ruleID"`
+	RuleName       string        `json:"ruleName,omitempty"`
+	// Result is the result of the finished rule. It is only set for the ruleFinished event.
+	Result *RuleResult `json:"result,omitempty"`
+	// Error is set if the rule run failed.
+	Error string `json:"error,omitempty"`
+}
+
+// Result is the result of a run request.
+// It contains all run rulesets, the selected ruleset or the ruleset with the selected rule.
+type Result struct {
+	Rulesets  []RulesetResult `json:"rulesets"`
+	StartTime time.Time       `json:"startTime,omitzero"`
+	EndTime   time.Time       `json:"endTime,omitzero"`
+}
+
+// RulesetResult is the result of a ruleset run.
+type RulesetResult struct {
+	ID        string       `json:"id"`
+	Name      string       `json:"name"`
+	Version   string       `json:"version"`
+	Rules     []RuleResult `json:"rules"`
+	StartTime time.Time    `json:"startTime,omitzero"`
+	EndTime   time.Time    `json:"endTime,omitzero"`
+}
+
+// RuleResult is the result of a rule run.
+type RuleResult struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	// Severity is one of Low, Medium or High, if set.
+	Severity  string        `json:"severity,omitempty"`
+	Checks    []CheckResult `json:"checks"`
+	StartTime time.Time     `json:"startTime,omitzero"`
+	EndTime   time.Time     `json:"endTime,omitzero"`
+}
+
+// CheckResult is the result of a single check of a rule.
+type CheckResult struct {
+	// Status is one of Passed, Skipped, Accepted, Warning, Failed or Errored.
+	Status  string `json:"status"`
+	Message string `json:"message"`
+	// Target describes the checked object.
+	Target map[string]string `json:"target,omitempty"`
+}
+
+// Error describes why a request failed.
+type Error struct {
+	Message string `json:"message"`
+}
@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package protocol
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+)
+
+// ProgressFunc reports the progress of a run. It can be called concurrently.
+type ProgressFunc func(progress Progress)
+
+// Handler answers the requests of diki in a plugin.
+type Handler interface {
+	// Describe returns the description of the plugin.
+	// The configuration is nil unless diki asks the plugin to validate it.
+	Describe(ctx context.Context, conf *ProviderConfig) (Description, error)
+	// RunAll runs all configured rulesets.
+	RunAll(ctx context.Context, conf ProviderConfig, progress ProgressFunc) (Result, error)
+	// RunRuleset runs a single configured ruleset.
+	RunRuleset(ctx context.Context, conf ProviderConfig, rulesetID, rulesetVersion string, progress ProgressFunc) (Result, error)
+	// RunRule runs a single rule of a configured ruleset.
+	// The result contains the ruleset with the single rule.
+	RunRule(ctx context.Context, conf ProviderConfig, rulesetID, rulesetVersion, ruleID string, progress ProgressFunc) (Result, error)
+}
+
+// Serve reads a single request from r, answers it with the handler and writes the messages to w.
+// Failed requests are answered with an error message. An error is returned only if the messages cannot be written.
+func Serve(ctx context.Context, r io.Reader, w io.Writer, h Handler) error {
+	var (
+		mu  sync.Mutex
+		enc = json.NewEncoder(w)
+	)
+	write := func(msg Message) error {
+		mu.Lock()
+		defer mu.Unlock()
+		return enc.Encode(msg)
+	}
+
+	var writeErr error
+	progress := func(p Progress) {
+		if err := write(Message{Type: MessageTypeProgress, Progress: &p}); err != nil {
+			mu.Lock()
+			writeErr = errors.Join(writeErr, err)
+			mu.Unlock()
+		}
+	}
+
+	msg, err := handle(ctx, r, h, progress)
+	if err != nil {
+		msg = Message{Type: MessageTypeError, Error: &Error{Message: err.Error()}}
+	}
+	if err := write(msg); err != nil {
+		return err
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	return writeErr
+}
+
+func handle(ctx context.Context, r io.Reader, h Handler, progress ProgressFunc) (Message, error) {
+	var req Request
+	if err := json.NewDecoder(r).Decode(&req); err != nil {
+		return Message{}, fmt.Errorf("failed to decode request: %w", err)
+	}
+	if req.ProtocolVersion != Version {
+		return Message{}, fmt.Errorf("unsupported protocol version %q, supported version is %s", req.ProtocolVersion, Version)
+	}
+
+	switch req.Method {
+	case MethodDescribe:
+		description, err := h.Describe(ctx, req.Config)
+		if err != nil {
+			return Message{}, err
+		}
+		return Message{Type: MessageTypeDescribe, Description: &description}, nil
+	case MethodRunAll, MethodRunRuleset, MethodRunRule:
+	default:
+		return Message{}, fmt.Errorf("unknown method %q", req.Method)
+	}
+
+	if req.Config == nil {
+		return Message{}, fmt.Errorf("method %s requires a provider configuration", req.Method)
+	}
+
+	var (
+		result Result
+		err    error
+	)
+	switch req.Method {
+	case MethodRunAll:
+		result, err = h.RunAll(ctx, *req.Config, progress)
+	case MethodRunRuleset:
+		result, err = h.RunRuleset(ctx, *req.Config, req.RulesetID, req.RulesetVersion, progress)
+	case MethodRunRule:
+		result, err = h.RunRule(ctx, *req.Config, req.RulesetID, req.RulesetVersion, req.RuleID, progress)
+	}
+	if err != nil {
+		return Message{}, err
+	}
+	return Message{Type: MessageTypeResult, Result: &result}, nil
+}
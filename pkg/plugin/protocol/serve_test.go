@@ -0,0 +1,103 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package protocol_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/diki/pkg/plugin/protocol"
+)
+
+type fakeHandler struct {
+	describedConfig *protocol.ProviderConfig
+}
+
+func (f *fakeHandler) Describe(_ context.Context, conf *protocol.ProviderConfig) (protocol.Description, error) {
+	f.describedConfig = conf
+	return protocol.Description{ProtocolVersion: protocol.Version, ID: "foo", Name: "Foo"}, nil
+}
+
+func (f *fakeHandler) RunAll(_ context.Context, conf protocol.ProviderConfig, progress protocol.ProgressFunc) (protocol.Result, error) {
+	progress(protocol.Progress{Event: protocol.ProgressEventRuleStarted, RulesetID: "bar", RulesetVersion: "v1", RuleID: "1"})
+	progress(protocol.Progress{Event: protocol.ProgressEventRuleFinished, RulesetID: "bar", RulesetVersion: "v1", RuleID: "1"})
+	return protocol.Result{Rulesets: []protocol.RulesetResult{{ID: "bar", Name: conf.Name, Version: "v1"}}}, nil
+}
+
+func (f *fakeHandler) RunRuleset(context.Context, protocol.ProviderConfig, string, string, protocol.ProgressFunc) (protocol.Result, error) {
+	return protocol.Result{}, errors.New("ruleset failed")
+}
+
+func (f *fakeHandler) RunRule(context.Context, protocol.ProviderConfig, string, string, string, protocol.ProgressFunc) (protocol.Result, error) {
+	return protocol.Result{}, nil
+}
+
+var _ = Describe("#Serve", func() {
+	var (
+		ctx     = context.TODO()
+		handler *fakeHandler
+	)
+
+	BeforeEach(func() {
+		handler = &fakeHandler{}
+	})
+
+	serve := func(req string) []protocol.Message {
+		var out bytes.Buffer
+		Expect(protocol.Serve(ctx, strings.NewReader(req), &out, handler)).To(Succeed())
+
+		var messages []protocol.Message
+		dec := json.NewDecoder(&out)
+		for dec.More() {
+			var msg protocol.Message
+			Expect(dec.Decode(&msg)).To(Succeed())
+			messages = append(messages, msg)
+		}
+		return messages
+	}
+
+	It("should answer describe requests", func() {
+		messages := serve(`{"protocolVersion":"v1","method":"describe"}`)
+
+		Expect(messages).To(Equal([]protocol.Message{{
+			Type:        protocol.MessageTypeDescribe,
+			Description: &protocol.Description{ProtocolVersion: "v1", ID: "foo", Name: "Foo"},
+		}}))
+		Expect(handler.describedConfig).To(BeNil())
+	})
+
+	It("should write the progress before the result", func() {
+		messages := serve(`{"protocolVersion":"v1","method":"runAll","config":{"id":"foo","name":"Foo"}}`)
+
+		Expect(messages).To(HaveLen(3))
+		Expect(messages[0].Type).To(Equal(protocol.MessageTypeProgress))
+		Expect(messages[0].Progress.Event).To(Equal(protocol.ProgressEventRuleStarted))
+		Expect(messages[1].Progress.Event).To(Equal(protocol.ProgressEventRuleFinished))
+		Expect(messages[2].Type).To(Equal(protocol.MessageTypeResult))
+		Expect(messages[2].Result.Rulesets).To(Equal([]protocol.RulesetResult{{ID: "bar", Name: "Foo", Version: "v1"}}))
+	})
+
+	DescribeTable("should answer invalid requests with an error",
+		func(req, expectedError string) {
+			messages := serve(req)
+
+			Expect(messages).To(Equal([]protocol.Message{{
+				Type:  protocol.MessageTypeError,
+				Error: &protocol.Error{Message: expectedError},
+			}}))
+		},
+		Entry("malformed request", `{`, "failed to decode request: unexpected EOF"),
+		Entry("unsupported protocol version", `{"protocolVersion":"v0","method":"describe"}`, `unsupported protocol version "v0", supported version is v1`),
+		Entry("unknown method", `{"protocolVersion":"v1","method":"foo"}`, `unknown method "foo"`),
+		Entry("missing configuration", `{"protocolVersion":"v1","method":"runRule"}`, "method runRule requires a provider configuration"),
+		Entry("failed run", `{"protocolVersion":"v1","method":"runRuleset","config":{"id":"foo"}}`, "ruleset failed"),
+	)
+})
@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package builder
+
+import (
+	"k8s.io/client-go/rest"
+
+	"github.com/gardener/diki/pkg/registry"
+)
+
+// NewRegistry returns a registry containing all built-in providers and their rulesets.
+func NewRegistry() (*registry.Registry, error) {
+	r := registry.New()
+	for _, newProviderType := range []func() (registry.ProviderType, error){
+		providerType(GardenProvider),
+		providerType(GardenerProvider),
+		providerType(LinuxHostProvider),
+		providerType(ManagedK8SProvider),
+		providerType(ManifestsProvider),
+		providerType(SelfManagedK8SProvider),
+		providerType(VirtualGardenProvider),
+	} {
+		providerType, err := newProviderType()
+		if err != nil {
+			return nil, err
+		}
+		if err := r.Register(providerType); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// providerType converts the constructor of a typed provider type to one of a [registry.ProviderType].
+func providerType[P registry.RulesetProvider](newProviderType func() (*registry.Provider[P], error)) func() (registry.ProviderType, error) {
+	return func() (registry.ProviderType, error) {
+		return newProviderType()
+	}
+}
+
+func setConfigDefaults(config *rest.Config) {
+	if config.QPS <= 0 {
+		config.QPS = 20
+	}
+
+	if config.Burst <= 0 {
+		config.Burst = 40
+	}
+}
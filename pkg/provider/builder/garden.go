@@ -5,94 +5,91 @@
 package builder
 
 import (
-	"fmt"
+	"encoding/json"
 	"log/slog"
 
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"github.com/gardener/diki/pkg/config"
-	"github.com/gardener/diki/pkg/metadata"
-	"github.com/gardener/diki/pkg/provider"
 	"github.com/gardener/diki/pkg/provider/garden"
 	"github.com/gardener/diki/pkg/provider/garden/ruleset/securityhardenedshoot"
+	"github.com/gardener/diki/pkg/registry"
 	"github.com/gardener/diki/pkg/ruleset"
 )
 
-// GardenProviderFromConfig returns a Provider from a [ProviderConfig].
-func GardenProviderFromConfig(conf config.ProviderConfig, fldPath *field.Path) (provider.Provider, error) {
-	p, err := garden.FromGenericConfig(conf)
-	if err != nil {
-		return nil, err
-	}
-
-	rulesetsPath := fldPath.Child("rulesets")
-
-	setConfigDefaults(p.Config)
-	providerLogger := slog.Default().With("provider", p.ID())
-	setLoggerFunc := garden.WithLogger(providerLogger)
-	setLoggerFunc(p)
-	rulesets := make([]ruleset.Ruleset, 0, len(conf.Rulesets))
-	for rulesetIdx, rulesetConfig := range conf.Rulesets {
-		switch rulesetConfig.ID {
-		case securityhardenedshoot.RulesetID:
-			ruleset, err := securityhardenedshoot.FromGenericConfig(rulesetConfig, p.Config, providerLogger, rulesetsPath.Index(rulesetIdx))
-			if err != nil {
-				return nil, err
-			}
-			setLoggerHardened := securityhardenedshoot.WithLogger(providerLogger.With("ruleset", ruleset.ID(), "version", ruleset.Version()))
-			setLoggerHardened(ruleset)
-			rulesets = append(rulesets, ruleset)
-		default:
-			return nil, fmt.Errorf("unknown ruleset identifier: %s", rulesetConfig.ID)
+// GardenProvider returns the Garden provider type with its built-in rulesets.
+func GardenProvider() (*registry.Provider[*garden.Provider], error) {
+	providerType := registry.NewProvider(garden.ProviderID, garden.ProviderName, func(conf config.ProviderConfig, logger *slog.Logger) (*garden.Provider, error) {
+		p, err := garden.FromGenericConfig(conf)
+		if err != nil {
+			return nil, err
 		}
-	}
 
-	if err := p.AddRulesets(rulesets...); err != nil {
-		return nil, err
-	}
+		setConfigDefaults(p.Config)
+		setLoggerFunc := garden.WithLogger(logger)
+		setLoggerFunc(p)
+		return p, nil
+	})
 
-	return p, nil
+	return providerType, providerType.RegisterRulesets(
+		registry.Ruleset[*garden.Provider]{
+			ID:                securityhardenedshoot.RulesetID,
+			Name:              securityhardenedshoot.RulesetName,
+			SupportedVersions: securityhardenedshoot.SupportedVersions,
+			FromConfig: func(p *garden.Provider, conf config.RulesetConfig, logger *slog.Logger, fldPath *field.Path) (ruleset.Ruleset, error) {
+				if p.ShootSelector != nil {
+					return nil, addGardenShootRuleset(providerType, p, conf, logger, fldPath)
+				}
+				ruleset, err := securityhardenedshoot.FromGenericConfig(conf, p.Config, logger, fldPath)
+				if err != nil {
+					return nil, err
+				}
+				setLoggerHardened := securityhardenedshoot.WithLogger(logger)
+				setLoggerHardened(ruleset)
+				return ruleset, nil
+			},
+		},
+	)
 }
 
-// gardenGetSupportedVersions returns the Supported Versions of a specific ruleset that is supported by the Garden provider.
-func gardenGetSupportedVersions(ruleset string) []string {
-	switch ruleset {
-	case securityhardenedshoot.RulesetID:
-		return securityhardenedshoot.SupportedVersions
-	default:
-		return nil
+// addGardenShootRuleset adds a Security Hardened Shoot Cluster ruleset which is created for every shoot selected by the provider.
+// The rules registered with the provider type are added to the ruleset of every shoot.
+func addGardenShootRuleset(providerType *registry.Provider[*garden.Provider], p *garden.Provider, rulesetConfig config.RulesetConfig, rulesetLogger *slog.Logger, fldPath *field.Path) error {
+	rulesetArgsByte, err := json.Marshal(rulesetConfig.Args)
+	if err != nil {
+		return err
 	}
-}
 
-// GardenProviderMetadata returns available metadata for the Garden Provider and it's supported rulesets.
-func GardenProviderMetadata() metadata.ProviderDetailed {
-	providerMetadata := metadata.ProviderDetailed{
-		Provider: metadata.Provider{
-			ID:   garden.ProviderID,
-			Name: garden.ProviderName,
-		},
-		Rulesets: []metadata.Ruleset{
-			{
-				ID:   securityhardenedshoot.RulesetID,
-				Name: securityhardenedshoot.RulesetName,
-			},
-		},
+	var rulesetArgs securityhardenedshoot.Args
+	if err := json.Unmarshal(rulesetArgsByte, &rulesetArgs); err != nil {
+		return err
 	}
+	if len(rulesetArgs.ShootName) > 0 || len(rulesetArgs.ProjectNamespace) > 0 {
+		return field.Forbidden(fldPath.Child("args"), "shootName and projectNamespace must not be set when shoots are selected by the provider")
+	}
+
+	newRuleset := func(shoot garden.Shoot) (ruleset.Ruleset, error) {
+		shootRulesetConfig := rulesetConfig
+		shootRulesetConfig.Args = securityhardenedshoot.Args{ShootName: shoot.Name, ProjectNamespace: shoot.Namespace}
 
-	for i := range providerMetadata.Rulesets {
-		supportedVersions := gardenGetSupportedVersions(providerMetadata.Rulesets[i].ID)
-		for _, supportedVersion := range supportedVersions {
-			providerMetadata.Rulesets[i].Versions = append(
-				providerMetadata.Rulesets[i].Versions,
-				metadata.Version{Version: supportedVersion, Latest: false},
-			)
+		shootLogger := rulesetLogger.With(garden.MetadataProject, shoot.Project, garden.MetadataShootName, shoot.Name)
+		ruleset, err := securityhardenedshoot.FromGenericConfig(shootRulesetConfig, p.Config, shootLogger, fldPath)
+		if err != nil {
+			return nil, err
 		}
+		setLoggerHardened := securityhardenedshoot.WithLogger(shootLogger)
+		setLoggerHardened(ruleset)
 
-		// Mark the first version as latest as the versions are sorted from newest to oldest
-		if len(providerMetadata.Rulesets[i].Versions) > 0 {
-			providerMetadata.Rulesets[i].Versions[0].Latest = true
+		if err := providerType.AddRules(p, ruleset, shootRulesetConfig); err != nil {
+			return nil, err
 		}
+		return ruleset, nil
 	}
 
-	return providerMetadata
+	// the ruleset is created once, so that its configuration is validated before any shoot is selected
+	ruleset, err := newRuleset(garden.Shoot{Project: "validation", Namespace: "garden-validation", Name: "validation"})
+	if err != nil {
+		return err
+	}
+	return p.AddShootRuleset(ruleset.ID(), ruleset.Version(), newRuleset)
 }
@@ -0,0 +1,48 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package builder
+
+import (
+	"log/slog"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/diki/pkg/config"
+	"github.com/gardener/diki/pkg/provider/linuxhost"
+	"github.com/gardener/diki/pkg/provider/linuxhost/ruleset/disak8sstig"
+	"github.com/gardener/diki/pkg/registry"
+	"github.com/gardener/diki/pkg/ruleset"
+)
+
+// LinuxHostProvider returns the Linux Host provider type with its built-in rulesets.
+func LinuxHostProvider() (*registry.Provider[*linuxhost.Provider], error) {
+	providerType := registry.NewProvider(linuxhost.ProviderID, linuxhost.ProviderName, func(conf config.ProviderConfig, logger *slog.Logger) (*linuxhost.Provider, error) {
+		p, err := linuxhost.FromGenericConfig(conf)
+		if err != nil {
+			return nil, err
+		}
+
+		setLoggerFunc := linuxhost.WithLogger(logger)
+		setLoggerFunc(p)
+		return p, nil
+	})
+
+	return providerType, providerType.RegisterRulesets(
+		registry.Ruleset[*linuxhost.Provider]{
+			ID:                disak8sstig.RulesetID,
+			Name:              disak8sstig.RulesetName,
+			SupportedVersions: disak8sstig.SupportedVersions,
+			FromConfig: func(p *linuxhost.Provider, conf config.RulesetConfig, logger *slog.Logger, fldPath *field.Path) (ruleset.Ruleset, error) {
+				ruleset, err := disak8sstig.FromGenericConfig(conf, p.Root, p.PodExecutor, fldPath)
+				if err != nil {
+					return nil, err
+				}
+				setLoggerDISA := disak8sstig.WithLogger(logger)
+				setLoggerDISA(ruleset)
+				return ruleset, nil
+			},
+		},
+	)
+}
@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package builder
+
+import (
+	"log/slog"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/diki/pkg/config"
+	"github.com/gardener/diki/pkg/provider/managedk8s/ruleset/securityhardenedk8s"
+	"github.com/gardener/diki/pkg/provider/manifests"
+	"github.com/gardener/diki/pkg/registry"
+	"github.com/gardener/diki/pkg/ruleset"
+)
+
+// manifestsSkippedSecurityHardenedRules are the rules of the Security Hardened Kubernetes Cluster ruleset
+// which check objects that are not known from manifests.
+var manifestsSkippedSecurityHardenedRules = map[string]string{
+	"2000": "The rule checks the NetworkPolicies of all Namespaces in a cluster, which are not known from manifests.",
+	"2002": "StorageClasses are provided by the cluster and are usually not deployed with manifests.",
+}
+
+// ManifestsProvider returns the Manifests provider type with its built-in rulesets.
+func ManifestsProvider() (*registry.Provider[*manifests.Provider], error) {
+	providerType := registry.NewProvider(manifests.ProviderID, manifests.ProviderName, func(conf config.ProviderConfig, logger *slog.Logger) (*manifests.Provider, error) {
+		return manifests.FromGenericConfig(conf, manifests.WithLogger(logger))
+	})
+
+	return providerType, providerType.RegisterRulesets(
+		registry.Ruleset[*manifests.Provider]{
+			ID:                securityhardenedk8s.RulesetID,
+			Name:              securityhardenedk8s.RulesetName,
+			SupportedVersions: securityhardenedk8s.SupportedVersions,
+			FromConfig: func(p *manifests.Provider, conf config.RulesetConfig, logger *slog.Logger, fldPath *field.Path) (ruleset.Ruleset, error) {
+				ruleset, err := securityhardenedk8s.FromGenericConfig(conf, nil, fldPath,
+					securityhardenedk8s.WithClient(p.Client),
+					securityhardenedk8s.WithSkippedRules(manifestsSkippedSecurityHardenedRules),
+				)
+				if err != nil {
+					return nil, err
+				}
+				setLoggerHardened := securityhardenedk8s.WithLogger(logger)
+				setLoggerHardened(ruleset)
+				return ruleset, nil
+			},
+		},
+	)
+}
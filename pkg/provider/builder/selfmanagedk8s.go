@@ -0,0 +1,49 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package builder
+
+import (
+	"log/slog"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/diki/pkg/config"
+	"github.com/gardener/diki/pkg/provider/selfmanagedk8s"
+	"github.com/gardener/diki/pkg/provider/selfmanagedk8s/ruleset/disak8sstig"
+	"github.com/gardener/diki/pkg/registry"
+	"github.com/gardener/diki/pkg/ruleset"
+)
+
+// SelfManagedK8SProvider returns the Self-Managed Kubernetes provider type with its built-in rulesets.
+func SelfManagedK8SProvider() (*registry.Provider[*selfmanagedk8s.Provider], error) {
+	providerType := registry.NewProvider(selfmanagedk8s.ProviderID, selfmanagedk8s.ProviderName, func(conf config.ProviderConfig, logger *slog.Logger) (*selfmanagedk8s.Provider, error) {
+		p, err := selfmanagedk8s.FromGenericConfig(conf)
+		if err != nil {
+			return nil, err
+		}
+
+		setConfigDefaults(p.Config)
+		setLoggerFunc := selfmanagedk8s.WithLogger(logger)
+		setLoggerFunc(p)
+		return p, nil
+	})
+
+	return providerType, providerType.RegisterRulesets(
+		registry.Ruleset[*selfmanagedk8s.Provider]{
+			ID:                disak8sstig.RulesetID,
+			Name:              disak8sstig.RulesetName,
+			SupportedVersions: disak8sstig.SupportedVersions,
+			FromConfig: func(p *selfmanagedk8s.Provider, conf config.RulesetConfig, logger *slog.Logger, fldPath *field.Path) (ruleset.Ruleset, error) {
+				ruleset, err := disak8sstig.FromGenericConfig(conf, p.AdditionalOpsPodLabels, p.Config, fldPath)
+				if err != nil {
+					return nil, err
+				}
+				setLoggerDISA := disak8sstig.WithLogger(logger)
+				setLoggerDISA(ruleset)
+				return ruleset, nil
+			},
+		},
+	)
+}
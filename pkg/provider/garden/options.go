@@ -6,6 +6,7 @@ package garden
 
 import (
 	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gardener/diki/pkg/shared/provider"
 )
@@ -35,6 +36,27 @@ func WithConfig(config *rest.Config) CreateOption {
 	}
 }
 
+// WithClient sets the Client of a [Provider].
+func WithClient(c client.Client) CreateOption {
+	return func(p *Provider) {
+		p.Client = c
+	}
+}
+
+// WithShootSelector sets the ShootSelector of a [Provider].
+func WithShootSelector(selector *ShootSelector) CreateOption {
+	return func(p *Provider) {
+		p.ShootSelector = selector
+	}
+}
+
+// WithMaxConcurrentShoots sets the MaxConcurrentShoots of a [Provider].
+func WithMaxConcurrentShoots(maxConcurrentShoots int) CreateOption {
+	return func(p *Provider) {
+		p.MaxConcurrentShoots = maxConcurrentShoots
+	}
+}
+
 // WithMetadata sets the metadata of a [Provider].
 func WithMetadata(metadata map[string]string) CreateOption {
 	return func(p *Provider) {
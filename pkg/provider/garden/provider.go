@@ -10,8 +10,12 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"maps"
+	"slices"
 
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gardener/diki/pkg/config"
 	kubeutils "github.com/gardener/diki/pkg/kubernetes/utils"
@@ -30,24 +34,41 @@ const (
 
 // Provider is a Garden Cluster Provider that can
 // be used to implement rules against a garden cluster.
+// If a ShootSelector is set, the rulesets are run for every selected shoot.
 type Provider struct {
 	id, name string
 	Config   *rest.Config
-	rulesets map[string]ruleset.Ruleset
-	metadata map[string]string
-	logger   sharedprovider.Logger
+	// Client is used to select shoots. It is created from Config if not set.
+	Client client.Client
+	// ShootSelector selects the shoots which are checked by the rulesets added with AddShootRuleset.
+	ShootSelector *ShootSelector
+	// MaxConcurrentShoots is the maximum number of shoots which are checked concurrently.
+	MaxConcurrentShoots int
+	rulesets            map[string]ruleset.Ruleset
+	shootRulesets       map[string]shootRuleset
+	metadata            map[string]string
+	logger              sharedprovider.Logger
 }
 
 type providerArgs struct {
-	KubeconfigPath string `json:"kubeconfigPath" yaml:"kubeconfigPath"`
+	KubeconfigPath      string                      `json:"kubeconfigPath" yaml:"kubeconfigPath"`
+	Cluster             *config.ClusterAccessConfig `json:"cluster" yaml:"cluster"`
+	Shoots              *ShootSelector              `json:"shoots" yaml:"shoots"`
+	MaxConcurrentShoots int                         `json:"maxConcurrentShoots" yaml:"maxConcurrentShoots"`
 }
 
-var _ provider.Provider = &Provider{}
+var (
+	_ provider.Provider            = &Provider{}
+	_ provider.ClusterVersioner    = &Provider{}
+	_ provider.MultiTargetProvider = &Provider{}
+)
 
 // New creates a new Provider.
 func New(options ...CreateOption) (*Provider, error) {
 	p := &Provider{
-		rulesets: make(map[string]ruleset.Ruleset),
+		MaxConcurrentShoots: 5,
+		rulesets:            make(map[string]ruleset.Ruleset),
+		shootRulesets:       make(map[string]shootRuleset),
 	}
 	for _, o := range options {
 		o(p)
@@ -57,6 +78,12 @@ func New(options ...CreateOption) (*Provider, error) {
 	if p.Config == nil {
 		err = errors.Join(err, errors.New("cluster config is nil"))
 	}
+	if p.ShootSelector != nil {
+		err = errors.Join(err, p.ShootSelector.Validate(field.NewPath("shoots")).ToAggregate())
+	}
+	if p.MaxConcurrentShoots <= 0 {
+		err = errors.Join(err, errors.New("max concurrent shoots should be a positive number"))
+	}
 
 	if err != nil {
 		return nil, err
@@ -66,8 +93,43 @@ func New(options ...CreateOption) (*Provider, error) {
 }
 
 // RunAll executes all Rulesets registered with the Provider.
+// If a ShootSelector is set, the results of all selected shoots are combined
+// and the targets of the check results contain the project and name of the shoot.
 func (p *Provider) RunAll(ctx context.Context) (provider.ProviderResult, error) {
-	return sharedprovider.RunAll(ctx, p, p.rulesets, p.Logger())
+	if p.ShootSelector == nil {
+		return sharedprovider.RunAll(ctx, p, p.rulesets, p.Logger())
+	}
+
+	results, err := p.RunAllPerTarget(ctx)
+	if err != nil {
+		return provider.ProviderResult{}, err
+	}
+	return p.combineShootResults(results), nil
+}
+
+// RunAllPerTarget executes all Rulesets registered with the Provider for every selected shoot
+// and returns one result per shoot. The shoot is identified by the metadata of the result.
+// If no ShootSelector is set, it returns the result of [Provider.RunAll].
+func (p *Provider) RunAllPerTarget(ctx context.Context) ([]provider.ProviderResult, error) {
+	if p.ShootSelector == nil {
+		result, err := p.RunAll(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return []provider.ProviderResult{result}, nil
+	}
+
+	if len(p.shootRulesets) == 0 {
+		return nil, fmt.Errorf("no rulests are registered with the provider")
+	}
+	keys := slices.Sorted(maps.Keys(p.shootRulesets))
+	return p.runShoots(ctx, keys, "", func(ctx context.Context, shoot Shoot, log sharedprovider.Logger) (provider.ProviderResult, error) {
+		rulesets, err := p.newShootRulesets(shoot, keys...)
+		if err != nil {
+			return provider.ProviderResult{}, err
+		}
+		return sharedprovider.RunAll(ctx, p, rulesets, log)
+	})
 }
 
 func rulesetKey(rulesetID, rulesetVersion string) string {
@@ -80,22 +142,95 @@ func rulesetKey(rulesetID, rulesetVersion string) string {
 }
 
 // RunRuleset executes all Rules of a known Ruleset.
+// If a ShootSelector is set, the results of all selected shoots are combined
+// and the targets of the check results contain the project and name of the shoot.
 func (p *Provider) RunRuleset(ctx context.Context, rulesetID, rulesetVersion string) (ruleset.RulesetResult, error) {
-	rs, ok := p.rulesets[rulesetKey(rulesetID, rulesetVersion)]
-	if !ok {
-		return ruleset.RulesetResult{}, fmt.Errorf("ruleset with id %s and version %s does not exist", rulesetID, rulesetVersion)
+	if p.ShootSelector == nil {
+		rs, ok := p.rulesets[rulesetKey(rulesetID, rulesetVersion)]
+		if !ok {
+			return ruleset.RulesetResult{}, fmt.Errorf("ruleset with id %s and version %s does not exist", rulesetID, rulesetVersion)
+		}
+		return rs.Run(ctx)
+	}
+
+	results, err := p.RunRulesetPerTarget(ctx, rulesetID, rulesetVersion)
+	if err != nil {
+		return ruleset.RulesetResult{}, err
 	}
-	return rs.Run(ctx)
+	return p.combineShootResults(results).RulesetResults[0], nil
+}
+
+// RunRulesetPerTarget executes all Rules of a known Ruleset for every selected shoot
+// and returns one result per shoot. The shoot is identified by the metadata of the result.
+// If no ShootSelector is set, it returns the result of [Provider.RunRuleset].
+func (p *Provider) RunRulesetPerTarget(ctx context.Context, rulesetID, rulesetVersion string) ([]provider.ProviderResult, error) {
+	if p.ShootSelector == nil {
+		result, err := p.RunRuleset(ctx, rulesetID, rulesetVersion)
+		if err != nil {
+			return nil, err
+		}
+		return []provider.ProviderResult{{ProviderID: p.ID(), ProviderName: p.Name(), Metadata: p.Metadata(), RulesetResults: []ruleset.RulesetResult{result}, StartTime: result.StartTime, EndTime: result.EndTime}}, nil
+	}
+
+	key := rulesetKey(rulesetID, rulesetVersion)
+	if _, ok := p.shootRulesets[key]; !ok {
+		return nil, fmt.Errorf("ruleset with id %s and version %s does not exist", rulesetID, rulesetVersion)
+	}
+	return p.runShoots(ctx, []string{key}, "", func(ctx context.Context, shoot Shoot, _ sharedprovider.Logger) (provider.ProviderResult, error) {
+		rulesets, err := p.newShootRulesets(shoot, key)
+		if err != nil {
+			return provider.ProviderResult{}, err
+		}
+		result, err := rulesets[key].Run(ctx)
+		if err != nil {
+			return provider.ProviderResult{}, err
+		}
+		return provider.ProviderResult{ProviderID: p.ID(), ProviderName: p.Name(), Metadata: p.Metadata(), RulesetResults: []ruleset.RulesetResult{result}, StartTime: result.StartTime, EndTime: result.EndTime}, nil
+	})
 }
 
 // RunRule executes specific Rule of a known Ruleset.
+// If a ShootSelector is set, the results of all selected shoots are combined
+// and the targets of the check results contain the project and name of the shoot.
 func (p *Provider) RunRule(ctx context.Context, rulesetID, rulesetVersion, ruleID string) (rule.RuleResult, error) {
-	rs, ok := p.rulesets[rulesetKey(rulesetID, rulesetVersion)]
-	if !ok {
+	key := rulesetKey(rulesetID, rulesetVersion)
+	if p.ShootSelector == nil {
+		rs, ok := p.rulesets[key]
+		if !ok {
+			return rule.RuleResult{}, fmt.Errorf("ruleset with id %s and version %s does not exist", rulesetID, rulesetVersion)
+		}
+
+		return rs.RunRule(ctx, ruleID)
+	}
+
+	if _, ok := p.shootRulesets[key]; !ok {
 		return rule.RuleResult{}, fmt.Errorf("ruleset with id %s and version %s does not exist", rulesetID, rulesetVersion)
 	}
+	results, err := p.runShoots(ctx, []string{key}, ruleID, func(ctx context.Context, shoot Shoot, _ sharedprovider.Logger) (provider.ProviderResult, error) {
+		rulesets, err := p.newShootRulesets(shoot, key)
+		if err != nil {
+			return provider.ProviderResult{}, err
+		}
+		result, err := rulesets[key].RunRule(ctx, ruleID)
+		if err != nil {
+			return provider.ProviderResult{}, err
+		}
+		return provider.ProviderResult{RulesetResults: []ruleset.RulesetResult{{RulesetID: rulesetID, RulesetVersion: rulesetVersion, RuleResults: []rule.RuleResult{result}}}}, nil
+	})
+	if err != nil {
+		return rule.RuleResult{}, err
+	}
+	return p.combineShootResults(results).RulesetResults[0].RuleResults[0], nil
+}
 
-	return rs.RunRule(ctx, ruleID)
+// AddShootRuleset adds a Ruleset which is created for every shoot selected by the ShootSelector.
+func (p *Provider) AddShootRuleset(rulesetID, rulesetVersion string, newRuleset ShootRulesetFunc) error {
+	key := rulesetKey(rulesetID, rulesetVersion)
+	if _, ok := p.shootRulesets[key]; ok {
+		return fmt.Errorf("ruleset with id %s and version %s already exists", rulesetID, rulesetVersion)
+	}
+	p.shootRulesets[key] = shootRuleset{id: rulesetID, version: rulesetVersion, newRuleset: newRuleset}
+	return nil
 }
 
 // AddRulesets adds Rulesets to Provider.
@@ -110,6 +245,11 @@ func (p *Provider) AddRulesets(rulesets ...ruleset.Ruleset) error {
 	return nil
 }
 
+// ClusterVersions returns the Kubernetes server versions of the checked clusters.
+func (p *Provider) ClusterVersions(ctx context.Context) (map[string]string, error) {
+	return sharedprovider.ClusterVersions(ctx, map[string]*rest.Config{"garden": p.Config})
+}
+
 // ID returns the id of the Provider.
 func (p *Provider) ID() string {
 	return p.id
@@ -140,17 +280,30 @@ func FromGenericConfig(providerConf config.ProviderConfig) (*Provider, error) {
 		return nil, err
 	}
 
-	kubeconfig, err := kubeutils.RESTConfigFromFile(providerArgs.KubeconfigPath)
+	clusterAccess, errs := config.NewClusterAccessConfig(providerArgs.Cluster, providerArgs.KubeconfigPath, field.NewPath("cluster"), field.NewPath("kubeconfigPath"))
+	if len(errs) > 0 {
+		return nil, errs.ToAggregate()
+	}
+
+	kubeconfig, err := kubeutils.RESTConfigFromClusterAccess(clusterAccess)
 	if err != nil {
 		return nil, err
 	}
 
-	provider, err := New(
+	options := []CreateOption{
 		WithID(providerConf.ID),
 		WithName(providerConf.Name),
 		WithConfig(kubeconfig),
 		WithMetadata(providerConf.Metadata),
-	)
+	}
+	if providerArgs.Shoots != nil {
+		options = append(options, WithShootSelector(providerArgs.Shoots))
+	}
+	if providerArgs.MaxConcurrentShoots != 0 {
+		options = append(options, WithMaxConcurrentShoots(providerArgs.MaxConcurrentShoots))
+	}
+
+	provider, err := New(options...)
 	if err != nil {
 		return nil, err
 	}
@@ -20,6 +20,7 @@ import (
 	kubeutils "github.com/gardener/diki/pkg/kubernetes/utils"
 	"github.com/gardener/diki/pkg/rule"
 	"github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/option"
+	sharedoption "github.com/gardener/diki/pkg/shared/ruleset/option"
 )
 
 var (
@@ -40,6 +41,7 @@ type Options2000 struct {
 }
 
 type AcceptedEndpoint struct {
+	sharedoption.Expiration
 	Path string `yaml:"path" json:"path"`
 }
 
@@ -57,6 +59,7 @@ func (o Options2000) Validate(fldPath *field.Path) field.ErrorList {
 		if len(e.Path) == 0 {
 			allErrs = append(allErrs, field.Required(acceptedEndpointsPath.Index(i).Child("path"), "must not be empty"))
 		}
+		allErrs = append(allErrs, e.Expiration.Validate(acceptedEndpointsPath.Index(i))...)
 	}
 
 	return allErrs
@@ -129,10 +132,10 @@ func (r *Rule2000) Run(ctx context.Context) (rule.RuleResult, error) {
 
 		for _, condition := range authenticationConfig.Anonymous.Conditions {
 			endpointTarget := configMapTarget.With("details", fmt.Sprintf("endpoint: %s", condition.Path))
-			if slices.ContainsFunc(r.Options.AcceptedEndpoints, func(acceptedPath AcceptedEndpoint) bool {
+			if idx := slices.IndexFunc(r.Options.AcceptedEndpoints, func(acceptedPath AcceptedEndpoint) bool {
 				return acceptedPath.Path == condition.Path
-			}) {
-				checkResults = append(checkResults, rule.AcceptedCheckResult("Anonymous authentication is accepted for the specified endpoints of the kube-apiserver.", endpointTarget))
+			}); idx >= 0 {
+				checkResults = append(checkResults, rule.AcceptedCheckResultWithAcceptance("Anonymous authentication is accepted for the specified endpoints of the kube-apiserver.", endpointTarget, r.Options.AcceptedEndpoints[idx].Acceptance()))
 			} else {
 				checkResults = append(checkResults, rule.FailedCheckResult("Anonymous authentication is enabled for specific endpoints of the kube-apiserver.", endpointTarget))
 			}
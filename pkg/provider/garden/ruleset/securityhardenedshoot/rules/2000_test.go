@@ -6,6 +6,7 @@ package rules_test
 
 import (
 	"context"
+	"time"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
@@ -21,12 +22,14 @@ import (
 
 	"github.com/gardener/diki/pkg/provider/garden/ruleset/securityhardenedshoot/rules"
 	"github.com/gardener/diki/pkg/rule"
+	sharedoption "github.com/gardener/diki/pkg/shared/ruleset/option"
 )
 
 var _ = Describe("#2000", func() {
 	var (
 		fakeClient     client.Client
 		ctx            = context.TODO()
+		expiresAt      = time.Date(2027, time.January, 1, 0, 0, 0, 0, time.UTC)
 		shootName      = "foo"
 		shootNamespace = "bar"
 
@@ -295,7 +298,8 @@ kind: AuthenticationConfiguration
 						Path: "/healthz",
 					},
 					{
-						Path: "/livez",
+						Path:       "/livez",
+						Expiration: sharedoption.Expiration{Owner: "foo", ExpiresAt: &expiresAt},
 					},
 					{
 						Path: "/readyz",
@@ -307,7 +311,7 @@ kind: AuthenticationConfiguration
 			},
 			[]rule.CheckResult{
 				{Status: rule.Accepted, Message: "Anonymous authentication is accepted for the specified endpoints of the kube-apiserver.", Target: rule.NewTarget("name", "authentication-config", "namespace", "bar", "kind", "ConfigMap", "details", "endpoint: /healthz")},
-				{Status: rule.Accepted, Message: "Anonymous authentication is accepted for the specified endpoints of the kube-apiserver.", Target: rule.NewTarget("name", "authentication-config", "namespace", "bar", "kind", "ConfigMap", "details", "endpoint: /livez")},
+				{Status: rule.Accepted, Message: "Anonymous authentication is accepted for the specified endpoints of the kube-apiserver.", Target: rule.NewTarget("name", "authentication-config", "namespace", "bar", "kind", "ConfigMap", "details", "endpoint: /livez"), Acceptance: &rule.Acceptance{Source: rule.AcceptanceSourceRuleOption, Owner: "foo", ExpiresAt: &expiresAt}},
 				{Status: rule.Accepted, Message: "Anonymous authentication is accepted for the specified endpoints of the kube-apiserver.", Target: rule.NewTarget("name", "authentication-config", "namespace", "bar", "kind", "ConfigMap", "details", "endpoint: /readyz")},
 			},
 		),
@@ -400,7 +404,8 @@ kind: AuthenticationConfiguration
 						Path: "",
 					},
 					{
-						Path: "/barz",
+						Path:       "/barz",
+						Expiration: sharedoption.Expiration{ExpiresAt: &expiresAt},
 					},
 				},
 			}
@@ -413,6 +418,11 @@ kind: AuthenticationConfiguration
 					"Field":  Equal("foo.acceptedEndpoints[1].path"),
 					"Detail": Equal("must not be empty"),
 				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeRequired),
+					"Field":  Equal("foo.acceptedEndpoints[2].owner"),
+					"Detail": Equal("must be set when expiresAt is set"),
+				})),
 			))
 		})
 	})
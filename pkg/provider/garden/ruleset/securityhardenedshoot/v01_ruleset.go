@@ -14,6 +14,7 @@ import (
 
 	"github.com/gardener/diki/pkg/config"
 	internalconfig "github.com/gardener/diki/pkg/internal/config"
+	kubeutils "github.com/gardener/diki/pkg/kubernetes/utils"
 	"github.com/gardener/diki/pkg/provider/garden/ruleset/securityhardenedshoot/rules"
 	"github.com/gardener/diki/pkg/rule"
 	"github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/option"
@@ -36,6 +37,7 @@ func (r *Ruleset) registerV01Rules(ruleOptions map[string]config.RuleOptionsConf
 	if err != nil {
 		return err
 	}
+	c = kubeutils.NewCachedClient(c, r.Config.Host)
 
 	opts1000, err := getV01OptionOrNil[rules.Options1000](ruleOptions["1000"].Args)
 	if err != nil {
@@ -114,7 +116,7 @@ func (r *Ruleset) registerV01Rules(ruleOptions map[string]config.RuleOptionsConf
 
 		opt, found := ruleOptions[r.ID()]
 		if found && opt.Skip != nil && opt.Skip.Enabled {
-			rules[i] = rule.NewSkipRule(r.ID(), r.Name(), opt.Skip.Justification, rule.Accepted, rule.SkipRuleWithSeverity(severityLevel))
+			rules[i] = rule.NewSkipRule(r.ID(), r.Name(), opt.Skip.Justification, rule.Accepted, rule.SkipRuleWithSeverity(severityLevel), rule.SkipRuleWithAcceptance(opt.Skip.Owner, opt.Skip.ExpiresAt))
 		}
 	}
 
@@ -0,0 +1,374 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package garden
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	gardenerk8s "github.com/gardener/gardener/pkg/client/kubernetes"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kubeutils "github.com/gardener/diki/pkg/kubernetes/utils"
+	"github.com/gardener/diki/pkg/provider"
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/ruleset"
+	sharedprovider "github.com/gardener/diki/pkg/shared/provider"
+)
+
+const (
+	// MetadataProject is the key of the provider metadata containing the project of the checked shoot.
+	MetadataProject = "project"
+	// MetadataShootName is the key of the provider metadata containing the name of the checked shoot.
+	MetadataShootName = "shootName"
+	// MetadataShoot is the key of the provider metadata containing the project and name of the checked shoot
+	// in the form <project>/<name>. It is unique for all shoots of a landscape and can be used to merge reports.
+	MetadataShoot = "shoot"
+	// ErroredShootRuleID is the id of the rule results which record that the rulesets of a shoot could not be run.
+	ErroredShootRuleID = "shoot-run"
+)
+
+// Shoot identifies a shoot cluster in the garden cluster.
+type Shoot struct {
+	// Project is the name of the project of the shoot.
+	Project string
+	// Namespace is the namespace of the project.
+	Namespace string
+	Name      string
+}
+
+// String returns the project and name of the shoot in the form <project>/<name>.
+func (s Shoot) String() string {
+	return s.Project + "/" + s.Name
+}
+
+// ShootRulesetFunc creates a Ruleset which checks the given shoot.
+type ShootRulesetFunc func(shoot Shoot) (ruleset.Ruleset, error)
+
+type shootRuleset struct {
+	id, version string
+	newRuleset  ShootRulesetFunc
+}
+
+// ShootSelector selects the shoots which are checked by the rulesets of a [Provider].
+type ShootSelector struct {
+	// ProjectNames are the names of the selected projects.
+	// All projects are selected if neither ProjectNames nor ProjectMatchLabels are set.
+	ProjectNames []string `json:"projectNames,omitempty" yaml:"projectNames,omitempty"`
+	// ProjectMatchLabels are the labels of the selected projects.
+	ProjectMatchLabels map[string]string `json:"projectMatchLabels,omitempty" yaml:"projectMatchLabels,omitempty"`
+	// ShootNames are the names of the selected shoots.
+	// All shoots of the selected projects are selected if neither ShootNames nor ShootMatchLabels are set.
+	ShootNames []string `json:"shootNames,omitempty" yaml:"shootNames,omitempty"`
+	// ShootMatchLabels are the labels of the selected shoots.
+	ShootMatchLabels map[string]string `json:"shootMatchLabels,omitempty" yaml:"shootMatchLabels,omitempty"`
+	// Exclude contains projects and shoots which are not selected.
+	Exclude []ShootReference `json:"exclude,omitempty" yaml:"exclude,omitempty"`
+}
+
+// ShootReference references a shoot or all shoots of a project.
+type ShootReference struct {
+	Project string `json:"project" yaml:"project"`
+	// Name is the name of the shoot. All shoots of the project are referenced if not set.
+	Name string `json:"name,omitempty" yaml:"name,omitempty"`
+}
+
+// Validate validates that the shoot selector is correctly set.
+func (s *ShootSelector) Validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, metav1validation.ValidateLabels(s.ProjectMatchLabels, fldPath.Child("projectMatchLabels"))...)
+	allErrs = append(allErrs, metav1validation.ValidateLabels(s.ShootMatchLabels, fldPath.Child("shootMatchLabels"))...)
+	for i, reference := range s.Exclude {
+		if len(reference.Project) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("exclude").Index(i).Child("project"), "must not be empty"))
+		}
+	}
+	return allErrs
+}
+
+func (s *ShootSelector) excludes(project, name string) bool {
+	return slices.ContainsFunc(s.Exclude, func(reference ShootReference) bool {
+		return reference.Project == project && (len(reference.Name) == 0 || reference.Name == name)
+	})
+}
+
+// selectShoots returns the shoots selected by the ShootSelector of the Provider sorted by project and name.
+func (p *Provider) selectShoots(ctx context.Context) ([]Shoot, error) {
+	c, err := p.gardenClient()
+	if err != nil {
+		return nil, err
+	}
+
+	projects, err := p.selectProjects(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	var shoots []Shoot
+	for _, project := range projects {
+		if project.Spec.Namespace == nil || p.ShootSelector.excludes(project.Name, "") {
+			continue
+		}
+
+		shootList := &gardencorev1beta1.ShootList{}
+		if err := c.List(ctx, shootList, client.InNamespace(*project.Spec.Namespace), client.MatchingLabels(p.ShootSelector.ShootMatchLabels)); err != nil {
+			return nil, fmt.Errorf("failed to list shoots of project %s: %w", project.Name, err)
+		}
+		for _, shoot := range shootList.Items {
+			if p.ShootSelector.excludes(project.Name, shoot.Name) {
+				continue
+			}
+			if len(p.ShootSelector.ShootNames) > 0 && !slices.Contains(p.ShootSelector.ShootNames, shoot.Name) {
+				continue
+			}
+			shoots = append(shoots, Shoot{Project: project.Name, Namespace: shoot.Namespace, Name: shoot.Name})
+		}
+	}
+
+	slices.SortFunc(shoots, func(a, b Shoot) int {
+		return cmp.Or(cmp.Compare(a.Project, b.Project), cmp.Compare(a.Name, b.Name))
+	})
+	return shoots, nil
+}
+
+// selectProjects returns the projects selected by the ShootSelector of the Provider.
+// Projects which are selected by name are read one by one, so that other projects are not listed.
+func (p *Provider) selectProjects(ctx context.Context, c client.Client) ([]gardencorev1beta1.Project, error) {
+	if len(p.ShootSelector.ProjectNames) == 0 {
+		projects := &gardencorev1beta1.ProjectList{}
+		if err := c.List(ctx, projects, client.MatchingLabels(p.ShootSelector.ProjectMatchLabels)); err != nil {
+			return nil, fmt.Errorf("failed to list projects: %w", err)
+		}
+		return projects.Items, nil
+	}
+
+	var (
+		projects []gardencorev1beta1.Project
+		selector = labels.SelectorFromSet(p.ShootSelector.ProjectMatchLabels)
+	)
+	for _, name := range p.ShootSelector.ProjectNames {
+		project := &gardencorev1beta1.Project{}
+		if err := c.Get(ctx, client.ObjectKey{Name: name}, project); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to get project %s: %w", name, err)
+		}
+		if selector.Matches(labels.Set(project.Labels)) {
+			projects = append(projects, *project)
+		}
+	}
+	return projects, nil
+}
+
+func (p *Provider) gardenClient() (client.Client, error) {
+	if p.Client != nil {
+		return p.Client, nil
+	}
+
+	c, err := client.New(p.Config, client.Options{
+		Scheme: gardenerk8s.GardenScheme,
+	})
+	if err != nil {
+		return nil, err
+	}
+	p.Client = kubeutils.NewCachedClient(c, p.Config.Host)
+	return p.Client, nil
+}
+
+// runShoots selects the shoots and calls run for every shoot with at most MaxConcurrentShoots concurrent calls.
+// The results of the shoots are returned in the order of the shoots and carry the shoot in their metadata.
+// Shoots for which run fails get an errored result for the rulesets with the given keys, so that
+// the results of the other shoots are kept. The rule of the errored result is given by ruleID if it is set.
+func (p *Provider) runShoots(
+	ctx context.Context,
+	keys []string,
+	ruleID string,
+	run func(ctx context.Context, shoot Shoot, log sharedprovider.Logger) (provider.ProviderResult, error),
+) ([]provider.ProviderResult, error) {
+	shoots, err := p.selectShoots(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(shoots) == 0 {
+		return nil, errors.New("no shoots are selected by the shoot selector")
+	}
+
+	// rulesets of all shoots share a point-in-time view of the garden cluster objects
+	if kubeutils.ObjectCacheFromContext(ctx) == nil {
+		ctx = kubeutils.ContextWithObjectCache(ctx, kubeutils.NewObjectCache())
+	}
+
+	p.Logger().Info("starting shoots run", "number_of_shoots", len(shoots), "max_concurrent_shoots", p.MaxConcurrentShoots)
+	var (
+		results = make([]provider.ProviderResult, len(shoots))
+		sem     = make(chan struct{}, p.MaxConcurrentShoots)
+		wg      sync.WaitGroup
+	)
+	for i, shoot := range shoots {
+		wg.Go(func() {
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			log := p.Logger()
+			if slogger, ok := log.(*slog.Logger); ok {
+				log = slogger.With(MetadataProject, shoot.Project, MetadataShootName, shoot.Name)
+			}
+
+			result, err := run(ctx, shoot, log)
+			if err != nil {
+				log.Error("shoot run errored", "error", err)
+				result = p.erroredShootResult(keys, ruleID, err)
+			}
+
+			result.Metadata = maps.Clone(result.Metadata)
+			if result.Metadata == nil {
+				result.Metadata = map[string]string{}
+			}
+			result.Metadata[MetadataProject] = shoot.Project
+			result.Metadata[MetadataShootName] = shoot.Name
+			result.Metadata[MetadataShoot] = shoot.String()
+			results[i] = result
+		})
+	}
+	wg.Wait()
+	p.Logger().Info("finished shoots run", "number_of_shoots", len(shoots))
+
+	return results, nil
+}
+
+// erroredShootResult returns the result of a shoot whose rulesets with the given keys could not be run.
+// The rules of the rulesets might not be known, so every ruleset contains a single rule result
+// with an errored check result. Its id is ruleID if set and [ErroredShootRuleID] otherwise.
+func (p *Provider) erroredShootResult(keys []string, ruleID string, err error) provider.ProviderResult {
+	now := time.Now().UTC()
+	result := provider.ProviderResult{
+		ProviderID:   p.ID(),
+		ProviderName: p.Name(),
+		Metadata:     p.Metadata(),
+		StartTime:    now,
+		EndTime:      now,
+	}
+	for _, key := range keys {
+		shootRuleset := p.shootRulesets[key]
+		result.RulesetResults = append(result.RulesetResults, ruleset.RulesetResult{
+			RulesetID:      shootRuleset.id,
+			RulesetVersion: shootRuleset.version,
+			StartTime:      now,
+			EndTime:        now,
+			RuleResults: []rule.RuleResult{
+				{
+					RuleID:       cmp.Or(ruleID, ErroredShootRuleID),
+					CheckResults: []rule.CheckResult{rule.ErroredCheckResult(err.Error(), rule.NewTarget())},
+					StartTime:    now,
+					EndTime:      now,
+				},
+			},
+		})
+	}
+	return result
+}
+
+// newShootRulesets creates the Rulesets with the given keys for a shoot.
+func (p *Provider) newShootRulesets(shoot Shoot, keys ...string) (map[string]ruleset.Ruleset, error) {
+	rulesets := make(map[string]ruleset.Ruleset, len(keys))
+	for _, key := range keys {
+		rs, err := p.shootRulesets[key].newRuleset(shoot)
+		if err != nil {
+			return nil, err
+		}
+		rulesets[key] = rs
+	}
+	return rulesets, nil
+}
+
+// combineShootResults combines the results of the shoots into a single result.
+// The targets of the check results are extended with the project and name of the shoot.
+func (p *Provider) combineShootResults(results []provider.ProviderResult) provider.ProviderResult {
+	combined := provider.ProviderResult{
+		ProviderID:   p.ID(),
+		ProviderName: p.Name(),
+		Metadata:     maps.Clone(p.Metadata()),
+	}
+
+	for _, result := range results {
+		if combined.StartTime.IsZero() || result.StartTime.Before(combined.StartTime) {
+			combined.StartTime = result.StartTime
+		}
+		if result.EndTime.After(combined.EndTime) {
+			combined.EndTime = result.EndTime
+		}
+
+		for _, rulesetResult := range result.RulesetResults {
+			rsIdx := slices.IndexFunc(combined.RulesetResults, func(rs ruleset.RulesetResult) bool {
+				return rs.RulesetID == rulesetResult.RulesetID && rs.RulesetVersion == rulesetResult.RulesetVersion
+			})
+			if rsIdx < 0 {
+				combined.RulesetResults = append(combined.RulesetResults, ruleset.RulesetResult{
+					RulesetID:      rulesetResult.RulesetID,
+					RulesetName:    rulesetResult.RulesetName,
+					RulesetVersion: rulesetResult.RulesetVersion,
+					StartTime:      rulesetResult.StartTime,
+				})
+				rsIdx = len(combined.RulesetResults) - 1
+			}
+			combinedRuleset := &combined.RulesetResults[rsIdx]
+			combinedRuleset.RulesetName = cmp.Or(combinedRuleset.RulesetName, rulesetResult.RulesetName)
+			if rulesetResult.StartTime.Before(combinedRuleset.StartTime) {
+				combinedRuleset.StartTime = rulesetResult.StartTime
+			}
+			if rulesetResult.EndTime.After(combinedRuleset.EndTime) {
+				combinedRuleset.EndTime = rulesetResult.EndTime
+			}
+
+			for _, ruleResult := range rulesetResult.RuleResults {
+				ruleIdx := slices.IndexFunc(combinedRuleset.RuleResults, func(r rule.RuleResult) bool {
+					return r.RuleID == ruleResult.RuleID
+				})
+				if ruleIdx < 0 {
+					combinedRuleset.RuleResults = append(combinedRuleset.RuleResults, rule.RuleResult{
+						RuleID:    ruleResult.RuleID,
+						RuleName:  ruleResult.RuleName,
+						Severity:  ruleResult.Severity,
+						StartTime: ruleResult.StartTime,
+					})
+					ruleIdx = len(combinedRuleset.RuleResults) - 1
+				}
+				combinedRule := &combinedRuleset.RuleResults[ruleIdx]
+				combinedRule.RuleName = cmp.Or(combinedRule.RuleName, ruleResult.RuleName)
+				combinedRule.Severity = cmp.Or(combinedRule.Severity, ruleResult.Severity)
+				if ruleResult.StartTime.Before(combinedRule.StartTime) {
+					combinedRule.StartTime = ruleResult.StartTime
+				}
+				if ruleResult.EndTime.After(combinedRule.EndTime) {
+					combinedRule.EndTime = ruleResult.EndTime
+				}
+				combinedRule.Retries += ruleResult.Retries
+				combinedRule.OpsPods += ruleResult.OpsPods
+
+				for _, checkResult := range ruleResult.CheckResults {
+					if checkResult.Target == nil {
+						checkResult.Target = rule.NewTarget()
+					}
+					checkResult.Target = checkResult.Target.With("project", result.Metadata[MetadataProject], "shoot", result.Metadata[MetadataShootName])
+					combinedRule.CheckResults = append(combinedRule.CheckResults, checkResult)
+				}
+			}
+		}
+	}
+	return combined
+}
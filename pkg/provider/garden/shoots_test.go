@@ -0,0 +1,277 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package garden_test
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"log/slog"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	gardenerk8s "github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/rest"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/gardener/diki/pkg/provider/garden"
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/ruleset"
+)
+
+type shootRuleset struct {
+	id    string
+	shoot garden.Shoot
+}
+
+func (r *shootRuleset) ID() string      { return cmp.Or(r.id, "foo") }
+func (r *shootRuleset) Name() string    { return "Foo" }
+func (r *shootRuleset) Version() string { return "v1" }
+
+func (r *shootRuleset) Run(ctx context.Context) (ruleset.RulesetResult, error) {
+	ruleResult, err := r.RunRule(ctx, "1")
+	if err != nil {
+		return ruleset.RulesetResult{}, err
+	}
+	return ruleset.RulesetResult{RulesetID: r.ID(), RulesetName: r.Name(), RulesetVersion: r.Version(), RuleResults: []rule.RuleResult{ruleResult}}, nil
+}
+
+func (r *shootRuleset) RunRule(_ context.Context, id string) (rule.RuleResult, error) {
+	return rule.RuleResult{
+		RuleID:       id,
+		RuleName:     "Rule " + id,
+		CheckResults: []rule.CheckResult{rule.PassedCheckResult("Shoot is checked.", rule.NewTarget("namespace", r.shoot.Namespace))},
+	}, nil
+}
+
+var _ = Describe("shoots", func() {
+	var (
+		fakeClient client.Client
+		ctx        = context.TODO()
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(gardenerk8s.GardenScheme).Build()
+
+		for _, project := range []struct {
+			name   string
+			labels map[string]string
+		}{
+			{name: "foo", labels: map[string]string{"team": "a"}},
+			{name: "bar", labels: map[string]string{"team": "b"}},
+			{name: "baz", labels: map[string]string{"team": "a"}},
+		} {
+			Expect(fakeClient.Create(ctx, &gardencorev1beta1.Project{
+				ObjectMeta: metav1.ObjectMeta{Name: project.name, Labels: project.labels},
+				Spec:       gardencorev1beta1.ProjectSpec{Namespace: ptr.To("garden-" + project.name)},
+			})).To(Succeed())
+
+			for _, shoot := range []struct {
+				name   string
+				labels map[string]string
+			}{
+				{name: "one", labels: map[string]string{"purpose": "production"}},
+				{name: "two", labels: map[string]string{"purpose": "evaluation"}},
+			} {
+				Expect(fakeClient.Create(ctx, &gardencorev1beta1.Shoot{
+					ObjectMeta: metav1.ObjectMeta{Name: shoot.name, Namespace: "garden-" + project.name, Labels: shoot.labels},
+				})).To(Succeed())
+			}
+		}
+	})
+
+	newProvider := func(selector *garden.ShootSelector) *garden.Provider {
+		p, err := garden.New(
+			garden.WithID("garden"),
+			garden.WithName("Garden"),
+			garden.WithConfig(&rest.Config{}),
+			garden.WithClient(fakeClient),
+			garden.WithShootSelector(selector),
+			garden.WithMaxConcurrentShoots(2),
+			garden.WithMetadata(map[string]string{"landscape": "dev"}),
+			garden.WithLogger(slog.New(slog.DiscardHandler)),
+		)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(p.AddShootRuleset("foo", "v1", func(shoot garden.Shoot) (ruleset.Ruleset, error) {
+			return &shootRuleset{shoot: shoot}, nil
+		})).To(Succeed())
+		return p
+	}
+
+	shootsOf := func(p *garden.Provider) []string {
+		results, err := p.RunAllPerTarget(ctx)
+		Expect(err).NotTo(HaveOccurred())
+
+		var shoots []string
+		for _, result := range results {
+			shoots = append(shoots, result.Metadata[garden.MetadataShoot])
+		}
+		return shoots
+	}
+
+	Describe("#RunAllPerTarget", func() {
+		It("should run the rulesets for every shoot of all projects", func() {
+			p := newProvider(&garden.ShootSelector{})
+
+			results, err := p.RunAllPerTarget(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(results).To(HaveLen(6))
+
+			Expect(results[0].ProviderID).To(Equal("garden"))
+			Expect(results[0].Metadata).To(Equal(map[string]string{
+				"landscape": "dev",
+				"project":   "bar",
+				"shootName": "one",
+				"shoot":     "bar/one",
+			}))
+			Expect(results[0].RulesetResults).To(HaveLen(1))
+			Expect(results[0].RulesetResults[0].RuleResults[0].CheckResults).To(Equal([]rule.CheckResult{
+				rule.PassedCheckResult("Shoot is checked.", rule.NewTarget("namespace", "garden-bar")),
+			}))
+			Expect(p.Metadata()).To(Equal(map[string]string{"landscape": "dev"}))
+		})
+
+		It("should select shoots by project and shoot selectors and exclusions", func() {
+			Expect(shootsOf(newProvider(&garden.ShootSelector{
+				ProjectNames: []string{"foo", "bar"},
+			}))).To(Equal([]string{"bar/one", "bar/two", "foo/one", "foo/two"}))
+
+			Expect(shootsOf(newProvider(&garden.ShootSelector{
+				ProjectMatchLabels: map[string]string{"team": "a"},
+				ShootMatchLabels:   map[string]string{"purpose": "production"},
+			}))).To(Equal([]string{"baz/one", "foo/one"}))
+
+			Expect(shootsOf(newProvider(&garden.ShootSelector{
+				ShootNames: []string{"two"},
+				Exclude: []garden.ShootReference{
+					{Project: "foo"},
+					{Project: "bar", Name: "two"},
+				},
+			}))).To(Equal([]string{"baz/two"}))
+		})
+
+		It("should keep the results of the other shoots when a shoot errors", func() {
+			p := newProvider(&garden.ShootSelector{ProjectNames: []string{"foo"}})
+			Expect(p.AddShootRuleset("bar", "v1", func(shoot garden.Shoot) (ruleset.Ruleset, error) {
+				if shoot.Name == "one" {
+					return nil, errors.New("cannot access shoot")
+				}
+				return &shootRuleset{id: "bar", shoot: shoot}, nil
+			})).To(Succeed())
+
+			results, err := p.RunAllPerTarget(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(results).To(HaveLen(2))
+
+			Expect(results[0].Metadata[garden.MetadataShoot]).To(Equal("foo/one"))
+			Expect(results[0].RulesetResults).To(HaveLen(2))
+			for _, rulesetResult := range results[0].RulesetResults {
+				Expect(rulesetResult.RuleResults).To(HaveLen(1))
+				Expect(rulesetResult.RuleResults[0].RuleID).To(Equal(garden.ErroredShootRuleID))
+				Expect(rulesetResult.RuleResults[0].CheckResults).To(Equal([]rule.CheckResult{
+					rule.ErroredCheckResult("cannot access shoot", rule.NewTarget()),
+				}))
+			}
+			Expect([]string{results[0].RulesetResults[0].RulesetID, results[0].RulesetResults[1].RulesetID}).To(ConsistOf("foo", "bar"))
+
+			Expect(results[1].Metadata[garden.MetadataShoot]).To(Equal("foo/two"))
+			Expect(results[1].RulesetResults).To(HaveLen(2))
+			Expect(results[1].RulesetResults[0].RuleResults[0].CheckResults).To(Equal([]rule.CheckResult{
+				rule.PassedCheckResult("Shoot is checked.", rule.NewTarget("namespace", "garden-foo")),
+			}))
+		})
+
+		It("should get the projects selected by name without listing all projects", func() {
+			fakeClient = interceptor.NewClient(fakeClient.(client.WithWatch), interceptor.Funcs{
+				List: func(ctx context.Context, c client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+					if _, ok := list.(*gardencorev1beta1.ProjectList); ok {
+						return errors.New("projects must not be listed")
+					}
+					return c.List(ctx, list, opts...)
+				},
+			})
+
+			Expect(shootsOf(newProvider(&garden.ShootSelector{
+				ProjectNames:       []string{"foo", "bar", "qux"},
+				ProjectMatchLabels: map[string]string{"team": "a"},
+			}))).To(Equal([]string{"foo/one", "foo/two"}))
+		})
+
+		It("should return an error when no shoots are selected", func() {
+			p := newProvider(&garden.ShootSelector{ProjectNames: []string{"qux"}})
+
+			_, err := p.RunAllPerTarget(ctx)
+			Expect(err).To(MatchError("no shoots are selected by the shoot selector"))
+		})
+	})
+
+	Describe("#RunRule", func() {
+		It("should combine the results of all shoots", func() {
+			p := newProvider(&garden.ShootSelector{ShootNames: []string{"one"}})
+
+			result, err := p.RunRule(ctx, "foo", "v1", "2")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RuleID).To(Equal("2"))
+			Expect(result.CheckResults).To(Equal([]rule.CheckResult{
+				rule.PassedCheckResult("Shoot is checked.", rule.NewTarget("namespace", "garden-bar", "project", "bar", "shoot", "one")),
+				rule.PassedCheckResult("Shoot is checked.", rule.NewTarget("namespace", "garden-baz", "project", "baz", "shoot", "one")),
+				rule.PassedCheckResult("Shoot is checked.", rule.NewTarget("namespace", "garden-foo", "project", "foo", "shoot", "one")),
+			}))
+		})
+	})
+
+	Describe("#RunRuleset", func() {
+		It("should record errored results for shoots whose ruleset cannot be created", func() {
+			p := newProvider(&garden.ShootSelector{ShootNames: []string{"one"}})
+			Expect(p.AddShootRuleset("bar", "v1", func(shoot garden.Shoot) (ruleset.Ruleset, error) {
+				if shoot.Project == "baz" {
+					return nil, errors.New("cannot access shoot")
+				}
+				return &shootRuleset{id: "bar", shoot: shoot}, nil
+			})).To(Succeed())
+
+			result, err := p.RunRuleset(ctx, "bar", "v1")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RulesetID).To(Equal("bar"))
+			Expect(result.RuleResults).To(HaveLen(2))
+			Expect(result.RuleResults[0].RuleID).To(Equal("1"))
+			Expect(result.RuleResults[0].CheckResults).To(Equal([]rule.CheckResult{
+				rule.PassedCheckResult("Shoot is checked.", rule.NewTarget("namespace", "garden-bar", "project", "bar", "shoot", "one")),
+				rule.PassedCheckResult("Shoot is checked.", rule.NewTarget("namespace", "garden-foo", "project", "foo", "shoot", "one")),
+			}))
+			Expect(result.RuleResults[1].RuleID).To(Equal(garden.ErroredShootRuleID))
+			Expect(result.RuleResults[1].CheckResults).To(Equal([]rule.CheckResult{
+				rule.ErroredCheckResult("cannot access shoot", rule.NewTarget("project", "baz", "shoot", "one")),
+			}))
+		})
+	})
+
+	Describe("#Validate", func() {
+		It("should validate the shoot selector", func() {
+			selector := &garden.ShootSelector{
+				ProjectMatchLabels: map[string]string{"foo": "bar baz"},
+				Exclude:            []garden.ShootReference{{Name: "foo"}},
+			}
+
+			Expect(selector.Validate(field.NewPath("shoots"))).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("shoots.projectMatchLabels"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("shoots.exclude[0].project"),
+				})),
+			))
+		})
+	})
+})
@@ -0,0 +1,357 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gardener
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	authenticationv1alpha1 "github.com/gardener/gardener/pkg/apis/authentication/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	seedmanagementv1alpha1 "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1"
+	gardenerk8s "github.com/gardener/gardener/pkg/client/kubernetes"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// CredentialsType is the type of the short-lived credentials
+// which are requested for the shoot and seed clusters.
+type CredentialsType string
+
+const (
+	// CredentialsTypeAdmin requests credentials through the adminkubeconfig subresource of shoots.
+	CredentialsTypeAdmin CredentialsType = "admin"
+	// CredentialsTypeViewer requests credentials through the viewerkubeconfig subresource of shoots.
+	// Viewer credentials are read-only and are not sufficient for rules which create ops pods.
+	CredentialsTypeViewer CredentialsType = "viewer"
+
+	// DefaultCredentialsExpiration is the default validity duration of the requested credentials.
+	DefaultCredentialsExpiration = time.Hour
+	// MinCredentialsExpiration is the minimal validity duration of the requested credentials.
+	MinCredentialsExpiration = 10 * time.Minute
+)
+
+// GardenScheme is the scheme of the garden cluster used to request shoot credentials.
+var GardenScheme = runtime.NewScheme()
+
+func init() {
+	utilruntime.Must(gardenerk8s.AddGardenSchemeToScheme(GardenScheme))
+	utilruntime.Must(authenticationv1alpha1.AddToScheme(GardenScheme))
+}
+
+// ShootAccessConfig configures the credentials requested by a [ShootAccess].
+type ShootAccessConfig struct {
+	// ProjectNamespace is the namespace of the shoot in the garden cluster.
+	ProjectNamespace string
+	// ShootName is the name of the shoot.
+	ShootName string
+	// CredentialsType is the type of the requested credentials. Defaults to [CredentialsTypeAdmin].
+	CredentialsType CredentialsType
+	// Expiration is the requested validity duration of the credentials. Defaults to [DefaultCredentialsExpiration].
+	Expiration time.Duration
+	// SkipSeed disables requesting credentials for the seed of the shoot,
+	// e.g. when the seed is not a managed seed and its kubeconfig is set explicitly.
+	SkipSeed bool
+	// Dir is the directory in which the client certificates are written.
+	// A new temporary directory is created if it is not set. It is removed by [ShootAccess.Close].
+	Dir string
+}
+
+// ShootAccess provides short-lived credentials for a shoot cluster and the seed cluster
+// hosting its control plane. The credentials are requested from the garden cluster
+// through the adminkubeconfig or viewerkubeconfig subresources of the shoot and of the
+// shoot registered as managed seed.
+//
+// The client certificates are stored in files which are referenced by the rest configs,
+// so that clients created from the configs pick up refreshed credentials without being recreated.
+// The files are removed by [ShootAccess.Close].
+type ShootAccess struct {
+	// ShootConfig is the rest config of the shoot cluster.
+	ShootConfig *rest.Config
+	// SeedConfig is the rest config of the seed cluster. It is nil if the seed is skipped.
+	SeedConfig *rest.Config
+	// ControlPlaneNamespace is the namespace of the shoot control plane in the seed cluster.
+	ControlPlaneNamespace string
+
+	client          client.Client
+	credentialsType CredentialsType
+	expiration      time.Duration
+	dir             string
+	// removeDir is set if dir is a temporary directory created by the ShootAccess.
+	removeDir bool
+	// retryInterval is the interval in which failed refreshes are retried.
+	retryInterval time.Duration
+	logger        *slog.Logger
+
+	mu        sync.Mutex
+	clusters  []*accessedCluster
+	refreshAt time.Time
+}
+
+type accessedCluster struct {
+	name  string
+	shoot types.NamespacedName
+	file  string
+}
+
+// NewShootAccess resolves the seed of the shoot and requests the initial credentials of both clusters.
+func NewShootAccess(ctx context.Context, gardenClient client.Client, conf ShootAccessConfig, logger *slog.Logger) (*ShootAccess, error) {
+	if len(conf.CredentialsType) == 0 {
+		conf.CredentialsType = CredentialsTypeAdmin
+	}
+	if conf.Expiration == 0 {
+		conf.Expiration = DefaultCredentialsExpiration
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	switch {
+	case len(conf.ProjectNamespace) == 0:
+		return nil, errors.New("project namespace is not set")
+	case len(conf.ShootName) == 0:
+		return nil, errors.New("shoot name is not set")
+	case !slices.Contains([]CredentialsType{CredentialsTypeAdmin, CredentialsTypeViewer}, conf.CredentialsType):
+		return nil, fmt.Errorf("credentials type %s is not one of %s or %s", conf.CredentialsType, CredentialsTypeAdmin, CredentialsTypeViewer)
+	case conf.Expiration < MinCredentialsExpiration:
+		return nil, fmt.Errorf("credentials expiration should be at least %s", MinCredentialsExpiration)
+	}
+
+	a := &ShootAccess{
+		client:          gardenClient,
+		credentialsType: conf.CredentialsType,
+		expiration:      conf.Expiration,
+		dir:             conf.Dir,
+		retryInterval:   30 * time.Second,
+		logger:          logger,
+	}
+
+	shootKey := types.NamespacedName{Namespace: conf.ProjectNamespace, Name: conf.ShootName}
+	shoot := &gardencorev1beta1.Shoot{}
+	if err := gardenClient.Get(ctx, shootKey, shoot); err != nil {
+		return nil, fmt.Errorf("failed to get shoot %s: %w", shootKey, err)
+	}
+	if len(shoot.Status.TechnicalID) == 0 {
+		return nil, fmt.Errorf("shoot %s does not have a technical id", shootKey)
+	}
+	a.ControlPlaneNamespace = shoot.Status.TechnicalID
+	a.clusters = append(a.clusters, &accessedCluster{name: "shoot", shoot: shootKey})
+
+	if !conf.SkipSeed {
+		seedShootKey, err := a.seedShoot(ctx, shoot)
+		if err != nil {
+			return nil, err
+		}
+		a.clusters = append(a.clusters, &accessedCluster{name: "seed", shoot: seedShootKey})
+	}
+
+	if len(a.dir) == 0 {
+		dir, err := os.MkdirTemp("", "diki-gardener-")
+		if err != nil {
+			return nil, err
+		}
+		a.dir, a.removeDir = dir, true
+	}
+
+	configs, err := a.refresh(ctx)
+	if err != nil {
+		return nil, errors.Join(err, a.Close())
+	}
+	a.ShootConfig = configs[0]
+	if !conf.SkipSeed {
+		a.SeedConfig = configs[1]
+	}
+	return a, nil
+}
+
+// seedShoot returns the shoot which is registered as the seed hosting the control plane of shoot.
+func (a *ShootAccess) seedShoot(ctx context.Context, shoot *gardencorev1beta1.Shoot) (types.NamespacedName, error) {
+	if shoot.Status.SeedName == nil {
+		return types.NamespacedName{}, fmt.Errorf("shoot %s/%s is not scheduled to a seed", shoot.Namespace, shoot.Name)
+	}
+
+	seedName := *shoot.Status.SeedName
+	managedSeed := &seedmanagementv1alpha1.ManagedSeed{}
+	if err := a.client.Get(ctx, types.NamespacedName{Namespace: v1beta1constants.GardenNamespace, Name: seedName}, managedSeed); err != nil {
+		if apierrors.IsNotFound(err) {
+			return types.NamespacedName{}, fmt.Errorf("seed %s is not a managed seed, its kubeconfig has to be set explicitly", seedName)
+		}
+		return types.NamespacedName{}, fmt.Errorf("failed to get managed seed %s: %w", seedName, err)
+	}
+	if managedSeed.Spec.Shoot == nil {
+		return types.NamespacedName{}, fmt.Errorf("managed seed %s does not reference a shoot", seedName)
+	}
+	return types.NamespacedName{Namespace: v1beta1constants.GardenNamespace, Name: managedSeed.Spec.Shoot.Name}, nil
+}
+
+// refresh requests new credentials for all clusters and writes them to the certificate files.
+// It returns the rest configs of the clusters in the order of a.clusters.
+func (a *ShootAccess) refresh(ctx context.Context) ([]*rest.Config, error) {
+	var (
+		configs   = make([]*rest.Config, 0, len(a.clusters))
+		issuedAt  = time.Now()
+		expiresAt time.Time
+	)
+	for _, cluster := range a.clusters {
+		kubeconfig, clusterExpiresAt, err := a.requestKubeconfig(ctx, cluster.shoot)
+		if err != nil {
+			return nil, err
+		}
+
+		config, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse kubeconfig of shoot %s: %w", cluster.shoot, err)
+		}
+		if len(config.CertData) == 0 || len(config.KeyData) == 0 {
+			return nil, fmt.Errorf("kubeconfig of shoot %s does not contain a client certificate", cluster.shoot)
+		}
+
+		cluster.file = filepath.Join(a.dir, cluster.name+".pem")
+		if err := writeFileAtomically(cluster.file, slices.Concat(config.CertData, []byte("\n"), config.KeyData)); err != nil {
+			return nil, err
+		}
+
+		// the certificate and the key are stored in the same file, so that
+		// they are always replaced together when the credentials are refreshed
+		config.CertData, config.KeyData = nil, nil
+		config.CertFile, config.KeyFile = cluster.file, cluster.file
+		configs = append(configs, config)
+
+		if expiresAt.IsZero() || clusterExpiresAt.Before(expiresAt) {
+			expiresAt = clusterExpiresAt
+		}
+	}
+
+	a.refreshAt = issuedAt.Add(expiresAt.Sub(issuedAt) / 2)
+	a.logger.Debug("requested cluster credentials", "expiresAt", expiresAt, "refreshAt", a.refreshAt)
+	return configs, nil
+}
+
+func (a *ShootAccess) requestKubeconfig(ctx context.Context, shootKey types.NamespacedName) ([]byte, time.Time, error) {
+	var (
+		shoot             = &gardencorev1beta1.Shoot{}
+		expirationSeconds = int64(a.expiration.Seconds())
+	)
+	shoot.Namespace, shoot.Name = shootKey.Namespace, shootKey.Name
+
+	switch a.credentialsType {
+	case CredentialsTypeViewer:
+		request := &authenticationv1alpha1.ViewerKubeconfigRequest{
+			Spec: authenticationv1alpha1.ViewerKubeconfigRequestSpec{ExpirationSeconds: &expirationSeconds},
+		}
+		if err := a.client.SubResource("viewerkubeconfig").Create(ctx, shoot, request); err != nil {
+			return nil, time.Time{}, fmt.Errorf("failed to request viewer kubeconfig of shoot %s: %w", shootKey, err)
+		}
+		return request.Status.Kubeconfig, request.Status.ExpirationTimestamp.Time, nil
+	default:
+		request := &authenticationv1alpha1.AdminKubeconfigRequest{
+			Spec: authenticationv1alpha1.AdminKubeconfigRequestSpec{ExpirationSeconds: &expirationSeconds},
+		}
+		if err := a.client.SubResource("adminkubeconfig").Create(ctx, shoot, request); err != nil {
+			return nil, time.Time{}, fmt.Errorf("failed to request admin kubeconfig of shoot %s: %w", shootKey, err)
+		}
+		return request.Status.Kubeconfig, request.Status.ExpirationTimestamp.Time, nil
+	}
+}
+
+// Refresh requests new credentials if at least half of the validity duration of the current ones has passed.
+func (a *ShootAccess) Refresh(ctx context.Context) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if time.Now().Before(a.refreshAt) {
+		return nil
+	}
+	_, err := a.refresh(ctx)
+	return err
+}
+
+// KeepFresh refreshes the credentials until ctx is done.
+// Failed refreshes are logged and retried.
+func (a *ShootAccess) KeepFresh(ctx context.Context) {
+	for {
+		wait := a.retryInterval
+		if err := a.Refresh(ctx); err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			a.logger.Error("failed to refresh cluster credentials", "error", err)
+		} else {
+			a.mu.Lock()
+			wait = time.Until(a.refreshAt)
+			a.mu.Unlock()
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
+}
+
+// Dir returns the directory containing the client certificates.
+func (a *ShootAccess) Dir() string {
+	return a.dir
+}
+
+// Close removes the client certificates. The directory containing them is
+// removed as well if it was created by the ShootAccess.
+func (a *ShootAccess) Close() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.removeDir {
+		return os.RemoveAll(a.dir)
+	}
+
+	var err error
+	for _, cluster := range a.clusters {
+		if len(cluster.file) == 0 {
+			continue
+		}
+		if removeErr := os.Remove(cluster.file); removeErr != nil && !errors.Is(removeErr, os.ErrNotExist) {
+			err = errors.Join(err, removeErr)
+		}
+	}
+	return err
+}
+
+// writeFileAtomically writes data to a file which is only readable by the current user.
+func writeFileAtomically(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	if err := tmp.Chmod(0600); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,411 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gardener_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	authenticationv1alpha1 "github.com/gardener/gardener/pkg/apis/authentication/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	seedmanagementv1alpha1 "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/diki/pkg/config"
+	"github.com/gardener/diki/pkg/provider/gardener"
+)
+
+// fakeGarden is a minimal garden API server serving shoots, managed seeds
+// and the adminkubeconfig and viewerkubeconfig subresources of shoots.
+type fakeGarden struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	objects  map[string]any
+	requests map[string]int
+	// validity is the validity duration of issued credentials.
+	validity time.Duration
+}
+
+func newFakeGarden() *fakeGarden {
+	g := &fakeGarden{
+		objects:  map[string]any{},
+		requests: map[string]int{},
+		validity: time.Hour,
+	}
+	g.Server = httptest.NewServer(http.HandlerFunc(g.serve))
+	return g
+}
+
+func (g *fakeGarden) addShoot(shoot *gardencorev1beta1.Shoot) {
+	shoot.APIVersion, shoot.Kind = gardencorev1beta1.SchemeGroupVersion.String(), "Shoot"
+	g.objects[fmt.Sprintf("/apis/core.gardener.cloud/v1beta1/namespaces/%s/shoots/%s", shoot.Namespace, shoot.Name)] = shoot
+}
+
+func (g *fakeGarden) addManagedSeed(managedSeed *seedmanagementv1alpha1.ManagedSeed) {
+	managedSeed.APIVersion, managedSeed.Kind = seedmanagementv1alpha1.SchemeGroupVersion.String(), "ManagedSeed"
+	g.objects[fmt.Sprintf("/apis/seedmanagement.gardener.cloud/v1alpha1/namespaces/%s/managedseeds/%s", managedSeed.Namespace, managedSeed.Name)] = managedSeed
+}
+
+func (g *fakeGarden) requestCount(path string) int {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.requests[path]
+}
+
+func (g *fakeGarden) serve(w http.ResponseWriter, r *http.Request) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	write := func(obj any) {
+		Expect(json.NewEncoder(w).Encode(obj)).To(Succeed())
+	}
+
+	switch r.URL.Path {
+	case "/api":
+		write(metav1.APIVersions{TypeMeta: metav1.TypeMeta{Kind: "APIVersions"}, Versions: []string{"v1"}})
+		return
+	case "/apis":
+		group := func(name, version string) metav1.APIGroup {
+			gv := metav1.GroupVersionForDiscovery{GroupVersion: name + "/" + version, Version: version}
+			return metav1.APIGroup{Name: name, Versions: []metav1.GroupVersionForDiscovery{gv}, PreferredVersion: gv}
+		}
+		write(metav1.APIGroupList{
+			TypeMeta: metav1.TypeMeta{Kind: "APIGroupList", APIVersion: "v1"},
+			Groups:   []metav1.APIGroup{group("core.gardener.cloud", "v1beta1"), group("seedmanagement.gardener.cloud", "v1alpha1")},
+		})
+		return
+	case "/apis/core.gardener.cloud/v1beta1":
+		write(metav1.APIResourceList{
+			TypeMeta:     metav1.TypeMeta{Kind: "APIResourceList", APIVersion: "v1"},
+			GroupVersion: "core.gardener.cloud/v1beta1",
+			APIResources: []metav1.APIResource{
+				{Name: "shoots", Namespaced: true, Kind: "Shoot", Verbs: []string{"get"}},
+				{Name: "shoots/adminkubeconfig", Namespaced: true, Group: "authentication.gardener.cloud", Version: "v1alpha1", Kind: "AdminKubeconfigRequest", Verbs: []string{"create"}},
+				{Name: "shoots/viewerkubeconfig", Namespaced: true, Group: "authentication.gardener.cloud", Version: "v1alpha1", Kind: "ViewerKubeconfigRequest", Verbs: []string{"create"}},
+			},
+		})
+		return
+	case "/apis/seedmanagement.gardener.cloud/v1alpha1":
+		write(metav1.APIResourceList{
+			TypeMeta:     metav1.TypeMeta{Kind: "APIResourceList", APIVersion: "v1"},
+			GroupVersion: "seedmanagement.gardener.cloud/v1alpha1",
+			APIResources: []metav1.APIResource{{Name: "managedseeds", Namespaced: true, Kind: "ManagedSeed", Verbs: []string{"get"}}},
+		})
+		return
+	}
+
+	g.requests[r.URL.Path]++
+	if r.Method == http.MethodPost {
+		shootPath, subresource, _ := strings.Cut(r.URL.Path, "/shoots/")
+		shootName, subresource, _ := strings.Cut(subresource, "/")
+		shoot, ok := g.objects[shootPath+"/shoots/"+shootName].(*gardencorev1beta1.Shoot)
+		if !ok {
+			g.notFound(w)
+			return
+		}
+
+		kubeconfig := g.kubeconfig(shoot.Name, g.requests[r.URL.Path])
+		expirationTimestamp := metav1.NewTime(time.Now().Add(g.validity))
+		switch subresource {
+		case "adminkubeconfig":
+			write(&authenticationv1alpha1.AdminKubeconfigRequest{
+				TypeMeta: metav1.TypeMeta{APIVersion: authenticationv1alpha1.SchemeGroupVersion.String(), Kind: "AdminKubeconfigRequest"},
+				Status:   authenticationv1alpha1.AdminKubeconfigRequestStatus{Kubeconfig: kubeconfig, ExpirationTimestamp: expirationTimestamp},
+			})
+		case "viewerkubeconfig":
+			write(&authenticationv1alpha1.ViewerKubeconfigRequest{
+				TypeMeta: metav1.TypeMeta{APIVersion: authenticationv1alpha1.SchemeGroupVersion.String(), Kind: "ViewerKubeconfigRequest"},
+				Status:   authenticationv1alpha1.ViewerKubeconfigRequestStatus{Kubeconfig: kubeconfig, ExpirationTimestamp: expirationTimestamp},
+			})
+		default:
+			g.notFound(w)
+		}
+		return
+	}
+
+	obj, ok := g.objects[r.URL.Path]
+	if !ok {
+		g.notFound(w)
+		return
+	}
+	write(obj)
+}
+
+func (g *fakeGarden) notFound(w http.ResponseWriter) {
+	w.WriteHeader(http.StatusNotFound)
+	_, _ = w.Write([]byte(`{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"NotFound","code":404}`))
+}
+
+// kubeconfig returns a kubeconfig for the shoot with the given name.
+// Its client certificate and key contain the number of the request.
+func (g *fakeGarden) kubeconfig(shootName string, request int) []byte {
+	kubeconfig, err := clientcmd.Write(clientcmdapi.Config{
+		Clusters: map[string]*clientcmdapi.Cluster{shootName: {Server: "https://api." + shootName, CertificateAuthorityData: []byte("ca")}},
+		AuthInfos: map[string]*clientcmdapi.AuthInfo{shootName: {
+			ClientCertificateData: fmt.Appendf(nil, "cert-%d", request),
+			ClientKeyData:         fmt.Appendf(nil, "key-%d", request),
+		}},
+		Contexts:       map[string]*clientcmdapi.Context{shootName: {Cluster: shootName, AuthInfo: shootName}},
+		CurrentContext: shootName,
+	})
+	Expect(err).ToNot(HaveOccurred())
+	return kubeconfig
+}
+
+var _ = Describe("ShootAccess", func() {
+	var (
+		ctx          = context.Background()
+		garden       *fakeGarden
+		gardenClient client.Client
+		dir          string
+		logger       = slog.New(slog.DiscardHandler)
+	)
+
+	BeforeEach(func() {
+		garden = newFakeGarden()
+		DeferCleanup(garden.Close)
+
+		garden.addShoot(&gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "garden-bar"},
+			Status:     gardencorev1beta1.ShootStatus{SeedName: ptr.To("seed"), TechnicalID: "shoot--bar--foo"},
+		})
+		garden.addShoot(&gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "seed-shoot", Namespace: "garden"},
+			Status:     gardencorev1beta1.ShootStatus{SeedName: ptr.To("soil"), TechnicalID: "shoot--garden--seed-shoot"},
+		})
+		garden.addManagedSeed(&seedmanagementv1alpha1.ManagedSeed{
+			ObjectMeta: metav1.ObjectMeta{Name: "seed", Namespace: "garden"},
+			Spec:       seedmanagementv1alpha1.ManagedSeedSpec{Shoot: &seedmanagementv1alpha1.Shoot{Name: "seed-shoot"}},
+		})
+
+		var err error
+		gardenClient, err = client.New(&rest.Config{Host: garden.URL}, client.Options{Scheme: gardener.GardenScheme})
+		Expect(err).ToNot(HaveOccurred())
+		dir = GinkgoT().TempDir()
+	})
+
+	It("should request credentials for the shoot and its managed seed", func() {
+		access, err := gardener.NewShootAccess(ctx, gardenClient, gardener.ShootAccessConfig{
+			ProjectNamespace: "garden-bar",
+			ShootName:        "foo",
+			Dir:              dir,
+		}, logger)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(access.ControlPlaneNamespace).To(Equal("shoot--bar--foo"))
+		Expect(access.ShootConfig.Host).To(Equal("https://api.foo"))
+		Expect(access.ShootConfig.CAData).To(Equal([]byte("ca")))
+		Expect(access.ShootConfig.CertData).To(BeEmpty())
+		Expect(access.ShootConfig.CertFile).To(Equal(filepath.Join(dir, "shoot.pem")))
+		Expect(access.ShootConfig.KeyFile).To(Equal(filepath.Join(dir, "shoot.pem")))
+		Expect(access.SeedConfig.Host).To(Equal("https://api.seed-shoot"))
+		Expect(access.SeedConfig.CertFile).To(Equal(filepath.Join(dir, "seed.pem")))
+
+		Expect(os.ReadFile(filepath.Join(dir, "shoot.pem"))).To(Equal([]byte("cert-1\nkey-1")))
+		Expect(os.ReadFile(filepath.Join(dir, "seed.pem"))).To(Equal([]byte("cert-1\nkey-1")))
+		Expect(garden.requestCount("/apis/core.gardener.cloud/v1beta1/namespaces/garden-bar/shoots/foo/adminkubeconfig")).To(Equal(1))
+		Expect(garden.requestCount("/apis/core.gardener.cloud/v1beta1/namespaces/garden/shoots/seed-shoot/adminkubeconfig")).To(Equal(1))
+	})
+
+	It("should write the client certificates to files which are only readable by the current user", func() {
+		_, err := gardener.NewShootAccess(ctx, gardenClient, gardener.ShootAccessConfig{
+			ProjectNamespace: "garden-bar",
+			ShootName:        "foo",
+			Dir:              dir,
+		}, logger)
+		Expect(err).ToNot(HaveOccurred())
+
+		for _, file := range []string{"shoot.pem", "seed.pem"} {
+			info, err := os.Stat(filepath.Join(dir, file))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+		}
+	})
+
+	Describe("#Close", func() {
+		It("should remove the temporary directory created for the client certificates", func() {
+			access, err := gardener.NewShootAccess(ctx, gardenClient, gardener.ShootAccessConfig{
+				ProjectNamespace: "garden-bar",
+				ShootName:        "foo",
+			}, logger)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(access.Dir()).To(BeADirectory())
+			Expect(filepath.Join(access.Dir(), "shoot.pem")).To(BeARegularFile())
+
+			Expect(access.Close()).To(Succeed())
+			Expect(access.Dir()).ToNot(BeAnExistingFile())
+		})
+
+		It("should only remove the client certificates from a configured directory", func() {
+			access, err := gardener.NewShootAccess(ctx, gardenClient, gardener.ShootAccessConfig{
+				ProjectNamespace: "garden-bar",
+				ShootName:        "foo",
+				Dir:              dir,
+			}, logger)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(access.Close()).To(Succeed())
+			Expect(dir).To(BeADirectory())
+			Expect(filepath.Join(dir, "shoot.pem")).ToNot(BeAnExistingFile())
+			Expect(filepath.Join(dir, "seed.pem")).ToNot(BeAnExistingFile())
+		})
+	})
+
+	It("should request viewer credentials only for the shoot when the seed is skipped", func() {
+		access, err := gardener.NewShootAccess(ctx, gardenClient, gardener.ShootAccessConfig{
+			ProjectNamespace: "garden-bar",
+			ShootName:        "foo",
+			CredentialsType:  gardener.CredentialsTypeViewer,
+			SkipSeed:         true,
+			Dir:              dir,
+		}, logger)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(access.SeedConfig).To(BeNil())
+		Expect(garden.requestCount("/apis/core.gardener.cloud/v1beta1/namespaces/garden-bar/shoots/foo/viewerkubeconfig")).To(Equal(1))
+		Expect(garden.requestCount("/apis/core.gardener.cloud/v1beta1/namespaces/garden-bar/shoots/foo/adminkubeconfig")).To(Equal(0))
+		Expect(garden.requestCount("/apis/seedmanagement.gardener.cloud/v1alpha1/namespaces/garden/managedseeds/seed")).To(Equal(0))
+	})
+
+	It("should refresh the credentials when half of their validity has passed", func() {
+		garden.validity = 4 * time.Second
+		access, err := gardener.NewShootAccess(ctx, gardenClient, gardener.ShootAccessConfig{
+			ProjectNamespace: "garden-bar",
+			ShootName:        "foo",
+			Dir:              dir,
+		}, logger)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(access.Refresh(ctx)).To(Succeed())
+		Expect(os.ReadFile(filepath.Join(dir, "shoot.pem"))).To(Equal([]byte("cert-1\nkey-1")))
+
+		refreshCtx, cancel := context.WithCancel(ctx)
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			access.KeepFresh(refreshCtx)
+		}()
+		DeferCleanup(func() {
+			cancel()
+			Eventually(done).Should(BeClosed())
+		})
+
+		for _, file := range []string{"shoot.pem", "seed.pem"} {
+			Eventually(func() ([]byte, error) {
+				return os.ReadFile(filepath.Join(dir, file))
+			}).WithTimeout(10 * time.Second).Should(Equal([]byte("cert-2\nkey-2")))
+		}
+	})
+
+	DescribeTable("should return an error",
+		func(conf gardener.ShootAccessConfig, expectedErr string) {
+			if len(conf.ProjectNamespace) == 0 {
+				conf.ProjectNamespace = "garden-bar"
+			}
+			conf.Dir = dir
+
+			_, err := gardener.NewShootAccess(ctx, gardenClient, conf, logger)
+			Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+		},
+		Entry("when the shoot name is not set",
+			gardener.ShootAccessConfig{}, "shoot name is not set"),
+		Entry("when the credentials type is unknown",
+			gardener.ShootAccessConfig{ShootName: "foo", CredentialsType: "owner"}, "credentials type owner is not one of admin or viewer"),
+		Entry("when the expiration is too short",
+			gardener.ShootAccessConfig{ShootName: "foo", Expiration: time.Minute}, "credentials expiration should be at least 10m0s"),
+		Entry("when the shoot does not exist",
+			gardener.ShootAccessConfig{ShootName: "baz"}, "failed to get shoot garden-bar/baz"),
+		Entry("when the seed is not a managed seed",
+			gardener.ShootAccessConfig{ProjectNamespace: "garden", ShootName: "seed-shoot"}, "seed soil is not a managed seed, its kubeconfig has to be set explicitly"),
+	)
+
+	Describe("#FromGenericConfig", func() {
+		It("should create a provider from a garden kubeconfig", func() {
+			gardenKubeconfigPath := filepath.Join(dir, "garden.yaml")
+			Expect(clientcmd.WriteToFile(clientcmdapi.Config{
+				Clusters:       map[string]*clientcmdapi.Cluster{"garden": {Server: garden.URL}},
+				AuthInfos:      map[string]*clientcmdapi.AuthInfo{"garden": {Token: "token"}},
+				Contexts:       map[string]*clientcmdapi.Context{"garden": {Cluster: "garden", AuthInfo: "garden"}},
+				CurrentContext: "garden",
+			}, gardenKubeconfigPath)).To(Succeed())
+
+			p, err := gardener.FromGenericConfig(config.ProviderConfig{
+				ID:   "gardener",
+				Name: "Gardener",
+				Args: map[string]any{
+					"gardenKubeconfigPath":  gardenKubeconfigPath,
+					"projectNamespace":      "garden-bar",
+					"shootName":             "foo",
+					"credentialsExpiration": "30m",
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(p.Args).To(Equal(gardener.Args{ShootName: "foo", ShootNamespace: "shoot--bar--foo"}))
+			Expect(p.ShootConfig.Host).To(Equal("https://api.foo"))
+			Expect(p.SeedConfig.Host).To(Equal("https://api.seed-shoot"))
+
+			Expect(p.Close()).To(Succeed())
+			Expect(p.ShootAccess.Dir()).ToNot(BeAnExistingFile())
+		})
+
+		It("should remove the client certificates when the provider cannot be created", func() {
+			gardenKubeconfigPath := filepath.Join(dir, "garden.yaml")
+			Expect(clientcmd.WriteToFile(clientcmdapi.Config{
+				Clusters:       map[string]*clientcmdapi.Cluster{"garden": {Server: garden.URL}},
+				AuthInfos:      map[string]*clientcmdapi.AuthInfo{"garden": {Token: "token"}},
+				Contexts:       map[string]*clientcmdapi.Context{"garden": {Cluster: "garden", AuthInfo: "garden"}},
+				CurrentContext: "garden",
+			}, gardenKubeconfigPath)).To(Succeed())
+			tmpDir := GinkgoT().TempDir()
+			GinkgoT().Setenv("TMPDIR", tmpDir)
+
+			_, err := gardener.FromGenericConfig(config.ProviderConfig{
+				ID: "gardener",
+				Args: map[string]any{
+					"gardenKubeconfigPath": gardenKubeconfigPath,
+					"seedKubeconfigPath":   filepath.Join(dir, "missing.yaml"),
+					"projectNamespace":     "garden-bar",
+					"shootName":            "foo",
+				},
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(os.ReadDir(tmpDir)).To(BeEmpty())
+		})
+
+		It("should not allow a shoot kubeconfig together with a garden kubeconfig", func() {
+			_, err := gardener.FromGenericConfig(config.ProviderConfig{
+				ID: "gardener",
+				Args: map[string]any{
+					"gardenKubeconfigPath": "garden.yaml",
+					"shootKubeconfigPath":  "shoot.yaml",
+				},
+			})
+			Expect(err).To(MatchError(And(
+				ContainSubstring("shootKubeconfigPath: Forbidden: must not be set when the garden cluster is set"),
+				ContainSubstring("projectNamespace: Required value: must be set when the garden cluster is set"),
+				ContainSubstring("shootName: Required value: must be set when the garden cluster is set"),
+			)))
+		})
+	})
+})
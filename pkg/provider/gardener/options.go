@@ -49,6 +49,13 @@ func WithSeedConfig(config *rest.Config) CreateOption {
 	}
 }
 
+// WithShootAccess sets the ShootAccess of a Provider.
+func WithShootAccess(shootAccess *ShootAccess) CreateOption {
+	return func(p *Provider) {
+		p.ShootAccess = shootAccess
+	}
+}
+
 // WithArgs sets the arguments of a Provider.
 func WithArgs(args Args) CreateOption {
 	return func(p *Provider) {
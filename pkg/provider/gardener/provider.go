@@ -9,9 +9,14 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"log/slog"
+	"sync"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gardener/diki/pkg/config"
 	kubeutils "github.com/gardener/diki/pkg/kubernetes/utils"
@@ -38,14 +43,25 @@ type Provider struct {
 	rulesets                map[string]ruleset.Ruleset
 	metadata                map[string]string
 	logger                  *slog.Logger
+
+	// ShootAccess refreshes the credentials of ShootConfig and SeedConfig during runs.
+	// It is set when the credentials are requested through the garden cluster.
+	ShootAccess *ShootAccess
 }
 
 type providerArgs struct {
-	AdditionalOpsPodLabels map[string]string `json:"additionalOpsPodLabels" yaml:"additionalOpsPodLabels"`
-	ShootKubeconfigPath    string            `json:"shootKubeconfigPath" yaml:"shootKubeconfigPath"`
-	SeedKubeconfigPath     string            `json:"seedKubeconfigPath" yaml:"seedKubeconfigPath"`
-	ShootName              string            `json:"shootName" yaml:"shootName"`
-	ShootNamespace         string            `json:"shootNamespace" yaml:"shootNamespace"`
+	AdditionalOpsPodLabels map[string]string           `json:"additionalOpsPodLabels" yaml:"additionalOpsPodLabels"`
+	ShootKubeconfigPath    string                      `json:"shootKubeconfigPath" yaml:"shootKubeconfigPath"`
+	SeedKubeconfigPath     string                      `json:"seedKubeconfigPath" yaml:"seedKubeconfigPath"`
+	ShootName              string                      `json:"shootName" yaml:"shootName"`
+	ShootNamespace         string                      `json:"shootNamespace" yaml:"shootNamespace"`
+	GardenKubeconfigPath   string                      `json:"gardenKubeconfigPath" yaml:"gardenKubeconfigPath"`
+	ShootCluster           *config.ClusterAccessConfig `json:"shootCluster" yaml:"shootCluster"`
+	SeedCluster            *config.ClusterAccessConfig `json:"seedCluster" yaml:"seedCluster"`
+	GardenCluster          *config.ClusterAccessConfig `json:"gardenCluster" yaml:"gardenCluster"`
+	ProjectNamespace       string                      `json:"projectNamespace" yaml:"projectNamespace"`
+	CredentialsType        CredentialsType             `json:"credentialsType" yaml:"credentialsType"`
+	CredentialsExpiration  *metav1.Duration            `json:"credentialsExpiration" yaml:"credentialsExpiration"`
 }
 
 // Args are Gardener Provider specific arguments.
@@ -54,7 +70,11 @@ type Args struct {
 	ShootNamespace string
 }
 
-var _ provider.Provider = &Provider{}
+var (
+	_ provider.Provider         = &Provider{}
+	_ provider.ClusterVersioner = &Provider{}
+	_ io.Closer                 = &Provider{}
+)
 
 // New creates a new Provider.
 func New(options ...CreateOption) (*Provider, error) {
@@ -102,9 +122,26 @@ func validateAgrs(args Args) error {
 
 // RunAll executes all Rulesets registered with the Provider.
 func (p *Provider) RunAll(ctx context.Context) (provider.ProviderResult, error) {
+	defer p.keepCredentialsFresh(ctx)()
 	return sharedprovider.RunAll(ctx, p, p.rulesets, p.Logger())
 }
 
+// keepCredentialsFresh refreshes the cluster credentials in the background
+// if they are requested through the garden cluster. The returned function stops the refresh.
+func (p *Provider) keepCredentialsFresh(ctx context.Context) func() {
+	if p.ShootAccess == nil {
+		return func() {}
+	}
+
+	var wg sync.WaitGroup
+	ctx, cancel := context.WithCancel(ctx)
+	wg.Go(func() { p.ShootAccess.KeepFresh(ctx) })
+	return func() {
+		cancel()
+		wg.Wait()
+	}
+}
+
 func rulesetKey(rulesetID, rulesetVersion string) string {
 	return rulesetID + "--" + rulesetVersion
 }
@@ -115,6 +152,8 @@ func (p *Provider) RunRuleset(ctx context.Context, rulesetID, rulesetVersion str
 	if !ok {
 		return ruleset.RulesetResult{}, fmt.Errorf("ruleset with id %s and version %s does not exist", rulesetID, rulesetVersion)
 	}
+
+	defer p.keepCredentialsFresh(ctx)()
 	return rs.Run(ctx)
 }
 
@@ -125,6 +164,7 @@ func (p *Provider) RunRule(ctx context.Context, rulesetID, rulesetVersion, ruleI
 		return rule.RuleResult{}, fmt.Errorf("ruleset with id %s and version %s does not exist", rulesetID, rulesetVersion)
 	}
 
+	defer p.keepCredentialsFresh(ctx)()
 	return rs.RunRule(ctx, ruleID)
 }
 
@@ -140,6 +180,19 @@ func (p *Provider) AddRulesets(rulesets ...ruleset.Ruleset) error {
 	return nil
 }
 
+// ClusterVersions returns the Kubernetes server versions of the checked clusters.
+func (p *Provider) ClusterVersions(ctx context.Context) (map[string]string, error) {
+	return sharedprovider.ClusterVersions(ctx, map[string]*rest.Config{"shoot": p.ShootConfig, "seed": p.SeedConfig})
+}
+
+// Close removes the client certificates written for the credentials requested through the garden cluster.
+func (p *Provider) Close() error {
+	if p.ShootAccess == nil {
+		return nil
+	}
+	return p.ShootAccess.Close()
+}
+
 // ID returns the id of the Provider.
 func (p *Provider) ID() string {
 	return p.id
@@ -170,12 +223,22 @@ func FromGenericConfig(providerConf config.ProviderConfig) (*Provider, error) {
 		return nil, err
 	}
 
-	shootKubeConfig, err := kubeutils.RESTConfigFromFile(providerGardenerArgs.ShootKubeconfigPath)
+	if providerGardenerArgs.GardenCluster != nil || len(providerGardenerArgs.GardenKubeconfigPath) > 0 {
+		return fromGardenCluster(providerConf, providerGardenerArgs)
+	}
+
+	shootClusterAccess, errs := config.NewClusterAccessConfig(providerGardenerArgs.ShootCluster, providerGardenerArgs.ShootKubeconfigPath, field.NewPath("shootCluster"), field.NewPath("shootKubeconfigPath"))
+	seedClusterAccess, seedErrs := config.NewClusterAccessConfig(providerGardenerArgs.SeedCluster, providerGardenerArgs.SeedKubeconfigPath, field.NewPath("seedCluster"), field.NewPath("seedKubeconfigPath"))
+	if errs = append(errs, seedErrs...); len(errs) > 0 {
+		return nil, errs.ToAggregate()
+	}
+
+	shootKubeConfig, err := kubeutils.RESTConfigFromClusterAccess(shootClusterAccess)
 	if err != nil {
 		return nil, err
 	}
 
-	seedKubeConfig, err := kubeutils.RESTConfigFromFile(providerGardenerArgs.SeedKubeconfigPath)
+	seedKubeConfig, err := kubeutils.RESTConfigFromClusterAccess(seedClusterAccess)
 	if err != nil {
 		return nil, err
 	}
@@ -201,6 +264,122 @@ func FromGenericConfig(providerConf config.ProviderConfig) (*Provider, error) {
 	return gardenerProvider, nil
 }
 
+// ValidateSnapshotConfig returns an error if a Gardener provider of the configuration requests
+// the cluster credentials through the garden cluster. Credentials cannot be requested when
+// the rules are evaluated against a snapshot, since the garden cluster is not recorded.
+func ValidateSnapshotConfig(c *config.DikiConfig) error {
+	for _, providerConf := range c.Providers {
+		if providerConf.ID != ProviderID || providerConf.Plugin != nil {
+			continue
+		}
+
+		providerArgsByte, err := json.Marshal(providerConf.Args)
+		if err != nil {
+			return err
+		}
+
+		var providerGardenerArgs providerArgs
+		if err := json.Unmarshal(providerArgsByte, &providerGardenerArgs); err != nil {
+			return err
+		}
+
+		if providerGardenerArgs.GardenCluster != nil || len(providerGardenerArgs.GardenKubeconfigPath) > 0 {
+			return fmt.Errorf("provider %s requests the cluster credentials through the garden cluster, which is not supported when evaluating a snapshot: set the shoot and seed kubeconfigs instead", providerConf.ID)
+		}
+	}
+	return nil
+}
+
+// fromGardenCluster creates a Provider which requests short-lived credentials
+// for the shoot and its seed through the garden cluster.
+func fromGardenCluster(providerConf config.ProviderConfig, providerGardenerArgs providerArgs) (*Provider, error) {
+	gardenClusterAccess, errs := config.NewClusterAccessConfig(providerGardenerArgs.GardenCluster, providerGardenerArgs.GardenKubeconfigPath, field.NewPath("gardenCluster"), field.NewPath("gardenKubeconfigPath"))
+	if providerGardenerArgs.ShootCluster != nil {
+		errs = append(errs, field.Forbidden(field.NewPath("shootCluster"), "must not be set when the garden cluster is set"))
+	}
+
+	if len(providerGardenerArgs.ShootKubeconfigPath) > 0 {
+		errs = append(errs, field.Forbidden(field.NewPath("shootKubeconfigPath"), "must not be set when the garden cluster is set"))
+	}
+
+	if len(providerGardenerArgs.ProjectNamespace) == 0 {
+		errs = append(errs, field.Required(field.NewPath("projectNamespace"), "must be set when the garden cluster is set"))
+	}
+
+	if len(providerGardenerArgs.ShootName) == 0 {
+		errs = append(errs, field.Required(field.NewPath("shootName"), "must be set when the garden cluster is set"))
+	}
+
+	// the seed cluster access is optional, it is only needed for seeds which are not managed seeds
+	var seedClusterAccess *config.ClusterAccessConfig
+	if providerGardenerArgs.SeedCluster != nil || len(providerGardenerArgs.SeedKubeconfigPath) > 0 {
+		access, seedErrs := config.NewClusterAccessConfig(providerGardenerArgs.SeedCluster, providerGardenerArgs.SeedKubeconfigPath, field.NewPath("seedCluster"), field.NewPath("seedKubeconfigPath"))
+		errs = append(errs, seedErrs...)
+		seedClusterAccess = &access
+	}
+
+	if len(errs) > 0 {
+		return nil, errs.ToAggregate()
+	}
+
+	gardenKubeConfig, err := kubeutils.RESTConfigFromClusterAccess(gardenClusterAccess)
+	if err != nil {
+		return nil, err
+	}
+
+	gardenClient, err := client.New(gardenKubeConfig, client.Options{Scheme: GardenScheme})
+	if err != nil {
+		return nil, err
+	}
+
+	accessConfig := ShootAccessConfig{
+		ProjectNamespace: providerGardenerArgs.ProjectNamespace,
+		ShootName:        providerGardenerArgs.ShootName,
+		CredentialsType:  providerGardenerArgs.CredentialsType,
+		SkipSeed:         seedClusterAccess != nil,
+	}
+	if providerGardenerArgs.CredentialsExpiration != nil {
+		accessConfig.Expiration = providerGardenerArgs.CredentialsExpiration.Duration
+	}
+
+	logger := slog.Default().With("provider", providerConf.ID)
+	shootAccess, err := NewShootAccess(context.Background(), gardenClient, accessConfig, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	seedKubeConfig := shootAccess.SeedConfig
+	if accessConfig.SkipSeed {
+		if seedKubeConfig, err = kubeutils.RESTConfigFromClusterAccess(*seedClusterAccess); err != nil {
+			return nil, errors.Join(err, shootAccess.Close())
+		}
+	}
+
+	args := Args{
+		ShootName:      providerGardenerArgs.ShootName,
+		ShootNamespace: providerGardenerArgs.ShootNamespace,
+	}
+	if len(args.ShootNamespace) == 0 {
+		args.ShootNamespace = shootAccess.ControlPlaneNamespace
+	}
+
+	p, err := New(
+		WithID(providerConf.ID),
+		WithName(providerConf.Name),
+		WithAdditionalOpsPodLabels(providerGardenerArgs.AdditionalOpsPodLabels),
+		WithSeedConfig(seedKubeConfig),
+		WithShootConfig(shootAccess.ShootConfig),
+		WithShootAccess(shootAccess),
+		WithMetadata(providerConf.Metadata),
+		WithArgs(args),
+		WithLogger(logger),
+	)
+	if err != nil {
+		return nil, errors.Join(err, shootAccess.Close())
+	}
+	return p, nil
+}
+
 // Logger returns the Provider's logger.
 // If not set it set it to slog.Default().With("provider", p.ID()) then return it.
 func (p *Provider) Logger() *slog.Logger {
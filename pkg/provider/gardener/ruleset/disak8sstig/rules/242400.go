@@ -213,7 +213,7 @@ func (r *Rule242400) checkKubeProxy(
 
 	execPod := &corev1.Pod{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      podName,
+			Name:      pod.PodName(podExecutor, podName),
 			Namespace: "kube-system",
 		},
 	}
@@ -256,7 +256,7 @@ func (r *Rule242451) checkPods(
 
 	execPod := &corev1.Pod{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      podName,
+			Name:      pod.PodName(podExecutor, podName),
 			Namespace: "kube-system",
 		},
 	}
@@ -370,23 +370,27 @@ func (r *Rule242451) checkKubelet(
 	}
 
 	if kubeletConfig.TLSPrivateKeyFile != nil && kubeletConfig.TLSCertFile != nil {
+		var tlsFilePaths []string
 		if len(*kubeletConfig.TLSPrivateKeyFile) == 0 {
 			checkResults = append(checkResults, rule.FailedCheckResult("could not find key file, option tlsPrivateKeyFile is empty.", nodeTarget))
 		} else {
-			keyFileStats, err := intutils.GetSingleFileStats(ctx, podExecutor, *kubeletConfig.TLSPrivateKeyFile)
-			if err != nil {
-				checkResults = append(checkResults, rule.ErroredCheckResult(err.Error(), execPodTarget))
-			}
-			selectedFileStats = append(selectedFileStats, keyFileStats)
+			tlsFilePaths = append(tlsFilePaths, *kubeletConfig.TLSPrivateKeyFile)
 		}
 		if len(*kubeletConfig.TLSCertFile) == 0 {
 			checkResults = append(checkResults, rule.FailedCheckResult("could not find cert file, option tlsCertFile is empty.", nodeTarget))
 		} else {
-			certFileStats, err := intutils.GetSingleFileStats(ctx, podExecutor, *kubeletConfig.TLSCertFile)
-			if err != nil {
-				checkResults = append(checkResults, rule.ErroredCheckResult(err.Error(), execPodTarget))
+			tlsFilePaths = append(tlsFilePaths, *kubeletConfig.TLSCertFile)
+		}
+
+		tlsFilesStats, errs, err := intutils.GetFilesStats(ctx, podExecutor, tlsFilePaths)
+		if err != nil {
+			return append(checkResults, rule.ErroredCheckResult(err.Error(), execPodTarget))
+		}
+		for i, tlsFileStats := range tlsFilesStats {
+			if errs[i] != nil {
+				checkResults = append(checkResults, rule.ErroredCheckResult(errs[i].Error(), execPodTarget))
 			}
-			selectedFileStats = append(selectedFileStats, certFileStats)
+			selectedFileStats = append(selectedFileStats, tlsFileStats)
 		}
 	} else {
 		kubeletPKIDir := "/var/lib/kubelet/pki"
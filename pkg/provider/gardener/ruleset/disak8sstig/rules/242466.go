@@ -234,7 +234,7 @@ func (r *Rule242466) checkPods(
 
 	execPod := &corev1.Pod{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      podName,
+			Name:      pod.PodName(podExecutor, podName),
 			Namespace: "kube-system",
 		},
 	}
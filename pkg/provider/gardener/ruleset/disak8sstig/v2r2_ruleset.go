@@ -17,12 +17,14 @@ import (
 	"github.com/gardener/diki/pkg/config"
 	internalconfig "github.com/gardener/diki/pkg/internal/config"
 	"github.com/gardener/diki/pkg/kubernetes/pod"
+	kubeutils "github.com/gardener/diki/pkg/kubernetes/utils"
 	"github.com/gardener/diki/pkg/provider/gardener/ruleset/disak8sstig/rules"
 	"github.com/gardener/diki/pkg/rule"
 	"github.com/gardener/diki/pkg/rule/retry"
 	option "github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/option"
 	"github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/retryerrors"
 	sharedrules "github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/rules"
+	"github.com/gardener/diki/pkg/snapshot"
 )
 
 func validateV2R2Options[O rules.RuleOption](options any, fldPath *field.Path) field.ErrorList {
@@ -89,23 +91,27 @@ func (r *Ruleset) registerV2R2Rules(ruleOptions map[string]config.RuleOptionsCon
 	if err != nil {
 		return err
 	}
+	shootClient = kubeutils.NewCachedClient(shootClient, r.ShootConfig.Host)
 
 	seedClient, err := client.New(r.SeedConfig, client.Options{Scheme: kubernetesgardener.SeedScheme})
 	if err != nil {
 		return err
 	}
+	seedClient = kubeutils.NewCachedClient(seedClient, r.SeedConfig.Host)
 
-	shootPodContext, err := pod.NewSimplePodContext(shootClient, r.ShootConfig, r.AdditionalOpsPodLabels)
+	simpleShootPodContext, err := pod.NewSimplePodContext(shootClient, r.ShootConfig, r.AdditionalOpsPodLabels)
 	if err != nil {
 		return err
 	}
+	shootPodContext := pod.NewPooledPodContext(simpleShootPodContext, r.ShootConfig.Host)
 
-	seedPodContext, err := pod.NewSimplePodContext(seedClient, r.SeedConfig, r.AdditionalOpsPodLabels)
+	simpleSeedPodContext, err := pod.NewSimplePodContext(seedClient, r.SeedConfig, r.AdditionalOpsPodLabels)
 	if err != nil {
 		return err
 	}
+	seedPodContext := pod.NewPooledPodContext(simpleSeedPodContext, r.SeedConfig.Host)
 
-	shootClientSet, err := kubernetes.NewForConfig(r.ShootConfig)
+	shootClientSet, err := kubernetes.NewForConfig(snapshot.WrapRESTConfig(r.ShootConfig))
 	if err != nil {
 		return err
 	}
@@ -707,7 +713,7 @@ func (r *Ruleset) registerV2R2Rules(ruleOptions map[string]config.RuleOptionsCon
 
 		opt, found := ruleOptions[r.ID()]
 		if found && opt.Skip != nil && opt.Skip.Enabled {
-			rules[i] = rule.NewSkipRule(r.ID(), r.Name(), opt.Skip.Justification, rule.Accepted, rule.SkipRuleWithSeverity(severityLevel))
+			rules[i] = rule.NewSkipRule(r.ID(), r.Name(), opt.Skip.Justification, rule.Accepted, rule.SkipRuleWithSeverity(severityLevel), rule.SkipRuleWithAcceptance(opt.Skip.Owner, opt.Skip.ExpiresAt))
 		}
 	}
 
@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package linuxhost
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/gardener/diki/pkg/kubernetes/pod"
+)
+
+var _ pod.PodExecutor = &LocalExecutor{}
+
+// LocalExecutor executes commands on the local host.
+// It behaves like a [pod.SimplePodExecutor]: the command argument is passed
+// to the command on stdin and output on stderr is returned as an error.
+type LocalExecutor struct{}
+
+// NewLocalExecutor creates a new LocalExecutor.
+func NewLocalExecutor() *LocalExecutor {
+	return &LocalExecutor{}
+}
+
+// Execute runs command with commandArg as stdin and returns its stdout.
+func (le *LocalExecutor) Execute(ctx context.Context, command string, commandArg string) (string, error) {
+	var (
+		stdout, stderr bytes.Buffer
+		cmd            = exec.CommandContext(ctx, command) // #nosec G204
+	)
+	cmd.Stdin = strings.NewReader(commandArg)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	err := cmd.Run()
+	switch {
+	case err != nil && stderr.Len() > 0:
+		return "", fmt.Errorf("err: %w, command %s %s stderr output: %s", err, command, commandArg, stderr.String())
+	case stderr.Len() > 0:
+		return "", fmt.Errorf("command %s %s stderr output: %s", command, commandArg, stderr.String())
+	case err != nil:
+		return "", fmt.Errorf("err: %w, command %s %s", err, command, commandArg)
+	}
+
+	return stdout.String(), nil
+}
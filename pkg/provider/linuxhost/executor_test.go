@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package linuxhost_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	intutils "github.com/gardener/diki/pkg/internal/utils"
+	"github.com/gardener/diki/pkg/provider/linuxhost"
+)
+
+var _ = Describe("LocalExecutor", func() {
+	var (
+		ctx      = context.TODO()
+		executor *linuxhost.LocalExecutor
+		root     string
+	)
+
+	BeforeEach(func() {
+		executor = linuxhost.NewLocalExecutor()
+		root = GinkgoT().TempDir()
+	})
+
+	It("should pass the command argument on stdin and return stdout", func() {
+		Expect(os.WriteFile(filepath.Join(root, "foo"), []byte("bar"), 0600)).To(Succeed())
+
+		result, err := executor.Execute(ctx, "/bin/sh", "cat "+filepath.Join(root, "foo"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal("bar"))
+	})
+
+	It("should return an error when the command writes to stderr", func() {
+		_, err := executor.Execute(ctx, "/bin/sh", "echo foo >&2")
+		Expect(err).To(MatchError("command /bin/sh echo foo >&2 stderr output: foo\n"))
+	})
+
+	It("should return an error when the command fails", func() {
+		_, err := executor.Execute(ctx, "/bin/sh", "exit 1")
+		Expect(err).To(MatchError("err: exit status 1, command /bin/sh exit 1"))
+	})
+
+	It("should return the file stats of a local file", func() {
+		filePath := filepath.Join(root, "foo")
+		Expect(os.WriteFile(filePath, []byte("bar"), 0640)).To(Succeed())
+
+		fileStats, err := intutils.GetSingleFileStats(ctx, executor, filePath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fileStats.Path).To(Equal(filePath))
+		Expect(fileStats.Permissions).To(Equal("640"))
+		Expect(fileStats.FileType).To(Equal("regular file"))
+	})
+})
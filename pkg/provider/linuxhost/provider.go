@@ -0,0 +1,175 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package linuxhost
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+
+	"github.com/gardener/diki/pkg/config"
+	"github.com/gardener/diki/pkg/kubernetes/pod"
+	"github.com/gardener/diki/pkg/provider"
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/ruleset"
+	sharedprovider "github.com/gardener/diki/pkg/shared/provider"
+)
+
+const (
+	// ProviderID is a constant containing the id of the Linux Host provider.
+	ProviderID = "linuxhost"
+	// ProviderName is a constant containing the user-friendly name of the Linux Host provider.
+	ProviderName = "Linux Host"
+)
+
+// Provider is a Linux Host Provider that can be used to implement rules
+// against the local host or against the root filesystem of a host mounted locally, e.g. at /host.
+// Commands are executed locally with a [pod.PodExecutor], so no Kubernetes cluster is needed.
+type Provider struct {
+	id, name string
+	// Root is the directory in which the root filesystem of the checked host is found.
+	Root        string
+	PodExecutor pod.PodExecutor
+	rulesets    map[string]ruleset.Ruleset
+	metadata    map[string]string
+	logger      sharedprovider.Logger
+}
+
+type providerArgs struct {
+	Root string `json:"root" yaml:"root"`
+}
+
+var _ provider.Provider = &Provider{}
+
+// New creates a new Provider.
+func New(options ...CreateOption) (*Provider, error) {
+	p := &Provider{
+		Root:        "/",
+		PodExecutor: NewLocalExecutor(),
+		rulesets:    make(map[string]ruleset.Ruleset),
+	}
+	for _, o := range options {
+		o(p)
+	}
+
+	var err error
+	if !filepath.IsAbs(p.Root) {
+		err = errors.Join(err, fmt.Errorf("root %s is not an absolute path", p.Root))
+	} else if info, statErr := os.Stat(p.Root); statErr != nil {
+		err = errors.Join(err, statErr)
+	} else if !info.IsDir() {
+		err = errors.Join(err, fmt.Errorf("root %s is not a directory", p.Root))
+	}
+	if p.PodExecutor == nil {
+		err = errors.Join(err, errors.New("pod executor is nil"))
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	p.Root = filepath.Clean(p.Root)
+	return p, nil
+}
+
+// RunAll executes all Rulesets registered with the Provider.
+func (p *Provider) RunAll(ctx context.Context) (provider.ProviderResult, error) {
+	return sharedprovider.RunAll(ctx, p, p.rulesets, p.Logger())
+}
+
+func rulesetKey(rulesetID, rulesetVersion string) string {
+	return rulesetID + "--" + rulesetVersion
+}
+
+// RunRuleset executes all Rules of a known Ruleset.
+func (p *Provider) RunRuleset(ctx context.Context, rulesetID, rulesetVersion string) (ruleset.RulesetResult, error) {
+	rs, ok := p.rulesets[rulesetKey(rulesetID, rulesetVersion)]
+	if !ok {
+		return ruleset.RulesetResult{}, fmt.Errorf("ruleset with id %s and version %s does not exist", rulesetID, rulesetVersion)
+	}
+	return rs.Run(ctx)
+}
+
+// RunRule executes specific Rule of a known Ruleset.
+func (p *Provider) RunRule(ctx context.Context, rulesetID, rulesetVersion, ruleID string) (rule.RuleResult, error) {
+	rs, ok := p.rulesets[rulesetKey(rulesetID, rulesetVersion)]
+	if !ok {
+		return rule.RuleResult{}, fmt.Errorf("ruleset with id %s and version %s does not exist", rulesetID, rulesetVersion)
+	}
+
+	return rs.RunRule(ctx, ruleID)
+}
+
+// AddRulesets adds Rulesets to Provider.
+func (p *Provider) AddRulesets(rulesets ...ruleset.Ruleset) error {
+	for _, r := range rulesets {
+		key := rulesetKey(r.ID(), r.Version())
+		if _, ok := p.rulesets[key]; ok {
+			return fmt.Errorf("ruleset with id %s and version %s already exists", r.ID(), r.Version())
+		}
+		p.rulesets[key] = r
+	}
+	return nil
+}
+
+// ID returns the id of the Provider.
+func (p *Provider) ID() string {
+	return p.id
+}
+
+// Name returns the name of the Provider.
+func (p *Provider) Name() string {
+	return p.name
+}
+
+// Metadata returns the metadata of the Provider.
+func (p *Provider) Metadata() map[string]string {
+	if p.metadata == nil {
+		p.metadata = map[string]string{}
+	}
+	return p.metadata
+}
+
+// FromGenericConfig creates a Provider from ProviderConfig.
+func FromGenericConfig(providerConf config.ProviderConfig) (*Provider, error) {
+	providerArgsByte, err := json.Marshal(providerConf.Args)
+	if err != nil {
+		return nil, err
+	}
+
+	var providerArgs providerArgs
+	if err := json.Unmarshal(providerArgsByte, &providerArgs); err != nil {
+		return nil, err
+	}
+
+	options := []CreateOption{
+		WithID(providerConf.ID),
+		WithName(providerConf.Name),
+		WithMetadata(providerConf.Metadata),
+	}
+	if len(providerArgs.Root) > 0 {
+		options = append(options, WithRoot(providerArgs.Root))
+	}
+
+	provider, err := New(options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return provider, nil
+}
+
+// Logger returns the Provider's logger.
+// If not set it set it to slog.Default().With("provider", p.ID()) then return it.
+func (p *Provider) Logger() sharedprovider.Logger {
+	if p.logger == nil {
+		p.logger = slog.Default().With("provider", p.ID())
+	}
+	return p.logger
+}
@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package linuxhost_test
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/diki/pkg/config"
+	"github.com/gardener/diki/pkg/provider/linuxhost"
+)
+
+var _ = Describe("linuxhost", func() {
+	Describe("#New", func() {
+		It("should default the root to /", func() {
+			provider, err := linuxhost.New(
+				linuxhost.WithID("foo"),
+				linuxhost.WithName("bar"),
+			)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(provider.ID()).To(Equal("foo"))
+			Expect(provider.Name()).To(Equal("bar"))
+			Expect(provider.Root).To(Equal("/"))
+			Expect(provider.PodExecutor).To(Equal(linuxhost.NewLocalExecutor()))
+		})
+
+		It("should return an error when the root is not an absolute directory", func() {
+			root := GinkgoT().TempDir()
+			filePath := filepath.Join(root, "foo")
+			Expect(os.WriteFile(filePath, nil, 0600)).To(Succeed())
+
+			_, err := linuxhost.New(linuxhost.WithRoot("foo"))
+			Expect(err).To(MatchError("root foo is not an absolute path"))
+
+			_, err = linuxhost.New(linuxhost.WithRoot(filePath))
+			Expect(err).To(MatchError("root " + filePath + " is not a directory"))
+
+			_, err = linuxhost.New(linuxhost.WithRoot(filepath.Join(root, "bar")))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("#FromGenericConfig", func() {
+		It("should set the root from the provider args", func() {
+			root := GinkgoT().TempDir()
+
+			provider, err := linuxhost.FromGenericConfig(config.ProviderConfig{
+				ID:   "linuxhost",
+				Name: "Linux Host",
+				Args: map[string]any{"root": root + "/"},
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(provider.ID()).To(Equal("linuxhost"))
+			Expect(provider.Root).To(Equal(root))
+		})
+	})
+})
@@ -0,0 +1,49 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"context"
+	"fmt"
+
+	intutils "github.com/gardener/diki/pkg/internal/utils"
+	"github.com/gardener/diki/pkg/kubernetes/pod"
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/option"
+	sharedrules "github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/rules"
+)
+
+var (
+	_ rule.Rule     = &Rule242406{}
+	_ rule.Severity = &Rule242406{}
+)
+
+type Rule242406 struct {
+	PodExecutor pod.PodExecutor
+	Root        string
+	Kubelet     Kubelet
+	Options     *option.FileOwnerOptions
+}
+
+func (r *Rule242406) ID() string {
+	return sharedrules.ID242406
+}
+
+func (r *Rule242406) Name() string {
+	return "The Kubernetes kubelet configuration file must be owned by root."
+}
+
+func (r *Rule242406) Severity() rule.SeverityLevel {
+	return rule.SeverityMedium
+}
+
+func (r *Rule242406) Run(ctx context.Context) (rule.RuleResult, error) {
+	expectedFileOwnerUsers, expectedFileOwnerGroups := r.Options.Owners()
+
+	return rule.Result(r, r.Kubelet.check(ctx, r.PodExecutor, r.Root, func(serviceFileStats intutils.FileStats) []rule.CheckResult {
+		target := rule.NewTarget("details", fmt.Sprintf("filePath: %s", serviceFileStats.Path))
+		return intutils.MatchFileOwnersCases(serviceFileStats, expectedFileOwnerUsers, expectedFileOwnerGroups, target)
+	})...), nil
+}
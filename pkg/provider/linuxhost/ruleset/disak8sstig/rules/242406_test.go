@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rules_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/diki/pkg/provider/linuxhost"
+	"github.com/gardener/diki/pkg/provider/linuxhost/ruleset/disak8sstig/rules"
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/option"
+)
+
+var _ = Describe("#242406", func() {
+	const servicePath = "/etc/systemd/system/kubelet.service"
+
+	var (
+		ctx        = context.TODO()
+		root       string
+		user       = strconv.Itoa(os.Getuid())
+		group      = strconv.Itoa(os.Getgid())
+		otherOwner = "65534"
+		r          *rules.Rule242406
+	)
+
+	BeforeEach(func() {
+		root = GinkgoT().TempDir()
+		writeHostFile(root, servicePath, 0644)
+		r = &rules.Rule242406{
+			PodExecutor: linuxhost.NewLocalExecutor(),
+			Root:        root,
+		}
+	})
+
+	It("should pass when the kubelet service has expected owners", func() {
+		r.Options = &option.FileOwnerOptions{
+			ExpectedFileOwner: option.ExpectedOwner{Users: []string{user}, Groups: []string{group}},
+		}
+
+		ruleResult, err := r.Run(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ruleResult.CheckResults).To(Equal([]rule.CheckResult{
+			rule.PassedCheckResult("File has expected owners", rule.NewTarget("details", fmt.Sprintf("fileName: %s, ownerUser: %s, ownerGroup: %s", servicePath, user, group))),
+		}))
+	})
+
+	It("should fail when the kubelet service has unexpected owners", func() {
+		r.Options = &option.FileOwnerOptions{
+			ExpectedFileOwner: option.ExpectedOwner{Users: []string{otherOwner}, Groups: []string{otherOwner}},
+		}
+
+		ruleResult, err := r.Run(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ruleResult.CheckResults).To(Equal([]rule.CheckResult{
+			rule.FailedCheckResult("File has unexpected owner user", rule.NewTarget("details", fmt.Sprintf("fileName: %s, ownerUser: %s, expectedOwnerUsers: [%s]", servicePath, user, otherOwner))),
+			rule.FailedCheckResult("File has unexpected owner group", rule.NewTarget("details", fmt.Sprintf("fileName: %s, ownerGroup: %s, expectedOwnerGroups: [%s]", servicePath, group, otherOwner))),
+		}))
+	})
+})
@@ -0,0 +1,47 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"context"
+	"fmt"
+
+	intutils "github.com/gardener/diki/pkg/internal/utils"
+	"github.com/gardener/diki/pkg/kubernetes/pod"
+	"github.com/gardener/diki/pkg/rule"
+	sharedrules "github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/rules"
+)
+
+var (
+	_ rule.Rule     = &Rule242407{}
+	_ rule.Severity = &Rule242407{}
+)
+
+type Rule242407 struct {
+	PodExecutor pod.PodExecutor
+	Root        string
+	Kubelet     Kubelet
+}
+
+func (r *Rule242407) ID() string {
+	return sharedrules.ID242407
+}
+
+func (r *Rule242407) Name() string {
+	return "The Kubernetes kubelet configuration files must have file permissions set to 644 or more restrictive."
+}
+
+func (r *Rule242407) Severity() rule.SeverityLevel {
+	return rule.SeverityMedium
+}
+
+func (r *Rule242407) Run(ctx context.Context) (rule.RuleResult, error) {
+	const expectedFilePermissionsMax = "644"
+
+	return rule.Result(r, r.Kubelet.check(ctx, r.PodExecutor, r.Root, func(serviceFileStats intutils.FileStats) []rule.CheckResult {
+		target := rule.NewTarget("details", fmt.Sprintf("filePath: %s", serviceFileStats.Path))
+		return []rule.CheckResult{intutils.MatchFilePermissionsCase(serviceFileStats, expectedFilePermissionsMax, target)}
+	})...), nil
+}
@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rules_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/diki/pkg/provider/linuxhost"
+	"github.com/gardener/diki/pkg/provider/linuxhost/ruleset/disak8sstig/rules"
+	"github.com/gardener/diki/pkg/rule"
+)
+
+var _ = Describe("#242407", func() {
+	const servicePath = "/usr/lib/systemd/system/kubelet.service"
+
+	var (
+		ctx  = context.TODO()
+		root string
+		r    *rules.Rule242407
+	)
+
+	BeforeEach(func() {
+		root = GinkgoT().TempDir()
+		r = &rules.Rule242407{
+			PodExecutor: linuxhost.NewLocalExecutor(),
+			Root:        root,
+		}
+	})
+
+	It("should pass when the kubelet service has expected permissions", func() {
+		writeHostFile(root, servicePath, 0644)
+
+		ruleResult, err := r.Run(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ruleResult.CheckResults).To(Equal([]rule.CheckResult{
+			rule.PassedCheckResult("File has expected permissions", rule.NewTarget("details", "fileName: /usr/lib/systemd/system/kubelet.service, permissions: 644")),
+		}))
+	})
+
+	It("should fail when the kubelet service has too wide permissions", func() {
+		writeHostFile(root, servicePath, 0664)
+
+		ruleResult, err := r.Run(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ruleResult.CheckResults).To(Equal([]rule.CheckResult{
+			rule.FailedCheckResult("File has too wide permissions", rule.NewTarget("details", "fileName: /usr/lib/systemd/system/kubelet.service, permissions: 664, expectedPermissionsMax: 644")),
+		}))
+	})
+
+	It("should prefer the kubelet service in /etc/systemd/system", func() {
+		writeHostFile(root, servicePath, 0664)
+		writeHostFile(root, "/etc/systemd/system/kubelet.service", 0600)
+
+		ruleResult, err := r.Run(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ruleResult.CheckResults).To(Equal([]rule.CheckResult{
+			rule.PassedCheckResult("File has expected permissions", rule.NewTarget("details", "fileName: /etc/systemd/system/kubelet.service, permissions: 600")),
+		}))
+	})
+
+	It("should resolve absolute symbolic links relative to the root", func() {
+		writeHostFile(root, "/opt/kubelet/kubelet.service", 0600)
+		Expect(os.MkdirAll(filepath.Join(root, "/etc/systemd/system"), 0755)).To(Succeed())
+		Expect(os.Symlink("/opt/kubelet/kubelet.service", filepath.Join(root, "/etc/systemd/system/kubelet.service"))).To(Succeed())
+
+		ruleResult, err := r.Run(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ruleResult.CheckResults).To(Equal([]rule.CheckResult{
+			rule.PassedCheckResult("File has expected permissions", rule.NewTarget("details", "fileName: /etc/systemd/system/kubelet.service, permissions: 600")),
+		}))
+	})
+
+	It("should not follow symbolic links out of the root", func() {
+		Expect(os.MkdirAll(filepath.Join(root, "/etc/systemd/system"), 0755)).To(Succeed())
+		Expect(os.Symlink("/../../../etc/passwd", filepath.Join(root, "/etc/systemd/system/kubelet.service"))).To(Succeed())
+		r.Kubelet.ServicePath = "/etc/systemd/system/kubelet.service"
+
+		ruleResult, err := r.Run(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ruleResult.CheckResults).To(HaveLen(1))
+		Expect(ruleResult.CheckResults[0].Status).To(Equal(rule.Errored))
+		Expect(ruleResult.CheckResults[0].Message).To(HaveSuffix("No such file or directory\n"))
+	})
+
+	It("should skip the rule when the kubelet service is not installed", func() {
+		ruleResult, err := r.Run(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ruleResult.CheckResults).To(Equal([]rule.CheckResult{
+			rule.SkippedCheckResult("Kubelet service is not installed.", rule.NewTarget()),
+		}))
+	})
+
+	It("should return errored result when the configured kubelet service is not found", func() {
+		r.Kubelet.ServicePath = "/foo/kubelet.service"
+
+		ruleResult, err := r.Run(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ruleResult.CheckResults).To(HaveLen(1))
+		Expect(ruleResult.CheckResults[0].Status).To(Equal(rule.Errored))
+		Expect(ruleResult.CheckResults[0].Message).To(ContainSubstring("No such file or directory"))
+	})
+})
@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rules_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/diki/pkg/provider/linuxhost"
+	"github.com/gardener/diki/pkg/provider/linuxhost/ruleset/disak8sstig/rules"
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/option"
+)
+
+var _ = Describe("#242451", func() {
+	var (
+		ctx   = context.TODO()
+		root  string
+		user  = strconv.Itoa(os.Getuid())
+		group = strconv.Itoa(os.Getgid())
+		r     *rules.Rule242451
+	)
+
+	BeforeEach(func() {
+		root = GinkgoT().TempDir()
+		writeHostFile(root, "/usr/lib/systemd/system/kubelet.service", 0644)
+		r = &rules.Rule242451{
+			PodExecutor: linuxhost.NewLocalExecutor(),
+			Root:        root,
+			Options: &option.FileOwnerOptions{
+				ExpectedFileOwner: option.ExpectedOwner{Users: []string{user}, Groups: []string{group}},
+			},
+		}
+	})
+
+	It("should check the owners of the certificates and keys in the kubelet PKI directory", func() {
+		writeHostFile(root, "/var/lib/kubelet/pki/kubelet.crt", 0644)
+		writeHostFile(root, "/var/lib/kubelet/pki/README", 0644)
+
+		ruleResult, err := r.Run(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ruleResult.CheckResults).To(Equal([]rule.CheckResult{
+			rule.PassedCheckResult("File has expected owners", rule.NewTarget("details", fmt.Sprintf("fileName: /var/lib/kubelet/pki/kubelet.crt, ownerUser: %s, ownerGroup: %s", user, group))),
+		}))
+	})
+
+	It("should resolve absolute symbolic links relative to the root", func() {
+		writeHostFile(root, "/opt/kubelet/pki/kubelet.crt", 0644)
+		Expect(os.MkdirAll(filepath.Join(root, "/var/lib/kubelet"), 0755)).To(Succeed())
+		Expect(os.Symlink("/opt/kubelet/pki", filepath.Join(root, "/var/lib/kubelet/pki"))).To(Succeed())
+
+		ruleResult, err := r.Run(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ruleResult.CheckResults).To(Equal([]rule.CheckResult{
+			rule.PassedCheckResult("File has expected owners", rule.NewTarget("details", fmt.Sprintf("fileName: /var/lib/kubelet/pki/kubelet.crt, ownerUser: %s, ownerGroup: %s", user, group))),
+		}))
+	})
+
+	It("should return errored result when the PKI directory does not exist", func() {
+		ruleResult, err := r.Run(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ruleResult.CheckResults).To(HaveLen(1))
+		Expect(ruleResult.CheckResults[0].Status).To(Equal(rule.Errored))
+		Expect(ruleResult.CheckResults[0].Target).To(Equal(rule.NewTarget("directory", "/var/lib/kubelet/pki")))
+	})
+})
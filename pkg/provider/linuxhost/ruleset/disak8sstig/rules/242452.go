@@ -0,0 +1,47 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"context"
+
+	intutils "github.com/gardener/diki/pkg/internal/utils"
+	"github.com/gardener/diki/pkg/kubernetes/pod"
+	"github.com/gardener/diki/pkg/rule"
+	sharedrules "github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/rules"
+)
+
+var (
+	_ rule.Rule     = &Rule242452{}
+	_ rule.Severity = &Rule242452{}
+)
+
+type Rule242452 struct {
+	PodExecutor pod.PodExecutor
+	Root        string
+	Kubelet     Kubelet
+}
+
+func (r *Rule242452) ID() string {
+	return sharedrules.ID242452
+}
+
+func (r *Rule242452) Name() string {
+	return "The Kubernetes kubelet KubeConfig must have file permissions set to 644 or more restrictive."
+}
+
+func (r *Rule242452) Severity() rule.SeverityLevel {
+	return rule.SeverityMedium
+}
+
+func (r *Rule242452) Run(ctx context.Context) (rule.RuleResult, error) {
+	const expectedFilePermissionsMax = "644"
+
+	return rule.Result(r, r.Kubelet.check(ctx, r.PodExecutor, r.Root, func(_ intutils.FileStats) []rule.CheckResult {
+		return checkFiles(ctx, r.PodExecutor, r.Root, []string{r.Kubelet.kubeconfigPath(), r.Kubelet.configPath()}, func(fileStat intutils.FileStats, target rule.Target) []rule.CheckResult {
+			return []rule.CheckResult{intutils.MatchFilePermissionsCase(fileStat, expectedFilePermissionsMax, target)}
+		})
+	})...), nil
+}
@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rules_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/diki/pkg/provider/linuxhost"
+	"github.com/gardener/diki/pkg/provider/linuxhost/ruleset/disak8sstig/rules"
+	"github.com/gardener/diki/pkg/rule"
+)
+
+var _ = Describe("#242452", func() {
+	var (
+		ctx  = context.TODO()
+		root string
+		r    *rules.Rule242452
+	)
+
+	BeforeEach(func() {
+		root = GinkgoT().TempDir()
+		writeHostFile(root, "/usr/lib/systemd/system/kubelet.service", 0644)
+		r = &rules.Rule242452{
+			PodExecutor: linuxhost.NewLocalExecutor(),
+			Root:        root,
+		}
+	})
+
+	It("should check the kubelet kubeconfig and config at the kubeadm paths", func() {
+		writeHostFile(root, "/etc/kubernetes/kubelet.conf", 0600)
+		writeHostFile(root, "/var/lib/kubelet/config.yaml", 0666)
+
+		ruleResult, err := r.Run(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ruleResult.CheckResults).To(Equal([]rule.CheckResult{
+			rule.PassedCheckResult("File has expected permissions", rule.NewTarget("details", "fileName: /etc/kubernetes/kubelet.conf, permissions: 600")),
+			rule.FailedCheckResult("File has too wide permissions", rule.NewTarget("details", "fileName: /var/lib/kubelet/config.yaml, permissions: 666, expectedPermissionsMax: 644")),
+		}))
+	})
+
+	It("should check the configured kubelet kubeconfig and config", func() {
+		writeHostFile(root, "/foo/kubelet.conf", 0644)
+		r.Kubelet = rules.Kubelet{
+			KubeconfigPath: "/foo/kubelet.conf",
+			ConfigPath:     "/foo/config.yaml",
+		}
+
+		ruleResult, err := r.Run(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ruleResult.CheckResults).To(HaveLen(2))
+		Expect(ruleResult.CheckResults[0]).To(Equal(rule.PassedCheckResult("File has expected permissions", rule.NewTarget("details", "fileName: /foo/kubelet.conf, permissions: 644"))))
+		Expect(ruleResult.CheckResults[1].Status).To(Equal(rule.Errored))
+		Expect(ruleResult.CheckResults[1].Target).To(Equal(rule.NewTarget("details", "filePath: /foo/config.yaml")))
+	})
+})
@@ -0,0 +1,49 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"context"
+
+	intutils "github.com/gardener/diki/pkg/internal/utils"
+	"github.com/gardener/diki/pkg/kubernetes/pod"
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/option"
+	sharedrules "github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/rules"
+)
+
+var (
+	_ rule.Rule     = &Rule242453{}
+	_ rule.Severity = &Rule242453{}
+)
+
+type Rule242453 struct {
+	PodExecutor pod.PodExecutor
+	Root        string
+	Kubelet     Kubelet
+	Options     *option.FileOwnerOptions
+}
+
+func (r *Rule242453) ID() string {
+	return sharedrules.ID242453
+}
+
+func (r *Rule242453) Name() string {
+	return "The Kubernetes kubelet KubeConfig file must be owned by root."
+}
+
+func (r *Rule242453) Severity() rule.SeverityLevel {
+	return rule.SeverityMedium
+}
+
+func (r *Rule242453) Run(ctx context.Context) (rule.RuleResult, error) {
+	expectedFileOwnerUsers, expectedFileOwnerGroups := r.Options.Owners()
+
+	return rule.Result(r, r.Kubelet.check(ctx, r.PodExecutor, r.Root, func(_ intutils.FileStats) []rule.CheckResult {
+		return checkFiles(ctx, r.PodExecutor, r.Root, []string{r.Kubelet.kubeconfigPath(), r.Kubelet.configPath()}, func(fileStat intutils.FileStats, target rule.Target) []rule.CheckResult {
+			return intutils.MatchFileOwnersCases(fileStat, expectedFileOwnerUsers, expectedFileOwnerGroups, target)
+		})
+	})...), nil
+}
@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rules_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/diki/pkg/provider/linuxhost"
+	"github.com/gardener/diki/pkg/provider/linuxhost/ruleset/disak8sstig/rules"
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/option"
+)
+
+var _ = Describe("#242453", func() {
+	var (
+		ctx   = context.TODO()
+		root  string
+		user  = strconv.Itoa(os.Getuid())
+		group = strconv.Itoa(os.Getgid())
+		r     *rules.Rule242453
+	)
+
+	BeforeEach(func() {
+		root = GinkgoT().TempDir()
+		writeHostFile(root, "/usr/lib/systemd/system/kubelet.service", 0644)
+		writeHostFile(root, "/etc/kubernetes/kubelet.conf", 0600)
+		writeHostFile(root, "/var/lib/kubelet/config.yaml", 0600)
+		r = &rules.Rule242453{
+			PodExecutor: linuxhost.NewLocalExecutor(),
+			Root:        root,
+			Options: &option.FileOwnerOptions{
+				ExpectedFileOwner: option.ExpectedOwner{Users: []string{user}, Groups: []string{group}},
+			},
+		}
+	})
+
+	It("should check the owners of the kubelet kubeconfig and config", func() {
+		ruleResult, err := r.Run(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ruleResult.CheckResults).To(Equal([]rule.CheckResult{
+			rule.PassedCheckResult("File has expected owners", rule.NewTarget("details", fmt.Sprintf("fileName: /etc/kubernetes/kubelet.conf, ownerUser: %s, ownerGroup: %s", user, group))),
+			rule.PassedCheckResult("File has expected owners", rule.NewTarget("details", fmt.Sprintf("fileName: /var/lib/kubelet/config.yaml, ownerUser: %s, ownerGroup: %s", user, group))),
+		}))
+	})
+
+	It("should skip the rule when the kubelet service is not installed", func() {
+		Expect(os.Remove(root + "/usr/lib/systemd/system/kubelet.service")).To(Succeed())
+
+		ruleResult, err := r.Run(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ruleResult.CheckResults).To(Equal([]rule.CheckResult{
+			rule.SkippedCheckResult("Kubelet service is not installed.", rule.NewTarget()),
+		}))
+	})
+})
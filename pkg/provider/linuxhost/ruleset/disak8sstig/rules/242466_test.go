@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rules_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/diki/pkg/provider/linuxhost"
+	"github.com/gardener/diki/pkg/provider/linuxhost/ruleset/disak8sstig/rules"
+	"github.com/gardener/diki/pkg/rule"
+)
+
+var _ = Describe("#242466", func() {
+	var (
+		ctx  = context.TODO()
+		root string
+		r    *rules.Rule242466
+	)
+
+	BeforeEach(func() {
+		root = GinkgoT().TempDir()
+		writeHostFile(root, "/usr/lib/systemd/system/kubelet.service", 0644)
+		r = &rules.Rule242466{
+			PodExecutor: linuxhost.NewLocalExecutor(),
+			Root:        root,
+		}
+	})
+
+	It("should check the permissions of the certificates in the kubelet PKI directory", func() {
+		writeHostFile(root, "/var/lib/kubelet/pki/kubelet.crt", 0644)
+		writeHostFile(root, "/var/lib/kubelet/pki/kubelet.key", 0600)
+
+		ruleResult, err := r.Run(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ruleResult.CheckResults).To(Equal([]rule.CheckResult{
+			rule.PassedCheckResult("File has expected permissions", rule.NewTarget("details", "fileName: /var/lib/kubelet/pki/kubelet.crt, permissions: 644")),
+		}))
+	})
+
+	It("should return errored result when no certificates are found", func() {
+		writeHostFile(root, "/var/lib/kubelet/pki/kubelet.key", 0600)
+
+		ruleResult, err := r.Run(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ruleResult.CheckResults).To(Equal([]rule.CheckResult{
+			rule.ErroredCheckResult("no '.crt' files found in PKI directory", rule.NewTarget("directory", "/var/lib/kubelet/pki")),
+		}))
+	})
+})
@@ -0,0 +1,47 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"context"
+
+	intutils "github.com/gardener/diki/pkg/internal/utils"
+	"github.com/gardener/diki/pkg/kubernetes/pod"
+	"github.com/gardener/diki/pkg/rule"
+	sharedrules "github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/rules"
+)
+
+var (
+	_ rule.Rule     = &Rule242467{}
+	_ rule.Severity = &Rule242467{}
+)
+
+type Rule242467 struct {
+	PodExecutor pod.PodExecutor
+	Root        string
+	Kubelet     Kubelet
+}
+
+func (r *Rule242467) ID() string {
+	return sharedrules.ID242467
+}
+
+func (r *Rule242467) Name() string {
+	return "The Kubernetes PKI keys must have file permissions set to 600 or more restrictive."
+}
+
+func (r *Rule242467) Severity() rule.SeverityLevel {
+	return rule.SeverityMedium
+}
+
+func (r *Rule242467) Run(ctx context.Context) (rule.RuleResult, error) {
+	const expectedFilePermissionsMax = "640"
+
+	return rule.Result(r, r.Kubelet.check(ctx, r.PodExecutor, r.Root, func(_ intutils.FileStats) []rule.CheckResult {
+		return checkDirFiles(ctx, r.PodExecutor, r.Root, r.Kubelet.pkiPath(), []string{".key", ".pem"}, "no '.key' files found in PKI directory", func(fileStat intutils.FileStats, target rule.Target) []rule.CheckResult {
+			return []rule.CheckResult{intutils.MatchFilePermissionsCase(fileStat, expectedFilePermissionsMax, target)}
+		})
+	})...), nil
+}
@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rules_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/diki/pkg/provider/linuxhost"
+	"github.com/gardener/diki/pkg/provider/linuxhost/ruleset/disak8sstig/rules"
+	"github.com/gardener/diki/pkg/rule"
+)
+
+var _ = Describe("#242467", func() {
+	var (
+		ctx  = context.TODO()
+		root string
+		r    *rules.Rule242467
+	)
+
+	BeforeEach(func() {
+		root = GinkgoT().TempDir()
+		writeHostFile(root, "/usr/lib/systemd/system/kubelet.service", 0644)
+		writeHostFile(root, "/etc/kubernetes/pki/ca.crt", 0644)
+		writeHostFile(root, "/etc/kubernetes/pki/ca.key", 0644)
+		r = &rules.Rule242467{
+			PodExecutor: linuxhost.NewLocalExecutor(),
+			Root:        root,
+			Kubelet:     rules.Kubelet{PKIPath: "/etc/kubernetes/pki"},
+		}
+	})
+
+	It("should check the permissions of the keys in the configured PKI directory", func() {
+		ruleResult, err := r.Run(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ruleResult.CheckResults).To(Equal([]rule.CheckResult{
+			rule.FailedCheckResult("File has too wide permissions", rule.NewTarget("details", "fileName: /etc/kubernetes/pki/ca.key, permissions: 644, expectedPermissionsMax: 640")),
+		}))
+	})
+})
@@ -0,0 +1,8 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package rules implements the rules that check a single Linux host.
+// Commands are executed on the host with a local pod executor and files are read
+// from the root filesystem of the host, which can be mounted at a different root, e.g. /host.
+package rules
@@ -0,0 +1,187 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	intutils "github.com/gardener/diki/pkg/internal/utils"
+	"github.com/gardener/diki/pkg/kubernetes/pod"
+	"github.com/gardener/diki/pkg/rule"
+)
+
+// maxSymlinks is the maximum number of symbolic links which are followed when a path of the host is resolved.
+const maxSymlinks = 255
+
+// resolveHostPath returns the local path of a file of the host whose root filesystem is found at root.
+// Symbolic links are resolved relative to root, so that absolute link targets point to files
+// of the checked host and not to files of the local host. Components which cannot be read
+// are kept unresolved, so that the error is reported when the file is accessed.
+func resolveHostPath(root, filePath string) (string, error) {
+	if root == "/" {
+		return filePath, nil
+	}
+
+	var (
+		resolved  = "/"
+		remaining = strings.Split(filePath, "/")
+		links     int
+	)
+	for len(remaining) > 0 {
+		component := remaining[0]
+		remaining = remaining[1:]
+
+		switch component {
+		case "", ".":
+			continue
+		case "..":
+			resolved = filepath.Dir(resolved)
+			continue
+		}
+
+		next := filepath.Join(resolved, component)
+		info, err := os.Lstat(filepath.Join(root, next))
+		if err != nil || info.Mode()&os.ModeSymlink == 0 {
+			resolved = next
+			continue
+		}
+
+		if links++; links > maxSymlinks {
+			return "", fmt.Errorf("too many levels of symbolic links: %s", filePath)
+		}
+		linkTarget, err := os.Readlink(filepath.Join(root, next))
+		if err != nil {
+			return "", err
+		}
+		if filepath.IsAbs(linkTarget) {
+			resolved = "/"
+		}
+		remaining = append(strings.Split(linkTarget, "/"), remaining...)
+	}
+	return filepath.Join(root, resolved), nil
+}
+
+// getFilesStats returns the stats of files of the host whose root filesystem is found at root.
+// The returned stats and errors are in the order of the file paths.
+func getFilesStats(ctx context.Context, podExecutor pod.PodExecutor, root string, filePaths []string) ([]intutils.FileStats, []error, error) {
+	var (
+		fileStats  = make([]intutils.FileStats, len(filePaths))
+		errs       = make([]error, len(filePaths))
+		indices    []int
+		localPaths []string
+	)
+	for i, filePath := range filePaths {
+		localPath, err := resolveHostPath(root, filePath)
+		if err != nil {
+			errs[i] = err
+			continue
+		}
+		indices = append(indices, i)
+		localPaths = append(localPaths, localPath)
+	}
+
+	localFileStats, localErrs, err := intutils.GetFilesStats(ctx, podExecutor, localPaths)
+	if err != nil {
+		return nil, nil, err
+	}
+	for j, i := range indices {
+		fileStats[i], errs[i] = localFileStats[j], localErrs[j]
+		if errs[i] == nil {
+			fileStats[i].Path = filePaths[i]
+		}
+	}
+	return fileStats, errs, nil
+}
+
+// getFileStatsByDir returns the stats of the files in a directory of the host whose root filesystem is found at root.
+// The stats are sorted by the paths of the files.
+func getFileStatsByDir(ctx context.Context, podExecutor pod.PodExecutor, root, dirPath string) ([]intutils.FileStats, error) {
+	localDirPath, err := resolveHostPath(root, dirPath)
+	if err != nil {
+		return nil, err
+	}
+
+	fileStats, err := intutils.GetFileStatsByDir(ctx, podExecutor, localDirPath)
+	if err != nil {
+		return nil, err
+	}
+	for i := range fileStats {
+		if relPath, err := filepath.Rel(localDirPath, fileStats[i].Path); err == nil {
+			fileStats[i].Path = filepath.Join(dirPath, relPath)
+		}
+	}
+	slices.SortFunc(fileStats, func(a, b intutils.FileStats) int {
+		return cmp.Compare(a.Path, b.Path)
+	})
+	return fileStats, nil
+}
+
+// checkFiles calls checkFn with the stats of every file of the host.
+func checkFiles(
+	ctx context.Context,
+	podExecutor pod.PodExecutor,
+	root string,
+	filePaths []string,
+	checkFn func(fileStat intutils.FileStats, target rule.Target) []rule.CheckResult,
+) []rule.CheckResult {
+	fileStats, errs, err := getFilesStats(ctx, podExecutor, root, filePaths)
+	if err != nil {
+		return []rule.CheckResult{rule.ErroredCheckResult(err.Error(), rule.NewTarget())}
+	}
+
+	var checkResults []rule.CheckResult
+	for i, fileStat := range fileStats {
+		target := rule.NewTarget("details", fmt.Sprintf("filePath: %s", filePaths[i]))
+		if errs[i] != nil {
+			checkResults = append(checkResults, rule.ErroredCheckResult(errs[i].Error(), target))
+			continue
+		}
+		checkResults = append(checkResults, checkFn(fileStat, target)...)
+	}
+	return checkResults
+}
+
+// checkDirFiles calls checkFn with the stats of the files in a directory of the host which have one of the given suffixes.
+func checkDirFiles(
+	ctx context.Context,
+	podExecutor pod.PodExecutor,
+	root, dirPath string,
+	suffixes []string,
+	notFoundMessage string,
+	checkFn func(fileStat intutils.FileStats, target rule.Target) []rule.CheckResult,
+) []rule.CheckResult {
+	dirTarget := rule.NewTarget("directory", dirPath)
+	fileStats, err := getFileStatsByDir(ctx, podExecutor, root, dirPath)
+	if err != nil {
+		return []rule.CheckResult{rule.ErroredCheckResult(err.Error(), dirTarget)}
+	}
+
+	var checkResults []rule.CheckResult
+	for _, fileStat := range fileStats {
+		if !hasAnySuffix(fileStat.Path, suffixes) {
+			continue
+		}
+		checkResults = append(checkResults, checkFn(fileStat, rule.NewTarget())...)
+	}
+	if len(checkResults) == 0 {
+		return []rule.CheckResult{rule.ErroredCheckResult(notFoundMessage, dirTarget)}
+	}
+	return checkResults
+}
+
+func hasAnySuffix(s string, suffixes []string) bool {
+	for _, suffix := range suffixes {
+		if strings.HasSuffix(s, suffix) {
+			return true
+		}
+	}
+	return false
+}
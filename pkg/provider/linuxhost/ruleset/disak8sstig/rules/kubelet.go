@@ -0,0 +1,107 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"context"
+	"strings"
+
+	intutils "github.com/gardener/diki/pkg/internal/utils"
+	"github.com/gardener/diki/pkg/kubernetes/pod"
+	"github.com/gardener/diki/pkg/rule"
+)
+
+const kubeletNotInstalledMessage = "Kubelet service is not installed."
+
+var (
+	// kubeletServicePaths are the paths at which the kubelet service is searched if its path is not configured.
+	kubeletServicePaths = []string{
+		"/etc/systemd/system/kubelet.service",
+		"/usr/lib/systemd/system/kubelet.service",
+		"/lib/systemd/system/kubelet.service",
+	}
+	defaultKubeletConfigPath     = "/var/lib/kubelet/config.yaml"
+	defaultKubeletKubeconfigPath = "/etc/kubernetes/kubelet.conf"
+	defaultKubeletPKIPath        = "/var/lib/kubelet/pki"
+)
+
+// Kubelet contains the paths of the kubelet files of a host.
+// Paths which are not set default to the paths used by kubeadm.
+type Kubelet struct {
+	// ServicePath is the path of the kubelet service unit.
+	// If not set, the unit is searched in the systemd unit directories.
+	ServicePath    string
+	ConfigPath     string
+	KubeconfigPath string
+	PKIPath        string
+}
+
+func (k Kubelet) configPath() string {
+	if len(k.ConfigPath) > 0 {
+		return k.ConfigPath
+	}
+	return defaultKubeletConfigPath
+}
+
+func (k Kubelet) kubeconfigPath() string {
+	if len(k.KubeconfigPath) > 0 {
+		return k.KubeconfigPath
+	}
+	return defaultKubeletKubeconfigPath
+}
+
+func (k Kubelet) pkiPath() string {
+	if len(k.PKIPath) > 0 {
+		return k.PKIPath
+	}
+	return defaultKubeletPKIPath
+}
+
+// serviceFileStats returns the stats of the kubelet service unit of the host.
+// It returns nil if the path of the unit is not configured and the unit is not found in the systemd unit directories.
+func (k Kubelet) serviceFileStats(ctx context.Context, podExecutor pod.PodExecutor, root string) (*intutils.FileStats, error) {
+	if len(k.ServicePath) > 0 {
+		fileStats, errs, err := getFilesStats(ctx, podExecutor, root, []string{k.ServicePath})
+		if err != nil {
+			return nil, err
+		}
+		if errs[0] != nil {
+			return nil, errs[0]
+		}
+		return &fileStats[0], nil
+	}
+
+	fileStats, errs, err := getFilesStats(ctx, podExecutor, root, kubeletServicePaths)
+	if err != nil {
+		return nil, err
+	}
+	for i, fileStat := range fileStats {
+		switch {
+		case errs[i] == nil:
+			return &fileStat, nil
+		case !strings.HasSuffix(strings.TrimSpace(strings.ToLower(errs[i].Error())), "no such file or directory"):
+			return nil, errs[i]
+		}
+	}
+	return nil, nil
+}
+
+// check calls checkFn with the stats of the kubelet service unit of the host.
+// The check is skipped if the kubelet service is not installed.
+func (k Kubelet) check(
+	ctx context.Context,
+	podExecutor pod.PodExecutor,
+	root string,
+	checkFn func(serviceFileStats intutils.FileStats) []rule.CheckResult,
+) []rule.CheckResult {
+	serviceFileStats, err := k.serviceFileStats(ctx, podExecutor, root)
+	if err != nil {
+		return []rule.CheckResult{rule.ErroredCheckResult(err.Error(), rule.NewTarget())}
+	}
+	if serviceFileStats == nil {
+		return []rule.CheckResult{rule.SkippedCheckResult(kubeletNotInstalledMessage, rule.NewTarget())}
+	}
+	return checkFn(*serviceFileStats)
+}
@@ -0,0 +1,13 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/option"
+)
+
+type RuleOption interface {
+	option.FileOwnerOptions
+}
@@ -0,0 +1,27 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rules_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestRules(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "DISA Kubernetes STIG rules Test Suite")
+}
+
+// writeHostFile creates a file with the given permissions in the root filesystem at root.
+func writeHostFile(root, filePath string, perm os.FileMode) {
+	localPath := filepath.Join(root, filePath)
+	ExpectWithOffset(1, os.MkdirAll(filepath.Dir(localPath), 0755)).To(Succeed())
+	ExpectWithOffset(1, os.WriteFile(localPath, []byte("foo"), perm)).To(Succeed())
+	ExpectWithOffset(1, os.Chmod(localPath, perm)).To(Succeed())
+}
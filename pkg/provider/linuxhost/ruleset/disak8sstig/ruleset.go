@@ -0,0 +1,200 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package disak8sstig
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"path/filepath"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/diki/pkg/config"
+	internalconfig "github.com/gardener/diki/pkg/internal/config"
+	"github.com/gardener/diki/pkg/kubernetes/pod"
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/ruleset"
+	sharedruleset "github.com/gardener/diki/pkg/shared/ruleset"
+)
+
+const (
+	// RulesetID is a constant containing the id of the DISA Kubernetes STIG Ruleset.
+	RulesetID = "disa-kubernetes-stig"
+	// RulesetName is a constant containing the user-friendly name of the DISA Kubernetes STIG ruleset.
+	RulesetName = "DISA Kubernetes Security Technical Implementation Guide"
+)
+
+var (
+	_ ruleset.Ruleset = &Ruleset{}
+	// SupportedVersions is a list of available versions for the DISA Kubernetes STIG Ruleset.
+	// Versions are sorted from newest to oldest.
+	SupportedVersions = []string{"v2r3"}
+)
+
+// Ruleset implements the rules of the DISA Kubernetes STIG which check a single host.
+type Ruleset struct {
+	version string
+	rules   map[string]rule.Rule
+	// Root is the directory in which the root filesystem of the checked host is found.
+	Root        string
+	PodExecutor pod.PodExecutor
+	numWorkers  int
+	args        Args
+	logger      *slog.Logger
+}
+
+// Args are Ruleset specific arguments.
+// The paths are paths on the checked host and default to the paths used by kubeadm.
+type Args struct {
+	// KubeletServicePath is the path of the kubelet service unit.
+	// If not set, the unit is searched in the systemd unit directories.
+	KubeletServicePath    string `json:"kubeletServicePath" yaml:"kubeletServicePath"`
+	KubeletConfigPath     string `json:"kubeletConfigPath" yaml:"kubeletConfigPath"`
+	KubeletKubeconfigPath string `json:"kubeletKubeconfigPath" yaml:"kubeletKubeconfigPath"`
+	KubeletPKIPath        string `json:"kubeletPKIPath" yaml:"kubeletPKIPath"`
+}
+
+// New creates a new Ruleset.
+func New(options ...CreateOption) (*Ruleset, error) {
+	r := &Ruleset{
+		rules:      map[string]rule.Rule{},
+		Root:       "/",
+		numWorkers: 5,
+	}
+
+	for _, o := range options {
+		o(r)
+	}
+
+	if r.PodExecutor == nil {
+		return nil, errors.New("pod executor is nil")
+	}
+
+	return r, nil
+}
+
+// ID returns the id of the Ruleset.
+func (r *Ruleset) ID() string {
+	return RulesetID
+}
+
+// Name returns the name of the Ruleset.
+func (r *Ruleset) Name() string {
+	return RulesetName
+}
+
+// Version returns the version of the Ruleset.
+func (r *Ruleset) Version() string {
+	return r.version
+}
+
+// FromGenericConfig creates a Ruleset from a RulesetConfig
+func FromGenericConfig(rulesetConfig config.RulesetConfig, root string, podExecutor pod.PodExecutor, fldPath *field.Path) (*Ruleset, error) {
+	rulesetArgsByte, err := json.Marshal(rulesetConfig.Args)
+	if err != nil {
+		return nil, err
+	}
+
+	var rulesetArgs Args
+	if err := json.Unmarshal(rulesetArgsByte, &rulesetArgs); err != nil {
+		return nil, err
+	}
+
+	if err := validateArgs(rulesetArgs, fldPath.Child("args")).ToAggregate(); err != nil {
+		return nil, err
+	}
+
+	ruleset, err := New(
+		WithVersion(rulesetConfig.Version),
+		WithRoot(root),
+		WithPodExecutor(podExecutor),
+		WithArgs(rulesetArgs),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		ruleOptions        = make(map[string]config.RuleOptionsConfig)
+		indexedRuleOptions = make(map[string]internalconfig.IndexedRuleOptionsConfig)
+	)
+
+	for index, opt := range rulesetConfig.RuleOptions {
+		if _, ok := ruleOptions[opt.RuleID]; ok {
+			return nil, fmt.Errorf("rule option for rule id: %s is already registered", opt.RuleID)
+		}
+
+		ruleOptions[opt.RuleID] = opt
+		indexedRuleOptions[opt.RuleID] = internalconfig.IndexedRuleOptionsConfig{Index: index, RuleOptionsConfig: opt}
+	}
+
+	switch rulesetConfig.Version {
+	case "v2r3":
+		if err := ruleset.validateV2R3RuleOptions(indexedRuleOptions, fldPath.Child("ruleOptions")); err != nil {
+			return nil, err
+		}
+		if err := ruleset.registerV2R3Rules(ruleOptions); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unknown ruleset %s version: %s", rulesetConfig.ID, rulesetConfig.Version)
+	}
+
+	return ruleset, nil
+}
+
+func validateArgs(args Args, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for _, p := range []struct{ name, path string }{
+		{"kubeletServicePath", args.KubeletServicePath},
+		{"kubeletConfigPath", args.KubeletConfigPath},
+		{"kubeletKubeconfigPath", args.KubeletKubeconfigPath},
+		{"kubeletPKIPath", args.KubeletPKIPath},
+	} {
+		if len(p.path) > 0 && !filepath.IsAbs(p.path) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child(p.name), p.path, "must be an absolute path"))
+		}
+	}
+	return allErrs
+}
+
+// RunRule executes specific known Rule of the Ruleset.
+func (r *Ruleset) RunRule(ctx context.Context, id string) (rule.RuleResult, error) {
+	rr, ok := r.rules[id]
+	if !ok {
+		return rule.RuleResult{}, fmt.Errorf("rule with id %s is not registered in the ruleset", id)
+	}
+
+	return rr.Run(ctx)
+}
+
+// Run executes all known Rules of the Ruleset.
+func (r *Ruleset) Run(ctx context.Context) (ruleset.RulesetResult, error) {
+	return sharedruleset.Run(ctx, r, r.rules, r.numWorkers, r.Logger())
+}
+
+// AddRules adds Rules to the Ruleset.
+func (r *Ruleset) AddRules(rules ...rule.Rule) error {
+	for _, rr := range rules {
+		if _, ok := r.rules[rr.ID()]; ok {
+			return fmt.Errorf("rule with id %s already exists", rr.ID())
+		}
+		r.rules[rr.ID()] = rr
+	}
+	return nil
+}
+
+// Logger returns the Ruleset's logger.
+// If not set it set it to slog.Default().With("ruleset", r.ID(), "version", r.Version() then return it.
+func (r *Ruleset) Logger() *slog.Logger {
+	if r.logger == nil {
+		r.logger = slog.Default().With("ruleset", r.ID(), "version", r.Version())
+	}
+	return r.logger
+}
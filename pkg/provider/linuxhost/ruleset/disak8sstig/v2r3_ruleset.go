@@ -0,0 +1,140 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package disak8sstig
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/diki/pkg/config"
+	internalconfig "github.com/gardener/diki/pkg/internal/config"
+	"github.com/gardener/diki/pkg/provider/linuxhost/ruleset/disak8sstig/rules"
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/option"
+	sharedrules "github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/rules"
+)
+
+func validateV2R3Options[O rules.RuleOption](options any, fldPath *field.Path) field.ErrorList {
+	parsedOptions, err := getV2R3OptionOrNil[O](options)
+	if err != nil {
+		return field.ErrorList{
+			field.InternalError(fldPath, err),
+		}
+	}
+
+	if parsedOptions == nil {
+		return nil
+	}
+
+	if val, ok := any(parsedOptions).(option.Option); ok {
+		return val.Validate(fldPath)
+	}
+
+	return nil
+}
+
+func (r *Ruleset) validateV2R3RuleOptions(ruleOptions map[string]internalconfig.IndexedRuleOptionsConfig, fldPath *field.Path) error {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, validateV2R3Options[option.FileOwnerOptions](ruleOptions[sharedrules.ID242406].Args, fldPath.Index(ruleOptions[sharedrules.ID242406].Index).Child("args"))...)
+	allErrs = append(allErrs, validateV2R3Options[option.FileOwnerOptions](ruleOptions[sharedrules.ID242451].Args, fldPath.Index(ruleOptions[sharedrules.ID242451].Index).Child("args"))...)
+	allErrs = append(allErrs, validateV2R3Options[option.FileOwnerOptions](ruleOptions[sharedrules.ID242453].Args, fldPath.Index(ruleOptions[sharedrules.ID242453].Index).Child("args"))...)
+
+	return allErrs.ToAggregate()
+}
+
+func (r *Ruleset) registerV2R3Rules(ruleOptions map[string]config.RuleOptionsConfig) error {
+	opts242406, err := getV2R3OptionOrNil[option.FileOwnerOptions](ruleOptions[sharedrules.ID242406].Args)
+	if err != nil {
+		return fmt.Errorf("rule option 242406 error: %s", err.Error())
+	}
+	opts242451, err := getV2R3OptionOrNil[option.FileOwnerOptions](ruleOptions[sharedrules.ID242451].Args)
+	if err != nil {
+		return fmt.Errorf("rule option 242451 error: %s", err.Error())
+	}
+	opts242453, err := getV2R3OptionOrNil[option.FileOwnerOptions](ruleOptions[sharedrules.ID242453].Args)
+	if err != nil {
+		return fmt.Errorf("rule option 242453 error: %s", err.Error())
+	}
+
+	kubelet := rules.Kubelet{
+		ServicePath:    r.args.KubeletServicePath,
+		ConfigPath:     r.args.KubeletConfigPath,
+		KubeconfigPath: r.args.KubeletKubeconfigPath,
+		PKIPath:        r.args.KubeletPKIPath,
+	}
+
+	rules := []rule.Rule{
+		&sharedrules.Rule242393{PodExecutor: r.PodExecutor},
+		&sharedrules.Rule242394{PodExecutor: r.PodExecutor, Root: r.Root},
+		&rules.Rule242406{
+			PodExecutor: r.PodExecutor,
+			Root:        r.Root,
+			Kubelet:     kubelet,
+			Options:     opts242406,
+		},
+		&rules.Rule242407{PodExecutor: r.PodExecutor, Root: r.Root, Kubelet: kubelet},
+		&rules.Rule242451{
+			PodExecutor: r.PodExecutor,
+			Root:        r.Root,
+			Kubelet:     kubelet,
+			Options:     opts242451,
+		},
+		&rules.Rule242452{PodExecutor: r.PodExecutor, Root: r.Root, Kubelet: kubelet},
+		&rules.Rule242453{
+			PodExecutor: r.PodExecutor,
+			Root:        r.Root,
+			Kubelet:     kubelet,
+			Options:     opts242453,
+		},
+		&rules.Rule242466{PodExecutor: r.PodExecutor, Root: r.Root, Kubelet: kubelet},
+		&rules.Rule242467{PodExecutor: r.PodExecutor, Root: r.Root, Kubelet: kubelet},
+	}
+
+	for i, r := range rules {
+		var severityLevel rule.SeverityLevel
+		if severity, ok := r.(rule.Severity); !ok {
+			return fmt.Errorf("rule %s does not implement rule.Severity", r.ID())
+		} else {
+			severityLevel = severity.Severity()
+		}
+
+		opt, found := ruleOptions[r.ID()]
+		if found && opt.Skip != nil && opt.Skip.Enabled {
+			rules[i] = rule.NewSkipRule(r.ID(), r.Name(), opt.Skip.Justification, rule.Accepted, rule.SkipRuleWithSeverity(severityLevel), rule.SkipRuleWithAcceptance(opt.Skip.Owner, opt.Skip.ExpiresAt))
+		}
+	}
+
+	// check that the registered rules equal
+	// the number of host rules in that ruleset version
+	if len(rules) != 9 {
+		return fmt.Errorf("revision expects 9 registered rules, but got: %d", len(rules))
+	}
+
+	return r.AddRules(rules...)
+}
+
+func parseV2R3Options[O rules.RuleOption](options any) (*O, error) {
+	optionsByte, err := json.Marshal(options)
+	if err != nil {
+		return nil, err
+	}
+
+	var parsedOptions O
+	if err := json.Unmarshal(optionsByte, &parsedOptions); err != nil {
+		return nil, err
+	}
+
+	return &parsedOptions, nil
+}
+
+func getV2R3OptionOrNil[O rules.RuleOption](options any) (*O, error) {
+	if options == nil {
+		return nil, nil
+	}
+	return parseV2R3Options[O](options)
+}
@@ -76,9 +76,9 @@ func (r *Rule242414) checkPods(pods []corev1.Pod, replicaSets []appsv1.ReplicaSe
 			for _, port := range container.Ports {
 				if port.HostPort != 0 && port.HostPort < 1024 {
 					target := target.With("container", container.Name, "details", fmt.Sprintf("port: %d", port.HostPort))
-					if accepted, justification := r.accepted(pod.Labels, namespaces[pod.Namespace].Labels, port.HostPort); accepted {
+					if accepted, justification, acceptance := r.accepted(pod.Labels, namespaces[pod.Namespace].Labels, port.HostPort); accepted {
 						msg := cmp.Or(justification, "Pod accepted to have containers using hostPort < 1024.")
-						podCheckResults = append(podCheckResults, rule.AcceptedCheckResult(msg, target))
+						podCheckResults = append(podCheckResults, rule.AcceptedCheckResultWithAcceptance(msg, target, acceptance))
 					} else {
 						podCheckResults = append(podCheckResults, rule.FailedCheckResult("Pod has container using hostPort < 1024.", target))
 					}
@@ -93,9 +93,9 @@ func (r *Rule242414) checkPods(pods []corev1.Pod, replicaSets []appsv1.ReplicaSe
 	return checkResults
 }
 
-func (r *Rule242414) accepted(podLabels, namespaceLabels map[string]string, hostPort int32) (bool, string) {
+func (r *Rule242414) accepted(podLabels, namespaceLabels map[string]string, hostPort int32) (bool, string, *rule.Acceptance) {
 	if r.Options == nil {
-		return false, ""
+		return false, "", nil
 	}
 
 	for _, acceptedPod := range r.Options.AcceptedPods {
@@ -103,11 +103,11 @@ func (r *Rule242414) accepted(podLabels, namespaceLabels map[string]string, host
 			utils.MatchLabels(namespaceLabels, acceptedPod.NamespaceMatchLabels) {
 			for _, acceptedHostPort := range acceptedPod.Ports {
 				if acceptedHostPort == hostPort {
-					return true, acceptedPod.Justification
+					return true, acceptedPod.Justification, acceptedPod.Acceptance()
 				}
 			}
 		}
 	}
 
-	return false, ""
+	return false, "", nil
 }
@@ -82,9 +82,9 @@ func (r *Rule242415) checkPods(pods []corev1.Pod, replicaSets []appsv1.ReplicaSe
 			for _, env := range container.Env {
 				if env.ValueFrom != nil && env.ValueFrom.SecretKeyRef != nil {
 					target = target.With("container", container.Name, "details", fmt.Sprintf("variableName: %s, keyRef: %s", env.Name, env.ValueFrom.SecretKeyRef.Key))
-					if accepted, justification := r.accepted(pod.Labels, namespaces[pod.Namespace].Labels, env.Name); accepted {
+					if accepted, justification, acceptance := r.accepted(pod.Labels, namespaces[pod.Namespace].Labels, env.Name); accepted {
 						msg := cmp.Or(justification, "Pod accepted to use environment to inject secret.")
-						podCheckResults = append(podCheckResults, rule.AcceptedCheckResult(msg, target))
+						podCheckResults = append(podCheckResults, rule.AcceptedCheckResultWithAcceptance(msg, target, acceptance))
 					} else {
 						podCheckResults = append(podCheckResults, rule.FailedCheckResult("Pod uses environment to inject secret.", target))
 					}
@@ -99,9 +99,9 @@ func (r *Rule242415) checkPods(pods []corev1.Pod, replicaSets []appsv1.ReplicaSe
 	return checkResults
 }
 
-func (r *Rule242415) accepted(podLabels, namespaceLabels map[string]string, environmentVariable string) (bool, string) {
+func (r *Rule242415) accepted(podLabels, namespaceLabels map[string]string, environmentVariable string) (bool, string, *rule.Acceptance) {
 	if r.Options == nil {
-		return false, ""
+		return false, "", nil
 	}
 
 	for _, acceptedPod := range r.Options.AcceptedPods {
@@ -109,11 +109,11 @@ func (r *Rule242415) accepted(podLabels, namespaceLabels map[string]string, envi
 			utils.MatchLabels(namespaceLabels, acceptedPod.NamespaceMatchLabels) {
 			for _, acceptedEnvironmentVariable := range acceptedPod.EnvironmentVariables {
 				if acceptedEnvironmentVariable == environmentVariable {
-					return true, acceptedPod.Justification
+					return true, acceptedPod.Justification, acceptedPod.Acceptance()
 				}
 			}
 		}
 	}
 
-	return false, ""
+	return false, "", nil
 }
@@ -18,12 +18,14 @@ import (
 	"github.com/gardener/diki/pkg/config"
 	internalconfig "github.com/gardener/diki/pkg/internal/config"
 	"github.com/gardener/diki/pkg/kubernetes/pod"
+	kubeutils "github.com/gardener/diki/pkg/kubernetes/utils"
 	"github.com/gardener/diki/pkg/provider/managedk8s/ruleset/disak8sstig/rules"
 	"github.com/gardener/diki/pkg/rule"
 	"github.com/gardener/diki/pkg/rule/retry"
 	"github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/option"
 	"github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/retryerrors"
 	sharedrules "github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/rules"
+	"github.com/gardener/diki/pkg/snapshot"
 )
 
 func validateV2R2Options[O rules.RuleOption](options any, fldPath *field.Path) field.ErrorList {
@@ -78,13 +80,15 @@ func (r *Ruleset) registerV2R2Rules(ruleOptions map[string]config.RuleOptionsCon
 	if err != nil {
 		return err
 	}
+	client = kubeutils.NewCachedClient(client, r.Config.Host)
 
-	podContext, err := pod.NewSimplePodContext(client, r.Config, r.AdditionalOpsPodLabels)
+	simplePodContext, err := pod.NewSimplePodContext(client, r.Config, r.AdditionalOpsPodLabels)
 	if err != nil {
 		return err
 	}
+	podContext := pod.NewPooledPodContext(simplePodContext, r.Config.Host)
 
-	clientSet, err := kubernetes.NewForConfig(r.Config)
+	clientSet, err := kubernetes.NewForConfig(snapshot.WrapRESTConfig(r.Config))
 	if err != nil {
 		return err
 	}
@@ -290,12 +294,12 @@ func (r *Ruleset) registerV2R2Rules(ruleOptions map[string]config.RuleOptionsCon
 		&rules.Rule242390{
 			KAPIExternalURL: r.Config.Host,
 			Client: &http.Client{
-				Transport: &http.Transport{
+				Transport: snapshot.NewRoundTripper(r.Config.Host, &http.Transport{
 					// the TLS MinVersion warnings are ignored in order to avoid version conflicts
 					TLSClientConfig: &tls.Config{ // #nosec: G402
 						RootCAs: authorityCertPool,
 					},
-				},
+				}),
 			},
 		},
 		&sharedrules.Rule242391{
@@ -886,7 +890,7 @@ func (r *Ruleset) registerV2R2Rules(ruleOptions map[string]config.RuleOptionsCon
 
 		opt, found := ruleOptions[r.ID()]
 		if found && opt.Skip != nil && opt.Skip.Enabled {
-			rules[i] = rule.NewSkipRule(r.ID(), r.Name(), opt.Skip.Justification, rule.Accepted, rule.SkipRuleWithSeverity(severityLevel))
+			rules[i] = rule.NewSkipRule(r.ID(), r.Name(), opt.Skip.Justification, rule.Accepted, rule.SkipRuleWithSeverity(severityLevel), rule.SkipRuleWithAcceptance(opt.Skip.Owner, opt.Skip.ExpiresAt))
 		}
 	}
 
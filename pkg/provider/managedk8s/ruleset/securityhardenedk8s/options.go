@@ -8,6 +8,7 @@ import (
 	"log/slog"
 
 	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // CreateOption is a function that acts on a [Ruleset]
@@ -28,6 +29,21 @@ func WithConfig(config *rest.Config) CreateOption {
 	}
 }
 
+// WithClient sets the Client of a [Ruleset].
+func WithClient(c client.Client) CreateOption {
+	return func(r *Ruleset) {
+		r.Client = c
+	}
+}
+
+// WithSkippedRules skips the rules of a [Ruleset] which cannot be checked with its client.
+// The skipped rules are given by their ids mapped to the justifications of the skips.
+func WithSkippedRules(skippedRules map[string]string) CreateOption {
+	return func(r *Ruleset) {
+		r.skippedRules = skippedRules
+	}
+}
+
 // WithNumberOfWorkers sets the max number of Workers of a [Ruleset].
 func WithNumberOfWorkers(numWorkers int) CreateOption {
 	return func(r *Ruleset) {
@@ -76,6 +76,9 @@ func (r *Rule2000) Run(ctx context.Context) (rule.RuleResult, error) {
 	var checkResults []rule.CheckResult
 
 	for _, namespace := range namespaces {
+		withEvidence := func(checkResult rule.CheckResult) rule.CheckResult {
+			return checkResult.WithEvidence(ctx, "network-policies", groupedNetworkPolicies[namespace.Name])
+		}
 
 		var (
 			deniesAllIngress, deniesAllEgress bool
@@ -125,9 +128,9 @@ func (r *Rule2000) Run(ctx context.Context) (rule.RuleResult, error) {
 		}
 
 		if deniesAllIngress && !allowsAllIngress {
-			checkResults = append(checkResults, rule.PassedCheckResult("Ingress traffic is denied by default.", deniesAllIngressTarget))
+			checkResults = append(checkResults, withEvidence(rule.PassedCheckResult("Ingress traffic is denied by default.", deniesAllIngressTarget)))
 		} else {
-			accepted, justification := r.acceptedIngress(namespace)
+			accepted, justification, acceptance := r.acceptedIngress(namespace)
 
 			acceptedTarget := target
 			msg := "Namespace is accepted to allow Ingress traffic by default."
@@ -141,18 +144,18 @@ func (r *Rule2000) Run(ctx context.Context) (rule.RuleResult, error) {
 
 			switch {
 			case accepted:
-				checkResults = append(checkResults, rule.AcceptedCheckResult(msg, acceptedTarget))
+				checkResults = append(checkResults, withEvidence(rule.AcceptedCheckResultWithAcceptance(msg, acceptedTarget, acceptance)))
 			case allowsAllIngress:
-				checkResults = append(checkResults, rule.FailedCheckResult("All Ingress traffic is allowed by default.", allowsAllIngressTarget))
+				checkResults = append(checkResults, withEvidence(rule.FailedCheckResult("All Ingress traffic is allowed by default.", allowsAllIngressTarget)))
 			default:
-				checkResults = append(checkResults, rule.FailedCheckResult("Ingress traffic is not denied by default.", target))
+				checkResults = append(checkResults, withEvidence(rule.FailedCheckResult("Ingress traffic is not denied by default.", target)))
 			}
 		}
 
 		if deniesAllEgress && !allowsAllEgress {
-			checkResults = append(checkResults, rule.PassedCheckResult("Egress traffic is denied by default.", deniesAllEgressTarget))
+			checkResults = append(checkResults, withEvidence(rule.PassedCheckResult("Egress traffic is denied by default.", deniesAllEgressTarget)))
 		} else {
-			accepted, justification := r.acceptedEgress(namespace)
+			accepted, justification, acceptance := r.acceptedEgress(namespace)
 
 			acceptedTarget := target
 			msg := "Namespace is accepted to allow Egress traffic by default."
@@ -166,11 +169,11 @@ func (r *Rule2000) Run(ctx context.Context) (rule.RuleResult, error) {
 
 			switch {
 			case accepted:
-				checkResults = append(checkResults, rule.AcceptedCheckResult(msg, acceptedTarget))
+				checkResults = append(checkResults, withEvidence(rule.AcceptedCheckResultWithAcceptance(msg, acceptedTarget, acceptance)))
 			case allowsAllEgress:
-				checkResults = append(checkResults, rule.FailedCheckResult("All Egress traffic is allowed by default.", allowsAllEgressTarget))
+				checkResults = append(checkResults, withEvidence(rule.FailedCheckResult("All Egress traffic is allowed by default.", allowsAllEgressTarget)))
 			default:
-				checkResults = append(checkResults, rule.FailedCheckResult("Egress traffic is not denied by default.", target))
+				checkResults = append(checkResults, withEvidence(rule.FailedCheckResult("Egress traffic is not denied by default.", target)))
 			}
 		}
 	}
@@ -178,33 +181,33 @@ func (r *Rule2000) Run(ctx context.Context) (rule.RuleResult, error) {
 	return rule.Result(r, checkResults...), nil
 }
 
-func (r *Rule2000) acceptedIngress(namespace corev1.Namespace) (bool, string) {
+func (r *Rule2000) acceptedIngress(namespace corev1.Namespace) (bool, string, *rule.Acceptance) {
 	if r.Options == nil {
-		return false, ""
+		return false, "", nil
 	}
 
 	for _, acceptedNamespace := range r.Options.AcceptedNamespaces {
 
 		if utils.MatchLabels(namespace.Labels, acceptedNamespace.MatchLabels) &&
 			acceptedNamespace.AcceptedTraffic.Ingress {
-			return true, acceptedNamespace.Justification
+			return true, acceptedNamespace.Justification, acceptedNamespace.Acceptance()
 		}
 	}
 
-	return false, ""
+	return false, "", nil
 }
 
-func (r *Rule2000) acceptedEgress(namespace corev1.Namespace) (bool, string) {
+func (r *Rule2000) acceptedEgress(namespace corev1.Namespace) (bool, string, *rule.Acceptance) {
 	if r.Options == nil {
-		return false, ""
+		return false, "", nil
 	}
 
 	for _, acceptedNamespace := range r.Options.AcceptedNamespaces {
 		if utils.MatchLabels(namespace.Labels, acceptedNamespace.MatchLabels) &&
 			acceptedNamespace.AcceptedTraffic.Egress {
-			return true, acceptedNamespace.Justification
+			return true, acceptedNamespace.Justification, acceptedNamespace.Acceptance()
 		}
 	}
 
-	return false, ""
+	return false, "", nil
 }
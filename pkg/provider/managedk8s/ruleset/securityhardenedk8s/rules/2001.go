@@ -127,9 +127,9 @@ func (r *Rule2001) Run(ctx context.Context) (rule.RuleResult, error) {
 			containerTarget := podTarget.With("container", container.Name)
 
 			if container.SecurityContext == nil || allowsPrivilegeEscalation(*container.SecurityContext) {
-				if accepted, justification := r.accepted(pod.Labels, namespaces[pod.Namespace].Labels); accepted {
+				if accepted, justification, acceptance := r.accepted(pod.Labels, namespaces[pod.Namespace].Labels); accepted {
 					msg := cmp.Or(justification, "Pod accepted to escalate privileges.")
-					podCheckResults = append(podCheckResults, rule.AcceptedCheckResult(msg, containerTarget))
+					podCheckResults = append(podCheckResults, rule.AcceptedCheckResultWithAcceptance(msg, containerTarget, acceptance))
 				} else {
 					podCheckResults = append(podCheckResults, rule.FailedCheckResult("Pod must not escalate privileges.", containerTarget))
 				}
@@ -144,17 +144,17 @@ func (r *Rule2001) Run(ctx context.Context) (rule.RuleResult, error) {
 	return rule.Result(r, checkResults...), nil
 }
 
-func (r *Rule2001) accepted(podLabels, namespaceLabels map[string]string) (bool, string) {
+func (r *Rule2001) accepted(podLabels, namespaceLabels map[string]string) (bool, string, *rule.Acceptance) {
 	if r.Options == nil {
-		return false, ""
+		return false, "", nil
 	}
 
 	for _, acceptedPod := range r.Options.AcceptedPods {
 		if utils.MatchLabels(podLabels, acceptedPod.MatchLabels) &&
 			utils.MatchLabels(namespaceLabels, acceptedPod.NamespaceMatchLabels) {
-			return true, acceptedPod.Justification
+			return true, acceptedPod.Justification, acceptedPod.Acceptance()
 		}
 	}
 
-	return false, ""
+	return false, "", nil
 }
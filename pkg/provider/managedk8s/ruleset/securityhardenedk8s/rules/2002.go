@@ -81,9 +81,9 @@ func (r *Rule2002) Run(ctx context.Context) (rule.RuleResult, error) {
 			continue
 		}
 
-		if accepted, justification := r.accepted(storageClass.Labels); accepted {
+		if accepted, justification, acceptance := r.accepted(storageClass.Labels); accepted {
 			msg := cmp.Or(justification, "StorageClass accepted to not have Delete ReclaimPolicy.")
-			checkResults = append(checkResults, rule.AcceptedCheckResult(msg, target))
+			checkResults = append(checkResults, rule.AcceptedCheckResultWithAcceptance(msg, target, acceptance))
 		} else {
 			checkResults = append(checkResults, rule.FailedCheckResult("StorageClass does not have a Delete ReclaimPolicy set.", target))
 		}
@@ -92,16 +92,16 @@ func (r *Rule2002) Run(ctx context.Context) (rule.RuleResult, error) {
 	return rule.Result(r, checkResults...), err
 }
 
-func (r *Rule2002) accepted(storageClassLabels map[string]string) (bool, string) {
+func (r *Rule2002) accepted(storageClassLabels map[string]string) (bool, string, *rule.Acceptance) {
 	if r.Options == nil {
-		return false, ""
+		return false, "", nil
 	}
 
 	for _, acceptedStorageClass := range r.Options.AcceptedStorageClasses {
 		if utils.MatchLabels(storageClassLabels, acceptedStorageClass.MatchLabels) {
-			return true, acceptedStorageClass.Justification
+			return true, acceptedStorageClass.Justification, acceptedStorageClass.Acceptance()
 		}
 	}
 
-	return false, ""
+	return false, "", nil
 }
@@ -122,9 +122,9 @@ func (r *Rule2003) Run(ctx context.Context) (rule.RuleResult, error) {
 				volume.Projected == nil &&
 				volume.Secret == nil {
 				uses = true
-				accepted, justification := r.accepted(volume, pod, allNamespaces[pod.Namespace])
+				accepted, justification, acceptance := r.accepted(volume, pod, allNamespaces[pod.Namespace])
 				if accepted {
-					checkResults = append(checkResults, rule.AcceptedCheckResult(justification, volumeTarget))
+					checkResults = append(checkResults, rule.AcceptedCheckResultWithAcceptance(justification, volumeTarget, acceptance))
 				} else {
 					checkResults = append(checkResults, rule.FailedCheckResult("Pod uses not allowed volume type.", volumeTarget))
 				}
@@ -138,18 +138,18 @@ func (r *Rule2003) Run(ctx context.Context) (rule.RuleResult, error) {
 	return rule.Result(r, checkResults...), nil
 }
 
-func (r *Rule2003) accepted(volume corev1.Volume, pod corev1.Pod, namespace corev1.Namespace) (bool, string) {
+func (r *Rule2003) accepted(volume corev1.Volume, pod corev1.Pod, namespace corev1.Namespace) (bool, string, *rule.Acceptance) {
 	if r.Options == nil {
-		return false, ""
+		return false, "", nil
 	}
 
 	for _, acceptedPod := range r.Options.AcceptedPods {
 		if utils.MatchLabels(pod.Labels, acceptedPod.MatchLabels) && utils.MatchLabels(namespace.Labels, acceptedPod.NamespaceMatchLabels) {
 			if slices.Contains(acceptedPod.VolumeNames, "*") || slices.Contains(acceptedPod.VolumeNames, volume.Name) {
-				return true, acceptedPod.Justification
+				return true, acceptedPod.Justification, acceptedPod.Acceptance()
 			}
 		}
 	}
 
-	return false, ""
+	return false, "", nil
 }
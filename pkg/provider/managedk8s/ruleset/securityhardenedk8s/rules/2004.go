@@ -82,9 +82,9 @@ func (r *Rule2004) Run(ctx context.Context) (rule.RuleResult, error) {
 		serviceTarget := kubeutils.TargetWithK8sObject(rule.NewTarget(), v1.TypeMeta{Kind: "Service"}, service.ObjectMeta)
 
 		if service.Spec.Type == corev1.ServiceTypeNodePort {
-			if accepted, justification := r.accepted(service, namespaces[service.Namespace]); accepted {
+			if accepted, justification, acceptance := r.accepted(service, namespaces[service.Namespace]); accepted {
 				msg := cmp.Or(justification, "Service accepted to be of type NodePort.")
-				checkResults = append(checkResults, rule.AcceptedCheckResult(msg, serviceTarget))
+				checkResults = append(checkResults, rule.AcceptedCheckResultWithAcceptance(msg, serviceTarget, acceptance))
 			} else {
 				checkResults = append(checkResults, rule.FailedCheckResult("Service should not be of type NodePort.", serviceTarget))
 			}
@@ -96,17 +96,17 @@ func (r *Rule2004) Run(ctx context.Context) (rule.RuleResult, error) {
 	return rule.Result(r, checkResults...), nil
 }
 
-func (r *Rule2004) accepted(service corev1.Service, namespace corev1.Namespace) (bool, string) {
+func (r *Rule2004) accepted(service corev1.Service, namespace corev1.Namespace) (bool, string, *rule.Acceptance) {
 	if r.Options == nil {
-		return false, ""
+		return false, "", nil
 	}
 
 	for _, acceptedService := range r.Options.AcceptedServices {
 		if utils.MatchLabels(service.Labels, acceptedService.MatchLabels) &&
 			utils.MatchLabels(namespace.Labels, acceptedService.NamespaceMatchLabels) {
-			return true, acceptedService.Justification
+			return true, acceptedService.Justification, acceptedService.Acceptance()
 		}
 	}
 
-	return false, ""
+	return false, "", nil
 }
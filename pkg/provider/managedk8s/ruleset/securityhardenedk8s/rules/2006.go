@@ -88,14 +88,14 @@ func (r *Rule2006) Run(ctx context.Context) (rule.RuleResult, error) {
 
 	var (
 		checkResults []rule.CheckResult
-		checkRules   = func(policyRules []rbacv1.PolicyRule, accepted bool, justification string, target rule.Target) rule.CheckResult {
+		checkRules   = func(policyRules []rbacv1.PolicyRule, accepted bool, justification string, acceptance *rule.Acceptance, target rule.Target) rule.CheckResult {
 			msg := cmp.Or(justification, "Role is accepted to use \"*\" in policy rule resources.")
 
 			for _, policyRule := range policyRules {
 				for _, resource := range policyRule.Resources {
 					if strings.Contains(resource, "*") {
 						if accepted {
-							return rule.AcceptedCheckResult(msg, target)
+							return rule.AcceptedCheckResultWithAcceptance(msg, target, acceptance)
 						}
 						return rule.FailedCheckResult("Role uses \"*\" in policy rule resources.", target)
 					}
@@ -108,45 +108,45 @@ func (r *Rule2006) Run(ctx context.Context) (rule.RuleResult, error) {
 	for _, role := range roles {
 		target := kubeutils.TargetWithK8sObject(rule.NewTarget(), v1.TypeMeta{Kind: "Role"}, role.ObjectMeta)
 
-		accepted, justification := r.acceptedRole(role, namespaces[role.Namespace])
-		checkResults = append(checkResults, checkRules(role.Rules, accepted, justification, target))
+		accepted, justification, acceptance := r.acceptedRole(role, namespaces[role.Namespace])
+		checkResults = append(checkResults, checkRules(role.Rules, accepted, justification, acceptance, target))
 	}
 
 	for _, clusterRole := range clusterRoles {
 		target := kubeutils.TargetWithK8sObject(rule.NewTarget(), v1.TypeMeta{Kind: "ClusterRole"}, clusterRole.ObjectMeta)
 
-		accepted, justification := r.acceptedClusterRole(clusterRole)
-		checkResults = append(checkResults, checkRules(clusterRole.Rules, accepted, justification, target))
+		accepted, justification, acceptance := r.acceptedClusterRole(clusterRole)
+		checkResults = append(checkResults, checkRules(clusterRole.Rules, accepted, justification, acceptance, target))
 	}
 
 	return rule.Result(r, checkResults...), nil
 }
 
-func (r *Rule2006) acceptedRole(role rbacv1.Role, namespace corev1.Namespace) (bool, string) {
+func (r *Rule2006) acceptedRole(role rbacv1.Role, namespace corev1.Namespace) (bool, string, *rule.Acceptance) {
 	if r.Options == nil {
-		return false, ""
+		return false, "", nil
 	}
 
 	for _, acceptedRole := range r.Options.AcceptedRoles {
 		if utils.MatchLabels(role.Labels, acceptedRole.MatchLabels) &&
 			utils.MatchLabels(namespace.Labels, acceptedRole.NamespaceMatchLabels) {
-			return true, acceptedRole.Justification
+			return true, acceptedRole.Justification, acceptedRole.Acceptance()
 		}
 	}
 
-	return false, ""
+	return false, "", nil
 }
 
-func (r *Rule2006) acceptedClusterRole(clusterRole rbacv1.ClusterRole) (bool, string) {
+func (r *Rule2006) acceptedClusterRole(clusterRole rbacv1.ClusterRole) (bool, string, *rule.Acceptance) {
 	if r.Options == nil {
-		return false, ""
+		return false, "", nil
 	}
 
 	for _, acceptedClusterRoles := range r.Options.AcceptedClusterRoles {
 		if utils.MatchLabels(clusterRole.Labels, acceptedClusterRoles.MatchLabels) {
-			return true, acceptedClusterRoles.Justification
+			return true, acceptedClusterRoles.Justification, acceptedClusterRoles.Acceptance()
 		}
 	}
 
-	return false, ""
+	return false, "", nil
 }
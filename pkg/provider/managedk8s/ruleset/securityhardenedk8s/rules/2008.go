@@ -20,6 +20,7 @@ import (
 	"github.com/gardener/diki/pkg/rule"
 	"github.com/gardener/diki/pkg/shared/kubernetes/option"
 	disaoptions "github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/option"
+	sharedoption "github.com/gardener/diki/pkg/shared/ruleset/option"
 )
 
 var (
@@ -39,6 +40,7 @@ type Options2008 struct {
 
 type AcceptedPods2008 struct {
 	option.NamespacedObjectSelector
+	sharedoption.Expiration
 	VolumeNames   []string `json:"volumeNames" yaml:"volumeNames"`
 	Justification string   `json:"justification" yaml:"justification"`
 }
@@ -50,7 +52,8 @@ func (o Options2008) Validate(fldPath *field.Path) field.ErrorList {
 		acceptedPodsPath = fldPath.Child("acceptedPods")
 	)
 	for pIdx, p := range o.AcceptedPods {
-		allErrs = append(allErrs, p.Validate(acceptedPodsPath.Index(pIdx))...)
+		allErrs = append(allErrs, p.NamespacedObjectSelector.Validate(acceptedPodsPath.Index(pIdx))...)
+		allErrs = append(allErrs, p.Expiration.Validate(acceptedPodsPath.Index(pIdx))...)
 		if len(p.VolumeNames) == 0 {
 			allErrs = append(allErrs, field.Required(acceptedPodsPath.Index(pIdx).Child("volumeNames"), "must not be empty"))
 		}
@@ -118,9 +121,9 @@ func (r *Rule2008) Run(ctx context.Context) (rule.RuleResult, error) {
 			volumeTarget := podTarget.With("volume", volume.Name)
 			if volume.HostPath != nil {
 				uses = true
-				if accepted, justification := r.accepted(pod, namespaces[pod.Namespace], volume.Name); accepted {
+				if accepted, justification, acceptance := r.accepted(pod, namespaces[pod.Namespace], volume.Name); accepted {
 					msg := cmp.Or(justification, "Pod accepted to use volume of type hostPath.")
-					checkResults = append(checkResults, rule.AcceptedCheckResult(msg, volumeTarget))
+					checkResults = append(checkResults, rule.AcceptedCheckResultWithAcceptance(msg, volumeTarget, acceptance))
 				} else {
 					checkResults = append(checkResults, rule.FailedCheckResult("Pod must not use volumes of type hostPath.", volumeTarget))
 				}
@@ -133,19 +136,19 @@ func (r *Rule2008) Run(ctx context.Context) (rule.RuleResult, error) {
 	return rule.Result(r, checkResults...), nil
 }
 
-func (r *Rule2008) accepted(pod corev1.Pod, namespace corev1.Namespace, volumeName string) (bool, string) {
+func (r *Rule2008) accepted(pod corev1.Pod, namespace corev1.Namespace, volumeName string) (bool, string, *rule.Acceptance) {
 	if r.Options == nil {
-		return false, ""
+		return false, "", nil
 	}
 
 	for _, acceptedPod := range r.Options.AcceptedPods {
 		if utils.MatchLabels(pod.Labels, acceptedPod.MatchLabels) &&
 			utils.MatchLabels(namespace.Labels, acceptedPod.NamespaceMatchLabels) {
 			if slices.Contains(acceptedPod.VolumeNames, "*") || slices.Contains(acceptedPod.VolumeNames, volumeName) {
-				return true, acceptedPod.Justification
+				return true, acceptedPod.Justification, acceptedPod.Acceptance()
 			}
 		}
 	}
 
-	return false, ""
+	return false, "", nil
 }
@@ -11,6 +11,7 @@ import (
 
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gardener/diki/pkg/config"
 	internalconfig "github.com/gardener/diki/pkg/internal/config"
@@ -35,11 +36,16 @@ var (
 
 // Ruleset implements Security Hardened Kubernetes Cluster.
 type Ruleset struct {
-	version    string
-	rules      map[string]rule.Rule
-	Config     *rest.Config
-	numWorkers int
-	logger     *slog.Logger
+	version string
+	rules   map[string]rule.Rule
+	Config  *rest.Config
+	// Client is used by the rules instead of a client created from Config when it is set.
+	Client client.Client
+	// skippedRules maps the ids of rules which cannot be checked with Client
+	// to the justification with which they are skipped.
+	skippedRules map[string]string
+	numWorkers   int
+	logger       *slog.Logger
 }
 
 // New creates a new Ruleset.
@@ -71,11 +77,14 @@ func (r *Ruleset) Version() string {
 	return r.version
 }
 
-// FromGenericConfig creates a Ruleset from a RulesetConfig
-func FromGenericConfig(rulesetConfig config.RulesetConfig, managedConfig *rest.Config, fldPath *field.Path) (*Ruleset, error) {
+// FromGenericConfig creates a Ruleset from a RulesetConfig.
+// The options are applied after the version and the config are set.
+func FromGenericConfig(rulesetConfig config.RulesetConfig, managedConfig *rest.Config, fldPath *field.Path, options ...CreateOption) (*Ruleset, error) {
 	ruleset, err := New(
-		WithVersion(rulesetConfig.Version),
-		WithConfig(managedConfig),
+		append([]CreateOption{
+			WithVersion(rulesetConfig.Version),
+			WithConfig(managedConfig),
+		}, options...)...,
 	)
 	if err != nil {
 		return nil, err
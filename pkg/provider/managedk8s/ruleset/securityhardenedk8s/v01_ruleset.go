@@ -13,6 +13,7 @@ import (
 
 	"github.com/gardener/diki/pkg/config"
 	internalconfig "github.com/gardener/diki/pkg/internal/config"
+	kubeutils "github.com/gardener/diki/pkg/kubernetes/utils"
 	"github.com/gardener/diki/pkg/provider/managedk8s/ruleset/securityhardenedk8s/rules"
 	"github.com/gardener/diki/pkg/rule"
 	"github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/option"
@@ -35,9 +36,13 @@ func (r *Ruleset) validateV01RuleOptions(ruleOptions map[string]internalconfig.I
 }
 
 func (r *Ruleset) registerV01Rules(ruleOptions map[string]config.RuleOptionsConfig) error { // TODO: add to FromGenericConfig
-	c, err := client.New(r.Config, client.Options{})
-	if err != nil {
-		return err
+	c := r.Client
+	if c == nil {
+		configClient, err := client.New(r.Config, client.Options{})
+		if err != nil {
+			return err
+		}
+		c = kubeutils.NewCachedClient(configClient, r.Config.Host)
 	}
 
 	opts2000, err := getV01OptionOrNil[rules.Options2000](ruleOptions["2000"].Args)
@@ -116,17 +121,22 @@ func (r *Ruleset) registerV01Rules(ruleOptions map[string]config.RuleOptionsConf
 		},
 	}
 
-	for i, r := range rules {
+	for i, rr := range rules {
 		var severityLevel rule.SeverityLevel
-		if severity, ok := r.(rule.Severity); !ok {
-			return fmt.Errorf("rule %s does not implement rule.Severity", r.ID())
+		if severity, ok := rr.(rule.Severity); !ok {
+			return fmt.Errorf("rule %s does not implement rule.Severity", rr.ID())
 		} else {
 			severityLevel = severity.Severity()
 		}
 
-		opt, found := ruleOptions[r.ID()]
+		if justification, ok := r.skippedRules[rr.ID()]; ok {
+			rules[i] = rule.NewSkipRule(rr.ID(), rr.Name(), justification, rule.Skipped, rule.SkipRuleWithSeverity(severityLevel))
+			continue
+		}
+
+		opt, found := ruleOptions[rr.ID()]
 		if found && opt.Skip != nil && opt.Skip.Enabled {
-			rules[i] = rule.NewSkipRule(r.ID(), r.Name(), opt.Skip.Justification, rule.Accepted, rule.SkipRuleWithSeverity(severityLevel))
+			rules[i] = rule.NewSkipRule(rr.ID(), rr.Name(), opt.Skip.Justification, rule.Accepted, rule.SkipRuleWithSeverity(severityLevel), rule.SkipRuleWithAcceptance(opt.Skip.Owner, opt.Skip.ExpiresAt))
 		}
 	}
 
@@ -0,0 +1,412 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package manifests
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/diki/pkg/rule"
+	sharedprovider "github.com/gardener/diki/pkg/shared/provider"
+)
+
+// clusterScopedKinds contains the kinds of the known cluster scoped objects.
+var clusterScopedKinds = map[string]struct{}{
+	"APIService":                       {},
+	"CertificateSigningRequest":        {},
+	"ClusterRole":                      {},
+	"ClusterRoleBinding":               {},
+	"ComponentStatus":                  {},
+	"CSIDriver":                        {},
+	"CSINode":                          {},
+	"CustomResourceDefinition":         {},
+	"IngressClass":                     {},
+	"MutatingWebhookConfiguration":     {},
+	"Namespace":                        {},
+	"Node":                             {},
+	"PersistentVolume":                 {},
+	"PriorityClass":                    {},
+	"RuntimeClass":                     {},
+	"StorageClass":                     {},
+	"ValidatingAdmissionPolicy":        {},
+	"ValidatingAdmissionPolicyBinding": {},
+	"ValidatingWebhookConfiguration":   {},
+	"VolumeAttachment":                 {},
+}
+
+// Source is the location of an object in a manifest file.
+type Source struct {
+	File string
+	Line int
+}
+
+func (s Source) String() string {
+	return s.File + ":" + strconv.Itoa(s.Line)
+}
+
+type objectKey struct {
+	kind, namespace, name string
+}
+
+func (k objectKey) String() string {
+	if len(k.namespace) == 0 {
+		return k.kind + " " + k.name
+	}
+	return k.kind + " " + k.namespace + "/" + k.name
+}
+
+// Manifests contains the objects loaded from manifest files
+// together with the locations at which they are defined.
+type Manifests struct {
+	// Objects contains the loaded objects, the Namespaces referenced by them
+	// and the Pods which would be created by the loaded workloads.
+	Objects []client.Object
+	sources map[objectKey]Source
+}
+
+// Source returns the location at which the object with the given kind, namespace and name is defined.
+func (m *Manifests) Source(kind, namespace, name string) (Source, bool) {
+	source, ok := m.sources[objectKey{kind: kind, namespace: namespace, name: name}]
+	return source, ok
+}
+
+// TargetWithSource creates a new Target with the file and line of the object the target refers to.
+// It returns the original Target if it does not refer to an object loaded from a manifest file.
+func (m *Manifests) TargetWithSource(t rule.Target) rule.Target {
+	kind, name := t["kind"], t["name"]
+	if len(kind) == 0 || len(name) == 0 {
+		return t
+	}
+
+	source, ok := m.Source(kind, t["namespace"], name)
+	if !ok {
+		return t
+	}
+	return t.With("file", source.File, "line", strconv.Itoa(source.Line))
+}
+
+// Load reads the manifest files found in the given paths. Directories are walked recursively
+// and all files with .yaml, .yml or .json extension in them are read.
+// Files can contain multiple YAML documents and objects of kind List.
+// Namespaced objects without a namespace are placed in defaultNamespace.
+// Objects of kinds that are not known to the Kubernetes client scheme are skipped.
+func Load(paths []string, defaultNamespace string, logger sharedprovider.Logger) (*Manifests, error) {
+	l := &loader{
+		defaultNamespace: defaultNamespace,
+		logger:           logger,
+		manifests: &Manifests{
+			sources: map[objectKey]Source{},
+		},
+	}
+
+	for _, path := range paths {
+		files, err := manifestFiles(path)
+		if err != nil {
+			return nil, err
+		}
+		for _, file := range files {
+			if err := l.loadFile(file); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	l.addNamespaces()
+	return l.manifests, nil
+}
+
+// manifestFiles returns the manifest files found in path in lexical order.
+func manifestFiles(path string) ([]string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+
+	var files []string
+	err = filepath.WalkDir(path, func(filePath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		switch strings.ToLower(filepath.Ext(filePath)) {
+		case ".yaml", ".yml", ".json":
+			files = append(files, filePath)
+		}
+		return nil
+	})
+	return files, err
+}
+
+type loader struct {
+	defaultNamespace string
+	logger           sharedprovider.Logger
+	manifests        *Manifests
+}
+
+func (l *loader) loadFile(file string) error {
+	f, err := os.Open(file) // #nosec G304
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	decoder := yaml.NewDecoder(f)
+	for {
+		var document yaml.Node
+		if err := decoder.Decode(&document); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return fmt.Errorf("failed to parse %s: %w", file, err)
+		}
+
+		if len(document.Content) == 0 {
+			continue
+		}
+		node := document.Content[0]
+		if node.Kind == yaml.ScalarNode && node.Tag == "!!null" {
+			continue
+		}
+		if err := l.loadNode(node, Source{File: file, Line: node.Line}); err != nil {
+			return err
+		}
+	}
+}
+
+func (l *loader) loadNode(node *yaml.Node, source Source) error {
+	if node.Kind != yaml.MappingNode {
+		return fmt.Errorf("%s: manifest is not an object", source)
+	}
+
+	var typeMeta struct {
+		APIVersion string `yaml:"apiVersion"`
+		Kind       string `yaml:"kind"`
+	}
+	if err := node.Decode(&typeMeta); err != nil {
+		return fmt.Errorf("%s: %w", source, err)
+	}
+	if len(typeMeta.Kind) == 0 {
+		return fmt.Errorf("%s: manifest does not have a kind", source)
+	}
+
+	if strings.HasSuffix(typeMeta.Kind, "List") {
+		if items := mappingValue(node, "items"); items != nil && items.Kind == yaml.SequenceNode {
+			for _, item := range items.Content {
+				if err := l.loadNode(item, Source{File: source.File, Line: item.Line}); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}
+
+	gvk := schema.FromAPIVersionAndKind(typeMeta.APIVersion, typeMeta.Kind)
+	runtimeObj, err := scheme.Scheme.New(gvk)
+	if err != nil {
+		if runtime.IsNotRegisteredError(err) {
+			l.logger.Info("skipping object of unknown kind", "file", source.File, "line", source.Line, "apiVersion", typeMeta.APIVersion, "kind", typeMeta.Kind)
+			return nil
+		}
+		return fmt.Errorf("%s: %w", source, err)
+	}
+	obj, ok := runtimeObj.(client.Object)
+	if !ok {
+		l.logger.Info("skipping object which is not a Kubernetes object", "file", source.File, "line", source.Line, "apiVersion", typeMeta.APIVersion, "kind", typeMeta.Kind)
+		return nil
+	}
+
+	var content map[string]any
+	if err := node.Decode(&content); err != nil {
+		return fmt.Errorf("%s: %w", source, err)
+	}
+	data, err := json.Marshal(content)
+	if err != nil {
+		return fmt.Errorf("%s: %w", source, err)
+	}
+	if err := json.Unmarshal(data, obj); err != nil {
+		return fmt.Errorf("%s: failed to decode %s: %w", source, typeMeta.Kind, err)
+	}
+	obj.GetObjectKind().SetGroupVersionKind(gvk)
+
+	if len(obj.GetName()) == 0 {
+		return fmt.Errorf("%s: %s does not have a name", source, typeMeta.Kind)
+	}
+	if _, ok := clusterScopedKinds[gvk.Kind]; ok {
+		obj.SetNamespace("")
+	} else if len(obj.GetNamespace()) == 0 {
+		obj.SetNamespace(l.defaultNamespace)
+	}
+
+	if pod, ok := obj.(*corev1.Pod); ok {
+		setContainerStatuses(pod)
+	}
+	if err := l.add(obj, source); err != nil {
+		return err
+	}
+
+	if pod := workloadPod(obj); pod != nil {
+		return l.add(pod, source)
+	}
+	return nil
+}
+
+func (l *loader) add(obj client.Object, source Source) error {
+	key := objectKey{
+		kind:      obj.GetObjectKind().GroupVersionKind().Kind,
+		namespace: obj.GetNamespace(),
+		name:      obj.GetName(),
+	}
+	if existing, ok := l.manifests.sources[key]; ok {
+		return fmt.Errorf("%s is defined in both %s and %s", key, existing, source)
+	}
+
+	obj.SetUID(types.UID(key.String()))
+	l.manifests.sources[key] = source
+	l.manifests.Objects = append(l.manifests.Objects, obj)
+	return nil
+}
+
+// addNamespaces adds the Namespaces which are referenced by the loaded objects,
+// but are not defined in the manifest files. All Namespaces are labeled with
+// kubernetes.io/metadata.name like the kube-apiserver does.
+func (l *loader) addNamespaces() {
+	var (
+		namespaces = map[string]*corev1.Namespace{}
+		referenced []string
+	)
+	for _, obj := range l.manifests.Objects {
+		if namespace, ok := obj.(*corev1.Namespace); ok {
+			namespaces[namespace.Name] = namespace
+			continue
+		}
+		if len(obj.GetNamespace()) > 0 && !slices.Contains(referenced, obj.GetNamespace()) {
+			referenced = append(referenced, obj.GetNamespace())
+		}
+	}
+
+	for _, name := range referenced {
+		if _, ok := namespaces[name]; ok {
+			continue
+		}
+		namespace := &corev1.Namespace{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: "v1",
+				Kind:       "Namespace",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+				UID:  types.UID(objectKey{kind: "Namespace", name: name}.String()),
+			},
+		}
+		namespaces[name] = namespace
+		l.manifests.Objects = append(l.manifests.Objects, namespace)
+	}
+
+	for _, namespace := range namespaces {
+		if namespace.Labels == nil {
+			namespace.Labels = map[string]string{}
+		}
+		namespace.Labels[corev1.LabelMetadataName] = namespace.Name
+	}
+}
+
+// workloadPod returns the Pod which would be created by a workload object.
+// The Pod is owned by the workload, so that check results for the Pod refer to the workload.
+// It returns nil if the object is not a workload.
+func workloadPod(obj client.Object) *corev1.Pod {
+	var template *corev1.PodTemplateSpec
+	switch o := obj.(type) {
+	case *appsv1.Deployment:
+		template = &o.Spec.Template
+	case *appsv1.ReplicaSet:
+		template = &o.Spec.Template
+	case *appsv1.StatefulSet:
+		template = &o.Spec.Template
+	case *appsv1.DaemonSet:
+		template = &o.Spec.Template
+	case *corev1.ReplicationController:
+		template = o.Spec.Template
+	case *batchv1.Job:
+		template = &o.Spec.Template
+	case *batchv1.CronJob:
+		template = &o.Spec.JobTemplate.Spec.Template
+	}
+	if template == nil {
+		return nil
+	}
+
+	gvk := obj.GetObjectKind().GroupVersionKind()
+	pod := &corev1.Pod{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "Pod",
+		},
+		ObjectMeta: *template.ObjectMeta.DeepCopy(),
+		Spec:       *template.Spec.DeepCopy(),
+	}
+	pod.Name = obj.GetName() + "-" + strings.ToLower(gvk.Kind)
+	pod.Namespace = obj.GetNamespace()
+	pod.OwnerReferences = []metav1.OwnerReference{
+		{
+			APIVersion: gvk.GroupVersion().String(),
+			Kind:       gvk.Kind,
+			Name:       obj.GetName(),
+			UID:        types.UID(objectKey{kind: gvk.Kind, namespace: obj.GetNamespace(), name: obj.GetName()}.String()),
+			Controller: ptr.To(true),
+		},
+	}
+	setContainerStatuses(pod)
+	return pod
+}
+
+// setContainerStatuses adds the statuses of containers which are not yet started.
+// As images are not pulled, the image id of a container is its image reference.
+func setContainerStatuses(pod *corev1.Pod) {
+	for _, container := range pod.Spec.Containers {
+		if !slices.ContainsFunc(pod.Status.ContainerStatuses, func(status corev1.ContainerStatus) bool { return status.Name == container.Name }) {
+			pod.Status.ContainerStatuses = append(pod.Status.ContainerStatuses, corev1.ContainerStatus{Name: container.Name, Image: container.Image, ImageID: container.Image})
+		}
+	}
+	for _, container := range pod.Spec.InitContainers {
+		if !slices.ContainsFunc(pod.Status.InitContainerStatuses, func(status corev1.ContainerStatus) bool { return status.Name == container.Name }) {
+			pod.Status.InitContainerStatuses = append(pod.Status.InitContainerStatuses, corev1.ContainerStatus{Name: container.Name, Image: container.Image, ImageID: container.Image})
+		}
+	}
+}
+
+// mappingValue returns the value node of key in a mapping node or nil if the key is not found.
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
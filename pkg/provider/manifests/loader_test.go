@@ -0,0 +1,235 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package manifests_test
+
+import (
+	"log/slog"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/diki/pkg/provider/manifests"
+	"github.com/gardener/diki/pkg/rule"
+)
+
+var _ = Describe("loader", func() {
+	var (
+		dir    string
+		logger *slog.Logger
+	)
+
+	BeforeEach(func() {
+		dir = GinkgoT().TempDir()
+		logger = slog.New(slog.DiscardHandler)
+	})
+
+	objectsOfKind := func(m *manifests.Manifests, kind string) []client.Object {
+		var objects []client.Object
+		for _, obj := range m.Objects {
+			if obj.GetObjectKind().GroupVersionKind().Kind == kind {
+				objects = append(objects, obj)
+			}
+		}
+		return objects
+	}
+
+	sourceOf := func(m *manifests.Manifests, kind, namespace, name string) manifests.Source {
+		source, ok := m.Source(kind, namespace, name)
+		Expect(ok).To(BeTrue())
+		return source
+	}
+
+	Describe("#Load", func() {
+		It("should load multi-document files, lists and json files from directories", func() {
+			deploymentFile := writeManifest(dir, "app/deployment.yaml", `---
+# comment
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: foo
+  namespace: bar
+spec:
+  template:
+    metadata:
+      labels:
+        app: foo
+    spec:
+      containers:
+      - name: foo
+        image: registry.example.com/foo:v1
+---
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: foo
+`)
+			listFile := writeManifest(dir, "rbac/list.yml", `apiVersion: v1
+kind: List
+items:
+- apiVersion: rbac.authorization.k8s.io/v1
+  kind: ClusterRole
+  metadata:
+    name: foo
+    namespace: ignored
+- apiVersion: rbac.authorization.k8s.io/v1
+  kind: Role
+  metadata:
+    name: foo
+`)
+			jsonFile := writeManifest(dir, "pod.json", `{
+  "apiVersion": "v1",
+  "kind": "Pod",
+  "metadata": {
+    "name": "foo"
+  },
+  "spec": {
+    "containers": [{"name": "foo", "image": "foo:v1"}]
+  }
+}
+`)
+			writeManifest(dir, "README.md", "not a manifest")
+
+			m, err := manifests.Load([]string{dir}, "default", logger)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(m.Objects).To(HaveLen(8))
+			Expect(sourceOf(m, "Deployment", "bar", "foo")).To(Equal(manifests.Source{File: deploymentFile, Line: 3}))
+			Expect(sourceOf(m, "Service", "default", "foo")).To(Equal(manifests.Source{File: deploymentFile, Line: 19}))
+			Expect(sourceOf(m, "ClusterRole", "", "foo")).To(Equal(manifests.Source{File: listFile, Line: 4}))
+			Expect(sourceOf(m, "Role", "default", "foo")).To(Equal(manifests.Source{File: listFile, Line: 9}))
+			Expect(sourceOf(m, "Pod", "default", "foo")).To(Equal(manifests.Source{File: jsonFile, Line: 1}))
+
+			roles := objectsOfKind(m, "Role")
+			Expect(roles).To(HaveLen(1))
+			Expect(roles[0]).To(BeAssignableToTypeOf(&rbacv1.Role{}))
+
+			namespaces := objectsOfKind(m, "Namespace")
+			Expect(namespaces).To(HaveLen(2))
+			Expect(namespaces[0].GetName()).To(Equal("bar"))
+			Expect(namespaces[0].GetLabels()).To(Equal(map[string]string{"kubernetes.io/metadata.name": "bar"}))
+			Expect(namespaces[1].GetName()).To(Equal("default"))
+
+			pods := objectsOfKind(m, "Pod")
+			Expect(pods).To(HaveLen(2))
+			Expect(pods[0].GetName()).To(Equal("foo-deployment"))
+			Expect(pods[1].GetName()).To(Equal("foo"))
+			Expect(pods[1].(*corev1.Pod).Status.ContainerStatuses).To(Equal([]corev1.ContainerStatus{
+				{Name: "foo", Image: "foo:v1", ImageID: "foo:v1"},
+			}))
+		})
+
+		It("should create pods for workloads which are owned by them", func() {
+			writeManifest(dir, "workloads.yaml", `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: foo
+spec:
+  template:
+    metadata:
+      labels:
+        app: foo
+    spec:
+      containers:
+      - name: foo
+        image: foo:v1
+---
+apiVersion: batch/v1
+kind: CronJob
+metadata:
+  name: foo
+spec:
+  schedule: "* * * * *"
+  jobTemplate:
+    spec:
+      template:
+        spec:
+          initContainers:
+          - name: bar
+            image: bar:v1
+`)
+
+			m, err := manifests.Load([]string{dir}, "default", logger)
+			Expect(err).NotTo(HaveOccurred())
+
+			deployments := objectsOfKind(m, "Deployment")
+			Expect(deployments).To(HaveLen(1))
+
+			pods := objectsOfKind(m, "Pod")
+			Expect(pods).To(HaveLen(2))
+
+			deploymentPod := pods[0].(*corev1.Pod)
+			Expect(deploymentPod.Name).To(Equal("foo-deployment"))
+			Expect(deploymentPod.Namespace).To(Equal("default"))
+			Expect(deploymentPod.Labels).To(Equal(map[string]string{"app": "foo"}))
+			Expect(deploymentPod.OwnerReferences).To(HaveLen(1))
+			Expect(deploymentPod.OwnerReferences[0].APIVersion).To(Equal("apps/v1"))
+			Expect(deploymentPod.OwnerReferences[0].Kind).To(Equal("Deployment"))
+			Expect(deploymentPod.OwnerReferences[0].Name).To(Equal("foo"))
+			Expect(deploymentPod.OwnerReferences[0].UID).To(Equal(deployments[0].(*appsv1.Deployment).UID))
+
+			cronJobPod := pods[1].(*corev1.Pod)
+			Expect(cronJobPod.Name).To(Equal("foo-cronjob"))
+			Expect(cronJobPod.OwnerReferences[0].Kind).To(Equal("CronJob"))
+			Expect(cronJobPod.Status.InitContainerStatuses).To(Equal([]corev1.ContainerStatus{
+				{Name: "bar", Image: "bar:v1", ImageID: "bar:v1"},
+			}))
+		})
+
+		It("should skip objects of unknown kinds", func() {
+			writeManifest(dir, "custom.yaml", `apiVersion: example.com/v1
+kind: Foo
+metadata:
+  name: foo
+`)
+
+			m, err := manifests.Load([]string{dir}, "default", logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(m.Objects).To(BeEmpty())
+		})
+
+		DescribeTable("should return an error for invalid manifests",
+			func(content, expectedErr string) {
+				file := writeManifest(dir, "invalid.yaml", content)
+
+				_, err := manifests.Load([]string{dir}, "default", logger)
+				Expect(err).To(MatchError(strings.ReplaceAll(expectedErr, "<file>", file)))
+			},
+			Entry("when the manifest is not an object", "- foo\n", "<file>:1: manifest is not an object"),
+			Entry("when the manifest has no kind", "apiVersion: v1\n", "<file>:1: manifest does not have a kind"),
+			Entry("when the object has no name", "apiVersion: v1\nkind: Service\n", "<file>:1: Service does not have a name"),
+			Entry("when an object is defined twice",
+				"apiVersion: v1\nkind: Service\nmetadata:\n  name: foo\n---\napiVersion: v1\nkind: Service\nmetadata:\n  name: foo\n  namespace: default\n",
+				"Service default/foo is defined in both <file>:1 and <file>:6"),
+		)
+
+		It("should return an error when a path does not exist", func() {
+			_, err := manifests.Load([]string{dir + "/foo"}, "default", logger)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("#TargetWithSource", func() {
+		It("should add the file and line of the targeted object", func() {
+			file := writeManifest(dir, "service.yaml", "apiVersion: v1\nkind: Service\nmetadata:\n  name: foo\n")
+
+			m, err := manifests.Load([]string{file}, "bar", logger)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(m.TargetWithSource(rule.NewTarget("kind", "Service", "namespace", "bar", "name", "foo"))).To(Equal(
+				rule.NewTarget("kind", "Service", "namespace", "bar", "name", "foo", "file", file, "line", "1"),
+			))
+			Expect(m.TargetWithSource(rule.NewTarget("kind", "Service", "namespace", "default", "name", "foo"))).To(Equal(
+				rule.NewTarget("kind", "Service", "namespace", "default", "name", "foo"),
+			))
+			Expect(m.TargetWithSource(rule.NewTarget("kind", "ServiceList"))).To(Equal(rule.NewTarget("kind", "ServiceList")))
+		})
+	})
+})
@@ -0,0 +1,27 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package manifests_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestManifests(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Provider Manifests Suite")
+}
+
+// writeManifest writes content to a file with path relative to dir.
+func writeManifest(dir, path, content string) string {
+	filePath := filepath.Join(dir, path)
+	Expect(os.MkdirAll(filepath.Dir(filePath), 0700)).To(Succeed())
+	Expect(os.WriteFile(filePath, []byte(content), 0600)).To(Succeed())
+	return filePath
+}
@@ -0,0 +1,222 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package manifests
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/diki/pkg/config"
+	"github.com/gardener/diki/pkg/provider"
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/ruleset"
+	sharedprovider "github.com/gardener/diki/pkg/shared/provider"
+)
+
+const (
+	// ProviderID is a constant containing the id of the Manifests provider.
+	ProviderID = "manifests"
+	// ProviderName is a constant containing the user-friendly name of the Manifests provider.
+	ProviderName = "Manifests"
+)
+
+// Provider is a Manifests Provider that can be used to implement rules
+// against Kubernetes manifests before they are deployed, e.g. rendered Helm charts.
+// The manifests are loaded into an in-memory [client.Client], so no Kubernetes cluster is needed.
+// The in-memory client does not support listing objects with field selectors.
+// Check results are reported with the file and line at which the checked object is defined.
+type Provider struct {
+	id, name string
+	// Paths are the manifest files and directories containing manifest files.
+	Paths []string
+	// Namespace is the namespace of namespaced objects which do not specify one.
+	Namespace string
+	Manifests *Manifests
+	Client    client.Client
+	rulesets  map[string]ruleset.Ruleset
+	metadata  map[string]string
+	logger    sharedprovider.Logger
+}
+
+type providerArgs struct {
+	Paths     []string `json:"paths" yaml:"paths"`
+	Namespace string   `json:"namespace" yaml:"namespace"`
+}
+
+var _ provider.Provider = &Provider{}
+
+// New creates a new Provider and loads the manifests found in its paths.
+func New(options ...CreateOption) (*Provider, error) {
+	p := &Provider{
+		Namespace: "default",
+		rulesets:  make(map[string]ruleset.Ruleset),
+	}
+	for _, o := range options {
+		o(p)
+	}
+
+	var err error
+	if len(p.Paths) == 0 {
+		err = errors.Join(err, errors.New("paths are not set"))
+	}
+	if len(p.Namespace) == 0 {
+		err = errors.Join(err, errors.New("namespace is not set"))
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	manifests, err := Load(p.Paths, p.Namespace, p.Logger())
+	if err != nil {
+		return nil, err
+	}
+
+	p.Manifests = manifests
+	p.Client = fake.NewClientBuilder().
+		WithScheme(scheme.Scheme).
+		WithObjects(manifests.Objects...).
+		Build()
+	return p, nil
+}
+
+// RunAll executes all Rulesets registered with the Provider.
+func (p *Provider) RunAll(ctx context.Context) (provider.ProviderResult, error) {
+	result, err := sharedprovider.RunAll(ctx, p, p.rulesets, p.Logger())
+	if err != nil {
+		return provider.ProviderResult{}, err
+	}
+
+	for i := range result.RulesetResults {
+		p.rulesetResultWithSources(&result.RulesetResults[i])
+	}
+	return result, nil
+}
+
+func rulesetKey(rulesetID, rulesetVersion string) string {
+	return rulesetID + "--" + rulesetVersion
+}
+
+// RunRuleset executes all Rules of a known Ruleset.
+func (p *Provider) RunRuleset(ctx context.Context, rulesetID, rulesetVersion string) (ruleset.RulesetResult, error) {
+	rs, ok := p.rulesets[rulesetKey(rulesetID, rulesetVersion)]
+	if !ok {
+		return ruleset.RulesetResult{}, fmt.Errorf("ruleset with id %s and version %s does not exist", rulesetID, rulesetVersion)
+	}
+
+	result, err := rs.Run(ctx)
+	if err != nil {
+		return ruleset.RulesetResult{}, err
+	}
+
+	p.rulesetResultWithSources(&result)
+	return result, nil
+}
+
+// RunRule executes specific Rule of a known Ruleset.
+func (p *Provider) RunRule(ctx context.Context, rulesetID, rulesetVersion, ruleID string) (rule.RuleResult, error) {
+	rs, ok := p.rulesets[rulesetKey(rulesetID, rulesetVersion)]
+	if !ok {
+		return rule.RuleResult{}, fmt.Errorf("ruleset with id %s and version %s does not exist", rulesetID, rulesetVersion)
+	}
+
+	result, err := rs.RunRule(ctx, ruleID)
+	if err != nil {
+		return rule.RuleResult{}, err
+	}
+
+	p.ruleResultWithSources(&result)
+	return result, nil
+}
+
+func (p *Provider) rulesetResultWithSources(result *ruleset.RulesetResult) {
+	for i := range result.RuleResults {
+		p.ruleResultWithSources(&result.RuleResults[i])
+	}
+}
+
+func (p *Provider) ruleResultWithSources(result *rule.RuleResult) {
+	for i := range result.CheckResults {
+		result.CheckResults[i].Target = p.Manifests.TargetWithSource(result.CheckResults[i].Target)
+	}
+}
+
+// AddRulesets adds Rulesets to Provider.
+func (p *Provider) AddRulesets(rulesets ...ruleset.Ruleset) error {
+	for _, r := range rulesets {
+		key := rulesetKey(r.ID(), r.Version())
+		if _, ok := p.rulesets[key]; ok {
+			return fmt.Errorf("ruleset with id %s and version %s already exists", r.ID(), r.Version())
+		}
+		p.rulesets[key] = r
+	}
+	return nil
+}
+
+// ID returns the id of the Provider.
+func (p *Provider) ID() string {
+	return p.id
+}
+
+// Name returns the name of the Provider.
+func (p *Provider) Name() string {
+	return p.name
+}
+
+// Metadata returns the metadata of the Provider.
+func (p *Provider) Metadata() map[string]string {
+	if p.metadata == nil {
+		p.metadata = map[string]string{}
+	}
+	return p.metadata
+}
+
+// FromGenericConfig creates a Provider from ProviderConfig.
+// The additional options are applied before the manifests are loaded.
+func FromGenericConfig(providerConf config.ProviderConfig, additionalOptions ...CreateOption) (*Provider, error) {
+	providerArgsByte, err := json.Marshal(providerConf.Args)
+	if err != nil {
+		return nil, err
+	}
+
+	var providerArgs providerArgs
+	if err := json.Unmarshal(providerArgsByte, &providerArgs); err != nil {
+		return nil, err
+	}
+
+	options := []CreateOption{
+		WithID(providerConf.ID),
+		WithName(providerConf.Name),
+		WithPaths(providerArgs.Paths...),
+		WithMetadata(providerConf.Metadata),
+	}
+	if len(providerArgs.Namespace) > 0 {
+		options = append(options, WithNamespace(providerArgs.Namespace))
+	}
+	options = append(options, additionalOptions...)
+
+	provider, err := New(options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return provider, nil
+}
+
+// Logger returns the Provider's logger.
+// If not set it set it to slog.Default().With("provider", p.ID()) then return it.
+func (p *Provider) Logger() sharedprovider.Logger {
+	if p.logger == nil {
+		p.logger = slog.Default().With("provider", p.ID())
+	}
+	return p.logger
+}
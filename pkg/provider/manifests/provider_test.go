@@ -0,0 +1,143 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package manifests_test
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/diki/pkg/config"
+	"github.com/gardener/diki/pkg/provider/managedk8s/ruleset/securityhardenedk8s"
+	"github.com/gardener/diki/pkg/provider/manifests"
+	"github.com/gardener/diki/pkg/rule"
+)
+
+var _ = Describe("manifests", func() {
+	var dir string
+
+	BeforeEach(func() {
+		dir = GinkgoT().TempDir()
+	})
+
+	Describe("#New", func() {
+		It("should return an error when paths are not set", func() {
+			_, err := manifests.New(manifests.WithNamespace(""))
+			Expect(err).To(MatchError("paths are not set\nnamespace is not set"))
+		})
+
+		It("should load the manifests into the client", func() {
+			writeManifest(dir, "service.yaml", "apiVersion: v1\nkind: Service\nmetadata:\n  name: foo\n")
+
+			provider, err := manifests.New(
+				manifests.WithID("foo"),
+				manifests.WithName("bar"),
+				manifests.WithPaths(dir),
+				manifests.WithLogger(slog.New(slog.DiscardHandler)),
+			)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(provider.ID()).To(Equal("foo"))
+			Expect(provider.Name()).To(Equal("bar"))
+			Expect(provider.Namespace).To(Equal("default"))
+			Expect(provider.Manifests.Objects).To(HaveLen(2))
+		})
+	})
+
+	Describe("#FromGenericConfig", func() {
+		It("should set the paths and namespace from the provider args", func() {
+			provider, err := manifests.FromGenericConfig(config.ProviderConfig{
+				ID:   "manifests",
+				Name: "Manifests",
+				Args: map[string]any{"paths": []string{dir}, "namespace": "foo"},
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(provider.ID()).To(Equal("manifests"))
+			Expect(provider.Paths).To(Equal([]string{dir}))
+			Expect(provider.Namespace).To(Equal("foo"))
+		})
+
+		It("should apply the additional options before loading the manifests", func() {
+			writeManifest(dir, "crd.yaml", "apiVersion: foo.bar/v1\nkind: Foo\nmetadata:\n  name: foo\n")
+			var buf bytes.Buffer
+
+			_, err := manifests.FromGenericConfig(config.ProviderConfig{
+				ID:   "manifests",
+				Name: "Manifests",
+				Args: map[string]any{"paths": []string{dir}},
+			}, manifests.WithLogger(slog.New(slog.NewTextHandler(&buf, nil))))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(buf.String()).To(ContainSubstring("skipping object of unknown kind"))
+		})
+	})
+
+	Describe("#RunRule", func() {
+		It("should report the file and line of the checked objects", func() {
+			file := writeManifest(dir, "chart.yaml", `apiVersion: v1
+kind: Service
+metadata:
+  name: foo
+spec:
+  type: NodePort
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: foo
+  namespace: bar
+spec:
+  template:
+    spec:
+      containers:
+      - name: foo
+        image: foo:v1
+      volumes:
+      - name: host
+        hostPath:
+          path: /var
+`)
+
+			provider, err := manifests.New(
+				manifests.WithID("manifests"),
+				manifests.WithPaths(dir),
+				manifests.WithLogger(slog.New(slog.DiscardHandler)),
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			rs, err := securityhardenedk8s.FromGenericConfig(config.RulesetConfig{
+				ID:      securityhardenedk8s.RulesetID,
+				Version: "v0.1.0",
+			}, nil, field.NewPath("rulesets").Index(0),
+				securityhardenedk8s.WithClient(provider.Client),
+				securityhardenedk8s.WithSkippedRules(map[string]string{"2000": "foo"}),
+			)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(provider.AddRulesets(rs)).To(Succeed())
+
+			result, err := provider.RunRule(context.TODO(), securityhardenedk8s.RulesetID, "v0.1.0", "2004")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.CheckResults).To(Equal([]rule.CheckResult{
+				rule.FailedCheckResult("Service should not be of type NodePort.", rule.NewTarget("kind", "Service", "namespace", "default", "name", "foo", "file", file, "line", "1")),
+			}))
+
+			result, err = provider.RunRule(context.TODO(), securityhardenedk8s.RulesetID, "v0.1.0", "2008")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.CheckResults).To(Equal([]rule.CheckResult{
+				rule.FailedCheckResult("Pod must not use volumes of type hostPath.", rule.NewTarget("kind", "Deployment", "namespace", "bar", "name", "foo", "volume", "host", "file", file, "line", "8")),
+			}))
+
+			result, err = provider.RunRule(context.TODO(), securityhardenedk8s.RulesetID, "v0.1.0", "2000")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.CheckResults).To(HaveLen(1))
+			Expect(result.CheckResults[0].Status).To(Equal(rule.Skipped))
+			Expect(result.CheckResults[0].Message).To(Equal("foo"))
+		})
+	})
+})
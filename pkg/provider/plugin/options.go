@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package plugin
+
+import (
+	"github.com/gardener/diki/pkg/config"
+	"github.com/gardener/diki/pkg/plugin/protocol"
+	"github.com/gardener/diki/pkg/shared/provider"
+)
+
+// CreateOption is a function that acts on a [Provider]
+// and is used to construct such objects.
+type CreateOption func(*Provider)
+
+// WithID sets the id of a [Provider].
+func WithID(id string) CreateOption {
+	return func(p *Provider) {
+		p.id = id
+	}
+}
+
+// WithName sets the name of a [Provider].
+func WithName(name string) CreateOption {
+	return func(p *Provider) {
+		p.name = name
+	}
+}
+
+// WithMetadata sets the metadata of a [Provider].
+func WithMetadata(metadata map[string]string) CreateOption {
+	return func(p *Provider) {
+		p.metadata = metadata
+	}
+}
+
+// WithClient sets the Client with which a [Provider] runs its plugin.
+func WithClient(client *protocol.Client) CreateOption {
+	return func(p *Provider) {
+		p.Client = client
+	}
+}
+
+// WithArgs sets the provider arguments which a [Provider] passes to its plugin.
+func WithArgs(args any) CreateOption {
+	return func(p *Provider) {
+		p.args = args
+	}
+}
+
+// WithRulesets sets the configuration of the rulesets which a [Provider] runs with its plugin.
+func WithRulesets(rulesets ...config.RulesetConfig) CreateOption {
+	return func(p *Provider) {
+		p.rulesetConfigs = append(p.rulesetConfigs, rulesets...)
+	}
+}
+
+// WithLogger sets the logger of a [Provider].
+func WithLogger(logger provider.Logger) CreateOption {
+	return func(p *Provider) {
+		p.logger = logger
+	}
+}
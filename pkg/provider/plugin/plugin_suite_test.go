@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package plugin_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/diki/pkg/plugin/protocol"
+)
+
+// fakePluginEnv makes the test binary serve the fake plugin instead of running the tests.
+// Its value selects the behaviour of the fake plugin.
+const fakePluginEnv = "DIKI_FAKE_PLUGIN"
+
+func TestMain(m *testing.M) {
+	if mode, ok := os.LookupEnv(fakePluginEnv); ok {
+		if err := protocol.Serve(context.Background(), os.Stdin, os.Stdout, &fakePlugin{mode: mode}); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+func TestPlugin(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Provider Plugin Suite")
+}
+
+// fakePlugin implements a ruleset foo with the rules 1, 2 and 3.
+type fakePlugin struct {
+	mode string
+}
+
+func (f *fakePlugin) Describe(context.Context, *protocol.ProviderConfig) (protocol.Description, error) {
+	return protocol.Description{
+		ProtocolVersion: protocol.Version,
+		ID:              "fake",
+		Name:            "Fake",
+		Rulesets:        []protocol.RulesetDescription{{ID: "foo", Name: "Foo", Versions: []string{"v2", "v1"}}},
+	}, nil
+}
+
+func (f *fakePlugin) RunAll(_ context.Context, conf protocol.ProviderConfig, progress protocol.ProgressFunc) (protocol.Result, error) {
+	return f.run(conf, "", progress)
+}
+
+func (f *fakePlugin) RunRuleset(_ context.Context, conf protocol.ProviderConfig, _, _ string, progress protocol.ProgressFunc) (protocol.Result, error) {
+	return f.run(conf, "", progress)
+}
+
+func (f *fakePlugin) RunRule(_ context.Context, conf protocol.ProviderConfig, _, _, ruleID string, progress protocol.ProgressFunc) (protocol.Result, error) {
+	return f.run(conf, ruleID, progress)
+}
+
+func (f *fakePlugin) run(conf protocol.ProviderConfig, ruleID string, progress protocol.ProgressFunc) (protocol.Result, error) {
+	if f.mode == "fail" {
+		return protocol.Result{}, errors.New("foo failed")
+	}
+
+	status, severity := "Failed", "High"
+	switch f.mode {
+	case "invalid-status":
+		status = "Foo"
+	case "invalid-severity":
+		severity = "Critical"
+	}
+	rules := []protocol.RuleResult{
+		{ID: "1", Name: "One", Severity: severity, Checks: []protocol.CheckResult{{Status: "Passed", Message: "bar", Target: map[string]string{"name": "bar"}}}},
+		{ID: "2", Name: "Two"},
+		{ID: "3", Name: "Three", Severity: "Low", Checks: []protocol.CheckResult{{Status: status, Message: "baz"}}},
+	}
+
+	result := protocol.Result{}
+	for _, rs := range conf.Rulesets {
+		rulesetResult := protocol.RulesetResult{ID: rs.ID, Name: "Foo", Version: rs.Version}
+		for _, r := range rules {
+			if len(ruleID) > 0 && r.ID != ruleID {
+				continue
+			}
+			event := protocol.Progress{RulesetID: rs.ID, RulesetVersion: rs.Version, RuleID: r.ID, RuleName: r.Name}
+			event.Event = protocol.ProgressEventRuleStarted
+			progress(event)
+			event.Event, event.Result = protocol.ProgressEventRuleFinished, &r
+			progress(event)
+			rulesetResult.Rules = append(rulesetResult.Rules, r)
+		}
+		result.Rulesets = append(result.Rulesets, rulesetResult)
+	}
+	return result, nil
+}
@@ -0,0 +1,442 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package plugin contains a provider which runs its rulesets with an external plugin executable.
+// The plugin speaks the protocol defined in the [protocol] package.
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/diki/pkg/config"
+	"github.com/gardener/diki/pkg/plugin/protocol"
+	"github.com/gardener/diki/pkg/provider"
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/ruleset"
+	sharedprovider "github.com/gardener/diki/pkg/shared/provider"
+)
+
+// describeTimeout is the time a plugin has to describe itself when the provider is created.
+const describeTimeout = time.Minute
+
+// Provider is a Provider whose rulesets are implemented by an external plugin executable.
+// Every run starts the plugin with a request and maps the results of the plugin to diki results.
+type Provider struct {
+	id, name string
+	// Client runs the plugin executable.
+	Client         *protocol.Client
+	args           any
+	rulesetConfigs []config.RulesetConfig
+	rulesets       map[string]config.RulesetConfig
+	config         protocol.ProviderConfig
+	metadata       map[string]string
+	logger         sharedprovider.Logger
+}
+
+var _ provider.Provider = &Provider{}
+
+// New creates a new Provider.
+func New(options ...CreateOption) (*Provider, error) {
+	p := &Provider{
+		rulesets: map[string]config.RulesetConfig{},
+	}
+	for _, o := range options {
+		o(p)
+	}
+
+	var err error
+	if len(p.id) == 0 {
+		err = errors.Join(err, errors.New("id is not set"))
+	}
+	if p.Client == nil || len(p.Client.Command) == 0 {
+		err = errors.Join(err, errors.New("plugin command is not set"))
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	p.config = protocol.ProviderConfig{ID: p.id, Name: p.name, Metadata: p.metadata}
+	if p.config.Args, err = rawJSON(p.args); err != nil {
+		return nil, err
+	}
+
+	for _, rulesetConfig := range p.rulesetConfigs {
+		key := rulesetKey(rulesetConfig.ID, rulesetConfig.Version)
+		if _, ok := p.rulesets[key]; ok {
+			return nil, fmt.Errorf("ruleset with id %s and version %s already exists", rulesetConfig.ID, rulesetConfig.Version)
+		}
+		p.rulesets[key] = rulesetConfig
+
+		wireConfig, err := toRulesetConfig(rulesetConfig)
+		if err != nil {
+			return nil, err
+		}
+		p.config.Rulesets = append(p.config.Rulesets, wireConfig)
+	}
+
+	return p, nil
+}
+
+// Describe asks the plugin for its description and lets it validate the configuration of the Provider.
+func (p *Provider) Describe(ctx context.Context) (protocol.Description, error) {
+	msg, err := p.Client.Call(ctx, protocol.Request{Method: protocol.MethodDescribe, Config: &p.config}, nil)
+	if err != nil {
+		return protocol.Description{}, err
+	}
+	return *msg.Description, nil
+}
+
+// RunAll executes all Rulesets configured for the Provider with the plugin.
+func (p *Provider) RunAll(ctx context.Context) (provider.ProviderResult, error) {
+	if len(p.rulesets) == 0 {
+		return provider.ProviderResult{}, fmt.Errorf("no rulests are registered with the provider")
+	}
+
+	result := provider.ProviderResult{
+		ProviderName: p.Name(),
+		ProviderID:   p.ID(),
+		Metadata:     maps.Clone(p.Metadata()),
+		StartTime:    time.Now().UTC(),
+	}
+
+	p.Logger().Info("starting provider run", "number_of_rulesets", len(p.rulesets))
+	res, err := p.call(ctx, protocol.Request{Method: protocol.MethodRunAll})
+	if err != nil {
+		return provider.ProviderResult{}, err
+	}
+	if result.RulesetResults, err = p.rulesetResults(res); err != nil {
+		return provider.ProviderResult{}, err
+	}
+	p.Logger().Info("finished provider run")
+
+	result.EndTime = time.Now().UTC()
+	return result, nil
+}
+
+// RunRuleset executes all Rules of a configured Ruleset with the plugin.
+func (p *Provider) RunRuleset(ctx context.Context, rulesetID, rulesetVersion string) (ruleset.RulesetResult, error) {
+	if _, ok := p.rulesets[rulesetKey(rulesetID, rulesetVersion)]; !ok {
+		return ruleset.RulesetResult{}, fmt.Errorf("ruleset with id %s and version %s does not exist", rulesetID, rulesetVersion)
+	}
+
+	res, err := p.call(ctx, protocol.Request{Method: protocol.MethodRunRuleset, RulesetID: rulesetID, RulesetVersion: rulesetVersion})
+	if err != nil {
+		return ruleset.RulesetResult{}, err
+	}
+	return p.singleRulesetResult(res, rulesetID, rulesetVersion)
+}
+
+// RunRule executes specific Rule of a configured Ruleset with the plugin.
+func (p *Provider) RunRule(ctx context.Context, rulesetID, rulesetVersion, ruleID string) (rule.RuleResult, error) {
+	if _, ok := p.rulesets[rulesetKey(rulesetID, rulesetVersion)]; !ok {
+		return rule.RuleResult{}, fmt.Errorf("ruleset with id %s and version %s does not exist", rulesetID, rulesetVersion)
+	}
+
+	res, err := p.call(ctx, protocol.Request{Method: protocol.MethodRunRule, RulesetID: rulesetID, RulesetVersion: rulesetVersion, RuleID: ruleID})
+	if err != nil {
+		return rule.RuleResult{}, err
+	}
+	rulesetResult, err := p.singleRulesetResult(res, rulesetID, rulesetVersion)
+	if err != nil {
+		return rule.RuleResult{}, err
+	}
+	if len(rulesetResult.RuleResults) != 1 || rulesetResult.RuleResults[0].RuleID != ruleID {
+		return rule.RuleResult{}, fmt.Errorf("plugin did not return the result of rule with id %s", ruleID)
+	}
+	return rulesetResult.RuleResults[0], nil
+}
+
+// call sends a run request to the plugin and forwards its progress to the logger and the run hooks of the context.
+func (p *Provider) call(ctx context.Context, req protocol.Request) (protocol.Result, error) {
+	hooks := rule.RunHooksFromContext(ctx)
+	onProgress := func(progress protocol.Progress) {
+		info := rule.RunInfo{
+			RulesetID:      progress.RulesetID,
+			RulesetVersion: progress.RulesetVersion,
+			RuleID:         progress.RuleID,
+			RuleName:       progress.RuleName,
+		}
+		logArgs := []any{"ruleset", progress.RulesetID, "version", progress.RulesetVersion, "rule_id", progress.RuleID}
+
+		switch progress.Event {
+		case protocol.ProgressEventRuleStarted:
+			p.Logger().Info("starting rule run", logArgs...)
+			hooks.Start(info)
+		case protocol.ProgressEventRuleFinished:
+			var (
+				res rule.RuleResult
+				err error
+			)
+			if len(progress.Error) > 0 {
+				err = errors.New(progress.Error)
+			} else if progress.Result != nil {
+				res, err = p.ruleResult(progress.RulesetID, progress.RulesetVersion, *progress.Result)
+			}
+
+			if err != nil {
+				p.Logger().Error("finished rule run", append(logArgs, "error", err)...)
+			} else {
+				p.Logger().Info("finished rule run", logArgs...)
+			}
+			hooks.Finish(info, res, err)
+		}
+	}
+
+	req.Config = &p.config
+	msg, err := p.Client.Call(ctx, req, onProgress)
+	if err != nil {
+		return protocol.Result{}, err
+	}
+	return *msg.Result, nil
+}
+
+func (p *Provider) singleRulesetResult(res protocol.Result, rulesetID, rulesetVersion string) (ruleset.RulesetResult, error) {
+	rulesetResults, err := p.rulesetResults(res)
+	if err != nil {
+		return ruleset.RulesetResult{}, err
+	}
+	if len(rulesetResults) != 1 || rulesetResults[0].RulesetID != rulesetID || rulesetResults[0].RulesetVersion != rulesetVersion {
+		return ruleset.RulesetResult{}, fmt.Errorf("plugin did not return the result of ruleset with id %s and version %s", rulesetID, rulesetVersion)
+	}
+	return rulesetResults[0], nil
+}
+
+// rulesetResults maps the ruleset results of the plugin to diki ruleset results.
+// Rulesets without start and end times get the times of the plugin run.
+func (p *Provider) rulesetResults(res protocol.Result) ([]ruleset.RulesetResult, error) {
+	var errAgg error
+	rulesetResults := make([]ruleset.RulesetResult, 0, len(res.Rulesets))
+	for _, rs := range res.Rulesets {
+		rulesetResult := ruleset.RulesetResult{
+			RulesetID:      rs.ID,
+			RulesetName:    rs.Name,
+			RulesetVersion: rs.Version,
+			RuleResults:    make([]rule.RuleResult, 0, len(rs.Rules)),
+			StartTime:      orTime(rs.StartTime, res.StartTime),
+			EndTime:        orTime(rs.EndTime, res.EndTime),
+		}
+		for _, r := range rs.Rules {
+			ruleResult, err := p.ruleResult(rs.ID, rs.Version, r)
+			if err != nil {
+				errAgg = errors.Join(errAgg, err)
+				continue
+			}
+			rulesetResult.RuleResults = append(rulesetResult.RuleResults, ruleResult)
+		}
+		rulesetResults = append(rulesetResults, rulesetResult)
+	}
+	if errAgg != nil {
+		return nil, errAgg
+	}
+	return rulesetResults, nil
+}
+
+// ruleResult maps a rule result of the plugin to a diki rule result.
+// Rules which are skipped by the configuration are reported as accepted.
+// Rules with an unknown severity or check status are reported with a single errored check.
+func (p *Provider) ruleResult(rulesetID, rulesetVersion string, r protocol.RuleResult) (rule.RuleResult, error) {
+	rulesetConfig, ok := p.rulesets[rulesetKey(rulesetID, rulesetVersion)]
+	if !ok {
+		return rule.RuleResult{}, fmt.Errorf("plugin returned a result for ruleset with id %s and version %s which is not configured", rulesetID, rulesetVersion)
+	}
+
+	result := rule.RuleResult{
+		RuleID:       r.ID,
+		RuleName:     r.Name,
+		CheckResults: make([]rule.CheckResult, 0, len(r.Checks)),
+		StartTime:    r.StartTime,
+		EndTime:      r.EndTime,
+	}
+
+	severity := rule.SeverityLevel(r.Severity)
+	if len(severity) > 0 && !slices.Contains([]rule.SeverityLevel{rule.SeverityLow, rule.SeverityMedium, rule.SeverityHigh}, severity) {
+		result.CheckResults = []rule.CheckResult{rule.ErroredCheckResult(fmt.Sprintf("plugin reported unknown severity %s", r.Severity), rule.NewTarget())}
+		return result, nil
+	}
+	result.Severity = severity
+
+	for _, opt := range rulesetConfig.RuleOptions {
+		if opt.RuleID == r.ID && opt.Skip != nil && opt.Skip.Enabled {
+			skipRule := rule.NewSkipRule(r.ID, r.Name, opt.Skip.Justification, rule.Accepted, rule.SkipRuleWithSeverity(severity), rule.SkipRuleWithAcceptance(opt.Skip.Owner, opt.Skip.ExpiresAt))
+			skipResult, err := skipRule.Run(context.Background())
+			result.CheckResults = skipResult.CheckResults
+			return result, err
+		}
+	}
+
+	for _, check := range r.Checks {
+		status := rule.Status(check.Status)
+		if !slices.Contains(rule.Statuses(), status) {
+			result.CheckResults = []rule.CheckResult{rule.ErroredCheckResult(fmt.Sprintf("plugin reported unknown status %s", check.Status), rule.NewTarget())}
+			return result, nil
+		}
+		result.CheckResults = append(result.CheckResults, rule.CheckResult{
+			Status:  status,
+			Message: check.Message,
+			Target:  rule.Target(check.Target),
+		})
+	}
+
+	if len(result.CheckResults) == 0 {
+		result.CheckResults = append(result.CheckResults, rule.WarningCheckResult("Rule run did not report any status.", rule.NewTarget()))
+	}
+	return result, nil
+}
+
+// ID returns the id of the Provider.
+func (p *Provider) ID() string {
+	return p.id
+}
+
+// Name returns the name of the Provider.
+func (p *Provider) Name() string {
+	return p.name
+}
+
+// Metadata returns the metadata of the Provider.
+func (p *Provider) Metadata() map[string]string {
+	if p.metadata == nil {
+		p.metadata = map[string]string{}
+	}
+	return p.metadata
+}
+
+// FromGenericConfig creates a Provider from ProviderConfig.
+// The plugin is asked for its description, which has to match the configuration.
+// The name of the Provider defaults to the name of the plugin.
+func FromGenericConfig(providerConf config.ProviderConfig, logger *slog.Logger, fldPath *field.Path) (*Provider, error) {
+	if providerConf.Plugin == nil {
+		return nil, field.Required(fldPath.Child("plugin"), "plugin has to be set")
+	}
+	if logger == nil {
+		logger = slog.Default().With("provider", providerConf.ID)
+	}
+
+	p, err := New(
+		WithID(providerConf.ID),
+		WithName(providerConf.Name),
+		WithMetadata(providerConf.Metadata),
+		WithClient(&protocol.Client{
+			Command: providerConf.Plugin.Command,
+			Args:    providerConf.Plugin.Args,
+			Env:     providerConf.Plugin.Env,
+			Logger:  logger,
+		}),
+		WithArgs(providerConf.Args),
+		WithRulesets(providerConf.Rulesets...),
+		WithLogger(logger),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), describeTimeout)
+	defer cancel()
+	description, err := p.Describe(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if errs := validateDescription(description, providerConf, fldPath); len(errs) > 0 {
+		return nil, errs.ToAggregate()
+	}
+
+	if len(p.name) == 0 {
+		p.name = description.Name
+		p.config.Name = description.Name
+	}
+	return p, nil
+}
+
+// validateDescription checks that the plugin implements the configured provider and rulesets.
+func validateDescription(description protocol.Description, providerConf config.ProviderConfig, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if description.ProtocolVersion != protocol.Version {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("plugin", "command"), providerConf.Plugin.Command, fmt.Sprintf("plugin speaks protocol version %q instead of %s", description.ProtocolVersion, protocol.Version)))
+	}
+	if description.ID != providerConf.ID {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("id"), providerConf.ID, fmt.Sprintf("plugin implements provider with id %s", description.ID)))
+	}
+
+	rulesetIDs := make([]string, 0, len(description.Rulesets))
+	for _, rs := range description.Rulesets {
+		rulesetIDs = append(rulesetIDs, rs.ID)
+	}
+	for rulesetIdx, rulesetConfig := range providerConf.Rulesets {
+		rulesetPath := fldPath.Child("rulesets").Index(rulesetIdx)
+		idx := slices.IndexFunc(description.Rulesets, func(rs protocol.RulesetDescription) bool { return rs.ID == rulesetConfig.ID })
+		if idx < 0 {
+			allErrs = append(allErrs, field.NotSupported(rulesetPath.Child("id"), rulesetConfig.ID, rulesetIDs))
+			continue
+		}
+		if versions := description.Rulesets[idx].Versions; !slices.Contains(versions, rulesetConfig.Version) {
+			allErrs = append(allErrs, field.NotSupported(rulesetPath.Child("version"), rulesetConfig.Version, versions))
+		}
+	}
+	return allErrs
+}
+
+// Logger returns the Provider's logger.
+// If not set it set it to slog.Default().With("provider", p.ID()) then return it.
+func (p *Provider) Logger() sharedprovider.Logger {
+	if p.logger == nil {
+		p.logger = slog.Default().With("provider", p.ID())
+	}
+	return p.logger
+}
+
+func rulesetKey(rulesetID, rulesetVersion string) string {
+	return rulesetID + "--" + rulesetVersion
+}
+
+func toRulesetConfig(conf config.RulesetConfig) (protocol.RulesetConfig, error) {
+	args, err := rawJSON(conf.Args)
+	if err != nil {
+		return protocol.RulesetConfig{}, err
+	}
+
+	rulesetConfig := protocol.RulesetConfig{ID: conf.ID, Name: conf.Name, Version: conf.Version, Args: args}
+	for _, opt := range conf.RuleOptions {
+		ruleArgs, err := rawJSON(opt.Args)
+		if err != nil {
+			return protocol.RulesetConfig{}, err
+		}
+
+		ruleOptions := protocol.RuleOptionsConfig{RuleID: opt.RuleID, Args: ruleArgs}
+		if opt.Skip != nil {
+			ruleOptions.Skip = &protocol.RuleSkipConfig{
+				Enabled:       opt.Skip.Enabled,
+				Justification: opt.Skip.Justification,
+				Owner:         opt.Skip.Owner,
+				ExpiresAt:     opt.Skip.ExpiresAt,
+			}
+		}
+		rulesetConfig.RuleOptions = append(rulesetConfig.RuleOptions, ruleOptions)
+	}
+	return rulesetConfig, nil
+}
+
+// rawJSON encodes the arguments decoded from the diki configuration for the plugin.
+func rawJSON(args any) (json.RawMessage, error) {
+	if args == nil {
+		return nil, nil
+	}
+	return json.Marshal(args)
+}
+
+func orTime(t, fallback time.Time) time.Time {
+	if t.IsZero() {
+		return fallback
+	}
+	return t
+}
@@ -0,0 +1,221 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package plugin_test
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"sync"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/diki/pkg/config"
+	"github.com/gardener/diki/pkg/provider/plugin"
+	"github.com/gardener/diki/pkg/rule"
+)
+
+var _ = Describe("plugin", func() {
+	var (
+		ctx          = context.TODO()
+		logger       = slog.New(slog.DiscardHandler)
+		fldPath      = field.NewPath("providers").Index(0)
+		providerConf config.ProviderConfig
+	)
+
+	BeforeEach(func() {
+		providerConf = config.ProviderConfig{
+			ID:       "fake",
+			Metadata: map[string]string{"foo": "bar"},
+			Args:     map[string]any{"foo": "bar"},
+			Plugin: &config.PluginConfig{
+				Command: os.Args[0],
+				Env:     map[string]string{fakePluginEnv: ""},
+			},
+			Rulesets: []config.RulesetConfig{
+				{
+					ID:      "foo",
+					Version: "v1",
+					RuleOptions: []config.RuleOptionsConfig{
+						{RuleID: "3", Skip: &config.RuleOptionSkipConfig{Enabled: true, Justification: "accepted", Owner: "team"}},
+					},
+				},
+			},
+		}
+	})
+
+	statuses := func(rr rule.RuleResult) []rule.Status {
+		var res []rule.Status
+		for _, check := range rr.CheckResults {
+			res = append(res, check.Status)
+		}
+		return res
+	}
+
+	Describe("#New", func() {
+		It("should require an id and a plugin command", func() {
+			_, err := plugin.New()
+
+			Expect(err).To(MatchError("id is not set\nplugin command is not set"))
+		})
+	})
+
+	Describe("#FromGenericConfig", func() {
+		It("should default the name to the name of the plugin", func() {
+			p, err := plugin.FromGenericConfig(providerConf, logger, fldPath)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(p.ID()).To(Equal("fake"))
+			Expect(p.Name()).To(Equal("Fake"))
+			Expect(p.Metadata()).To(Equal(map[string]string{"foo": "bar"}))
+		})
+
+		It("should return an error if the plugin does not implement the configuration", func() {
+			providerConf.ID = "bar"
+			providerConf.Rulesets = append(providerConf.Rulesets,
+				config.RulesetConfig{ID: "foo", Version: "v3"},
+				config.RulesetConfig{ID: "bar", Version: "v1"},
+			)
+
+			_, err := plugin.FromGenericConfig(providerConf, logger, fldPath)
+
+			Expect(err).To(MatchError(ContainSubstring(`providers[0].id: Invalid value: "bar": plugin implements provider with id fake`)))
+			Expect(err).To(MatchError(ContainSubstring(`providers[0].rulesets[1].version: Unsupported value: "v3"`)))
+			Expect(err).To(MatchError(ContainSubstring(`providers[0].rulesets[2].id: Unsupported value: "bar"`)))
+		})
+
+		It("should return an error if the plugin cannot be started", func() {
+			providerConf.Plugin.Command = "/not/existing"
+
+			_, err := plugin.FromGenericConfig(providerConf, logger, fldPath)
+
+			Expect(err).To(MatchError(ContainSubstring("failed to start plugin /not/existing")))
+		})
+	})
+
+	Describe("#RunAll", func() {
+		It("should map the results of the plugin", func() {
+			p, err := plugin.FromGenericConfig(providerConf, logger, fldPath)
+			Expect(err).NotTo(HaveOccurred())
+
+			var (
+				mu       sync.Mutex
+				started  []string
+				finished []rule.RuleResult
+			)
+			hooksCtx := rule.ContextWithRunHooks(ctx, &rule.RunHooks{
+				OnStart: func(info rule.RunInfo) {
+					mu.Lock()
+					defer mu.Unlock()
+					started = append(started, info.RuleID)
+				},
+				OnFinish: func(_ rule.RunInfo, result rule.RuleResult, err error) {
+					Expect(err).NotTo(HaveOccurred())
+					mu.Lock()
+					defer mu.Unlock()
+					finished = append(finished, result)
+				},
+			})
+
+			res, err := p.RunAll(hooksCtx)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res.ProviderID).To(Equal("fake"))
+			Expect(res.ProviderName).To(Equal("Fake"))
+			Expect(res.Metadata).To(Equal(map[string]string{"foo": "bar"}))
+			Expect(res.RulesetResults).To(HaveLen(1))
+
+			rulesetResult := res.RulesetResults[0]
+			Expect(rulesetResult.RulesetID).To(Equal("foo"))
+			Expect(rulesetResult.RulesetName).To(Equal("Foo"))
+			Expect(rulesetResult.RulesetVersion).To(Equal("v1"))
+			Expect(rulesetResult.RuleResults).To(HaveLen(3))
+
+			Expect(rulesetResult.RuleResults[0].Severity).To(Equal(rule.SeverityHigh))
+			Expect(rulesetResult.RuleResults[0].CheckResults).To(Equal([]rule.CheckResult{{Status: rule.Passed, Message: "bar", Target: rule.NewTarget("name", "bar")}}))
+			Expect(rulesetResult.RuleResults[1].CheckResults).To(Equal([]rule.CheckResult{rule.WarningCheckResult("Rule run did not report any status.", rule.NewTarget())}))
+			Expect(rulesetResult.RuleResults[2].Severity).To(Equal(rule.SeverityLow))
+			Expect(rulesetResult.RuleResults[2].CheckResults).To(Equal([]rule.CheckResult{{
+				Status:     rule.Accepted,
+				Message:    "accepted",
+				Acceptance: &rule.Acceptance{Source: rule.AcceptanceSourceSkipConfig, Owner: "team"},
+			}}))
+
+			Expect(started).To(Equal([]string{"1", "2", "3"}))
+			Expect(finished).To(HaveLen(3))
+			Expect(finished[2]).To(Equal(rulesetResult.RuleResults[2]))
+		})
+
+		It("should return the errors of the plugin", func() {
+			p, err := plugin.FromGenericConfig(providerConf, logger, fldPath)
+			Expect(err).NotTo(HaveOccurred())
+			p.Client.Env = map[string]string{fakePluginEnv: "fail"}
+
+			_, err = p.RunAll(ctx)
+
+			Expect(err).To(MatchError(ContainSubstring("foo failed")))
+		})
+
+		It("should report errored checks for rules with unknown statuses", func() {
+			providerConf.Rulesets[0].RuleOptions = nil
+			p, err := plugin.FromGenericConfig(providerConf, logger, fldPath)
+			Expect(err).NotTo(HaveOccurred())
+			p.Client.Env = map[string]string{fakePluginEnv: "invalid-status"}
+
+			res, err := p.RunAll(ctx)
+			Expect(err).NotTo(HaveOccurred())
+
+			ruleResults := res.RulesetResults[0].RuleResults
+			Expect(ruleResults).To(HaveLen(3))
+			Expect(ruleResults[0].CheckResults).To(Equal([]rule.CheckResult{{Status: rule.Passed, Message: "bar", Target: rule.NewTarget("name", "bar")}}))
+			Expect(ruleResults[2].RuleID).To(Equal("3"))
+			Expect(ruleResults[2].Severity).To(Equal(rule.SeverityLow))
+			Expect(ruleResults[2].CheckResults).To(Equal([]rule.CheckResult{rule.ErroredCheckResult("plugin reported unknown status Foo", rule.NewTarget())}))
+		})
+
+		It("should report errored checks for rules with unknown severities", func() {
+			p, err := plugin.FromGenericConfig(providerConf, logger, fldPath)
+			Expect(err).NotTo(HaveOccurred())
+			p.Client.Env = map[string]string{fakePluginEnv: "invalid-severity"}
+
+			res, err := p.RunAll(ctx)
+			Expect(err).NotTo(HaveOccurred())
+
+			ruleResults := res.RulesetResults[0].RuleResults
+			Expect(ruleResults).To(HaveLen(3))
+			Expect(ruleResults[0].RuleID).To(Equal("1"))
+			Expect(ruleResults[0].Severity).To(BeEmpty())
+			Expect(ruleResults[0].CheckResults).To(Equal([]rule.CheckResult{rule.ErroredCheckResult("plugin reported unknown severity Critical", rule.NewTarget())}))
+			Expect(ruleResults[1].CheckResults).To(Equal([]rule.CheckResult{rule.WarningCheckResult("Rule run did not report any status.", rule.NewTarget())}))
+		})
+	})
+
+	Describe("#RunRuleset", func() {
+		It("should return an error for rulesets which are not configured", func() {
+			p, err := plugin.FromGenericConfig(providerConf, logger, fldPath)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = p.RunRuleset(ctx, "foo", "v2")
+
+			Expect(err).To(MatchError("ruleset with id foo and version v2 does not exist"))
+		})
+	})
+
+	Describe("#RunRule", func() {
+		It("should return the result of the rule", func() {
+			p, err := plugin.FromGenericConfig(providerConf, logger, fldPath)
+			Expect(err).NotTo(HaveOccurred())
+
+			res, err := p.RunRule(ctx, "foo", "v1", "1")
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res.RuleID).To(Equal("1"))
+			Expect(res.RuleName).To(Equal("One"))
+			Expect(statuses(res)).To(Equal([]rule.Status{rule.Passed}))
+		})
+	})
+})
@@ -6,11 +6,10 @@ package provider
 
 import (
 	"context"
+	"errors"
+	"io"
+	"time"
 
-	"k8s.io/apimachinery/pkg/util/validation/field"
-
-	"github.com/gardener/diki/pkg/config"
-	"github.com/gardener/diki/pkg/metadata"
 	"github.com/gardener/diki/pkg/rule"
 	"github.com/gardener/diki/pkg/ruleset"
 )
@@ -31,16 +30,32 @@ type ProviderResult struct {
 	ProviderName   string
 	Metadata       map[string]string
 	RulesetResults []ruleset.RulesetResult
+	// StartTime and EndTime are the times at which the provider run started and finished.
+	StartTime, EndTime time.Time
 }
 
-// ProviderFromConfigFunc constructs a Provider from ProviderConfig.
-type ProviderFromConfigFunc func(conf config.ProviderConfig, fldPath *field.Path) (Provider, error)
+// ClusterVersioner is implemented by providers which check Kubernetes clusters.
+type ClusterVersioner interface {
+	// ClusterVersions returns the Kubernetes server versions of the checked clusters by cluster name.
+	ClusterVersions(ctx context.Context) (map[string]string, error)
+}
 
-// MetadataFunc constructs a detailed Provider metadata object.
-type MetadataFunc func() metadata.ProviderDetailed
+// MultiTargetProvider is implemented by providers which check several targets in one run, e.g. many shoot clusters.
+// The results of the targets are reported separately and are distinguished by their metadata.
+type MultiTargetProvider interface {
+	// RunAllPerTarget executes all Rulesets for every target and returns one result per target.
+	RunAllPerTarget(ctx context.Context) ([]ProviderResult, error)
+	// RunRulesetPerTarget executes all Rules of a known Ruleset for every target and returns one result per target.
+	RunRulesetPerTarget(ctx context.Context, rulesetID, rulesetVersion string) ([]ProviderResult, error)
+}
 
-// ProviderOption constructs a pair of a configuration and metadata function for a specific provider.
-type ProviderOption struct {
-	ProviderFromConfigFunc
-	MetadataFunc
+// Close closes the providers which implement [io.Closer], e.g. to remove the temporary files of their cluster credentials.
+func Close(providers ...Provider) error {
+	var err error
+	for _, p := range providers {
+		if closer, ok := p.(io.Closer); ok {
+			err = errors.Join(err, closer.Close())
+		}
+	}
+	return err
 }
@@ -0,0 +1,118 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	apiserverv1beta1 "k8s.io/apiserver/pkg/apis/apiserver/v1beta1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/diki/pkg/internal/utils"
+	"github.com/gardener/diki/pkg/kubernetes/pod"
+	kubeutils "github.com/gardener/diki/pkg/kubernetes/utils"
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/shared/provider"
+	sharedrules "github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/rules"
+)
+
+var (
+	_ rule.Rule     = &Rule242382{}
+	_ rule.Severity = &Rule242382{}
+)
+
+type Rule242382 struct {
+	InstanceID string
+	Client     client.Client
+	PodContext pod.PodContext
+	Logger     provider.Logger
+}
+
+func (r *Rule242382) ID() string {
+	return sharedrules.ID242382
+}
+
+func (r *Rule242382) Name() string {
+	return "The Kubernetes API Server must enable Node,RBAC as the authorization mode."
+}
+
+func (r *Rule242382) Severity() rule.SeverityLevel {
+	return rule.SeverityMedium
+}
+
+func (r *Rule242382) Run(ctx context.Context) (rule.RuleResult, error) {
+	const (
+		authorizationModeOpt   = "authorization-mode"
+		authorizationConfigOpt = "authorization-config"
+	)
+	expectedStartModes := []string{"Node", "RBAC"}
+
+	runner, err := newOpsPodRunner(r.ID(), r.InstanceID, r.Client, r.PodContext, r.Logger)
+	if err != nil {
+		return rule.RuleResult{}, err
+	}
+
+	checkResults := checkStaticPods(ctx, r.Client, kubeAPIServer, func(instance kubeutils.ComponentInstance, target rule.Target) []rule.CheckResult {
+		authzConfigOptSlice := instance.FlagValues(authorizationConfigOpt)
+		switch {
+		case len(authzConfigOptSlice) > 1:
+			return []rule.CheckResult{rule.WarningCheckResult(fmt.Sprintf("Option %s has been set more than once in container command.", authorizationConfigOpt), target)}
+		case len(authzConfigOptSlice) == 1 && strings.TrimSpace(authzConfigOptSlice[0]) == "":
+			return []rule.CheckResult{rule.FailedCheckResult(fmt.Sprintf("Option %s is empty.", authorizationConfigOpt), target)}
+		case len(authzConfigOptSlice) == 1:
+			return r.checkAuthzConfig(ctx, runner, instance, authzConfigOptSlice[0], expectedStartModes, target)
+		}
+
+		authzModeOptSlice := instance.FlagValues(authorizationModeOpt)
+		// option defaults to not allowed value AlwaysAllow
+		switch {
+		case len(authzModeOptSlice) == 0:
+			return []rule.CheckResult{rule.FailedCheckResult(fmt.Sprintf("Option %s has not been set.", authorizationModeOpt), target)}
+		case len(authzModeOptSlice) > 1:
+			return []rule.CheckResult{rule.WarningCheckResult(fmt.Sprintf("Option %s has been set more than once in container command.", authorizationModeOpt), target)}
+		case slices.Contains(strings.Split(authzModeOptSlice[0], ","), "AlwaysAllow"):
+			return []rule.CheckResult{rule.FailedCheckResult(fmt.Sprintf("Option %s set to not allowed value.", authorizationModeOpt), target)}
+		case utils.StartsWith(strings.Split(authzModeOptSlice[0], ","), expectedStartModes...):
+			return []rule.CheckResult{rule.PassedCheckResult(fmt.Sprintf("Option %s set to expected value.", authorizationModeOpt), target)}
+		default:
+			return []rule.CheckResult{rule.FailedCheckResult(fmt.Sprintf("Option %s set to not expected value.", authorizationModeOpt), target)}
+		}
+	})
+	return rule.Result(r, checkResults...), nil
+}
+
+// checkAuthzConfig checks the authorization configuration file of a kube-apiserver static pod,
+// which is read from the node of the pod.
+func (r *Rule242382) checkAuthzConfig(ctx context.Context, runner opsPodRunner, instance kubeutils.ComponentInstance, filePath string, expectedModes []string, target rule.Target) []rule.CheckResult {
+	return runner.run(ctx, instance.NodeName, func(podExecutor pod.PodExecutor, _ string, execPodTarget rule.Target) []rule.CheckResult {
+		authorizationConfigByteSlice, err := readHostFile(ctx, podExecutor, instance, filePath)
+		if err != nil {
+			return []rule.CheckResult{rule.ErroredCheckResult(err.Error(), execPodTarget)}
+		}
+
+		authorizationConfig := apiserverv1beta1.AuthorizationConfiguration{}
+		if _, _, err := serializer.NewCodecFactory(scheme.Scheme).UniversalDeserializer().Decode(authorizationConfigByteSlice, nil, &authorizationConfig); err != nil {
+			return []rule.CheckResult{rule.ErroredCheckResult(err.Error(), target)}
+		}
+
+		var modes []string
+		for _, authorizer := range authorizationConfig.Authorizers {
+			if authorizer.Type == "AlwaysAllow" {
+				return []rule.CheckResult{rule.FailedCheckResult("AuthorizationConfiguration has not allowed mode type set.", target)}
+			}
+			modes = append(modes, authorizer.Type)
+		}
+
+		if utils.StartsWith(modes, expectedModes...) {
+			return []rule.CheckResult{rule.PassedCheckResult("AuthorizationConfiguration has expected start mode types set.", target)}
+		}
+		return []rule.CheckResult{rule.FailedCheckResult("AuthorizationConfiguration does not have expected start mode types set.", target)}
+	})
+}
@@ -0,0 +1,202 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rules_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	fakestrgen "github.com/gardener/diki/pkg/internal/stringgen/fake"
+	fakepod "github.com/gardener/diki/pkg/kubernetes/pod/fake"
+	"github.com/gardener/diki/pkg/provider/selfmanagedk8s/ruleset/disak8sstig/rules"
+	"github.com/gardener/diki/pkg/rule"
+	sharedrules "github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/rules"
+)
+
+var _ = Describe("#242382", func() {
+	const (
+		conformantConfig = `apiVersion: apiserver.config.k8s.io/v1beta1
+kind: AuthorizationConfiguration
+authorizers:
+- type: Node
+  name: node
+- type: RBAC
+  name: rbac
+`
+		nonConformantConfig = `apiVersion: apiserver.config.k8s.io/v1beta1
+kind: AuthorizationConfiguration
+authorizers:
+- type: RBAC
+  name: rbac
+- type: Node
+  name: node
+`
+		alwaysAllowConfig = `apiVersion: apiserver.config.k8s.io/v1beta1
+kind: AuthorizationConfiguration
+authorizers:
+- type: Node
+  name: node
+- type: RBAC
+  name: rbac
+- type: AlwaysAllow
+  name: always-allow
+`
+	)
+
+	var (
+		fakeClient    client.Client
+		ctx           = context.TODO()
+		kapiPod       *corev1.Pod
+		dikiPod       *corev1.Pod
+		target        = rule.NewTarget("name", "kube-apiserver-node01", "namespace", "kube-system", "kind", "Pod")
+		execPodTarget = rule.NewTarget("name", "diki-242382-aaaaaaaaaa", "namespace", "kube-system", "kind", "Pod")
+	)
+
+	BeforeEach(func() {
+		sharedrules.Generator = &fakestrgen.FakeRandString{Rune: 'a'}
+		fakeClient = fakeclient.NewClientBuilder().Build()
+
+		kapiPod = &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "kube-apiserver-node01",
+				Namespace: "kube-system",
+				Labels: map[string]string{
+					"component": "kube-apiserver",
+					"tier":      "control-plane",
+				},
+				Annotations: map[string]string{
+					corev1.MirrorPodAnnotationKey: "foo",
+				},
+			},
+			Spec: corev1.PodSpec{
+				NodeName: "node01",
+				Containers: []corev1.Container{
+					{
+						Name: "kube-apiserver",
+						VolumeMounts: []corev1.VolumeMount{
+							{
+								Name:      "authz",
+								MountPath: "/etc/kubernetes/authz",
+							},
+						},
+					},
+				},
+				Volumes: []corev1.Volume{
+					{
+						Name: "authz",
+						VolumeSource: corev1.VolumeSource{
+							HostPath: &corev1.HostPathVolumeSource{
+								Path: "/etc/kubernetes/authorization",
+							},
+						},
+					},
+				},
+			},
+		}
+
+		dikiPod = &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "diki-242382-aaaaaaaaaa",
+				Namespace: "kube-system",
+			},
+			Status: corev1.PodStatus{
+				ContainerStatuses: []corev1.ContainerStatus{
+					{
+						Name:        "test",
+						ContainerID: "containerd://bar",
+					},
+				},
+			},
+		}
+	})
+
+	DescribeTable("Run cases",
+		func(command []string, executeReturnString [][]string, executeReturnError [][]error, expectedCheckResults []rule.CheckResult) {
+			kapiPod.Spec.Containers[0].Command = command
+			Expect(fakeClient.Create(ctx, kapiPod)).To(Succeed())
+			Expect(fakeClient.Create(ctx, dikiPod)).To(Succeed())
+
+			r := &rules.Rule242382{
+				Logger:     testLogger,
+				Client:     fakeClient,
+				PodContext: fakepod.NewFakeSimplePodContext(executeReturnString, executeReturnError),
+			}
+
+			ruleResult, err := r.Run(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ruleResult.CheckResults).To(Equal(expectedCheckResults))
+		},
+		Entry("should fail when the authorization mode is not set",
+			[]string{"kube-apiserver"},
+			[][]string{},
+			[][]error{},
+			[]rule.CheckResult{
+				rule.FailedCheckResult("Option authorization-mode has not been set.", target),
+			}),
+		Entry("should pass when the authorization mode starts with Node,RBAC",
+			[]string{"kube-apiserver", "--authorization-mode=Node,RBAC,Webhook"},
+			[][]string{},
+			[][]error{},
+			[]rule.CheckResult{
+				rule.PassedCheckResult("Option authorization-mode set to expected value.", target),
+			}),
+		Entry("should fail when the authorization mode contains AlwaysAllow",
+			[]string{"kube-apiserver", "--authorization-mode=Node,RBAC,AlwaysAllow"},
+			[][]string{},
+			[][]error{},
+			[]rule.CheckResult{
+				rule.FailedCheckResult("Option authorization-mode set to not allowed value.", target),
+			}),
+		Entry("should fail when the authorization mode does not start with Node,RBAC",
+			[]string{"kube-apiserver", "--authorization-mode=RBAC,Node"},
+			[][]string{},
+			[][]error{},
+			[]rule.CheckResult{
+				rule.FailedCheckResult("Option authorization-mode set to not expected value.", target),
+			}),
+		Entry("should fail when the authorization config is empty",
+			[]string{"kube-apiserver", "--authorization-config= "},
+			[][]string{},
+			[][]error{},
+			[]rule.CheckResult{
+				rule.FailedCheckResult("Option authorization-config is empty.", target),
+			}),
+		Entry("should pass when the authorization config has the expected modes",
+			[]string{"kube-apiserver", "--authorization-config=/etc/kubernetes/authz/config.yaml", "--authorization-mode=AlwaysAllow"},
+			[][]string{{conformantConfig}},
+			[][]error{{nil}},
+			[]rule.CheckResult{
+				rule.PassedCheckResult("AuthorizationConfiguration has expected start mode types set.", target),
+			}),
+		Entry("should fail when the authorization config does not start with the expected modes",
+			[]string{"kube-apiserver", "--authorization-config=/etc/kubernetes/authz/config.yaml"},
+			[][]string{{nonConformantConfig}},
+			[][]error{{nil}},
+			[]rule.CheckResult{
+				rule.FailedCheckResult("AuthorizationConfiguration does not have expected start mode types set.", target),
+			}),
+		Entry("should fail when the authorization config contains AlwaysAllow",
+			[]string{"kube-apiserver", "--authorization-config=/etc/kubernetes/authz/config.yaml"},
+			[][]string{{alwaysAllowConfig}},
+			[][]error{{nil}},
+			[]rule.CheckResult{
+				rule.FailedCheckResult("AuthorizationConfiguration has not allowed mode type set.", target),
+			}),
+		Entry("should error when the authorization config cannot be read",
+			[]string{"kube-apiserver", "--authorization-config=/etc/kubernetes/authz/config.yaml"},
+			[][]string{{""}},
+			[][]error{{errors.New("foo")}},
+			[]rule.CheckResult{
+				rule.ErroredCheckResult("foo", execPodTarget),
+			}),
+	)
+})
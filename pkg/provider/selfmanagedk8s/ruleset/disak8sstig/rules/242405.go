@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	intutils "github.com/gardener/diki/pkg/internal/utils"
+	"github.com/gardener/diki/pkg/kubernetes/pod"
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/shared/provider"
+	"github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/option"
+	sharedrules "github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/rules"
+)
+
+var (
+	_ rule.Rule     = &Rule242405{}
+	_ rule.Severity = &Rule242405{}
+)
+
+type Rule242405 struct {
+	InstanceID string
+	Client     client.Client
+	PodContext pod.PodContext
+	Options    *option.FileOwnerOptions
+	Logger     provider.Logger
+}
+
+func (r *Rule242405) ID() string {
+	return sharedrules.ID242405
+}
+
+func (r *Rule242405) Name() string {
+	return "Kubernetes manifests must be owned by root."
+}
+
+func (r *Rule242405) Severity() rule.SeverityLevel {
+	return rule.SeverityMedium
+}
+
+func (r *Rule242405) Run(ctx context.Context) (rule.RuleResult, error) {
+	runner, err := newOpsPodRunner(r.ID(), r.InstanceID, r.Client, r.PodContext, r.Logger)
+	if err != nil {
+		return rule.RuleResult{}, err
+	}
+
+	users, groups := r.Options.Owners()
+	pods, checkResults := getComponentsStaticPods(ctx, r.Client, kubeAPIServer, kubeControllerManager, kubeScheduler, etcd)
+	if len(pods) == 0 {
+		return rule.Result(r, checkResults...), nil
+	}
+
+	// the manifests are checked on the nodes of the found static pods, so missing components are not reported
+	checkResults = checkStaticPodManifests(ctx, runner, pods, func(fileStat intutils.FileStats, target rule.Target) []rule.CheckResult {
+		return intutils.MatchFileOwnersCases(fileStat, users, groups, target)
+	})
+	return rule.Result(r, checkResults...), nil
+}
@@ -0,0 +1,144 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rules_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	fakestrgen "github.com/gardener/diki/pkg/internal/stringgen/fake"
+	fakepod "github.com/gardener/diki/pkg/kubernetes/pod/fake"
+	"github.com/gardener/diki/pkg/provider/selfmanagedk8s/ruleset/disak8sstig/rules"
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/option"
+	sharedrules "github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/rules"
+)
+
+var _ = Describe("#242405", func() {
+	const (
+		kubeletPID     = "1\n"
+		kubeletCommand = "/usr/bin/kubelet --kubeconfig=/etc/kubernetes/kubelet.conf --config=/var/lib/kubelet/config.yaml"
+		kubeletConfig  = "staticPodPath: /etc/kubernetes/manifests\n"
+		manifestStats  = "600\t0\t0\tregular file\t/etc/kubernetes/manifests/etcd.yaml\n600\t1000\t0\tregular file\t/etc/kubernetes/manifests/kube-apiserver.yaml\n"
+	)
+
+	var (
+		fakeClient    client.Client
+		ctx           = context.TODO()
+		kapiPod       *corev1.Pod
+		dikiPod       *corev1.Pod
+		nodeTarget    = rule.NewTarget("name", "node01", "kind", "Node")
+		execPodTarget = rule.NewTarget("name", "diki-242405-aaaaaaaaaa", "namespace", "kube-system", "kind", "Pod")
+	)
+
+	BeforeEach(func() {
+		sharedrules.Generator = &fakestrgen.FakeRandString{Rune: 'a'}
+		fakeClient = fakeclient.NewClientBuilder().Build()
+
+		kapiPod = &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "kube-apiserver-node01",
+				Namespace: "kube-system",
+				Labels: map[string]string{
+					"component": "kube-apiserver",
+					"tier":      "control-plane",
+				},
+				Annotations: map[string]string{
+					corev1.MirrorPodAnnotationKey: "foo",
+				},
+			},
+			Spec: corev1.PodSpec{
+				NodeName: "node01",
+				Containers: []corev1.Container{
+					{
+						Name: "kube-apiserver",
+					},
+				},
+			},
+		}
+
+		dikiPod = &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "diki-242405-aaaaaaaaaa",
+				Namespace: "kube-system",
+			},
+			Status: corev1.PodStatus{
+				ContainerStatuses: []corev1.ContainerStatus{
+					{
+						Name:        "test",
+						ContainerID: "containerd://bar",
+					},
+				},
+			},
+		}
+	})
+
+	DescribeTable("Run cases",
+		func(options *option.FileOwnerOptions, executeReturnString [][]string, executeReturnError [][]error, expectedCheckResults []rule.CheckResult) {
+			Expect(fakeClient.Create(ctx, kapiPod)).To(Succeed())
+			Expect(fakeClient.Create(ctx, dikiPod)).To(Succeed())
+
+			r := &rules.Rule242405{
+				Logger:     testLogger,
+				Client:     fakeClient,
+				PodContext: fakepod.NewFakeSimplePodContext(executeReturnString, executeReturnError),
+				Options:    options,
+			}
+
+			ruleResult, err := r.Run(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ruleResult.CheckResults).To(Equal(expectedCheckResults))
+		},
+		Entry("should pass when the manifests are owned by root",
+			nil,
+			[][]string{{kubeletPID, kubeletCommand, kubeletConfig, manifestStats}},
+			[][]error{{nil, nil, nil, nil}},
+			[]rule.CheckResult{
+				rule.PassedCheckResult("File has expected owners", nodeTarget.With("details", "fileName: /etc/kubernetes/manifests/etcd.yaml, ownerUser: 0, ownerGroup: 0")),
+				rule.FailedCheckResult("File has unexpected owner user", nodeTarget.With("details", "fileName: /etc/kubernetes/manifests/kube-apiserver.yaml, ownerUser: 1000, expectedOwnerUsers: [0]")),
+			}),
+		Entry("should use the expected file owners from the options",
+			&option.FileOwnerOptions{
+				ExpectedFileOwner: option.ExpectedOwner{
+					Users:  []string{"0", "1000"},
+					Groups: []string{"0"},
+				},
+			},
+			[][]string{{kubeletPID, kubeletCommand, kubeletConfig, manifestStats}},
+			[][]error{{nil, nil, nil, nil}},
+			[]rule.CheckResult{
+				rule.PassedCheckResult("File has expected owners", nodeTarget.With("details", "fileName: /etc/kubernetes/manifests/etcd.yaml, ownerUser: 0, ownerGroup: 0")),
+				rule.PassedCheckResult("File has expected owners", nodeTarget.With("details", "fileName: /etc/kubernetes/manifests/kube-apiserver.yaml, ownerUser: 1000, ownerGroup: 0")),
+			}),
+		Entry("should error when no manifests are found",
+			nil,
+			[][]string{{kubeletPID, kubeletCommand, kubeletConfig, "", "0\n"}},
+			[][]error{{nil, nil, nil, nil, nil}},
+			[]rule.CheckResult{
+				rule.ErroredCheckResult("no static pod manifests found", nodeTarget.With("directory", "/etc/kubernetes/manifests")),
+			}),
+		Entry("should error when the kubelet config cannot be read",
+			nil,
+			[][]string{{kubeletPID, kubeletCommand, ""}},
+			[][]error{{nil, nil, errors.New("foo")}},
+			[]rule.CheckResult{
+				rule.ErroredCheckResult("could not retrieve kubelet config: foo", execPodTarget),
+			}),
+		Entry("should error when the kubelet is not running",
+			nil,
+			[][]string{{"0\n"}},
+			[][]error{{nil}},
+			[]rule.CheckResult{
+				rule.ErroredCheckResult("kubelet service is not running", execPodTarget),
+			}),
+	)
+})
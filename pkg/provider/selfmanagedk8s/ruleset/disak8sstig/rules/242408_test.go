@@ -0,0 +1,150 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rules_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	fakestrgen "github.com/gardener/diki/pkg/internal/stringgen/fake"
+	fakepod "github.com/gardener/diki/pkg/kubernetes/pod/fake"
+	"github.com/gardener/diki/pkg/provider/selfmanagedk8s/ruleset/disak8sstig/rules"
+	"github.com/gardener/diki/pkg/rule"
+	sharedrules "github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/rules"
+)
+
+var _ = Describe("#242408", func() {
+	const (
+		kubeletPID               = "1\n"
+		kubeletCommand           = "/usr/bin/kubelet --kubeconfig=/etc/kubernetes/kubelet.conf --config=/var/lib/kubelet/config.yaml"
+		kubeletConfig            = "staticPodPath: /etc/kubernetes/manifests\n"
+		customKubeletConfig      = "staticPodPath: /etc/kubernetes/static-pods\n"
+		compliantManifestStats   = "600\t0\t0\tregular file\t/etc/kubernetes/manifests/etcd.yaml\n600\t0\t0\tregular file\t/etc/kubernetes/manifests/kube-apiserver.yaml\n"
+		nonCompliantManifestStat = "664\t0\t0\tregular file\t/etc/kubernetes/static-pods/kube-apiserver.yaml\n"
+	)
+
+	var (
+		fakeClient    client.Client
+		ctx           = context.TODO()
+		kapiPod       *corev1.Pod
+		dikiPod       *corev1.Pod
+		nodeTarget    = rule.NewTarget("name", "node01", "kind", "Node")
+		execPodTarget = rule.NewTarget("name", "diki-242408-aaaaaaaaaa", "namespace", "kube-system", "kind", "Pod")
+	)
+
+	BeforeEach(func() {
+		sharedrules.Generator = &fakestrgen.FakeRandString{Rune: 'a'}
+		fakeClient = fakeclient.NewClientBuilder().Build()
+
+		kapiPod = &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "kube-apiserver-node01",
+				Namespace: "kube-system",
+				Labels: map[string]string{
+					"component": "kube-apiserver",
+					"tier":      "control-plane",
+				},
+				Annotations: map[string]string{
+					corev1.MirrorPodAnnotationKey: "foo",
+				},
+			},
+			Spec: corev1.PodSpec{
+				NodeName: "node01",
+				Containers: []corev1.Container{
+					{
+						Name: "kube-apiserver",
+					},
+				},
+			},
+		}
+
+		dikiPod = &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "diki-242408-aaaaaaaaaa",
+				Namespace: "kube-system",
+			},
+			Status: corev1.PodStatus{
+				ContainerStatuses: []corev1.ContainerStatus{
+					{
+						Name:        "test",
+						ContainerID: "containerd://bar",
+					},
+				},
+			},
+		}
+	})
+
+	It("should error when no control plane static pods are found", func() {
+		r := &rules.Rule242408{
+			Logger:     testLogger,
+			Client:     fakeClient,
+			PodContext: fakepod.NewFakeSimplePodContext([][]string{}, [][]error{}),
+		}
+
+		ruleResult, err := r.Run(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ruleResult.CheckResults).To(Equal([]rule.CheckResult{
+			rule.ErroredCheckResult("pods not found", rule.NewTarget("namespace", "kube-system", "selector", "component=kube-apiserver,tier=control-plane")),
+			rule.ErroredCheckResult("pods not found", rule.NewTarget("namespace", "kube-system", "selector", "component=kube-controller-manager,tier=control-plane")),
+			rule.ErroredCheckResult("pods not found", rule.NewTarget("namespace", "kube-system", "selector", "component=kube-scheduler,tier=control-plane")),
+			rule.ErroredCheckResult("pods not found", rule.NewTarget("namespace", "kube-system", "selector", "component=etcd,tier=control-plane")),
+		}))
+	})
+
+	DescribeTable("Run cases",
+		func(executeReturnString [][]string, executeReturnError [][]error, expectedCheckResults []rule.CheckResult) {
+			Expect(fakeClient.Create(ctx, kapiPod)).To(Succeed())
+			Expect(fakeClient.Create(ctx, dikiPod)).To(Succeed())
+
+			r := &rules.Rule242408{
+				Logger:     testLogger,
+				Client:     fakeClient,
+				PodContext: fakepod.NewFakeSimplePodContext(executeReturnString, executeReturnError),
+			}
+
+			ruleResult, err := r.Run(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ruleResult.CheckResults).To(Equal(expectedCheckResults))
+		},
+		Entry("should pass when the manifests have expected permissions",
+			[][]string{{kubeletPID, kubeletCommand, kubeletConfig, compliantManifestStats}},
+			[][]error{{nil, nil, nil, nil}},
+			[]rule.CheckResult{
+				rule.PassedCheckResult("File has expected permissions", nodeTarget.With("details", "fileName: /etc/kubernetes/manifests/etcd.yaml, permissions: 600")),
+				rule.PassedCheckResult("File has expected permissions", nodeTarget.With("details", "fileName: /etc/kubernetes/manifests/kube-apiserver.yaml, permissions: 600")),
+			}),
+		Entry("should check the static pod path of the kubelet",
+			[][]string{{kubeletPID, kubeletCommand, customKubeletConfig, nonCompliantManifestStat}},
+			[][]error{{nil, nil, nil, nil}},
+			[]rule.CheckResult{
+				rule.FailedCheckResult("File has too wide permissions", nodeTarget.With("details", "fileName: /etc/kubernetes/static-pods/kube-apiserver.yaml, permissions: 664, expectedPermissionsMax: 644")),
+			}),
+		Entry("should error when no manifests are found",
+			[][]string{{kubeletPID, kubeletCommand, kubeletConfig, "", "0\n"}},
+			[][]error{{nil, nil, nil, nil, nil}},
+			[]rule.CheckResult{
+				rule.ErroredCheckResult("no static pod manifests found", nodeTarget.With("directory", "/etc/kubernetes/manifests")),
+			}),
+		Entry("should error when the kubelet config cannot be read",
+			[][]string{{kubeletPID, kubeletCommand, ""}},
+			[][]error{{nil, nil, errors.New("foo")}},
+			[]rule.CheckResult{
+				rule.ErroredCheckResult("could not retrieve kubelet config: foo", execPodTarget),
+			}),
+		Entry("should error when the kubelet is not running",
+			[][]string{{"0\n"}},
+			[][]error{{nil}},
+			[]rule.CheckResult{
+				rule.ErroredCheckResult("kubelet service is not running", execPodTarget),
+			}),
+	)
+})
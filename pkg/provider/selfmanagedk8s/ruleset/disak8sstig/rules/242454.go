@@ -0,0 +1,83 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"context"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	intutils "github.com/gardener/diki/pkg/internal/utils"
+	"github.com/gardener/diki/pkg/kubernetes/pod"
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/shared/provider"
+	"github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/option"
+	sharedrules "github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/rules"
+)
+
+var (
+	_ rule.Rule     = &Rule242454{}
+	_ rule.Severity = &Rule242454{}
+)
+
+type Rule242454 struct {
+	InstanceID string
+	Client     client.Client
+	PodContext pod.PodContext
+	Options    *Options242454
+	Logger     provider.Logger
+}
+
+type Options242454 struct {
+	NodeGroupByLabels []string `json:"nodeGroupByLabels" yaml:"nodeGroupByLabels"`
+	*option.FileOwnerOptions
+}
+
+var _ option.Option = (*Options242454)(nil)
+
+func (o Options242454) Validate(fldPath *field.Path) field.ErrorList {
+	allErrs := option.ValidateLabelNames(o.NodeGroupByLabels, fldPath.Child("nodeGroupByLabels"))
+	if o.FileOwnerOptions != nil {
+		return append(allErrs, o.FileOwnerOptions.Validate(fldPath)...)
+	}
+	return allErrs
+}
+
+func (r *Rule242454) ID() string {
+	return sharedrules.ID242454
+}
+
+func (r *Rule242454) Name() string {
+	return "The Kubernetes kubeadm.conf must be owned by root."
+}
+
+func (r *Rule242454) Severity() rule.SeverityLevel {
+	return rule.SeverityMedium
+}
+
+func (r *Rule242454) Run(ctx context.Context) (rule.RuleResult, error) {
+	var nodeLabels []string
+	if r.Options != nil && r.Options.NodeGroupByLabels != nil {
+		nodeLabels = slices.Clone(r.Options.NodeGroupByLabels)
+	}
+
+	var fileOwnerOptions *option.FileOwnerOptions
+	if r.Options != nil {
+		fileOwnerOptions = r.Options.FileOwnerOptions
+	}
+	users, groups := fileOwnerOptions.Owners()
+
+	runner, err := newOpsPodRunner(r.ID(), r.InstanceID, r.Client, r.PodContext, r.Logger)
+	if err != nil {
+		return rule.RuleResult{}, err
+	}
+
+	checkResults := checkKubeadmConfFiles(ctx, r.Client, runner, nodeLabels, func(fileStat intutils.FileStats, target rule.Target) []rule.CheckResult {
+		return intutils.MatchFileOwnersCases(fileStat, users, groups, target)
+	})
+	return rule.Result(r, checkResults...), nil
+}
@@ -0,0 +1,183 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rules_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	fakestrgen "github.com/gardener/diki/pkg/internal/stringgen/fake"
+	fakepod "github.com/gardener/diki/pkg/kubernetes/pod/fake"
+	"github.com/gardener/diki/pkg/provider/selfmanagedk8s/ruleset/disak8sstig/rules"
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/option"
+	sharedrules "github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/rules"
+)
+
+var _ = Describe("#242454", func() {
+	const (
+		dropInPaths          = "/usr/lib/systemd/system/kubelet.service.d/10-kubeadm.conf /etc/systemd/system/kubelet.service.d/20-foo.conf\n"
+		noKubeadmDropInPaths = "/etc/systemd/system/kubelet.service.d/20-foo.conf\n"
+		rootOwnedStats       = "644\t0\t0\tregular file\t/usr/lib/systemd/system/kubelet.service.d/10-kubeadm.conf\n"
+		nonRootOwnedStats    = "644\t1000\t1000\tregular file\t/usr/lib/systemd/system/kubelet.service.d/10-kubeadm.conf\n"
+	)
+
+	var (
+		fakeClient    client.Client
+		ctx           = context.TODO()
+		node          *corev1.Node
+		dikiPod       *corev1.Pod
+		nodeTarget    = rule.NewTarget("name", "node01", "kind", "Node")
+		execPodTarget = rule.NewTarget("name", "diki-242454-aaaaaaaaaa", "namespace", "kube-system", "kind", "Pod")
+	)
+
+	BeforeEach(func() {
+		sharedrules.Generator = &fakestrgen.FakeRandString{Rune: 'a'}
+		fakeClient = fakeclient.NewClientBuilder().Build()
+
+		node = &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "node01",
+			},
+			Status: corev1.NodeStatus{
+				Allocatable: corev1.ResourceList{
+					"pods": resource.MustParse("100.0"),
+				},
+			},
+		}
+
+		dikiPod = &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "diki-242454-aaaaaaaaaa",
+				Namespace: "kube-system",
+			},
+			Status: corev1.PodStatus{
+				ContainerStatuses: []corev1.ContainerStatus{
+					{
+						Name:        "test",
+						ContainerID: "containerd://bar",
+					},
+				},
+			},
+		}
+	})
+
+	It("should error when no nodes can be selected", func() {
+		r := &rules.Rule242454{
+			Logger:     testLogger,
+			Client:     fakeClient,
+			PodContext: fakepod.NewFakeSimplePodContext([][]string{}, [][]error{}),
+		}
+
+		ruleResult, err := r.Run(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ruleResult.CheckResults).To(Equal([]rule.CheckResult{
+			rule.ErroredCheckResult("no allocatable nodes could be selected", rule.NewTarget()),
+		}))
+	})
+
+	DescribeTable("Run cases",
+		func(options *rules.Options242454, executeReturnString [][]string, executeReturnError [][]error, expectedCheckResults []rule.CheckResult) {
+			Expect(fakeClient.Create(ctx, node)).To(Succeed())
+			Expect(fakeClient.Create(ctx, dikiPod)).To(Succeed())
+
+			r := &rules.Rule242454{
+				Logger:     testLogger,
+				Client:     fakeClient,
+				PodContext: fakepod.NewFakeSimplePodContext(executeReturnString, executeReturnError),
+				Options:    options,
+			}
+
+			ruleResult, err := r.Run(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ruleResult.CheckResults).To(Equal(expectedCheckResults))
+		},
+		Entry("should pass when kubeadm.conf is owned by root",
+			nil,
+			[][]string{{dropInPaths, rootOwnedStats}},
+			[][]error{{nil, nil}},
+			[]rule.CheckResult{
+				rule.PassedCheckResult("File has expected owners", nodeTarget.With("details", "fileName: /usr/lib/systemd/system/kubelet.service.d/10-kubeadm.conf, ownerUser: 0, ownerGroup: 0")),
+			}),
+		Entry("should fail when kubeadm.conf is not owned by root",
+			nil,
+			[][]string{{dropInPaths, nonRootOwnedStats}},
+			[][]error{{nil, nil}},
+			[]rule.CheckResult{
+				rule.FailedCheckResult("File has unexpected owner user", nodeTarget.With("details", "fileName: /usr/lib/systemd/system/kubelet.service.d/10-kubeadm.conf, ownerUser: 1000, expectedOwnerUsers: [0]")),
+				rule.FailedCheckResult("File has unexpected owner group", nodeTarget.With("details", "fileName: /usr/lib/systemd/system/kubelet.service.d/10-kubeadm.conf, ownerGroup: 1000, expectedOwnerGroups: [0]")),
+			}),
+		Entry("should use the expected file owners from the options",
+			&rules.Options242454{
+				FileOwnerOptions: &option.FileOwnerOptions{
+					ExpectedFileOwner: option.ExpectedOwner{
+						Users:  []string{"1000"},
+						Groups: []string{"1000"},
+					},
+				},
+			},
+			[][]string{{dropInPaths, nonRootOwnedStats}},
+			[][]error{{nil, nil}},
+			[]rule.CheckResult{
+				rule.PassedCheckResult("File has expected owners", nodeTarget.With("details", "fileName: /usr/lib/systemd/system/kubelet.service.d/10-kubeadm.conf, ownerUser: 1000, ownerGroup: 1000")),
+			}),
+		Entry("should skip when the kubelet service does not use kubeadm.conf",
+			nil,
+			[][]string{{noKubeadmDropInPaths}},
+			[][]error{{nil}},
+			[]rule.CheckResult{
+				rule.SkippedCheckResult("Kubelet service does not use a kubeadm.conf drop-in file.", nodeTarget),
+			}),
+		Entry("should error when the drop-in files cannot be retrieved",
+			nil,
+			[][]string{{""}},
+			[][]error{{errors.New("foo")}},
+			[]rule.CheckResult{
+				rule.ErroredCheckResult("foo", execPodTarget),
+			}),
+		Entry("should error when kubeadm.conf cannot be found",
+			nil,
+			[][]string{{dropInPaths, ""}},
+			[][]error{{nil, nil}},
+			[]rule.CheckResult{
+				rule.ErroredCheckResult("could not find file /usr/lib/systemd/system/kubelet.service.d/10-kubeadm.conf", execPodTarget),
+			}),
+	)
+
+	Describe("#Validate", func() {
+		It("should not error when the options are correct", func() {
+			options := rules.Options242454{NodeGroupByLabels: []string{"foo", "bar"}}
+
+			Expect(options.Validate(field.NewPath("foo"))).To(BeEmpty())
+		})
+
+		It("should error when the node labels are invalid", func() {
+			options := rules.Options242454{NodeGroupByLabels: []string{"foo?"}}
+
+			Expect(options.Validate(field.NewPath("foo"))).ToNot(BeEmpty())
+		})
+
+		It("should error when the expected file owners are invalid", func() {
+			options := rules.Options242454{
+				FileOwnerOptions: &option.FileOwnerOptions{
+					ExpectedFileOwner: option.ExpectedOwner{
+						Users: []string{"foo"},
+					},
+				},
+			}
+
+			Expect(options.Validate(field.NewPath("foo"))).ToNot(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"context"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	intutils "github.com/gardener/diki/pkg/internal/utils"
+	"github.com/gardener/diki/pkg/kubernetes/pod"
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/shared/provider"
+	"github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/option"
+	sharedrules "github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/rules"
+)
+
+var (
+	_ rule.Rule     = &Rule242455{}
+	_ rule.Severity = &Rule242455{}
+)
+
+type Rule242455 struct {
+	InstanceID string
+	Client     client.Client
+	PodContext pod.PodContext
+	Options    *Options242455
+	Logger     provider.Logger
+}
+
+type Options242455 struct {
+	NodeGroupByLabels []string `json:"nodeGroupByLabels" yaml:"nodeGroupByLabels"`
+}
+
+var _ option.Option = (*Options242455)(nil)
+
+func (o Options242455) Validate(fldPath *field.Path) field.ErrorList {
+	return option.ValidateLabelNames(o.NodeGroupByLabels, fldPath.Child("nodeGroupByLabels"))
+}
+
+func (r *Rule242455) ID() string {
+	return sharedrules.ID242455
+}
+
+func (r *Rule242455) Name() string {
+	return "The Kubernetes kubeadm.conf must have file permissions set to 644 or more restrictive."
+}
+
+func (r *Rule242455) Severity() rule.SeverityLevel {
+	return rule.SeverityMedium
+}
+
+func (r *Rule242455) Run(ctx context.Context) (rule.RuleResult, error) {
+	var nodeLabels []string
+	if r.Options != nil && r.Options.NodeGroupByLabels != nil {
+		nodeLabels = slices.Clone(r.Options.NodeGroupByLabels)
+	}
+
+	runner, err := newOpsPodRunner(r.ID(), r.InstanceID, r.Client, r.PodContext, r.Logger)
+	if err != nil {
+		return rule.RuleResult{}, err
+	}
+
+	checkResults := checkKubeadmConfFiles(ctx, r.Client, runner, nodeLabels, func(fileStat intutils.FileStats, target rule.Target) []rule.CheckResult {
+		return []rule.CheckResult{intutils.MatchFilePermissionsCase(fileStat, "644", target)}
+	})
+	return rule.Result(r, checkResults...), nil
+}
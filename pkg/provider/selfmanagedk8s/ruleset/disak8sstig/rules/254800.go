@@ -0,0 +1,135 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	apiserverv1 "k8s.io/apiserver/pkg/apis/apiserver/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	admissionapiv1 "k8s.io/pod-security-admission/admission/api/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	intkubeutils "github.com/gardener/diki/pkg/internal/kubernetes/utils"
+	"github.com/gardener/diki/pkg/kubernetes/pod"
+	kubeutils "github.com/gardener/diki/pkg/kubernetes/utils"
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/shared/provider"
+	sharedrules "github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/rules"
+)
+
+var (
+	_ rule.Rule     = &Rule254800{}
+	_ rule.Severity = &Rule254800{}
+)
+
+type Rule254800 struct {
+	InstanceID string
+	Client     client.Client
+	PodContext pod.PodContext
+	Options    *sharedrules.Options254800
+	Logger     provider.Logger
+}
+
+func (r *Rule254800) ID() string {
+	return sharedrules.ID254800
+}
+
+func (r *Rule254800) Name() string {
+	return "Kubernetes must have a Pod Security Admission control file configured."
+}
+
+func (r *Rule254800) Severity() rule.SeverityLevel {
+	return rule.SeverityHigh
+}
+
+func (r *Rule254800) Run(ctx context.Context) (rule.RuleResult, error) {
+	const option = "admission-control-config-file"
+
+	runner, err := newOpsPodRunner(r.ID(), r.InstanceID, r.Client, r.PodContext, r.Logger)
+	if err != nil {
+		return rule.RuleResult{}, err
+	}
+	options := cmp.Or(r.Options, &sharedrules.Options254800{MinPodSecurityStandardsProfile: intkubeutils.PSSProfileBaseline})
+
+	checkResults := checkStaticPods(ctx, r.Client, kubeAPIServer, func(instance kubeutils.ComponentInstance, target rule.Target) []rule.CheckResult {
+		optSlice := instance.FlagValues(option)
+		switch {
+		case len(optSlice) == 0:
+			return []rule.CheckResult{rule.WarningCheckResult(fmt.Sprintf("Option %s has not been set.", option), target)}
+		case len(optSlice) > 1:
+			return []rule.CheckResult{rule.WarningCheckResult(fmt.Sprintf("Option %s has been set more than once in container command.", option), target)}
+		}
+
+		return runner.run(ctx, instance.NodeName, func(podExecutor pod.PodExecutor, _ string, execPodTarget rule.Target) []rule.CheckResult {
+			admissionConfigByteSlice, err := readHostFile(ctx, podExecutor, instance, optSlice[0])
+			if err != nil {
+				return []rule.CheckResult{rule.ErroredCheckResult(err.Error(), execPodTarget)}
+			}
+
+			admissionConfig := apiserverv1.AdmissionConfiguration{}
+			if _, _, err := serializer.NewCodecFactory(scheme.Scheme).UniversalDeserializer().Decode(admissionConfigByteSlice, nil, &admissionConfig); err != nil {
+				return []rule.CheckResult{rule.ErroredCheckResult(err.Error(), target)}
+			}
+
+			for _, plugin := range admissionConfig.Plugins {
+				if plugin.Name != "PodSecurity" {
+					continue
+				}
+
+				var podSecurityConfigByteSlice []byte
+				switch {
+				case plugin.Configuration != nil:
+					podSecurityConfigByteSlice = plugin.Configuration.Raw
+				case strings.TrimSpace(plugin.Path) != "":
+					podSecurityConfigByteSlice, err = readHostFile(ctx, podExecutor, instance, plugin.Path)
+					if err != nil {
+						return []rule.CheckResult{rule.ErroredCheckResult(err.Error(), execPodTarget)}
+					}
+				default:
+					continue
+				}
+
+				podSecurityConfig := admissionapiv1.PodSecurityConfiguration{}
+				if _, _, err := serializer.NewCodecFactory(scheme.Scheme).UniversalDeserializer().Decode(podSecurityConfigByteSlice, nil, &podSecurityConfig); err != nil {
+					return []rule.CheckResult{rule.FailedCheckResult(err.Error(), target)}
+				}
+				return r.checkPrivilegeLevel(podSecurityConfig, options, target)
+			}
+
+			return []rule.CheckResult{rule.FailedCheckResult("PodSecurity is not configured", target)}
+		})
+	})
+	return rule.Result(r, checkResults...), nil
+}
+
+func (r *Rule254800) checkPrivilegeLevel(podSecurityConfig admissionapiv1.PodSecurityConfiguration, options *sharedrules.Options254800, target rule.Target) []rule.CheckResult {
+	var (
+		checkResults []rule.CheckResult
+		modes        = []struct {
+			name    string
+			profile string
+		}{
+			{name: "Enforce", profile: podSecurityConfig.Defaults.Enforce},
+			{name: "Audit", profile: podSecurityConfig.Defaults.Audit},
+			{name: "Warn", profile: podSecurityConfig.Defaults.Warn},
+		}
+	)
+
+	for _, mode := range modes {
+		if intkubeutils.PodSecurityStandardProfile(mode.profile).LessRestrictive(options.MinPodSecurityStandardsProfile) {
+			checkResults = append(checkResults, rule.FailedCheckResult(fmt.Sprintf("%s mode profile is less restrictive than the minimum Pod Security Standards profile allowed: %s", mode.name, options.MinPodSecurityStandardsProfile), target))
+		}
+	}
+
+	if len(checkResults) == 0 {
+		checkResults = append(checkResults, rule.PassedCheckResult("PodSecurity is properly configured", target))
+	}
+	return checkResults
+}
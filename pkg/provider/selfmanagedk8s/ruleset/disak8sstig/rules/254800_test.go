@@ -0,0 +1,193 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rules_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	fakestrgen "github.com/gardener/diki/pkg/internal/stringgen/fake"
+	fakepod "github.com/gardener/diki/pkg/kubernetes/pod/fake"
+	"github.com/gardener/diki/pkg/provider/selfmanagedk8s/ruleset/disak8sstig/rules"
+	"github.com/gardener/diki/pkg/rule"
+	sharedrules "github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/rules"
+)
+
+var _ = Describe("#254800", func() {
+	const (
+		inlineConfig = `apiVersion: apiserver.config.k8s.io/v1
+kind: AdmissionConfiguration
+plugins:
+- name: PodSecurity
+  configuration:
+    apiVersion: pod-security.admission.config.k8s.io/v1
+    kind: PodSecurityConfiguration
+    defaults:
+      enforce: baseline
+      audit: restricted
+      warn: privileged
+`
+		pathConfig = `apiVersion: apiserver.config.k8s.io/v1
+kind: AdmissionConfiguration
+plugins:
+- name: PodSecurity
+  path: /etc/kubernetes/admission/pod-security.yaml
+`
+		podSecurityConfig = `apiVersion: pod-security.admission.config.k8s.io/v1
+kind: PodSecurityConfiguration
+defaults:
+  enforce: restricted
+  audit: restricted
+  warn: baseline
+`
+		noPodSecurityConfig = `apiVersion: apiserver.config.k8s.io/v1
+kind: AdmissionConfiguration
+plugins:
+- name: EventRateLimit
+  path: /etc/kubernetes/admission/event-rate-limit.yaml
+`
+	)
+
+	var (
+		fakeClient    client.Client
+		ctx           = context.TODO()
+		kapiPod       *corev1.Pod
+		dikiPod       *corev1.Pod
+		target        = rule.NewTarget("name", "kube-apiserver-node01", "namespace", "kube-system", "kind", "Pod")
+		execPodTarget = rule.NewTarget("name", "diki-254800-aaaaaaaaaa", "namespace", "kube-system", "kind", "Pod")
+	)
+
+	BeforeEach(func() {
+		sharedrules.Generator = &fakestrgen.FakeRandString{Rune: 'a'}
+		fakeClient = fakeclient.NewClientBuilder().Build()
+
+		kapiPod = &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "kube-apiserver-node01",
+				Namespace: "kube-system",
+				Labels: map[string]string{
+					"component": "kube-apiserver",
+					"tier":      "control-plane",
+				},
+				Annotations: map[string]string{
+					corev1.MirrorPodAnnotationKey: "foo",
+				},
+			},
+			Spec: corev1.PodSpec{
+				NodeName: "node01",
+				Containers: []corev1.Container{
+					{
+						Name: "kube-apiserver",
+						VolumeMounts: []corev1.VolumeMount{
+							{
+								Name:      "admission",
+								MountPath: "/etc/kubernetes/admission",
+							},
+						},
+					},
+				},
+				Volumes: []corev1.Volume{
+					{
+						Name: "admission",
+						VolumeSource: corev1.VolumeSource{
+							HostPath: &corev1.HostPathVolumeSource{
+								Path: "/etc/kubernetes/admission-control",
+							},
+						},
+					},
+				},
+			},
+		}
+
+		dikiPod = &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "diki-254800-aaaaaaaaaa",
+				Namespace: "kube-system",
+			},
+			Status: corev1.PodStatus{
+				ContainerStatuses: []corev1.ContainerStatus{
+					{
+						Name:        "test",
+						ContainerID: "containerd://bar",
+					},
+				},
+			},
+		}
+	})
+
+	DescribeTable("Run cases",
+		func(command []string, options *sharedrules.Options254800, executeReturnString [][]string, executeReturnError [][]error, expectedCheckResults []rule.CheckResult) {
+			kapiPod.Spec.Containers[0].Command = command
+			Expect(fakeClient.Create(ctx, kapiPod)).To(Succeed())
+			Expect(fakeClient.Create(ctx, dikiPod)).To(Succeed())
+
+			r := &rules.Rule254800{
+				Logger:     testLogger,
+				Client:     fakeClient,
+				PodContext: fakepod.NewFakeSimplePodContext(executeReturnString, executeReturnError),
+				Options:    options,
+			}
+
+			ruleResult, err := r.Run(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ruleResult.CheckResults).To(Equal(expectedCheckResults))
+		},
+		Entry("should warn when the admission control config file is not set",
+			[]string{"kube-apiserver"},
+			nil,
+			[][]string{},
+			[][]error{},
+			[]rule.CheckResult{
+				rule.WarningCheckResult("Option admission-control-config-file has not been set.", target),
+			}),
+		Entry("should fail when the inline configuration is less restrictive than baseline",
+			[]string{"kube-apiserver", "--admission-control-config-file=/etc/kubernetes/admission/config.yaml"},
+			nil,
+			[][]string{{inlineConfig}},
+			[][]error{{nil}},
+			[]rule.CheckResult{
+				rule.FailedCheckResult("Warn mode profile is less restrictive than the minimum Pod Security Standards profile allowed: baseline", target),
+			}),
+		Entry("should pass when the inline configuration satisfies the minimum profile from the options",
+			[]string{"kube-apiserver", "--admission-control-config-file=/etc/kubernetes/admission/config.yaml"},
+			&sharedrules.Options254800{MinPodSecurityStandardsProfile: "privileged"},
+			[][]string{{inlineConfig}},
+			[][]error{{nil}},
+			[]rule.CheckResult{
+				rule.PassedCheckResult("PodSecurity is properly configured", target),
+			}),
+		Entry("should pass when the configuration file referenced by path is conformant",
+			[]string{"kube-apiserver", "--admission-control-config-file=/etc/kubernetes/admission/config.yaml"},
+			nil,
+			[][]string{{pathConfig, podSecurityConfig}},
+			[][]error{{nil, nil}},
+			[]rule.CheckResult{
+				rule.PassedCheckResult("PodSecurity is properly configured", target),
+			}),
+		Entry("should fail when PodSecurity is not configured",
+			[]string{"kube-apiserver", "--admission-control-config-file=/etc/kubernetes/admission/config.yaml"},
+			nil,
+			[][]string{{noPodSecurityConfig}},
+			[][]error{{nil}},
+			[]rule.CheckResult{
+				rule.FailedCheckResult("PodSecurity is not configured", target),
+			}),
+		Entry("should error when the admission control config file cannot be read",
+			[]string{"kube-apiserver", "--admission-control-config-file=/etc/kubernetes/admission/config.yaml"},
+			nil,
+			[][]string{{""}},
+			[][]error{{errors.New("foo")}},
+			[]rule.CheckResult{
+				rule.ErroredCheckResult("foo", execPodTarget),
+			}),
+	)
+})
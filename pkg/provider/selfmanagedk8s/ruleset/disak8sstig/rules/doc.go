@@ -0,0 +1,8 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package rules implements the rules of the control plane components of
+// self-managed clusters. The components run as static pods on the control plane
+// nodes and are checked through their mirror pods and through ops pods on these nodes.
+package rules
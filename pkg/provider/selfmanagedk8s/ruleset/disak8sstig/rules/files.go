@@ -0,0 +1,135 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	intutils "github.com/gardener/diki/pkg/internal/utils"
+	"github.com/gardener/diki/pkg/kubernetes/pod"
+	kubeutils "github.com/gardener/diki/pkg/kubernetes/utils"
+	"github.com/gardener/diki/pkg/rule"
+)
+
+// defaultStaticPodPath is the directory of the static pod manifests used by kubeadm.
+const defaultStaticPodPath = "/etc/kubernetes/manifests"
+
+// checkStaticPodManifests creates an ops pod on every node of the given static pods and
+// calls checkFn with the stats of every file in the static pod directory of the kubelet.
+func checkStaticPodManifests(
+	ctx context.Context,
+	runner opsPodRunner,
+	pods []corev1.Pod,
+	checkFn func(fileStat intutils.FileStats, target rule.Target) []rule.CheckResult,
+) []rule.CheckResult {
+	var (
+		checkResults []rule.CheckResult
+		nodeNames, _ = groupByNode(pods)
+	)
+
+	for _, nodeName := range nodeNames {
+		nodeTarget := rule.NewTarget("name", nodeName, "kind", "Node")
+		checkResults = append(checkResults, runner.run(ctx, nodeName, func(podExecutor pod.PodExecutor, _ string, execPodTarget rule.Target) []rule.CheckResult {
+			rawKubeletCommand, err := kubeutils.GetKubeletCommand(ctx, podExecutor)
+			if err != nil {
+				return []rule.CheckResult{rule.ErroredCheckResult(err.Error(), execPodTarget)}
+			}
+
+			kubeletConfig, err := kubeutils.GetKubeletConfig(ctx, podExecutor, rawKubeletCommand)
+			if err != nil {
+				return []rule.CheckResult{rule.ErroredCheckResult(fmt.Sprintf("could not retrieve kubelet config: %s", err.Error()), execPodTarget)}
+			}
+
+			staticPodPath := defaultStaticPodPath
+			if kubeletConfig.StaticPodPath != nil && len(*kubeletConfig.StaticPodPath) > 0 {
+				staticPodPath = *kubeletConfig.StaticPodPath
+			}
+
+			fileStats, err := intutils.GetFileStatsByDir(ctx, podExecutor, staticPodPath)
+			if err != nil {
+				return []rule.CheckResult{rule.ErroredCheckResult(err.Error(), execPodTarget)}
+			}
+			if len(fileStats) == 0 {
+				return []rule.CheckResult{rule.ErroredCheckResult("no static pod manifests found", nodeTarget.With("directory", staticPodPath))}
+			}
+
+			var checkResults []rule.CheckResult
+			for _, fileStat := range fileStats {
+				checkResults = append(checkResults, checkFn(fileStat, nodeTarget)...)
+			}
+			return checkResults
+		})...)
+	}
+	return checkResults
+}
+
+// checkKubeadmConfFiles creates an ops pod on the selected nodes and calls checkFn with the stats of
+// the kubeadm drop-in files of the kubelet service, e.g. /usr/lib/systemd/system/kubelet.service.d/10-kubeadm.conf.
+func checkKubeadmConfFiles(
+	ctx context.Context,
+	c client.Client,
+	runner opsPodRunner,
+	nodeLabels []string,
+	checkFn func(fileStat intutils.FileStats, target rule.Target) []rule.CheckResult,
+) []rule.CheckResult {
+	pods, err := kubeutils.GetPods(ctx, c, "", labels.NewSelector(), 300)
+	if err != nil {
+		return []rule.CheckResult{rule.ErroredCheckResult(err.Error(), rule.NewTarget("kind", "PodList"))}
+	}
+	nodes, err := kubeutils.GetNodes(ctx, c, 300)
+	if err != nil {
+		return []rule.CheckResult{rule.ErroredCheckResult(err.Error(), rule.NewTarget("kind", "NodeList"))}
+	}
+
+	nodesAllocatablePods := kubeutils.GetNodesAllocatablePodsNum(pods, nodes)
+	selectedNodes, checkResults := kubeutils.SelectNodes(nodes, nodesAllocatablePods, nodeLabels)
+	if len(selectedNodes) == 0 {
+		return append(checkResults, rule.ErroredCheckResult("no allocatable nodes could be selected", rule.NewTarget()))
+	}
+
+	for _, node := range selectedNodes {
+		nodeTarget := kubeutils.TargetWithK8sObject(rule.NewTarget(), metav1.TypeMeta{Kind: "Node"}, node.ObjectMeta)
+		checkResults = append(checkResults, runner.run(ctx, node.Name, func(podExecutor pod.PodExecutor, _ string, execPodTarget rule.Target) []rule.CheckResult {
+			dropInPaths, err := podExecutor.Execute(ctx, "/bin/sh", "systemctl show -P DropInPaths kubelet.service")
+			if err != nil {
+				return []rule.CheckResult{rule.ErroredCheckResult(err.Error(), execPodTarget)}
+			}
+
+			var kubeadmConfPaths []string
+			for _, dropInPath := range strings.Fields(dropInPaths) {
+				if strings.HasSuffix(path.Base(dropInPath), "kubeadm.conf") {
+					kubeadmConfPaths = append(kubeadmConfPaths, dropInPath)
+				}
+			}
+			if len(kubeadmConfPaths) == 0 {
+				return []rule.CheckResult{rule.SkippedCheckResult("Kubelet service does not use a kubeadm.conf drop-in file.", nodeTarget)}
+			}
+
+			fileStats, errs, err := intutils.GetFilesStats(ctx, podExecutor, kubeadmConfPaths)
+			if err != nil {
+				return []rule.CheckResult{rule.ErroredCheckResult(err.Error(), execPodTarget)}
+			}
+
+			var checkResults []rule.CheckResult
+			for i, fileStat := range fileStats {
+				if errs[i] != nil {
+					checkResults = append(checkResults, rule.ErroredCheckResult(errs[i].Error(), execPodTarget))
+					continue
+				}
+				checkResults = append(checkResults, checkFn(fileStat, nodeTarget)...)
+			}
+			return checkResults
+		})...)
+	}
+	return checkResults
+}
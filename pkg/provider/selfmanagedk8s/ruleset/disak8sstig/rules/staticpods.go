@@ -0,0 +1,207 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/component-base/version"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/diki/imagevector"
+	"github.com/gardener/diki/pkg/kubernetes/pod"
+	kubeutils "github.com/gardener/diki/pkg/kubernetes/utils"
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/shared/images"
+	"github.com/gardener/diki/pkg/shared/provider"
+	sharedrules "github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/rules"
+)
+
+const (
+	kubeAPIServer         = "kube-apiserver"
+	kubeControllerManager = "kube-controller-manager"
+	kubeScheduler         = "kube-scheduler"
+	etcd                  = "etcd"
+
+	// controlPlaneNamespace is the namespace of the mirror pods of the control plane static pods.
+	controlPlaneNamespace = "kube-system"
+	// opsPodNamespace is the namespace in which ops pods are created.
+	opsPodNamespace = "kube-system"
+)
+
+// staticPodSelector selects the mirror pods of a control plane component
+// by the labels which kubeadm sets in the static pod manifests.
+func staticPodSelector(component string) labels.Selector {
+	return labels.SelectorFromSet(labels.Set{"component": component, "tier": "control-plane"})
+}
+
+// NewStaticPodSource returns the source of a control plane component which runs as static pods.
+// The container of a component is named after the component.
+func NewStaticPodSource(c client.Client, component string) *kubeutils.StaticPodSource {
+	return &kubeutils.StaticPodSource{
+		Client:        c,
+		Namespace:     controlPlaneNamespace,
+		Selector:      staticPodSelector(component),
+		ContainerName: component,
+	}
+}
+
+// getStaticPods returns the mirror pods of the static pods of a control plane component sorted by name.
+func getStaticPods(ctx context.Context, c client.Client, component string) ([]corev1.Pod, error) {
+	return kubeutils.GetMirrorPods(ctx, c, controlPlaneNamespace, staticPodSelector(component), 300)
+}
+
+// getComponentsStaticPods returns the mirror pods of the static pods of the given control plane components.
+// Components without static pods are reported with an errored check result.
+func getComponentsStaticPods(ctx context.Context, c client.Client, components ...string) ([]corev1.Pod, []rule.CheckResult) {
+	var (
+		staticPods   []corev1.Pod
+		checkResults []rule.CheckResult
+	)
+	for _, component := range components {
+		pods, err := getStaticPods(ctx, c, component)
+		if err != nil {
+			return nil, []rule.CheckResult{rule.ErroredCheckResult(err.Error(), rule.NewTarget("namespace", controlPlaneNamespace, "kind", "PodList"))}
+		}
+		if len(pods) == 0 {
+			checkResults = append(checkResults, rule.ErroredCheckResult("pods not found", NewStaticPodSource(c, component).Target()))
+			continue
+		}
+		staticPods = append(staticPods, pods...)
+	}
+	return staticPods, checkResults
+}
+
+// checkStaticPods calls checkFn for every static pod instance of a control plane component.
+func checkStaticPods(
+	ctx context.Context,
+	c client.Client,
+	component string,
+	checkFn func(instance kubeutils.ComponentInstance, target rule.Target) []rule.CheckResult,
+) []rule.CheckResult {
+	source := NewStaticPodSource(c, component)
+	instances, err := source.Instances(ctx)
+	if err != nil {
+		return []rule.CheckResult{rule.ErroredCheckResult(err.Error(), source.Target())}
+	}
+
+	var checkResults []rule.CheckResult
+	for _, instance := range instances {
+		if instance.Err != nil {
+			checkResults = append(checkResults, rule.ErroredCheckResult(instance.Err.Error(), instance.Target))
+			continue
+		}
+		checkResults = append(checkResults, checkFn(instance, instance.Target)...)
+	}
+	return checkResults
+}
+
+// readHostFile reads a file which is mounted in a static pod container from a hostPath volume
+// with an ops pod on the node of the pod.
+func readHostFile(ctx context.Context, podExecutor pod.PodExecutor, instance kubeutils.ComponentInstance, filePath string) ([]byte, error) {
+	nodeFilePath, err := instance.HostPath(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := podExecutor.Execute(ctx, "/bin/sh", fmt.Sprintf("cat %s", nodeFilePath))
+	if err != nil {
+		return nil, err
+	}
+	return []byte(data), nil
+}
+
+// opsPodRunner runs commands on nodes through ops pods.
+type opsPodRunner struct {
+	ruleID     string
+	instanceID string
+	image      string
+	client     client.Client
+	podContext pod.PodContext
+	logger     provider.Logger
+}
+
+func newOpsPodRunner(ruleID, instanceID string, c client.Client, podContext pod.PodContext, logger provider.Logger) (opsPodRunner, error) {
+	image, err := imagevector.ImageVector().FindImage(images.DikiOpsImageName)
+	if err != nil {
+		return opsPodRunner{}, fmt.Errorf("failed to find image version for %s: %w", images.DikiOpsImageName, err)
+	}
+	image.WithOptionalTag(version.Get().GitVersion)
+
+	return opsPodRunner{
+		ruleID:     ruleID,
+		instanceID: instanceID,
+		image:      image.String(),
+		client:     c,
+		podContext: podContext,
+		logger:     logger,
+	}, nil
+}
+
+// run creates an ops pod on a node and calls runFn with its executor and the path of its root file system on the node.
+// The ops pod is deleted before run returns.
+func (r opsPodRunner) run(
+	ctx context.Context,
+	nodeName string,
+	runFn func(podExecutor pod.PodExecutor, execContainerPath string, execPodTarget rule.Target) []rule.CheckResult,
+) []rule.CheckResult {
+	var (
+		podName          = fmt.Sprintf("diki-%s-%s", r.ruleID, sharedrules.Generator.Generate(10))
+		execPodTarget    = rule.NewTarget("name", podName, "namespace", opsPodNamespace, "kind", "Pod")
+		additionalLabels = map[string]string{pod.LabelInstanceID: r.instanceID}
+	)
+
+	defer func() {
+		timeoutCtx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+		defer cancel()
+
+		if err := r.podContext.Delete(timeoutCtx, podName, opsPodNamespace); err != nil {
+			r.logger.Error(err.Error())
+		}
+	}()
+
+	podExecutor, err := r.podContext.Create(ctx, pod.NewPrivilegedPod(podName, opsPodNamespace, r.image, nodeName, additionalLabels))
+	if err != nil {
+		return []rule.CheckResult{rule.ErroredCheckResult(err.Error(), execPodTarget)}
+	}
+
+	execPodTarget = execPodTarget.With("name", pod.PodName(podExecutor, podName))
+
+	execPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      execPodTarget["name"],
+			Namespace: opsPodNamespace,
+		},
+	}
+	if err := r.client.Get(ctx, client.ObjectKeyFromObject(execPod), execPod); err != nil {
+		return []rule.CheckResult{rule.ErroredCheckResult(err.Error(), execPodTarget)}
+	}
+
+	var (
+		execContainerID     = execPod.Status.ContainerStatuses[0].ContainerID
+		execBaseContainerID = strings.Split(execContainerID, "//")[1]
+		execContainerPath   = fmt.Sprintf("/run/containerd/io.containerd.runtime.v2.task/k8s.io/%s/rootfs", execBaseContainerID)
+	)
+
+	return runFn(podExecutor, execContainerPath, execPodTarget)
+}
+
+// groupByNode groups pods by the names of their nodes. The node names are returned sorted.
+func groupByNode(pods []corev1.Pod) ([]string, map[string][]corev1.Pod) {
+	podsByNode := map[string][]corev1.Pod{}
+	for _, p := range pods {
+		podsByNode[p.Spec.NodeName] = append(podsByNode[p.Spec.NodeName], p)
+	}
+
+	return slices.Sorted(maps.Keys(podsByNode)), podsByNode
+}
@@ -0,0 +1,806 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package disak8sstig
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/diki/pkg/config"
+	internalconfig "github.com/gardener/diki/pkg/internal/config"
+	"github.com/gardener/diki/pkg/kubernetes/pod"
+	kubeutils "github.com/gardener/diki/pkg/kubernetes/utils"
+	managedk8srules "github.com/gardener/diki/pkg/provider/managedk8s/ruleset/disak8sstig/rules"
+	"github.com/gardener/diki/pkg/provider/selfmanagedk8s/ruleset/disak8sstig/rules"
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/rule/retry"
+	"github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/option"
+	"github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/retryerrors"
+	sharedrules "github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/rules"
+	"github.com/gardener/diki/pkg/snapshot"
+)
+
+func validateV2R3Options[O rules.RuleOption](options any, fldPath *field.Path) field.ErrorList {
+	parsedOptions, err := getV2R3OptionOrNil[O](options)
+	if err != nil {
+		return field.ErrorList{
+			field.InternalError(fldPath, err),
+		}
+	}
+
+	if parsedOptions == nil {
+		return nil
+	}
+
+	if val, ok := any(parsedOptions).(option.Option); ok {
+		return val.Validate(fldPath)
+	}
+
+	return nil
+}
+
+func (r *Ruleset) validateV2R3RuleOptions(ruleOptions map[string]internalconfig.IndexedRuleOptionsConfig, fldPath *field.Path) error {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, validateV2R3Options[sharedrules.Options242383](ruleOptions[sharedrules.ID242383].Args, fldPath.Index(ruleOptions[sharedrules.ID242383].Index).Child("args"))...)
+	allErrs = append(allErrs, validateV2R3Options[sharedrules.Options242393](ruleOptions[sharedrules.ID242393].Args, fldPath.Index(ruleOptions[sharedrules.ID242393].Index).Child("args"))...)
+	allErrs = append(allErrs, validateV2R3Options[sharedrules.Options242394](ruleOptions[sharedrules.ID242394].Args, fldPath.Index(ruleOptions[sharedrules.ID242394].Index).Child("args"))...)
+	allErrs = append(allErrs, validateV2R3Options[sharedrules.Options242396](ruleOptions[sharedrules.ID242396].Args, fldPath.Index(ruleOptions[sharedrules.ID242396].Index).Child("args"))...)
+	allErrs = append(allErrs, validateV2R3Options[managedk8srules.Options242400](ruleOptions[sharedrules.ID242400].Args, fldPath.Index(ruleOptions[sharedrules.ID242400].Index).Child("args"))...)
+	allErrs = append(allErrs, validateV2R3Options[sharedrules.Options242404](ruleOptions[sharedrules.ID242404].Args, fldPath.Index(ruleOptions[sharedrules.ID242404].Index).Child("args"))...)
+	allErrs = append(allErrs, validateV2R3Options[option.FileOwnerOptions](ruleOptions[sharedrules.ID242405].Args, fldPath.Index(ruleOptions[sharedrules.ID242405].Index).Child("args"))...)
+	allErrs = append(allErrs, validateV2R3Options[sharedrules.Options242406](ruleOptions[sharedrules.ID242406].Args, fldPath.Index(ruleOptions[sharedrules.ID242406].Index).Child("args"))...)
+	allErrs = append(allErrs, validateV2R3Options[sharedrules.Options242407](ruleOptions[sharedrules.ID242407].Args, fldPath.Index(ruleOptions[sharedrules.ID242407].Index).Child("args"))...)
+	allErrs = append(allErrs, validateV2R3Options[option.Options242414](ruleOptions[sharedrules.ID242414].Args, fldPath.Index(ruleOptions[sharedrules.ID242414].Index).Child("args"))...)
+	allErrs = append(allErrs, validateV2R3Options[option.Options242415](ruleOptions[sharedrules.ID242415].Args, fldPath.Index(ruleOptions[sharedrules.ID242415].Index).Child("args"))...)
+	allErrs = append(allErrs, validateV2R3Options[sharedrules.Options242417](ruleOptions[sharedrules.ID242417].Args, fldPath.Index(ruleOptions[sharedrules.ID242417].Index).Child("args"))...)
+	allErrs = append(allErrs, validateV2R3Options[managedk8srules.Options242442](ruleOptions[sharedrules.ID242442].Args, fldPath.Index(ruleOptions[sharedrules.ID242442].Index).Child("args"))...)
+	allErrs = append(allErrs, validateV2R3Options[option.FileOwnerOptions](ruleOptions[sharedrules.ID242445].Args, fldPath.Index(ruleOptions[sharedrules.ID242445].Index).Child("args"))...)
+	allErrs = append(allErrs, validateV2R3Options[option.FileOwnerOptions](ruleOptions[sharedrules.ID242446].Args, fldPath.Index(ruleOptions[sharedrules.ID242446].Index).Child("args"))...)
+	allErrs = append(allErrs, validateV2R3Options[sharedrules.Options242447](ruleOptions[sharedrules.ID242447].Args, fldPath.Index(ruleOptions[sharedrules.ID242447].Index).Child("args"))...)
+	allErrs = append(allErrs, validateV2R3Options[sharedrules.Options242448](ruleOptions[sharedrules.ID242448].Args, fldPath.Index(ruleOptions[sharedrules.ID242448].Index).Child("args"))...)
+	allErrs = append(allErrs, validateV2R3Options[sharedrules.Options242449](ruleOptions[sharedrules.ID242449].Args, fldPath.Index(ruleOptions[sharedrules.ID242449].Index).Child("args"))...)
+	allErrs = append(allErrs, validateV2R3Options[sharedrules.Options242450](ruleOptions[sharedrules.ID242450].Args, fldPath.Index(ruleOptions[sharedrules.ID242450].Index).Child("args"))...)
+	allErrs = append(allErrs, validateV2R3Options[managedk8srules.Options242451](ruleOptions[sharedrules.ID242451].Args, fldPath.Index(ruleOptions[sharedrules.ID242451].Index).Child("args"))...)
+	allErrs = append(allErrs, validateV2R3Options[sharedrules.Options242452](ruleOptions[sharedrules.ID242452].Args, fldPath.Index(ruleOptions[sharedrules.ID242452].Index).Child("args"))...)
+	allErrs = append(allErrs, validateV2R3Options[sharedrules.Options242453](ruleOptions[sharedrules.ID242453].Args, fldPath.Index(ruleOptions[sharedrules.ID242453].Index).Child("args"))...)
+	allErrs = append(allErrs, validateV2R3Options[rules.Options242454](ruleOptions[sharedrules.ID242454].Args, fldPath.Index(ruleOptions[sharedrules.ID242454].Index).Child("args"))...)
+	allErrs = append(allErrs, validateV2R3Options[rules.Options242455](ruleOptions[sharedrules.ID242455].Args, fldPath.Index(ruleOptions[sharedrules.ID242455].Index).Child("args"))...)
+	allErrs = append(allErrs, validateV2R3Options[managedk8srules.Options242466](ruleOptions[sharedrules.ID242466].Args, fldPath.Index(ruleOptions[sharedrules.ID242466].Index).Child("args"))...)
+	allErrs = append(allErrs, validateV2R3Options[managedk8srules.Options242467](ruleOptions[sharedrules.ID242467].Args, fldPath.Index(ruleOptions[sharedrules.ID242467].Index).Child("args"))...)
+	allErrs = append(allErrs, validateV2R3Options[sharedrules.Options254800](ruleOptions[sharedrules.ID254800].Args, fldPath.Index(ruleOptions[sharedrules.ID254800].Index).Child("args"))...)
+
+	return allErrs.ToAggregate()
+}
+
+func (r *Ruleset) registerV2R3Rules(ruleOptions map[string]config.RuleOptionsConfig) error { // TODO: add to FromGenericConfig
+	client, err := client.New(r.Config, client.Options{})
+	if err != nil {
+		return err
+	}
+	client = kubeutils.NewCachedClient(client, r.Config.Host)
+
+	simplePodContext, err := pod.NewSimplePodContext(client, r.Config, r.AdditionalOpsPodLabels)
+	if err != nil {
+		return err
+	}
+	podContext := pod.NewPooledPodContext(simplePodContext, r.Config.Host)
+
+	clientSet, err := kubernetes.NewForConfig(snapshot.WrapRESTConfig(r.Config))
+	if err != nil {
+		return err
+	}
+
+	authorityCertPool := x509.NewCertPool()
+	ok := authorityCertPool.AppendCertsFromPEM(r.Config.CAData)
+	if !ok {
+		return fmt.Errorf("failed to parse kube-apiserver CA data from config")
+	}
+
+	opts242383, err := getV2R3OptionOrNil[sharedrules.Options242383](ruleOptions[sharedrules.ID242383].Args)
+	if err != nil {
+		return fmt.Errorf("rule option 242383 error: %s", err.Error())
+	}
+	opts242393, err := getV2R3OptionOrNil[sharedrules.Options242393](ruleOptions[sharedrules.ID242393].Args)
+	if err != nil {
+		return fmt.Errorf("rule option 242393 error: %s", err.Error())
+	}
+	opts242394, err := getV2R3OptionOrNil[sharedrules.Options242394](ruleOptions[sharedrules.ID242394].Args)
+	if err != nil {
+		return fmt.Errorf("rule option 242394 error: %s", err.Error())
+	}
+	opts242396, err := getV2R3OptionOrNil[sharedrules.Options242396](ruleOptions[sharedrules.ID242396].Args)
+	if err != nil {
+		return fmt.Errorf("rule option 242396 error: %s", err.Error())
+	}
+	opts242400, err := getV2R3OptionOrNil[managedk8srules.Options242400](ruleOptions[sharedrules.ID242400].Args)
+	if err != nil {
+		return fmt.Errorf("rule option 242400 error: %s", err.Error())
+	}
+	opts242404, err := getV2R3OptionOrNil[sharedrules.Options242404](ruleOptions[sharedrules.ID242404].Args)
+	if err != nil {
+		return fmt.Errorf("rule option 242404 error: %s", err.Error())
+	}
+	opts242405, err := getV2R3OptionOrNil[option.FileOwnerOptions](ruleOptions[sharedrules.ID242405].Args)
+	if err != nil {
+		return fmt.Errorf("rule option 242405 error: %s", err.Error())
+	}
+	opts242406, err := getV2R3OptionOrNil[sharedrules.Options242406](ruleOptions[sharedrules.ID242406].Args)
+	if err != nil {
+		return fmt.Errorf("rule option 242406 error: %s", err.Error())
+	}
+	opts242407, err := getV2R3OptionOrNil[sharedrules.Options242407](ruleOptions[sharedrules.ID242407].Args)
+	if err != nil {
+		return fmt.Errorf("rule option 242407 error: %s", err.Error())
+	}
+	opts242414, err := getV2R3OptionOrNil[option.Options242414](ruleOptions[sharedrules.ID242414].Args)
+	if err != nil {
+		return fmt.Errorf("rule option 242414 error: %s", err.Error())
+	}
+	opts242415, err := getV2R3OptionOrNil[option.Options242415](ruleOptions[sharedrules.ID242415].Args)
+	if err != nil {
+		return fmt.Errorf("rule option 242415 error: %s", err.Error())
+	}
+	opts242417, err := getV2R3OptionOrNil[sharedrules.Options242417](ruleOptions[sharedrules.ID242417].Args)
+	if err != nil {
+		return fmt.Errorf("rule option 242417 error: %s", err.Error())
+	}
+	opts242442, err := getV2R3OptionOrNil[managedk8srules.Options242442](ruleOptions[sharedrules.ID242442].Args)
+	if err != nil {
+		return fmt.Errorf("rule option 242442 error: %s", err.Error())
+	}
+	opts242445, err := getV2R3OptionOrNil[option.FileOwnerOptions](ruleOptions[sharedrules.ID242445].Args)
+	if err != nil {
+		return fmt.Errorf("rule option 242445 error: %s", err.Error())
+	}
+	opts242446, err := getV2R3OptionOrNil[option.FileOwnerOptions](ruleOptions[sharedrules.ID242446].Args)
+	if err != nil {
+		return fmt.Errorf("rule option 242446 error: %s", err.Error())
+	}
+	opts242447, err := getV2R3OptionOrNil[sharedrules.Options242447](ruleOptions[sharedrules.ID242447].Args)
+	if err != nil {
+		return fmt.Errorf("rule option 242447 error: %s", err.Error())
+	}
+	opts242448, err := getV2R3OptionOrNil[sharedrules.Options242448](ruleOptions[sharedrules.ID242448].Args)
+	if err != nil {
+		return fmt.Errorf("rule option 242448 error: %s", err.Error())
+	}
+	opts242449, err := getV2R3OptionOrNil[sharedrules.Options242449](ruleOptions[sharedrules.ID242449].Args)
+	if err != nil {
+		return fmt.Errorf("rule option 242449 error: %s", err.Error())
+	}
+	opts242450, err := getV2R3OptionOrNil[sharedrules.Options242450](ruleOptions[sharedrules.ID242450].Args)
+	if err != nil {
+		return fmt.Errorf("rule option 242450 error: %s", err.Error())
+	}
+	opts242451, err := getV2R3OptionOrNil[managedk8srules.Options242451](ruleOptions[sharedrules.ID242451].Args)
+	if err != nil {
+		return fmt.Errorf("rule option 242451 error: %s", err.Error())
+	}
+	opts242452, err := getV2R3OptionOrNil[sharedrules.Options242452](ruleOptions[sharedrules.ID242452].Args)
+	if err != nil {
+		return fmt.Errorf("rule option 242452 error: %s", err.Error())
+	}
+	opts242453, err := getV2R3OptionOrNil[sharedrules.Options242453](ruleOptions[sharedrules.ID242453].Args)
+	if err != nil {
+		return fmt.Errorf("rule option 242453 error: %s", err.Error())
+	}
+	opts242454, err := getV2R3OptionOrNil[rules.Options242454](ruleOptions[sharedrules.ID242454].Args)
+	if err != nil {
+		return fmt.Errorf("rule option 242454 error: %s", err.Error())
+	}
+	opts242455, err := getV2R3OptionOrNil[rules.Options242455](ruleOptions[sharedrules.ID242455].Args)
+	if err != nil {
+		return fmt.Errorf("rule option 242455 error: %s", err.Error())
+	}
+	opts242466, err := getV2R3OptionOrNil[managedk8srules.Options242466](ruleOptions[sharedrules.ID242466].Args)
+	if err != nil {
+		return fmt.Errorf("rule option 242466 error: %s", err.Error())
+	}
+	opts242467, err := getV2R3OptionOrNil[managedk8srules.Options242467](ruleOptions[sharedrules.ID242467].Args)
+	if err != nil {
+		return fmt.Errorf("rule option 242467 error: %s", err.Error())
+	}
+	opts254800, err := getV2R3OptionOrNil[sharedrules.Options254800](ruleOptions[sharedrules.ID254800].Args)
+	if err != nil {
+		return fmt.Errorf("rule option 254800 error: %s", err.Error())
+	}
+
+	rcOpsPod := retry.RetryConditionFromRegex(
+		*retryerrors.OpsPodNotFoundRegexp,
+	)
+	rcFileChecks := retry.RetryConditionFromRegex(
+		*retryerrors.ContainerNotFoundOnNodeRegexp,
+		*retryerrors.ContainerFileNotFoundOnNodeRegexp,
+		*retryerrors.ContainerNotReadyRegexp,
+		*retryerrors.OpsPodNotFoundRegexp,
+		*retryerrors.ObjectNotFoundRegexp,
+	)
+
+	var (
+		kubeAPIServerSource         = rules.NewStaticPodSource(client, "kube-apiserver")
+		kubeControllerManagerSource = rules.NewStaticPodSource(client, "kube-controller-manager")
+		kubeSchedulerSource         = rules.NewStaticPodSource(client, "kube-scheduler")
+		etcdSource                  = rules.NewStaticPodSource(client, "etcd")
+		controlPlaneSources         = []kubeutils.ComponentSource{kubeAPIServerSource, kubeControllerManagerSource, kubeSchedulerSource}
+	)
+
+	rules := []rule.Rule{
+		&sharedrules.Rule242376{Source: kubeControllerManagerSource},
+		&sharedrules.Rule242377{Source: kubeSchedulerSource},
+		&sharedrules.Rule242378{Source: kubeAPIServerSource},
+		&sharedrules.Rule242379{Client: client, Sources: []kubeutils.ComponentSource{etcdSource}},
+		&sharedrules.Rule242380{Client: client, Sources: []kubeutils.ComponentSource{etcdSource}},
+		&sharedrules.Rule242381{Source: kubeControllerManagerSource},
+		retry.New(
+			retry.WithLogger(r.Logger().With("rule_id", sharedrules.ID242382)),
+			retry.WithBaseRule(&rules.Rule242382{
+				Logger:     r.Logger().With("rule_id", sharedrules.ID242382),
+				InstanceID: r.instanceID,
+				Client:     client,
+				PodContext: podContext,
+			}),
+			retry.WithRetryCondition(rcOpsPod),
+			retry.WithMaxRetries(*r.args.MaxRetries),
+		),
+		&sharedrules.Rule242383{
+			Client:  client,
+			Options: opts242383,
+		},
+		&sharedrules.Rule242384{Source: kubeSchedulerSource},
+		&sharedrules.Rule242385{Source: kubeControllerManagerSource},
+		&sharedrules.Rule242386{Source: kubeAPIServerSource},
+		&sharedrules.Rule242387{
+			Client:       client,
+			V1RESTClient: clientSet.CoreV1().RESTClient(),
+		},
+		&sharedrules.Rule242388{Source: kubeAPIServerSource},
+		&sharedrules.Rule242389{Source: kubeAPIServerSource},
+		&managedk8srules.Rule242390{
+			KAPIExternalURL: r.Config.Host,
+			Client: &http.Client{
+				Transport: snapshot.NewRoundTripper(r.Config.Host, &http.Transport{
+					// the TLS MinVersion warnings are ignored in order to avoid version conflicts
+					TLSClientConfig: &tls.Config{ // #nosec: G402
+						RootCAs: authorityCertPool,
+					},
+				}),
+			},
+		},
+		&sharedrules.Rule242391{
+			Client:       client,
+			V1RESTClient: clientSet.CoreV1().RESTClient(),
+		},
+		&sharedrules.Rule242392{
+			Client:       client,
+			V1RESTClient: clientSet.CoreV1().RESTClient(),
+		},
+		retry.New(
+			retry.WithLogger(r.Logger().With("rule_id", sharedrules.ID242393)),
+			retry.WithBaseRule(&sharedrules.Rule242393{
+				Logger:     r.Logger().With("rule_id", sharedrules.ID242393),
+				InstanceID: r.instanceID,
+				Client:     client,
+				PodContext: podContext,
+				Options:    opts242393,
+			}),
+			retry.WithRetryCondition(rcOpsPod),
+			retry.WithMaxRetries(*r.args.MaxRetries),
+		),
+		retry.New(
+			retry.WithLogger(r.Logger().With("rule_id", sharedrules.ID242394)),
+			retry.WithBaseRule(&sharedrules.Rule242394{
+				Logger:     r.Logger().With("rule_id", sharedrules.ID242394),
+				InstanceID: r.instanceID,
+				Client:     client,
+				PodContext: podContext,
+				Options:    opts242394,
+			}),
+			retry.WithRetryCondition(rcOpsPod),
+			retry.WithMaxRetries(*r.args.MaxRetries),
+		),
+		&sharedrules.Rule242395{Client: client},
+		retry.New(
+			retry.WithLogger(r.Logger().With("rule_id", sharedrules.ID242396)),
+			retry.WithBaseRule(&sharedrules.Rule242396{
+				Logger:     r.Logger().With("rule_id", sharedrules.ID242396),
+				InstanceID: r.instanceID,
+				Client:     client,
+				PodContext: podContext,
+				Options:    opts242396,
+			}),
+			retry.WithRetryCondition(rcOpsPod),
+			retry.WithMaxRetries(*r.args.MaxRetries),
+		),
+		&sharedrules.Rule242397{
+			Client:       client,
+			V1RESTClient: clientSet.CoreV1().RESTClient(),
+		},
+		rule.NewSkipRule(
+			// feature-gates.DynamicAuditing removed in v1.19. ref https://kubernetes.io/docs/reference/command-line-tools-reference/feature-gates-removed/
+			sharedrules.ID242398,
+			"Kubernetes DynamicAuditing must not be enabled.",
+			"Option feature-gates.DynamicAuditing was removed in Kubernetes v1.19.",
+			rule.Skipped,
+			rule.SkipRuleWithSeverity(rule.SeverityMedium),
+		),
+		rule.NewSkipRule(
+			sharedrules.ID242399,
+			"Kubernetes DynamicKubeletConfig must not be enabled.",
+			// feature-gates.DynamicKubeletConfig removed in v1.26. ref https://kubernetes.io/docs/reference/command-line-tools-reference/feature-gates-removed/
+			"Option feature-gates.DynamicKubeletConfig removed in Kubernetes v1.26.",
+			rule.Skipped,
+			rule.SkipRuleWithSeverity(rule.SeverityMedium),
+		),
+		retry.New(
+			retry.WithLogger(r.Logger().With("rule_id", sharedrules.ID242400)),
+			retry.WithBaseRule(&managedk8srules.Rule242400{
+				Logger:       r.Logger().With("rule_id", sharedrules.ID242400),
+				InstanceID:   r.instanceID,
+				Client:       client,
+				PodContext:   podContext,
+				V1RESTClient: clientSet.CoreV1().RESTClient(),
+				Options:      opts242400,
+			}),
+			retry.WithRetryCondition(rcFileChecks),
+			retry.WithMaxRetries(*r.args.MaxRetries),
+		),
+
+		&sharedrules.Rule242402{Source: kubeAPIServerSource},
+		retry.New(
+			retry.WithLogger(r.Logger().With("rule_id", sharedrules.ID242403)),
+			retry.WithBaseRule(&sharedrules.Rule242403{
+				Logger:     r.Logger().With("rule_id", sharedrules.ID242403),
+				InstanceID: r.instanceID,
+				Client:     client,
+				PodContext: podContext,
+				Source:     kubeAPIServerSource,
+			}),
+			retry.WithRetryCondition(rcOpsPod),
+			retry.WithMaxRetries(*r.args.MaxRetries),
+		),
+		retry.New(
+			retry.WithLogger(r.Logger().With("rule_id", sharedrules.ID242404)),
+			retry.WithBaseRule(&sharedrules.Rule242404{
+				Logger:     r.Logger().With("rule_id", sharedrules.ID242404),
+				InstanceID: r.instanceID,
+				Client:     client,
+				PodContext: podContext,
+				Options:    opts242404,
+			}),
+			retry.WithRetryCondition(rcOpsPod),
+			retry.WithMaxRetries(*r.args.MaxRetries),
+		),
+		retry.New(
+			retry.WithLogger(r.Logger().With("rule_id", sharedrules.ID242405)),
+			retry.WithBaseRule(&rules.Rule242405{
+				Logger:     r.Logger().With("rule_id", sharedrules.ID242405),
+				InstanceID: r.instanceID,
+				Client:     client,
+				PodContext: podContext,
+				Options:    opts242405,
+			}),
+			retry.WithRetryCondition(rcFileChecks),
+			retry.WithMaxRetries(*r.args.MaxRetries),
+		),
+		retry.New(
+			retry.WithLogger(r.Logger().With("rule_id", sharedrules.ID242406)),
+			retry.WithBaseRule(&sharedrules.Rule242406{
+				Logger:     r.Logger().With("rule_id", sharedrules.ID242406),
+				InstanceID: r.instanceID,
+				Client:     client,
+				PodContext: podContext,
+				Options:    opts242406,
+			}),
+			retry.WithRetryCondition(rcFileChecks),
+			retry.WithMaxRetries(*r.args.MaxRetries),
+		),
+		retry.New(
+			retry.WithLogger(r.Logger().With("rule_id", sharedrules.ID242407)),
+			retry.WithBaseRule(&sharedrules.Rule242407{
+				Logger:     r.Logger().With("rule_id", sharedrules.ID242407),
+				InstanceID: r.instanceID,
+				Client:     client,
+				PodContext: podContext,
+				Options:    opts242407,
+			}),
+			retry.WithRetryCondition(rcFileChecks),
+			retry.WithMaxRetries(*r.args.MaxRetries),
+		),
+		retry.New(
+			retry.WithLogger(r.Logger().With("rule_id", sharedrules.ID242408)),
+			retry.WithBaseRule(&rules.Rule242408{
+				Logger:     r.Logger().With("rule_id", sharedrules.ID242408),
+				InstanceID: r.instanceID,
+				Client:     client,
+				PodContext: podContext,
+			}),
+			retry.WithRetryCondition(rcFileChecks),
+			retry.WithMaxRetries(*r.args.MaxRetries),
+		),
+		&sharedrules.Rule242409{Source: kubeControllerManagerSource},
+		rule.NewSkipRule(
+			sharedrules.ID242410,
+			"The Kubernetes API Server must enforce ports, protocols, and services (PPS) that adhere to the Ports, Protocols, and Services Management Category Assurance List (PPSM CAL).",
+			"Cannot be tested and should be enforced organizationally. The ports, protocols and services of the control plane components are determined by the cluster administrator.",
+			rule.Skipped,
+			rule.SkipRuleWithSeverity(rule.SeverityMedium),
+		),
+		rule.NewSkipRule(
+			sharedrules.ID242411,
+			"The Kubernetes Scheduler must enforce ports, protocols, and services (PPS) that adhere to the Ports, Protocols, and Services Management Category Assurance List (PPSM CAL).",
+			"Cannot be tested and should be enforced organizationally. The ports, protocols and services of the control plane components are determined by the cluster administrator.",
+			rule.Skipped,
+			rule.SkipRuleWithSeverity(rule.SeverityMedium),
+		),
+		rule.NewSkipRule(
+			sharedrules.ID242412,
+			"The Kubernetes Controllers must enforce ports, protocols, and services (PPS) that adhere to the Ports, Protocols, and Services Management Category Assurance List (PPSM CAL).",
+			"Cannot be tested and should be enforced organizationally. The ports, protocols and services of the control plane components are determined by the cluster administrator.",
+			rule.Skipped,
+			rule.SkipRuleWithSeverity(rule.SeverityMedium),
+		),
+		rule.NewSkipRule(
+			sharedrules.ID242413,
+			"The Kubernetes etcd must enforce ports, protocols, and services (PPS) that adhere to the Ports, Protocols, and Services Management Category Assurance List (PPSM CAL).",
+			"Cannot be tested and should be enforced organizationally. The ports, protocols and services of the control plane components are determined by the cluster administrator.",
+			rule.Skipped,
+			rule.SkipRuleWithSeverity(rule.SeverityMedium),
+		),
+		&managedk8srules.Rule242414{
+			Client:  client,
+			Options: opts242414,
+		},
+		&managedk8srules.Rule242415{
+			Client:  client,
+			Options: opts242415,
+		},
+		&sharedrules.Rule242417{
+			Client:  client,
+			Options: opts242417,
+		},
+		&sharedrules.Rule242418{Source: kubeAPIServerSource},
+		&sharedrules.Rule242419{Source: kubeAPIServerSource},
+		&sharedrules.Rule242420{
+			Client:       client,
+			V1RESTClient: clientSet.CoreV1().RESTClient(),
+		},
+		&sharedrules.Rule242421{Source: kubeControllerManagerSource},
+		&sharedrules.Rule242422{Source: kubeAPIServerSource},
+		&sharedrules.Rule242423{Client: client, Sources: []kubeutils.ComponentSource{etcdSource}},
+		&sharedrules.Rule242424{
+			Client:       client,
+			V1RESTClient: clientSet.CoreV1().RESTClient(),
+		},
+		&sharedrules.Rule242425{
+			Client:       client,
+			V1RESTClient: clientSet.CoreV1().RESTClient(),
+		},
+		&sharedrules.Rule242426{Client: client, Sources: []kubeutils.ComponentSource{etcdSource}},
+		&sharedrules.Rule242427{Client: client, Sources: []kubeutils.ComponentSource{etcdSource}},
+		&sharedrules.Rule242428{Client: client, Sources: []kubeutils.ComponentSource{etcdSource}},
+		&sharedrules.Rule242429{Source: kubeAPIServerSource},
+		&sharedrules.Rule242430{Source: kubeAPIServerSource},
+		&sharedrules.Rule242431{Source: kubeAPIServerSource},
+		&sharedrules.Rule242432{Client: client, Sources: []kubeutils.ComponentSource{etcdSource}},
+		&sharedrules.Rule242433{Client: client, Sources: []kubeutils.ComponentSource{etcdSource}},
+		&sharedrules.Rule242434{
+			Client:       client,
+			V1RESTClient: clientSet.CoreV1().RESTClient(),
+		},
+		&sharedrules.Rule242436{Source: kubeAPIServerSource},
+		rule.NewSkipRule(
+			sharedrules.ID242437,
+			"Kubernetes must have a pod security policy set.",
+			"PSPs are removed in K8s version 1.25.",
+			rule.Skipped,
+			rule.SkipRuleWithSeverity(rule.SeverityHigh),
+		),
+		&sharedrules.Rule242438{Source: kubeAPIServerSource},
+		&managedk8srules.Rule242442{
+			// We check only system (kube-proxy) pods in this rule, since there can be a user case to run different versions of images.
+			Client:  client,
+			Options: opts242442,
+		},
+		rule.NewSkipRule(
+			sharedrules.ID242443,
+			"Kubernetes must contain the latest updates as authorized by IAVMs, CTOs, DTMs, and STIGs.",
+			"Scanning/patching security vulnerabilities should be enforced organizationally. Security vulnerability scanning should be automated and maintainers should be informed automatically.",
+			rule.Skipped,
+			rule.SkipRuleWithSeverity(rule.SeverityMedium),
+		),
+		rule.NewSkipRule(
+			sharedrules.ID242444,
+			"Kubernetes component manifests must be owned by root.",
+			"Rule is duplicate of 242405.",
+			rule.Skipped,
+			rule.SkipRuleWithSeverity(rule.SeverityMedium),
+		),
+		retry.New(
+			retry.WithLogger(r.Logger().With("rule_id", sharedrules.ID242445)),
+			retry.WithBaseRule(&sharedrules.Rule242445{
+				Logger:     r.Logger().With("rule_id", sharedrules.ID242445),
+				InstanceID: r.instanceID,
+				Client:     client,
+				PodContext: podContext,
+				Options:    opts242445,
+				Sources:    []kubeutils.ComponentSource{etcdSource},
+			}),
+			retry.WithRetryCondition(rcFileChecks),
+			retry.WithMaxRetries(*r.args.MaxRetries),
+		),
+		retry.New(
+			retry.WithLogger(r.Logger().With("rule_id", sharedrules.ID242446)),
+			retry.WithBaseRule(&sharedrules.Rule242446{
+				Logger:     r.Logger().With("rule_id", sharedrules.ID242446),
+				InstanceID: r.instanceID,
+				Client:     client,
+				PodContext: podContext,
+				Options:    opts242446,
+				Sources:    controlPlaneSources,
+			}),
+			retry.WithRetryCondition(rcFileChecks),
+			retry.WithMaxRetries(*r.args.MaxRetries),
+		),
+		retry.New(
+			retry.WithLogger(r.Logger().With("rule_id", sharedrules.ID242447)),
+			retry.WithBaseRule(&sharedrules.Rule242447{
+				Logger:     r.Logger().With("rule_id", sharedrules.ID242447),
+				InstanceID: r.instanceID,
+				Client:     client,
+				PodContext: podContext,
+				Options:    opts242447,
+			}),
+			retry.WithRetryCondition(rcFileChecks),
+			retry.WithMaxRetries(*r.args.MaxRetries),
+		),
+		retry.New(
+			retry.WithLogger(r.Logger().With("rule_id", sharedrules.ID242448)),
+			retry.WithBaseRule(&sharedrules.Rule242448{
+				Logger:     r.Logger().With("rule_id", sharedrules.ID242448),
+				InstanceID: r.instanceID,
+				Client:     client,
+				PodContext: podContext,
+				Options:    opts242448,
+			}),
+			retry.WithRetryCondition(rcFileChecks),
+			retry.WithMaxRetries(*r.args.MaxRetries),
+		),
+		retry.New(
+			retry.WithLogger(r.Logger().With("rule_id", sharedrules.ID242449)),
+			retry.WithBaseRule(&sharedrules.Rule242449{
+				Logger:     r.Logger().With("rule_id", sharedrules.ID242449),
+				InstanceID: r.instanceID,
+				Client:     client,
+				PodContext: podContext,
+				Options:    opts242449,
+			}),
+			retry.WithRetryCondition(rcFileChecks),
+			retry.WithMaxRetries(*r.args.MaxRetries),
+		),
+		retry.New(
+			retry.WithLogger(r.Logger().With("rule_id", sharedrules.ID242450)),
+			retry.WithBaseRule(&sharedrules.Rule242450{
+				Logger:     r.Logger().With("rule_id", sharedrules.ID242450),
+				InstanceID: r.instanceID,
+				Client:     client,
+				PodContext: podContext,
+				Options:    opts242450,
+			}),
+			retry.WithRetryCondition(rcFileChecks),
+			retry.WithMaxRetries(*r.args.MaxRetries),
+		),
+		retry.New(
+			retry.WithLogger(r.Logger().With("rule_id", sharedrules.ID242451)),
+			retry.WithBaseRule(&managedk8srules.Rule242451{
+				Logger:     r.Logger().With("rule_id", sharedrules.ID242451),
+				InstanceID: r.instanceID,
+				Client:     client,
+				PodContext: podContext,
+				Options:    opts242451,
+			}),
+			retry.WithRetryCondition(rcFileChecks),
+			retry.WithMaxRetries(*r.args.MaxRetries),
+		),
+		retry.New(
+			retry.WithLogger(r.Logger().With("rule_id", sharedrules.ID242452)),
+			retry.WithBaseRule(&sharedrules.Rule242452{
+				Logger:     r.Logger().With("rule_id", sharedrules.ID242452),
+				InstanceID: r.instanceID,
+				Client:     client,
+				PodContext: podContext,
+				Options:    opts242452,
+			}),
+			retry.WithRetryCondition(rcFileChecks),
+			retry.WithMaxRetries(*r.args.MaxRetries),
+		),
+		retry.New(
+			retry.WithLogger(r.Logger().With("rule_id", sharedrules.ID242453)),
+			retry.WithBaseRule(&sharedrules.Rule242453{
+				Logger:     r.Logger().With("rule_id", sharedrules.ID242453),
+				InstanceID: r.instanceID,
+				Client:     client,
+				PodContext: podContext,
+				Options:    opts242453,
+			}),
+			retry.WithRetryCondition(rcFileChecks),
+			retry.WithMaxRetries(*r.args.MaxRetries),
+		),
+		retry.New(
+			retry.WithLogger(r.Logger().With("rule_id", sharedrules.ID242454)),
+			retry.WithBaseRule(&rules.Rule242454{
+				Logger:     r.Logger().With("rule_id", sharedrules.ID242454),
+				InstanceID: r.instanceID,
+				Client:     client,
+				PodContext: podContext,
+				Options:    opts242454,
+			}),
+			retry.WithRetryCondition(rcFileChecks),
+			retry.WithMaxRetries(*r.args.MaxRetries),
+		),
+		retry.New(
+			retry.WithLogger(r.Logger().With("rule_id", sharedrules.ID242455)),
+			retry.WithBaseRule(&rules.Rule242455{
+				Logger:     r.Logger().With("rule_id", sharedrules.ID242455),
+				InstanceID: r.instanceID,
+				Client:     client,
+				PodContext: podContext,
+				Options:    opts242455,
+			}),
+			retry.WithRetryCondition(rcFileChecks),
+			retry.WithMaxRetries(*r.args.MaxRetries),
+		),
+		rule.NewSkipRule(
+			sharedrules.ID242456,
+			"The Kubernetes kubelet config must have file permissions set to 644 or more restrictive.",
+			"Duplicate of 242452.",
+			rule.Skipped,
+			rule.SkipRuleWithSeverity(rule.SeverityMedium),
+		),
+		rule.NewSkipRule(
+			sharedrules.ID242457,
+			"The Kubernetes kubelet config must be owned by root.",
+			"Duplicate of 242453.",
+			rule.Skipped,
+			rule.SkipRuleWithSeverity(rule.SeverityMedium),
+		),
+		retry.New(
+			retry.WithLogger(r.Logger().With("rule_id", sharedrules.ID242459)),
+			retry.WithBaseRule(&sharedrules.Rule242459{
+				Logger:     r.Logger().With("rule_id", sharedrules.ID242459),
+				InstanceID: r.instanceID,
+				Client:     client,
+				PodContext: podContext,
+				Sources:    []kubeutils.ComponentSource{etcdSource},
+			}),
+			retry.WithRetryCondition(rcFileChecks),
+			retry.WithMaxRetries(*r.args.MaxRetries),
+		),
+		retry.New(
+			retry.WithLogger(r.Logger().With("rule_id", sharedrules.ID242460)),
+			retry.WithBaseRule(&sharedrules.Rule242460{
+				Logger:     r.Logger().With("rule_id", sharedrules.ID242460),
+				InstanceID: r.instanceID,
+				Client:     client,
+				PodContext: podContext,
+				Sources:    controlPlaneSources,
+			}),
+			retry.WithRetryCondition(rcFileChecks),
+			retry.WithMaxRetries(*r.args.MaxRetries),
+		),
+		&sharedrules.Rule242461{Source: kubeAPIServerSource},
+		&sharedrules.Rule242462{Source: kubeAPIServerSource},
+		&sharedrules.Rule242463{Source: kubeAPIServerSource},
+		&sharedrules.Rule242464{Source: kubeAPIServerSource},
+		rule.NewSkipRule(
+			sharedrules.ID242465,
+			"The Kubernetes API Server audit log path must be set.",
+			"Duplicate of 242402.",
+			rule.Skipped,
+			rule.SkipRuleWithSeverity(rule.SeverityMedium),
+		),
+		retry.New(
+			retry.WithLogger(r.Logger().With("rule_id", sharedrules.ID242466)),
+			retry.WithBaseRule(&managedk8srules.Rule242466{
+				Logger:     r.Logger().With("rule_id", sharedrules.ID242466),
+				InstanceID: r.instanceID,
+				Client:     client,
+				PodContext: podContext,
+				Options:    opts242466,
+			}),
+			retry.WithRetryCondition(rcFileChecks),
+			retry.WithMaxRetries(*r.args.MaxRetries),
+		),
+		retry.New(
+			retry.WithLogger(r.Logger().With("rule_id", sharedrules.ID242467)),
+			retry.WithBaseRule(&managedk8srules.Rule242467{
+				Logger:     r.Logger().With("rule_id", sharedrules.ID242467),
+				InstanceID: r.instanceID,
+				Client:     client,
+				PodContext: podContext,
+				Options:    opts242467,
+			}),
+			retry.WithRetryCondition(rcFileChecks),
+			retry.WithMaxRetries(*r.args.MaxRetries),
+		),
+		&sharedrules.Rule245541{
+			Client:       client,
+			V1RESTClient: clientSet.CoreV1().RESTClient(),
+		},
+		&sharedrules.Rule245542{Source: kubeAPIServerSource},
+		&sharedrules.Rule245543{Source: kubeAPIServerSource},
+		&sharedrules.Rule245544{Source: kubeAPIServerSource},
+		retry.New(
+			retry.WithLogger(r.Logger().With("rule_id", sharedrules.ID254800)),
+			retry.WithBaseRule(&rules.Rule254800{
+				Logger:     r.Logger().With("rule_id", sharedrules.ID254800),
+				InstanceID: r.instanceID,
+				Client:     client,
+				PodContext: podContext,
+				Options:    opts254800,
+			}),
+			retry.WithRetryCondition(rcOpsPod),
+			retry.WithMaxRetries(*r.args.MaxRetries),
+		),
+		rule.NewSkipRule(
+			// featureGates.PodSecurity made GA in v1.25 and removed in v1.28. ref https://kubernetes.io/docs/reference/command-line-tools-reference/feature-gates-removed/
+			sharedrules.ID254801,
+			"Kubernetes must enable PodSecurity admission controller on static pods and Kubelets.",
+			"Option featureGates.PodSecurity was made GA in v1.25 and removed in v1.28.",
+			rule.Skipped,
+			rule.SkipRuleWithSeverity(rule.SeverityHigh),
+		),
+	}
+
+	for i, r := range rules {
+		var severityLevel rule.SeverityLevel
+		if severity, ok := r.(rule.Severity); !ok {
+			return fmt.Errorf("rule %s does not implement rule.Severity", r.ID())
+		} else {
+			severityLevel = severity.Severity()
+		}
+
+		opt, found := ruleOptions[r.ID()]
+		if found && opt.Skip != nil && opt.Skip.Enabled {
+			rules[i] = rule.NewSkipRule(r.ID(), r.Name(), opt.Skip.Justification, rule.Accepted, rule.SkipRuleWithSeverity(severityLevel), rule.SkipRuleWithAcceptance(opt.Skip.Owner, opt.Skip.ExpiresAt))
+		}
+	}
+
+	// check that the registered rules equal
+	// the number of rules in that ruleset version
+	if len(rules) != 91 {
+		return fmt.Errorf("revision expects 91 registered rules, but got: %d", len(rules))
+	}
+
+	return r.AddRules(rules...)
+}
+
+func parseV2R3Options[O rules.RuleOption](options any) (*O, error) {
+	optionsByte, err := json.Marshal(options)
+	if err != nil {
+		return nil, err
+	}
+
+	var parsedOptions O
+	if err := json.Unmarshal(optionsByte, &parsedOptions); err != nil {
+		return nil, err
+	}
+
+	return &parsedOptions, nil
+}
+
+func getV2R3OptionOrNil[O rules.RuleOption](options any) (*O, error) {
+	if options == nil {
+		return nil, nil
+	}
+	return parseV2R3Options[O](options)
+}
@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"log/slog"
 
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/client-go/rest"
 
 	"github.com/gardener/diki/pkg/config"
@@ -40,11 +41,15 @@ type Provider struct {
 }
 
 type providerArgs struct {
-	AdditionalOpsPodLabels map[string]string `json:"additionalOpsPodLabels" yaml:"additionalOpsPodLabels"`
-	RuntimeKubeconfigPath  string            `json:"runtimeKubeconfigPath" yaml:"runtimeKubeconfigPath"`
+	AdditionalOpsPodLabels map[string]string           `json:"additionalOpsPodLabels" yaml:"additionalOpsPodLabels"`
+	RuntimeKubeconfigPath  string                      `json:"runtimeKubeconfigPath" yaml:"runtimeKubeconfigPath"`
+	RuntimeCluster         *config.ClusterAccessConfig `json:"runtimeCluster" yaml:"runtimeCluster"`
 }
 
-var _ provider.Provider = &Provider{}
+var (
+	_ provider.Provider         = &Provider{}
+	_ provider.ClusterVersioner = &Provider{}
+)
 
 // New creates a new Provider.
 func New(options ...CreateOption) (*Provider, error) {
@@ -107,6 +112,11 @@ func (p *Provider) AddRulesets(rulesets ...ruleset.Ruleset) error {
 	return nil
 }
 
+// ClusterVersions returns the Kubernetes server versions of the checked clusters.
+func (p *Provider) ClusterVersions(ctx context.Context) (map[string]string, error) {
+	return sharedprovider.ClusterVersions(ctx, map[string]*rest.Config{"runtime": p.RuntimeConfig})
+}
+
 // ID returns the id of the Provider.
 func (p *Provider) ID() string {
 	return p.id
@@ -137,7 +147,12 @@ func FromGenericConfig(providerConf config.ProviderConfig) (*Provider, error) {
 		return nil, err
 	}
 
-	runtimeKubeconfig, err := kubeutils.RESTConfigFromFile(providerGardenArgs.RuntimeKubeconfigPath)
+	runtimeClusterAccess, errs := config.NewClusterAccessConfig(providerGardenArgs.RuntimeCluster, providerGardenArgs.RuntimeKubeconfigPath, field.NewPath("runtimeCluster"), field.NewPath("runtimeKubeconfigPath"))
+	if len(errs) > 0 {
+		return nil, errs.ToAggregate()
+	}
+
+	runtimeKubeconfig, err := kubeutils.RESTConfigFromClusterAccess(runtimeClusterAccess)
 	if err != nil {
 		return nil, err
 	}
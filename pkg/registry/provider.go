@@ -0,0 +1,234 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package registry
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/diki/pkg/config"
+	"github.com/gardener/diki/pkg/metadata"
+	"github.com/gardener/diki/pkg/provider"
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/ruleset"
+)
+
+// RulesetProvider is a provider to which rulesets can be added.
+type RulesetProvider interface {
+	provider.Provider
+	AddRulesets(rulesets ...ruleset.Ruleset) error
+}
+
+// ruleAdder is implemented by rulesets to which additional rules can be added.
+type ruleAdder interface {
+	AddRules(rules ...rule.Rule) error
+}
+
+// NewProviderFunc creates a provider of type P without rulesets from its configuration.
+type NewProviderFunc[P RulesetProvider] func(conf config.ProviderConfig, logger *slog.Logger) (P, error)
+
+// RulesetFromConfigFunc creates a ruleset for the provider p from its configuration.
+// It may return a nil ruleset if it added the ruleset to the provider by itself.
+type RulesetFromConfigFunc[P RulesetProvider] func(p P, conf config.RulesetConfig, logger *slog.Logger, fldPath *field.Path) (ruleset.Ruleset, error)
+
+// RulesFunc returns additional rules for a ruleset created for the provider p.
+// Rules which are skipped in the rule options of the ruleset configuration are replaced by skip rules.
+type RulesFunc[P RulesetProvider] func(p P, rs ruleset.Ruleset, conf config.RulesetConfig) ([]rule.Rule, error)
+
+// Ruleset describes a ruleset which is available for providers of type P.
+type Ruleset[P RulesetProvider] struct {
+	// ID is the identifier of the ruleset used in the configuration.
+	ID string
+	// Name is the user-friendly name of the ruleset.
+	Name string
+	// SupportedVersions are the versions of the ruleset sorted from newest to oldest.
+	SupportedVersions []string
+	// FromConfig creates the ruleset from its configuration.
+	FromConfig RulesetFromConfigFunc[P]
+}
+
+// Provider is a [ProviderType] for providers of type P, whose rulesets and rules are registered separately.
+type Provider[P RulesetProvider] struct {
+	id          string
+	name        string
+	newProvider NewProviderFunc[P]
+
+	mu       sync.RWMutex
+	rulesets []Ruleset[P]
+	rules    map[string][]RulesFunc[P]
+}
+
+var _ ProviderType = &Provider[RulesetProvider]{}
+
+// NewProvider creates a provider type without rulesets.
+func NewProvider[P RulesetProvider](id, name string, newProvider NewProviderFunc[P]) *Provider[P] {
+	return &Provider[P]{
+		id:          id,
+		name:        name,
+		newProvider: newProvider,
+		rules:       map[string][]RulesFunc[P]{},
+	}
+}
+
+// ID returns the provider identifier used in the configuration.
+func (t *Provider[P]) ID() string {
+	return t.id
+}
+
+// Name returns the user-friendly name of the provider.
+func (t *Provider[P]) Name() string {
+	return t.name
+}
+
+// RegisterRulesets makes rulesets available for the provider type.
+func (t *Provider[P]) RegisterRulesets(rulesets ...Ruleset[P]) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for _, rs := range rulesets {
+		if rs.FromConfig == nil {
+			return fmt.Errorf("ruleset with id %s has no FromConfig function", rs.ID)
+		}
+		if slices.ContainsFunc(t.rulesets, func(registered Ruleset[P]) bool { return registered.ID == rs.ID }) {
+			return fmt.Errorf("ruleset with id %s is already registered for provider %s", rs.ID, t.id)
+		}
+		t.rulesets = append(t.rulesets, rs)
+	}
+	return nil
+}
+
+// RegisterRules adds the rules returned by rulesFunc to every ruleset with the given id which is created for the provider type.
+// The ruleset has to be registered before and its rulesets have to implement AddRules.
+// Rulesets which are added to the provider by their FromConfig function get the rules only if the function adds them with [Provider.AddRules].
+func (t *Provider[P]) RegisterRules(rulesetID string, rulesFunc RulesFunc[P]) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if !slices.ContainsFunc(t.rulesets, func(registered Ruleset[P]) bool { return registered.ID == rulesetID }) {
+		return fmt.Errorf("ruleset with id %s is not registered for provider %s", rulesetID, t.id)
+	}
+	t.rules[rulesetID] = append(t.rules[rulesetID], rulesFunc)
+	return nil
+}
+
+// FromConfig creates a provider with its configured rulesets.
+func (t *Provider[P]) FromConfig(conf config.ProviderConfig, logger *slog.Logger, fldPath *field.Path) (provider.Provider, error) {
+	p, err := t.newProvider(conf, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := t.addRulesets(p, conf, logger, fldPath); err != nil {
+		return nil, errors.Join(err, provider.Close(p))
+	}
+	return p, nil
+}
+
+// addRulesets creates the configured rulesets and adds them to the provider.
+func (t *Provider[P]) addRulesets(p P, conf config.ProviderConfig, logger *slog.Logger, fldPath *field.Path) error {
+	// the registered rulesets are copied, so that FromConfig functions can call AddRules without holding the lock
+	t.mu.RLock()
+	registered := slices.Clone(t.rulesets)
+	t.mu.RUnlock()
+
+	rulesetsPath := fldPath.Child("rulesets")
+	rulesets := make([]ruleset.Ruleset, 0, len(conf.Rulesets))
+	for rulesetIdx, rulesetConfig := range conf.Rulesets {
+		idx := slices.IndexFunc(registered, func(r Ruleset[P]) bool { return r.ID == rulesetConfig.ID })
+		if idx < 0 {
+			return fmt.Errorf("unknown ruleset identifier: %s", rulesetConfig.ID)
+		}
+
+		rulesetLogger := logger.With("ruleset", rulesetConfig.ID, "version", rulesetConfig.Version)
+		rs, err := registered[idx].FromConfig(p, rulesetConfig, rulesetLogger, rulesetsPath.Index(rulesetIdx))
+		if err != nil {
+			return err
+		}
+		if rs == nil {
+			continue
+		}
+
+		if err := t.AddRules(p, rs, rulesetConfig); err != nil {
+			return err
+		}
+		rulesets = append(rulesets, rs)
+	}
+
+	return p.AddRulesets(rulesets...)
+}
+
+// AddRules adds the registered additional rules to a ruleset created for the provider p from its configuration.
+// It is called for the rulesets returned by the FromConfig functions of the rulesets. FromConfig functions which add
+// rulesets to the provider by themselves, e.g. rulesets created for every target of the provider, have to call it for them.
+func (t *Provider[P]) AddRules(p P, rs ruleset.Ruleset, conf config.RulesetConfig) error {
+	t.mu.RLock()
+	rulesFuncs := slices.Clone(t.rules[rs.ID()])
+	t.mu.RUnlock()
+	if len(rulesFuncs) == 0 {
+		return nil
+	}
+
+	adder, ok := rs.(ruleAdder)
+	if !ok {
+		return fmt.Errorf("ruleset with id %s does not support additional rules", rs.ID())
+	}
+
+	for _, rulesFunc := range rulesFuncs {
+		rules, err := rulesFunc(p, rs, conf)
+		if err != nil {
+			return err
+		}
+		for i, r := range rules {
+			for _, opt := range conf.RuleOptions {
+				if opt.RuleID == r.ID() && opt.Skip != nil && opt.Skip.Enabled {
+					skipOptions := []rule.SkipRuleOption{rule.SkipRuleWithAcceptance(opt.Skip.Owner, opt.Skip.ExpiresAt)}
+					if severity, ok := r.(rule.Severity); ok {
+						skipOptions = append(skipOptions, rule.SkipRuleWithSeverity(severity.Severity()))
+					}
+					rules[i] = rule.NewSkipRule(r.ID(), r.Name(), opt.Skip.Justification, rule.Accepted, skipOptions...)
+				}
+			}
+		}
+		if err := adder.AddRules(rules...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Metadata returns the metadata of the provider and its registered rulesets.
+// The first supported version of every ruleset is marked as latest.
+func (t *Provider[P]) Metadata() metadata.ProviderDetailed {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	providerMetadata := metadata.ProviderDetailed{
+		Provider: metadata.Provider{
+			ID:   t.id,
+			Name: t.name,
+		},
+		Rulesets: make([]metadata.Ruleset, 0, len(t.rulesets)),
+	}
+
+	for _, rs := range t.rulesets {
+		rulesetMetadata := metadata.Ruleset{ID: rs.ID, Name: rs.Name}
+		for _, supportedVersion := range rs.SupportedVersions {
+			rulesetMetadata.Versions = append(rulesetMetadata.Versions, metadata.Version{Version: supportedVersion, Latest: false})
+		}
+
+		// Mark the first version as latest as the versions are sorted from newest to oldest
+		if len(rulesetMetadata.Versions) > 0 {
+			rulesetMetadata.Versions[0].Latest = true
+		}
+		providerMetadata.Rulesets = append(providerMetadata.Rulesets, rulesetMetadata)
+	}
+
+	return providerMetadata
+}
@@ -0,0 +1,174 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package registry contains the provider types which diki can create from its configuration,
+// together with the rulesets and rules which are available for each of them.
+//
+// The built-in providers and rulesets are registered by the builder package.
+// Other Go modules can register additional provider types, rulesets and rules
+// before the registry is passed to the runner or the diki command.
+package registry
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/diki/pkg/config"
+	"github.com/gardener/diki/pkg/metadata"
+	"github.com/gardener/diki/pkg/provider"
+	"github.com/gardener/diki/pkg/provider/plugin"
+)
+
+// ProviderType creates providers of one type from their configuration.
+type ProviderType interface {
+	// ID returns the provider identifier used in the configuration.
+	ID() string
+	// Name returns the user-friendly name of the provider.
+	Name() string
+	// FromConfig creates a provider with its configured rulesets.
+	FromConfig(conf config.ProviderConfig, logger *slog.Logger, fldPath *field.Path) (provider.Provider, error)
+	// Metadata returns the metadata of the provider and its supported rulesets.
+	Metadata() metadata.ProviderDetailed
+}
+
+// Registry contains the provider types which can be created from a diki configuration.
+// It is safe for concurrent use.
+type Registry struct {
+	mu            sync.RWMutex
+	providerTypes map[string]ProviderType
+}
+
+// New creates an empty Registry.
+func New() *Registry {
+	return &Registry{
+		providerTypes: map[string]ProviderType{},
+	}
+}
+
+// Register adds provider types to the Registry.
+func (r *Registry) Register(providerTypes ...ProviderType) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, providerType := range providerTypes {
+		if _, ok := r.providerTypes[providerType.ID()]; ok {
+			return fmt.Errorf("provider with id %s is already registered", providerType.ID())
+		}
+		r.providerTypes[providerType.ID()] = providerType
+	}
+	return nil
+}
+
+// ProviderType returns the provider type with the given id.
+func (r *Registry) ProviderType(id string) (ProviderType, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	providerType, ok := r.providerTypes[id]
+	return providerType, ok
+}
+
+// ProviderTypes returns all registered provider types sorted by their id.
+func (r *Registry) ProviderTypes() []ProviderType {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	providerTypes := make([]ProviderType, 0, len(r.providerTypes))
+	for _, providerType := range r.providerTypes {
+		providerTypes = append(providerTypes, providerType)
+	}
+	slices.SortFunc(providerTypes, func(a, b ProviderType) int {
+		return cmp.Compare(a.ID(), b.ID())
+	})
+	return providerTypes
+}
+
+// NewProviders creates the providers of the configuration.
+// Providers with a plugin configuration are run by their plugin executable instead of a registered provider type.
+// The logger is passed to the providers, which log with it and its derivatives.
+// The providers have to be closed with [provider.Close] after they are run.
+func (r *Registry) NewProviders(c *config.DikiConfig, logger *slog.Logger) (_ map[string]provider.Provider, err error) {
+	providers := map[string]provider.Provider{}
+	rootPath := field.NewPath("providers")
+	defer func() {
+		if err != nil {
+			err = errors.Join(err, provider.Close(slices.Collect(maps.Values(providers))...))
+		}
+	}()
+
+	for providerIdx, providerConfig := range c.Providers {
+		var (
+			p              provider.Provider
+			err            error
+			providerLogger = logger.With("provider", providerConfig.ID)
+			providerPath   = rootPath.Index(providerIdx)
+		)
+
+		if err := providerConfig.Validate(providerPath).ToAggregate(); err != nil {
+			return nil, err
+		}
+
+		if providerConfig.Plugin != nil {
+			if _, ok := r.ProviderType(providerConfig.ID); ok {
+				return nil, field.Invalid(providerPath.Child("id"), providerConfig.ID, "plugin providers must not use the id of a registered provider")
+			}
+			p, err = plugin.FromGenericConfig(providerConfig, providerLogger, providerPath)
+		} else {
+			providerType, ok := r.ProviderType(providerConfig.ID)
+			if !ok {
+				return nil, fmt.Errorf("unknown provider identifier: %s", providerConfig.ID)
+			}
+			p, err = providerType.FromConfig(providerConfig, providerLogger, providerPath)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := providers[p.ID()]; ok {
+			return nil, errors.Join(fmt.Errorf("provider with id %s was already registered", p.ID()), provider.Close(p))
+		}
+		providers[p.ID()] = p
+	}
+
+	return providers, nil
+}
+
+// RegisterRulesets registers rulesets with the provider type with the given id.
+// The provider type has to be a [Provider] for providers of type P.
+func RegisterRulesets[P RulesetProvider](r *Registry, providerID string, rulesets ...Ruleset[P]) error {
+	providerType, err := typedProvider[P](r, providerID)
+	if err != nil {
+		return err
+	}
+	return providerType.RegisterRulesets(rulesets...)
+}
+
+// RegisterRules registers additional rules for a ruleset of the provider type with the given id.
+// The provider type has to be a [Provider] for providers of type P.
+func RegisterRules[P RulesetProvider](r *Registry, providerID, rulesetID string, rulesFunc RulesFunc[P]) error {
+	providerType, err := typedProvider[P](r, providerID)
+	if err != nil {
+		return err
+	}
+	return providerType.RegisterRules(rulesetID, rulesFunc)
+}
+
+func typedProvider[P RulesetProvider](r *Registry, providerID string) (*Provider[P], error) {
+	providerType, ok := r.ProviderType(providerID)
+	if !ok {
+		return nil, fmt.Errorf("unknown provider identifier: %s", providerID)
+	}
+
+	typed, ok := providerType.(*Provider[P])
+	if !ok {
+		return nil, fmt.Errorf("provider with id %s does not support rulesets for providers of type %T", providerID, *new(P))
+	}
+	return typed, nil
+}
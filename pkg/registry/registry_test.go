@@ -0,0 +1,304 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package registry_test
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/diki/pkg/config"
+	"github.com/gardener/diki/pkg/metadata"
+	"github.com/gardener/diki/pkg/provider"
+	"github.com/gardener/diki/pkg/provider/builder"
+	"github.com/gardener/diki/pkg/provider/managedk8s"
+	"github.com/gardener/diki/pkg/registry"
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/ruleset"
+)
+
+var logger = slog.New(slog.DiscardHandler)
+
+type fakeRule struct {
+	id       string
+	severity rule.SeverityLevel
+}
+
+func (r *fakeRule) ID() string                   { return r.id }
+func (r *fakeRule) Name() string                 { return "Rule " + r.id }
+func (r *fakeRule) Severity() rule.SeverityLevel { return r.severity }
+func (r *fakeRule) Run(_ context.Context) (rule.RuleResult, error) {
+	return rule.RuleResult{RuleID: r.id, CheckResults: []rule.CheckResult{rule.PassedCheckResult("passed", rule.NewTarget())}}, nil
+}
+
+type fakeRuleset struct {
+	id, version string
+	rules       []rule.Rule
+}
+
+func (r *fakeRuleset) ID() string      { return r.id }
+func (r *fakeRuleset) Name() string    { return "Ruleset " + r.id }
+func (r *fakeRuleset) Version() string { return r.version }
+func (r *fakeRuleset) Run(_ context.Context) (ruleset.RulesetResult, error) {
+	return ruleset.RulesetResult{}, nil
+}
+func (r *fakeRuleset) RunRule(_ context.Context, _ string) (rule.RuleResult, error) {
+	return rule.RuleResult{}, nil
+}
+func (r *fakeRuleset) AddRules(rules ...rule.Rule) error {
+	r.rules = append(r.rules, rules...)
+	return nil
+}
+
+type fakeProvider struct {
+	id       string
+	rulesets []ruleset.Ruleset
+}
+
+func (p *fakeProvider) ID() string                  { return p.id }
+func (p *fakeProvider) Name() string                { return "Provider " + p.id }
+func (p *fakeProvider) Metadata() map[string]string { return nil }
+func (p *fakeProvider) RunAll(_ context.Context) (provider.ProviderResult, error) {
+	return provider.ProviderResult{}, nil
+}
+func (p *fakeProvider) RunRuleset(_ context.Context, _, _ string) (ruleset.RulesetResult, error) {
+	return ruleset.RulesetResult{}, nil
+}
+func (p *fakeProvider) RunRule(_ context.Context, _, _, _ string) (rule.RuleResult, error) {
+	return rule.RuleResult{}, nil
+}
+func (p *fakeProvider) AddRulesets(rulesets ...ruleset.Ruleset) error {
+	p.rulesets = append(p.rulesets, rulesets...)
+	return nil
+}
+
+func newFakeProviderType(id string) *registry.Provider[*fakeProvider] {
+	return registry.NewProvider(id, "Provider "+id, func(conf config.ProviderConfig, _ *slog.Logger) (*fakeProvider, error) {
+		if conf.Args != nil {
+			return nil, fmt.Errorf("unexpected args")
+		}
+		return &fakeProvider{id: conf.ID}, nil
+	})
+}
+
+func fakeRulesetFromConfig(_ *fakeProvider, conf config.RulesetConfig, _ *slog.Logger, fldPath *field.Path) (ruleset.Ruleset, error) {
+	if conf.Version != "v1" && conf.Version != "v2" {
+		return nil, field.Invalid(fldPath.Child("version"), conf.Version, "unknown version")
+	}
+	return &fakeRuleset{id: conf.ID, version: conf.Version, rules: []rule.Rule{&fakeRule{id: "1"}}}, nil
+}
+
+func ruleIDs(rs ruleset.Ruleset) []string {
+	var ids []string
+	for _, r := range rs.(*fakeRuleset).rules {
+		ids = append(ids, r.ID())
+	}
+	return ids
+}
+
+var _ = Describe("registry", func() {
+	var (
+		r   *registry.Registry
+		foo *registry.Provider[*fakeProvider]
+	)
+
+	BeforeEach(func() {
+		r = registry.New()
+		foo = newFakeProviderType("foo")
+		Expect(foo.RegisterRulesets(registry.Ruleset[*fakeProvider]{
+			ID:                "one",
+			Name:              "Ruleset One",
+			SupportedVersions: []string{"v2", "v1"},
+			FromConfig:        fakeRulesetFromConfig,
+		})).To(Succeed())
+		Expect(r.Register(foo, newFakeProviderType("bar"))).To(Succeed())
+	})
+
+	Describe("#Register", func() {
+		It("should return the provider types sorted by id", func() {
+			var ids []string
+			for _, providerType := range r.ProviderTypes() {
+				ids = append(ids, providerType.ID())
+			}
+			Expect(ids).To(Equal([]string{"bar", "foo"}))
+
+			providerType, ok := r.ProviderType("foo")
+			Expect(ok).To(BeTrue())
+			Expect(providerType).To(BeIdenticalTo(foo))
+		})
+
+		It("should not register a provider type twice", func() {
+			Expect(r.Register(newFakeProviderType("foo"))).To(MatchError("provider with id foo is already registered"))
+		})
+	})
+
+	Describe("#RegisterRulesets", func() {
+		It("should register rulesets with a provider type of the registry", func() {
+			Expect(registry.RegisterRulesets(r, "bar", registry.Ruleset[*fakeProvider]{ID: "two", FromConfig: fakeRulesetFromConfig})).To(Succeed())
+
+			providerType, _ := r.ProviderType("bar")
+			Expect(providerType.Metadata().Rulesets).To(Equal([]metadata.Ruleset{{ID: "two"}}))
+		})
+
+		It("should not register a ruleset twice", func() {
+			Expect(registry.RegisterRulesets(r, "foo", registry.Ruleset[*fakeProvider]{ID: "one", FromConfig: fakeRulesetFromConfig})).To(MatchError("ruleset with id one is already registered for provider foo"))
+		})
+
+		It("should return an error for unknown providers", func() {
+			Expect(registry.RegisterRulesets(r, "baz", registry.Ruleset[*fakeProvider]{ID: "one", FromConfig: fakeRulesetFromConfig})).To(MatchError("unknown provider identifier: baz"))
+		})
+
+		It("should return an error for providers of another type", func() {
+			Expect(registry.RegisterRulesets(r, "foo", registry.Ruleset[*managedk8s.Provider]{ID: "two", FromConfig: func(*managedk8s.Provider, config.RulesetConfig, *slog.Logger, *field.Path) (ruleset.Ruleset, error) {
+				return nil, nil
+			}})).To(MatchError("provider with id foo does not support rulesets for providers of type *managedk8s.Provider"))
+		})
+
+		It("should require a FromConfig function", func() {
+			Expect(foo.RegisterRulesets(registry.Ruleset[*fakeProvider]{ID: "two"})).To(MatchError("ruleset with id two has no FromConfig function"))
+		})
+	})
+
+	Describe("#Metadata", func() {
+		It("should mark the first supported version as latest", func() {
+			Expect(foo.Metadata()).To(Equal(metadata.ProviderDetailed{
+				Provider: metadata.Provider{ID: "foo", Name: "Provider foo"},
+				Rulesets: []metadata.Ruleset{
+					{
+						ID:       "one",
+						Name:     "Ruleset One",
+						Versions: []metadata.Version{{Version: "v2", Latest: true}, {Version: "v1"}},
+					},
+				},
+			}))
+		})
+	})
+
+	Describe("#NewProviders", func() {
+		It("should create the configured providers with their rulesets", func() {
+			providers, err := r.NewProviders(&config.DikiConfig{
+				Providers: []config.ProviderConfig{
+					{ID: "foo", Rulesets: []config.RulesetConfig{{ID: "one", Version: "v1"}, {ID: "one", Version: "v2"}}},
+					{ID: "bar"},
+				},
+			}, logger)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(providers).To(HaveLen(2))
+			Expect(providers["bar"].(*fakeProvider).rulesets).To(BeEmpty())
+			rulesets := providers["foo"].(*fakeProvider).rulesets
+			Expect(rulesets).To(HaveLen(2))
+			Expect(rulesets[0].Version()).To(Equal("v1"))
+			Expect(rulesets[1].Version()).To(Equal("v2"))
+		})
+
+		DescribeTable("should return an error for invalid configurations",
+			func(providers []config.ProviderConfig, expectedErr string) {
+				_, err := r.NewProviders(&config.DikiConfig{Providers: providers}, logger)
+				Expect(err).To(MatchError(expectedErr))
+			},
+			Entry("unknown provider", []config.ProviderConfig{{ID: "baz"}}, "unknown provider identifier: baz"),
+			Entry("duplicated provider", []config.ProviderConfig{{ID: "foo"}, {ID: "foo"}}, "provider with id foo was already registered"),
+			Entry("unknown ruleset", []config.ProviderConfig{{ID: "foo", Rulesets: []config.RulesetConfig{{ID: "two", Version: "v1"}}}}, "unknown ruleset identifier: two"),
+			Entry("invalid ruleset", []config.ProviderConfig{{ID: "foo", Rulesets: []config.RulesetConfig{{ID: "one", Version: "v3"}}}}, "providers[0].rulesets[0].version: Invalid value: \"v3\": unknown version"),
+			Entry("invalid provider", []config.ProviderConfig{{ID: "foo", Args: map[string]any{"foo": "bar"}}}, "unexpected args"),
+			Entry("plugin with id of registered provider", []config.ProviderConfig{{ID: "foo", Plugin: &config.PluginConfig{Command: "foo"}}}, "providers[0].id: Invalid value: \"foo\": plugin providers must not use the id of a registered provider"),
+			Entry("plugin without command", []config.ProviderConfig{{ID: "baz", Plugin: &config.PluginConfig{}}}, "plugin command is not set"),
+		)
+	})
+
+	Describe("#RegisterRules", func() {
+		It("should add the rules to the created rulesets", func() {
+			Expect(registry.RegisterRules(r, "foo", "one", func(_ *fakeProvider, rs ruleset.Ruleset, _ config.RulesetConfig) ([]rule.Rule, error) {
+				return []rule.Rule{&fakeRule{id: "custom-" + rs.Version()}, &fakeRule{id: "skipped", severity: rule.SeverityHigh}}, nil
+			})).To(Succeed())
+
+			providers, err := r.NewProviders(&config.DikiConfig{
+				Providers: []config.ProviderConfig{
+					{
+						ID: "foo",
+						Rulesets: []config.RulesetConfig{
+							{
+								ID:          "one",
+								Version:     "v2",
+								RuleOptions: []config.RuleOptionsConfig{{RuleID: "skipped", Skip: &config.RuleOptionSkipConfig{Enabled: true, Justification: "foo"}}},
+							},
+						},
+					},
+				},
+			}, logger)
+			Expect(err).ToNot(HaveOccurred())
+
+			rs := providers["foo"].(*fakeProvider).rulesets[0]
+			Expect(ruleIDs(rs)).To(Equal([]string{"1", "custom-v2", "skipped"}))
+			Expect(rs.(*fakeRuleset).rules[2]).To(BeAssignableToTypeOf(&rule.SkipRule{}))
+			Expect(rs.(*fakeRuleset).rules[2].(rule.Severity).Severity()).To(Equal(rule.SeverityHigh))
+		})
+
+		It("should add the rules to rulesets which are added to the provider by their FromConfig function", func() {
+			Expect(foo.RegisterRulesets(registry.Ruleset[*fakeProvider]{
+				ID: "per-target",
+				FromConfig: func(p *fakeProvider, conf config.RulesetConfig, _ *slog.Logger, _ *field.Path) (ruleset.Ruleset, error) {
+					for _, target := range []string{"a", "b"} {
+						rs := &fakeRuleset{id: conf.ID, version: target}
+						if err := foo.AddRules(p, rs, conf); err != nil {
+							return nil, err
+						}
+						if err := p.AddRulesets(rs); err != nil {
+							return nil, err
+						}
+					}
+					return nil, nil
+				},
+			})).To(Succeed())
+			Expect(registry.RegisterRules(r, "foo", "per-target", func(_ *fakeProvider, rs ruleset.Ruleset, _ config.RulesetConfig) ([]rule.Rule, error) {
+				return []rule.Rule{&fakeRule{id: "custom-" + rs.Version()}}, nil
+			})).To(Succeed())
+
+			providers, err := r.NewProviders(&config.DikiConfig{
+				Providers: []config.ProviderConfig{{ID: "foo", Rulesets: []config.RulesetConfig{{ID: "per-target", Version: "v1"}}}},
+			}, logger)
+			Expect(err).ToNot(HaveOccurred())
+
+			rulesets := providers["foo"].(*fakeProvider).rulesets
+			Expect(rulesets).To(HaveLen(2))
+			Expect(ruleIDs(rulesets[0])).To(Equal([]string{"custom-a"}))
+			Expect(ruleIDs(rulesets[1])).To(Equal([]string{"custom-b"}))
+		})
+
+		It("should return an error for unknown rulesets", func() {
+			Expect(registry.RegisterRules(r, "foo", "two", func(*fakeProvider, ruleset.Ruleset, config.RulesetConfig) ([]rule.Rule, error) {
+				return nil, nil
+			})).To(MatchError("ruleset with id two is not registered for provider foo"))
+		})
+	})
+
+	Describe("built-in registry", func() {
+		It("should contain all built-in providers", func() {
+			r, err := builder.NewRegistry()
+			Expect(err).ToNot(HaveOccurred())
+
+			var ids []string
+			for _, providerType := range r.ProviderTypes() {
+				ids = append(ids, providerType.ID())
+			}
+			Expect(ids).To(Equal([]string{"garden", "gardener", "linuxhost", "managedk8s", "manifests", "selfmanagedk8s", "virtualgarden"}))
+
+			providerType, _ := r.ProviderType("managedk8s")
+			var rulesetIDs []string
+			for _, rs := range providerType.Metadata().Rulesets {
+				Expect(rs.Versions).ToNot(BeEmpty())
+				Expect(rs.Versions[0].Latest).To(BeTrue())
+				rulesetIDs = append(rulesetIDs, rs.ID)
+			}
+			Expect(slices.Sorted(slices.Values(rulesetIDs))).To(Equal([]string{"disa-kubernetes-stig", "security-hardened-k8s"}))
+		})
+	})
+})
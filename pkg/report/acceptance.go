@@ -0,0 +1,103 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package report
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/gardener/diki/pkg/provider"
+	"github.com/gardener/diki/pkg/rule"
+)
+
+// UpcomingExpiration describes an acceptance which is
+// still valid at the time of the report but expires in the future.
+type UpcomingExpiration struct {
+	ProviderID     string    `json:"providerID"`
+	RulesetID      string    `json:"rulesetID"`
+	RulesetVersion string    `json:"rulesetVersion"`
+	RuleID         string    `json:"ruleID"`
+	Message        string    `json:"message"`
+	Owner          string    `json:"owner,omitempty"`
+	ExpiresAt      time.Time `json:"expiresAt"`
+}
+
+// AcceptanceGracePeriod is the period after the expiration of an acceptance
+// during which the affected checks are reported with Warning status instead of Failed.
+type AcceptanceGracePeriod time.Duration
+
+// ApplyToReport implements ReportOption.
+func (gp AcceptanceGracePeriod) ApplyToReport(opts *ReportOptions) {
+	if gp >= 0 {
+		opts.AcceptanceGracePeriod = time.Duration(gp)
+	}
+}
+
+// expireAcceptance returns the check result with Failed status if it was accepted
+// by an acceptance which has expired at the given time. During the grace period
+// the check result is returned with Warning status instead.
+func expireAcceptance(checkResult rule.CheckResult, now time.Time, gracePeriod time.Duration) rule.CheckResult {
+	if checkResult.Status != rule.Accepted || !checkResult.Acceptance.IsExpired(now) {
+		return checkResult
+	}
+
+	expiresAt := *checkResult.Acceptance.ExpiresAt
+	status := rule.Failed
+	if now.Before(expiresAt.Add(gracePeriod)) {
+		status = rule.Warning
+	}
+
+	owner := ""
+	if len(checkResult.Acceptance.Owner) > 0 {
+		owner = fmt.Sprintf(" owned by %s", checkResult.Acceptance.Owner)
+	}
+
+	checkResult.Status = status
+	checkResult.Message = fmt.Sprintf("Acceptance%s expired on %s: %s", owner, expiresAt.UTC().Format(time.DateOnly), checkResult.Message)
+	return checkResult
+}
+
+// getUpcomingExpirations returns all acceptances of accepted checks which have not expired
+// at the given time, sorted by their expiration date.
+func getUpcomingExpirations(results []provider.ProviderResult, now time.Time) []UpcomingExpiration {
+	var upcoming []UpcomingExpiration
+	for _, providerResult := range results {
+		for _, rulesetResult := range providerResult.RulesetResults {
+			for _, ruleResult := range rulesetResult.RuleResults {
+				for _, checkResult := range ruleResult.CheckResults {
+					acceptance := checkResult.Acceptance
+					if checkResult.Status != rule.Accepted || acceptance == nil || acceptance.ExpiresAt == nil || acceptance.IsExpired(now) {
+						continue
+					}
+
+					expiration := UpcomingExpiration{
+						ProviderID:     providerResult.ProviderID,
+						RulesetID:      rulesetResult.RulesetID,
+						RulesetVersion: rulesetResult.RulesetVersion,
+						RuleID:         ruleResult.RuleID,
+						Message:        checkResult.Message,
+						Owner:          acceptance.Owner,
+						ExpiresAt:      *acceptance.ExpiresAt,
+					}
+					if !slices.Contains(upcoming, expiration) {
+						upcoming = append(upcoming, expiration)
+					}
+				}
+			}
+		}
+	}
+
+	slices.SortStableFunc(upcoming, func(a, b UpcomingExpiration) int {
+		return cmp.Or(
+			a.ExpiresAt.Compare(b.ExpiresAt),
+			cmp.Compare(a.ProviderID, b.ProviderID),
+			cmp.Compare(a.RulesetID, b.RulesetID),
+			cmp.Compare(a.RuleID, b.RuleID),
+		)
+	})
+	return upcoming
+}
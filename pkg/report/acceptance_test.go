@@ -0,0 +1,113 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package report_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/diki/pkg/provider"
+	"github.com/gardener/diki/pkg/report"
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/ruleset"
+)
+
+var _ = Describe("acceptance", func() {
+	var (
+		future             = time.Now().UTC().Add(30 * 24 * time.Hour).Truncate(time.Second)
+		expired            = time.Now().UTC().Add(-48 * time.Hour).Truncate(time.Second)
+		newProviderResults = func(checkResults ...rule.CheckResult) []provider.ProviderResult {
+			return []provider.ProviderResult{
+				{
+					ProviderID:   "provider-foo",
+					ProviderName: "Provider Foo",
+					RulesetResults: []ruleset.RulesetResult{
+						{
+							RulesetID:      "ruleset-foo",
+							RulesetName:    "Ruleset Foo",
+							RulesetVersion: "v1",
+							RuleResults: []rule.RuleResult{
+								{
+									RuleID:       "1",
+									RuleName:     "1",
+									CheckResults: checkResults,
+								},
+							},
+						},
+					},
+				},
+			}
+		}
+		checks = func(rep *report.Report) []report.Check {
+			return rep.Providers[0].Rulesets[0].Rules[0].Checks
+		}
+	)
+
+	It("should keep checks accepted by valid acceptances and list upcoming expirations", func() {
+		acceptance := &rule.Acceptance{Owner: "team-foo", ExpiresAt: &future}
+		rep := report.FromProviderResults(newProviderResults(
+			rule.AcceptedCheckResultWithAcceptance("foo", rule.NewTarget("name", "bar"), acceptance),
+			rule.AcceptedCheckResult("baz", rule.NewTarget()),
+		))
+
+		Expect(checks(rep)).To(ConsistOf(
+			report.Check{
+				Status:     rule.Accepted,
+				Message:    "foo",
+				Targets:    []rule.Target{rule.NewTarget("name", "bar")},
+				Acceptance: acceptance,
+			},
+			report.Check{
+				Status:  rule.Accepted,
+				Message: "baz",
+			},
+		))
+		Expect(rep.UpcomingExpirations).To(Equal([]report.UpcomingExpiration{
+			{
+				ProviderID:     "provider-foo",
+				RulesetID:      "ruleset-foo",
+				RulesetVersion: "v1",
+				RuleID:         "1",
+				Message:        "foo",
+				Owner:          "team-foo",
+				ExpiresAt:      future,
+			},
+		}))
+	})
+
+	It("should fail checks accepted by expired acceptances", func() {
+		acceptance := &rule.Acceptance{Owner: "team-foo", ExpiresAt: &expired}
+		rep := report.FromProviderResults(newProviderResults(
+			rule.AcceptedCheckResultWithAcceptance("foo", rule.NewTarget("name", "bar"), acceptance),
+		))
+
+		Expect(checks(rep)).To(Equal([]report.Check{
+			{
+				Status:     rule.Failed,
+				Message:    "Acceptance owned by team-foo expired on " + expired.Format(time.DateOnly) + ": foo",
+				Targets:    []rule.Target{rule.NewTarget("name", "bar")},
+				Acceptance: acceptance,
+			},
+		}))
+		Expect(rep.UpcomingExpirations).To(BeEmpty())
+	})
+
+	It("should warn for checks accepted by expired acceptances during the grace period", func() {
+		acceptance := &rule.Acceptance{ExpiresAt: &expired}
+		rep := report.FromProviderResults(newProviderResults(
+			rule.AcceptedCheckResultWithAcceptance("foo", rule.NewTarget(), acceptance),
+		), report.AcceptanceGracePeriod(7*24*time.Hour), report.MinStatus(rule.Warning))
+
+		Expect(checks(rep)).To(Equal([]report.Check{
+			{
+				Status:     rule.Warning,
+				Message:    "Acceptance expired on " + expired.Format(time.DateOnly) + ": foo",
+				Acceptance: acceptance,
+			},
+		}))
+	})
+})
@@ -27,16 +27,23 @@ type DifferenceReport struct {
 	Time      time.Time            `json:"time"`
 	MinStatus rule.Status          `json:"minStatus,omitempty"`
 	Providers []ProviderDifference `json:"providers"`
+	// OldReportDigest is the digest of the old report.
+	OldReportDigest string `json:"oldReportDigest,omitempty"`
+	// NewReportDigest is the digest of the new report.
+	NewReportDigest string `json:"newReportDigest,omitempty"`
 }
 
 // ProviderDifference contains the difference between two reports
 // for a known provider and its ran rulesets.
 type ProviderDifference struct {
-	ID          string              `json:"id"`
-	Name        string              `json:"name"`
-	OldMetadata map[string]string   `json:"oldMetadata,omitempty"`
-	NewMetadata map[string]string   `json:"newMetadata,omitempty"`
-	Rulesets    []RulesetDifference `json:"rulesets"`
+	ID          string            `json:"id"`
+	Name        string            `json:"name"`
+	OldMetadata map[string]string `json:"oldMetadata,omitempty"`
+	NewMetadata map[string]string `json:"newMetadata,omitempty"`
+	// ConfigChanges contains the changes of the run configuration
+	// if both reports contain their provenance.
+	ConfigChanges []ConfigChange      `json:"configChanges,omitempty"`
+	Rulesets      []RulesetDifference `json:"rulesets"`
 }
 
 // RulesetDifference contains the difference between two reports
@@ -82,7 +89,7 @@ func CreateDifference(oldReport, newReport Report, title string) (*DifferenceRep
 
 	for _, provider := range providers {
 		oldProviderIdx := slices.IndexFunc(oldReport.Providers, func(p Provider) bool {
-			return p.ID == provider
+			return newProviderKey(p.ID, p.Metadata) == provider
 		})
 
 		oldProvider := Provider{}
@@ -91,7 +98,7 @@ func CreateDifference(oldReport, newReport Report, title string) (*DifferenceRep
 		}
 
 		newProviderIdx := slices.IndexFunc(newReport.Providers, func(p Provider) bool {
-			return p.ID == provider
+			return newProviderKey(p.ID, p.Metadata) == provider
 		})
 
 		newProvider := Provider{}
@@ -160,11 +167,12 @@ func CreateDifference(oldReport, newReport Report, title string) (*DifferenceRep
 			providerName = oldProvider.Name
 		}
 		diff.Providers = append(diff.Providers, ProviderDifference{
-			ID:          provider,
-			Name:        providerName,
-			OldMetadata: oldMetadata,
-			NewMetadata: newMetadata,
-			Rulesets:    rulesetDiff,
+			ID:            provider.id,
+			Name:          providerName,
+			OldMetadata:   oldMetadata,
+			NewMetadata:   newMetadata,
+			ConfigChanges: getConfigChanges(oldReport.Provenance, newReport.Provenance, provider),
+			Rulesets:      rulesetDiff,
 		})
 	}
 	return diff, nil
@@ -253,21 +261,17 @@ func getCheckDifference(rules1, rules2 []Rule) []Rule {
 	return uniqueRulesChecks
 }
 
-// getUniqueProviders returns a list of all unique
-// provider IDs contained in providers1 and providers2.
-func getUniqueProviders(providers1, providers2 []Provider) []string {
-	var providers []string
+// getUniqueProviders returns a list of the keys of all unique
+// providers contained in providers1 and providers2.
+func getUniqueProviders(providers1, providers2 []Provider) []providerKey {
+	var providers []providerKey
 	for _, p1 := range providers1 {
-		providers = append(providers, p1.ID)
+		providers = append(providers, newProviderKey(p1.ID, p1.Metadata))
 	}
 
 	for _, p2 := range providers2 {
-		p1Idx := slices.IndexFunc(providers1, func(p1 Provider) bool {
-			return p2.ID == p1.ID
-		})
-
-		if p1Idx < 0 {
-			providers = append(providers, p2.ID)
+		if key := newProviderKey(p2.ID, p2.Metadata); !slices.Contains(providers, key) {
+			providers = append(providers, key)
 		}
 	}
 	return providers
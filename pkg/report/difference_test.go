@@ -588,5 +588,112 @@ var _ = Describe("diff", func() {
 			Expect(diff).To(Equal(expectedDiff))
 			Expect(err).To(BeNil())
 		})
+
+		It("should create the diff per shoot of providers with the same id", func() {
+			shootProvider := func(shootName string, status rule.Status) report.Provider {
+				return report.Provider{
+					ID:   "garden",
+					Name: "Garden",
+					Metadata: map[string]string{
+						"project":   "foo",
+						"shootName": shootName,
+					},
+					Rulesets: []report.Ruleset{
+						{
+							ID:      rulesetID,
+							Name:    rulesetName,
+							Version: rulesetVersion,
+							Rules: []report.Rule{
+								{
+									ID:       "1",
+									Name:     "1",
+									Severity: rule.SeverityLow,
+									Checks: []report.Check{
+										{
+											Status:  status,
+											Message: "foo",
+										},
+									},
+								},
+							},
+						},
+					},
+				}
+			}
+			oldReport := report.Report{
+				Time:      reportTime,
+				MinStatus: minStatus,
+				Providers: []report.Provider{
+					shootProvider("one", rule.Passed),
+					shootProvider("two", rule.Failed),
+				},
+			}
+			newReport := report.Report{
+				Time:      reportTime,
+				MinStatus: minStatus,
+				Providers: []report.Provider{
+					shootProvider("two", rule.Passed),
+					shootProvider("one", rule.Passed),
+				},
+			}
+
+			diff, err := report.CreateDifference(oldReport, newReport, title)
+			Expect(err).To(BeNil())
+
+			expectedMetadata := func(shootName string) map[string]string {
+				return map[string]string{
+					"project":   "foo",
+					"shootName": shootName,
+					"time":      reportTime.Format(time.RFC3339),
+				}
+			}
+			Expect(diff.Providers).To(Equal([]report.ProviderDifference{
+				{
+					ID:          "garden",
+					Name:        "Garden",
+					OldMetadata: expectedMetadata("one"),
+					NewMetadata: expectedMetadata("one"),
+					Rulesets: []report.RulesetDifference{
+						{
+							ID:      rulesetID,
+							Name:    rulesetName,
+							Version: rulesetVersion,
+						},
+					},
+				},
+				{
+					ID:          "garden",
+					Name:        "Garden",
+					OldMetadata: expectedMetadata("two"),
+					NewMetadata: expectedMetadata("two"),
+					Rulesets: []report.RulesetDifference{
+						{
+							ID:      rulesetID,
+							Name:    rulesetName,
+							Version: rulesetVersion,
+							Rules: []report.RuleDifference{
+								{
+									ID:       "1",
+									Name:     "1",
+									Severity: rule.SeverityLow,
+									Added: []report.Check{
+										{
+											Status:  rule.Passed,
+											Message: "foo",
+										},
+									},
+									Removed: []report.Check{
+										{
+											Status:  rule.Failed,
+											Message: "foo",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}))
+		})
 	})
 })
@@ -0,0 +1,241 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package report
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/gardener/diki/pkg/rule"
+)
+
+const (
+	// DefaultEvidenceMaxSize is the default maximum size in bytes of a single evidence.
+	DefaultEvidenceMaxSize = 1 << 20
+	// RedactedValue replaces secret values in evidence.
+	RedactedValue = "<redacted>"
+)
+
+// EvidenceReference references evidence stored in an [EvidenceBundle].
+type EvidenceReference struct {
+	Name   string      `json:"name"`
+	Target rule.Target `json:"target,omitempty"`
+	// Digest is the sha256 digest of the uncompressed evidence.
+	Digest string `json:"digest,omitempty"`
+	// Path is the location of the compressed evidence relative to the bundle directory.
+	Path string `json:"path,omitempty"`
+	// Size is the size in bytes of the uncompressed evidence.
+	Size int `json:"size"`
+	// Omitted contains the reason why the evidence was not stored.
+	Omitted string `json:"omitted,omitempty"`
+}
+
+// EvidenceBundle stores gzip compressed evidence in a directory.
+// Evidence is stored by the digest of its content, so that
+// identical evidence of different checks is stored once.
+type EvidenceBundle struct {
+	dir     string
+	maxSize int
+	mux     sync.Mutex
+}
+
+var _ ReportOption = &EvidenceBundle{}
+
+// NewEvidenceBundle creates an EvidenceBundle in the given directory.
+// Evidence larger than maxSize bytes is not stored.
+func NewEvidenceBundle(dir string, maxSize int) (*EvidenceBundle, error) {
+	if maxSize <= 0 {
+		return nil, fmt.Errorf("evidence max size must be positive, got %d", maxSize)
+	}
+	if err := os.MkdirAll(filepath.Join(dir, "sha256"), 0700); err != nil {
+		return nil, err
+	}
+	return &EvidenceBundle{dir: dir, maxSize: maxSize}, nil
+}
+
+// Dir returns the directory of the bundle.
+func (b *EvidenceBundle) Dir() string {
+	return b.dir
+}
+
+// ApplyToReport implements ReportOption.
+func (b *EvidenceBundle) ApplyToReport(opts *ReportOptions) {
+	opts.EvidenceBundle = b
+}
+
+// Add redacts and stores the evidence and returns a reference to it.
+// Failures are recorded in the Omitted field of the reference.
+func (b *EvidenceBundle) Add(target rule.Target, evidence rule.Evidence) EvidenceReference {
+	ref := EvidenceReference{Name: evidence.Name, Target: target}
+
+	data, err := redactEvidence(evidence.Data)
+	if err != nil {
+		ref.Omitted = fmt.Sprintf("failed to serialize evidence: %s", err)
+		return ref
+	}
+
+	ref.Size = len(data)
+	if ref.Size > b.maxSize {
+		ref.Omitted = fmt.Sprintf("evidence size %d exceeds limit of %d bytes", ref.Size, b.maxSize)
+		return ref
+	}
+
+	ref.Digest = Digest(data)
+	ref.Path = filepath.ToSlash(filepath.Join("sha256", strings.TrimPrefix(ref.Digest, "sha256:")+".json.gz"))
+	if err := b.write(ref.Path, data); err != nil {
+		ref.Digest, ref.Path = "", ""
+		ref.Omitted = fmt.Sprintf("failed to write evidence: %s", err)
+	}
+	return ref
+}
+
+func (b *EvidenceBundle) write(path string, data []byte) error {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+
+	filePath := filepath.Join(b.dir, filepath.FromSlash(path))
+	if _, err := os.Stat(filePath); err == nil {
+		return nil
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	if _, err := writer.Write(data); err != nil {
+		return err
+	}
+	if err := writer.Close(); err != nil {
+		return err
+	}
+	return os.WriteFile(filePath, buf.Bytes(), 0600)
+}
+
+// ReadEvidence reads and decompresses the referenced evidence from the bundle directory.
+// References which resolve outside of the bundle directory, also through symbolic links, are rejected.
+func ReadEvidence(dir string, ref EvidenceReference) ([]byte, error) {
+	if len(ref.Path) == 0 {
+		return nil, fmt.Errorf("evidence %s was not stored: %s", ref.Name, ref.Omitted)
+	}
+
+	path := filepath.FromSlash(ref.Path)
+	if !filepath.IsLocal(path) {
+		return nil, fmt.Errorf("evidence path %s is not within the bundle directory", ref.Path)
+	}
+
+	file, err := os.OpenInRoot(dir, path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	reader, err := gzip.NewReader(file)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	var buf bytes.Buffer
+	if _, err := buf.ReadFrom(reader); err != nil {
+		return nil, err
+	}
+	if digest := Digest(buf.Bytes()); digest != ref.Digest {
+		return nil, fmt.Errorf("evidence digest %s does not match referenced digest %s", digest, ref.Digest)
+	}
+	return buf.Bytes(), nil
+}
+
+// sensitiveKeys are substrings of lowercased keys whose values are redacted.
+var sensitiveKeys = []string{"password", "passwd", "secret", "token", "privatekey", "private_key", "credential", "apikey", "api_key"}
+
+// redactEvidence serializes the evidence data to json and redacts
+// values of sensitive keys and the data of Kubernetes Secrets.
+func redactEvidence(data any) ([]byte, error) {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var value any
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(redactValue(value)); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+func redactValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		isSecret := v["kind"] == "Secret"
+		for key, val := range v {
+			switch {
+			case isSecret && (key == "data" || key == "stringData"):
+				v[key] = redactAll(val)
+			case isSensitiveKey(key):
+				v[key] = redactAll(val)
+			default:
+				v[key] = redactValue(val)
+			}
+		}
+		return v
+	case []any:
+		for i, val := range v {
+			v[i] = redactValue(val)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+// redactAll replaces all string values, keeping the structure
+// so that it remains visible which keys were set.
+func redactAll(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, val := range v {
+			v[key] = redactAll(val)
+		}
+		return v
+	case []any:
+		for i, val := range v {
+			v[i] = redactAll(val)
+		}
+		return v
+	case string:
+		return RedactedValue
+	default:
+		return v
+	}
+}
+
+func isSensitiveKey(key string) bool {
+	lowerKey := strings.ToLower(key)
+	// keys referencing files or paths, e.g. "tlsPrivateKeyFile", do not contain secrets
+	if strings.HasSuffix(lowerKey, "file") || strings.HasSuffix(lowerKey, "path") || strings.HasSuffix(lowerKey, "ref") {
+		return false
+	}
+	for _, sensitiveKey := range sensitiveKeys {
+		if strings.Contains(lowerKey, sensitiveKey) {
+			return true
+		}
+	}
+	return false
+}
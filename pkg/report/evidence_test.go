@@ -0,0 +1,160 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package report_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/gardener/diki/pkg/provider"
+	"github.com/gardener/diki/pkg/report"
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/ruleset"
+)
+
+var _ = Describe("evidence", func() {
+	var (
+		dir    string
+		bundle *report.EvidenceBundle
+		target = rule.NewTarget("kind", "Node", "name", "node-1")
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir = GinkgoT().TempDir()
+		bundle, err = report.NewEvidenceBundle(dir, 1024)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("#Add", func() {
+		It("should store evidence by its digest", func() {
+			ref := bundle.Add(target, rule.Evidence{Name: "foo", Data: map[string]string{"bar": "baz"}})
+			Expect(ref.Omitted).To(BeEmpty())
+			Expect(ref.Name).To(Equal("foo"))
+			Expect(ref.Target).To(Equal(target))
+			Expect(ref.Digest).To(Equal(report.Digest([]byte(`{"bar":"baz"}`))))
+			Expect(ref.Path).To(Equal(filepath.Join("sha256", ref.Digest[len("sha256:"):]+".json.gz")))
+			Expect(ref.Size).To(Equal(13))
+
+			data, err := report.ReadEvidence(dir, ref)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(data).To(MatchJSON(`{"bar":"baz"}`))
+
+			otherRef := bundle.Add(rule.NewTarget(), rule.Evidence{Name: "other", Data: map[string]string{"bar": "baz"}})
+			Expect(otherRef.Path).To(Equal(ref.Path))
+			entries, err := os.ReadDir(filepath.Join(dir, "sha256"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(entries).To(HaveLen(1))
+		})
+
+		It("should omit evidence exceeding the size limit", func() {
+			ref := bundle.Add(target, rule.Evidence{Name: "foo", Data: make([]int, 1024)})
+			Expect(ref.Path).To(BeEmpty())
+			Expect(ref.Digest).To(BeEmpty())
+			Expect(ref.Omitted).To(Equal("evidence size 2049 exceeds limit of 1024 bytes"))
+
+			_, err := report.ReadEvidence(dir, ref)
+			Expect(err).To(MatchError("evidence foo was not stored: evidence size 2049 exceeds limit of 1024 bytes"))
+		})
+
+		It("should omit evidence which cannot be serialized", func() {
+			ref := bundle.Add(target, rule.Evidence{Name: "foo", Data: func() {}})
+			Expect(ref.Path).To(BeEmpty())
+			Expect(ref.Omitted).To(ContainSubstring("failed to serialize evidence"))
+		})
+
+		It("should redact secrets", func() {
+			secret := corev1.Secret{
+				TypeMeta:   metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"},
+				ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+				Data:       map[string][]byte{"key": []byte("value")},
+			}
+			config := map[string]any{
+				"password":          "foo",
+				"tlsPrivateKeyFile": "/var/lib/key",
+				"nested":            []any{map[string]any{"bearerToken": "bar", "enabled": true}},
+				"clientSecret":      map[string]any{"value": "baz", "enabled": true},
+			}
+
+			data, err := report.ReadEvidence(dir, bundle.Add(target, rule.Evidence{Name: "secret", Data: secret}))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(data).To(MatchJSON(`{"kind":"Secret","apiVersion":"v1","metadata":{"name":"foo","creationTimestamp":null},"data":{"key":"<redacted>"}}`))
+
+			data, err = report.ReadEvidence(dir, bundle.Add(target, rule.Evidence{Name: "config", Data: config}))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(data).To(MatchJSON(`{"password":"<redacted>","tlsPrivateKeyFile":"/var/lib/key","nested":[{"bearerToken":"<redacted>","enabled":true}],"clientSecret":{"value":"<redacted>","enabled":true}}`))
+		})
+	})
+
+	Describe("#ReadEvidence", func() {
+		It("should reject references outside of the bundle directory", func() {
+			ref := bundle.Add(target, rule.Evidence{Name: "foo", Data: map[string]string{"bar": "baz"}})
+			outside := GinkgoT().TempDir()
+			data, err := os.ReadFile(filepath.Join(dir, ref.Path))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(os.WriteFile(filepath.Join(outside, "foo.json.gz"), data, 0600)).To(Succeed())
+			Expect(os.Symlink(outside, filepath.Join(dir, "link"))).To(Succeed())
+
+			for _, path := range []string{
+				filepath.Join("..", filepath.Base(outside), "foo.json.gz"),
+				filepath.Join(outside, "foo.json.gz"),
+			} {
+				ref.Path = path
+				_, err = report.ReadEvidence(dir, ref)
+				Expect(err).To(MatchError("evidence path " + path + " is not within the bundle directory"))
+			}
+
+			ref.Path = filepath.Join("link", "foo.json.gz")
+			_, err = report.ReadEvidence(dir, ref)
+			Expect(err).To(MatchError(ContainSubstring("path escapes from parent")))
+		})
+	})
+	Describe("#FromProviderResults", func() {
+		It("should reference the evidence of checks", func() {
+			results := []provider.ProviderResult{
+				{
+					ProviderID: "provider-foo",
+					RulesetResults: []ruleset.RulesetResult{
+						{
+							RulesetID: "ruleset-foo",
+							RuleResults: []rule.RuleResult{
+								{
+									RuleID: "1",
+									CheckResults: []rule.CheckResult{
+										{Status: rule.Passed, Message: "foo", Target: target, Evidence: []rule.Evidence{{Name: "bar", Data: "baz"}}},
+										{Status: rule.Passed, Message: "foo", Target: rule.NewTarget("name", "node-2"), Evidence: []rule.Evidence{{Name: "bar", Data: "qux"}}},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+
+			rep := report.FromProviderResults(results, bundle)
+			Expect(rep.EvidenceDir).To(Equal(dir))
+
+			checks := rep.Providers[0].Rulesets[0].Rules[0].Checks
+			Expect(checks).To(HaveLen(1))
+			Expect(checks[0].Evidence).To(HaveLen(2))
+			Expect(checks[0].Evidence[0].Target).To(Equal(target))
+			Expect(checks[0].Evidence[1].Target).To(Equal(rule.NewTarget("name", "node-2")))
+
+			data, err := report.ReadEvidence(dir, checks[0].Evidence[1])
+			Expect(err).ToNot(HaveOccurred())
+			Expect(data).To(MatchJSON(`"qux"`))
+
+			reportJSON, err := json.Marshal(rep)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(reportJSON)).To(ContainSubstring(`"evidence":[{"name":"bar"`))
+		})
+	})
+})
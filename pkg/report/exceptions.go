@@ -0,0 +1,286 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package report
+
+import (
+	"maps"
+	"path"
+	"slices"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/diki/pkg/rule"
+)
+
+// Exceptions contains known findings that are accepted
+// after a Diki run has completed.
+type Exceptions struct {
+	Exceptions []Exception `json:"exceptions" yaml:"exceptions"`
+}
+
+// Exception accepts the Failed and Warning checks of a rule
+// that match its provider, ruleset, rule and target matchers.
+type Exception struct {
+	ProviderID string `json:"providerID" yaml:"providerID"`
+	RulesetID  string `json:"rulesetID" yaml:"rulesetID"`
+	// RulesetVersion is optional. If empty all versions of the ruleset are matched.
+	RulesetVersion string `json:"rulesetVersion,omitempty" yaml:"rulesetVersion,omitempty"`
+	RuleID         string `json:"ruleID" yaml:"ruleID"`
+	// Targets are matchers for check targets. A target is matched if all keys of
+	// at least one matcher are present in the target and their values match the
+	// specified [path.Match] patterns. If empty all checks of the rule are matched.
+	Targets       []rule.Target `json:"targets,omitempty" yaml:"targets,omitempty"`
+	Justification string        `json:"justification" yaml:"justification"`
+	Owner         string        `json:"owner" yaml:"owner"`
+	Ticket        string        `json:"ticket" yaml:"ticket"`
+	ExpiresAt     time.Time     `json:"expiresAt" yaml:"expiresAt"`
+}
+
+// CheckException contains information about an exception applied to a check.
+type CheckException struct {
+	Justification  string      `json:"justification"`
+	Owner          string      `json:"owner,omitempty"`
+	Ticket         string      `json:"ticket,omitempty"`
+	ExpiresAt      time.Time   `json:"expiresAt"`
+	OriginalStatus rule.Status `json:"originalStatus"`
+	Expired        bool        `json:"expired,omitempty"`
+}
+
+// Validate validates that the exceptions are correctly defined.
+func (e *Exceptions) Validate() field.ErrorList {
+	var (
+		allErrs        field.ErrorList
+		exceptionsPath = field.NewPath("exceptions")
+	)
+
+	for idx, exception := range e.Exceptions {
+		allErrs = append(allErrs, exception.validate(exceptionsPath.Index(idx))...)
+	}
+	return allErrs
+}
+
+func (e *Exception) validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if len(e.ProviderID) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("providerID"), "must not be empty"))
+	}
+	if len(e.RulesetID) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("rulesetID"), "must not be empty"))
+	}
+	if len(e.RuleID) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("ruleID"), "must not be empty"))
+	}
+	if len(e.Justification) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("justification"), "must not be empty"))
+	}
+	if len(e.Owner) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("owner"), "must not be empty"))
+	}
+	if len(e.Ticket) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("ticket"), "must not be empty"))
+	}
+	if e.ExpiresAt.IsZero() {
+		allErrs = append(allErrs, field.Required(fldPath.Child("expiresAt"), "must not be empty"))
+	}
+
+	for tIdx, target := range e.Targets {
+		targetPath := fldPath.Child("targets").Index(tIdx)
+		if len(target) == 0 {
+			allErrs = append(allErrs, field.Required(targetPath, "must not be empty"))
+		}
+		for key, pattern := range target {
+			if _, err := path.Match(pattern, ""); err != nil {
+				allErrs = append(allErrs, field.Invalid(targetPath.Key(key), pattern, err.Error()))
+			}
+		}
+	}
+	return allErrs
+}
+
+// isExpired returns true if the exception has expired at the given time.
+func (e *Exception) isExpired(now time.Time) bool {
+	return !now.Before(e.ExpiresAt)
+}
+
+// matchesRule returns true if the exception applies to the given provider, ruleset and rule.
+func (e *Exception) matchesRule(providerID string, ruleset Ruleset, ruleID string) bool {
+	return e.ProviderID == providerID &&
+		e.RulesetID == ruleset.ID &&
+		(len(e.RulesetVersion) == 0 || e.RulesetVersion == ruleset.Version) &&
+		e.RuleID == ruleID
+}
+
+// matchesTarget returns true if the target is matched by at least one of the exception's target matchers.
+func (e *Exception) matchesTarget(target rule.Target) bool {
+	if len(e.Targets) == 0 {
+		return true
+	}
+
+	return slices.ContainsFunc(e.Targets, func(matcher rule.Target) bool {
+		for key, pattern := range matcher {
+			value, ok := target[key]
+			if !ok {
+				return false
+			}
+			if matched, err := path.Match(pattern, value); err != nil || !matched {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// checkException returns a [CheckException] for a check with the given original status.
+func (e *Exception) checkException(originalStatus rule.Status, expired bool) *CheckException {
+	return &CheckException{
+		Justification:  e.Justification,
+		Owner:          e.Owner,
+		Ticket:         e.Ticket,
+		ExpiresAt:      e.ExpiresAt,
+		OriginalStatus: originalStatus,
+		Expired:        expired,
+	}
+}
+
+// ApplyExceptions sets the status of Failed and Warning checks matched by non-expired exceptions
+// to Accepted and annotates them with the applied exception. Checks which were accepted by
+// an exception that has expired at the given time are reverted to their original status.
+// Checks matched only by expired exceptions keep their status and are annotated as expired.
+// All expired exceptions that matched at least one check are recorded in the report.
+func (r *Report) ApplyExceptions(exceptions []Exception, now time.Time) {
+	expired := map[int]struct{}{}
+	for _, provider := range r.Providers {
+		for _, ruleset := range provider.Rulesets {
+			for ruleIdx := range ruleset.Rules {
+				var (
+					ruleID  = ruleset.Rules[ruleIdx].ID
+					matched []int
+				)
+				for idx := range exceptions {
+					if exceptions[idx].matchesRule(provider.ID, ruleset, ruleID) {
+						matched = append(matched, idx)
+					}
+				}
+
+				var checks []Check
+				for _, check := range ruleset.Rules[ruleIdx].Checks {
+					checks = append(checks, applyExceptionsToCheck(check, exceptions, matched, now, expired)...)
+				}
+				ruleset.Rules[ruleIdx].Checks = checks
+			}
+		}
+	}
+
+	for _, idx := range slices.Sorted(maps.Keys(expired)) {
+		if !slices.ContainsFunc(r.ExpiredExceptions, func(e Exception) bool {
+			return equalExceptions(e, exceptions[idx])
+		}) {
+			r.ExpiredExceptions = append(r.ExpiredExceptions, exceptions[idx])
+		}
+	}
+}
+
+// applyExceptionsToCheck applies the exceptions with the given indices to a single check.
+// It can return multiple checks when only a part of the check's targets are matched.
+func applyExceptionsToCheck(check Check, exceptions []Exception, indices []int, now time.Time, expired map[int]struct{}) []Check {
+	if check.Exception != nil && !check.Exception.Expired && check.Status == rule.Accepted && !now.Before(check.Exception.ExpiresAt) {
+		check.Status = check.Exception.OriginalStatus
+		check.Exception.Expired = true
+	}
+
+	if check.Status != rule.Failed && check.Status != rule.Warning {
+		return []Check{check}
+	}
+
+	// matchingException returns the index of the first non-expired exception matching
+	// the target or the first expired one if no other exception matches it.
+	matchingException := func(target rule.Target) (int, bool) {
+		expiredIdx := -1
+		for _, idx := range indices {
+			if !exceptions[idx].matchesTarget(target) {
+				continue
+			}
+			if !exceptions[idx].isExpired(now) {
+				return idx, true
+			}
+			if expiredIdx < 0 {
+				expiredIdx = idx
+			}
+		}
+		return expiredIdx, expiredIdx >= 0
+	}
+
+	newCheck := func(idx int, targets []rule.Target) Check {
+		if exceptions[idx].isExpired(now) {
+			expired[idx] = struct{}{}
+			return Check{
+				Status:    check.Status,
+				Message:   check.Message,
+				Targets:   targets,
+				Exception: exceptions[idx].checkException(check.Status, true),
+			}
+		}
+		return Check{
+			Status:    rule.Accepted,
+			Message:   check.Message,
+			Targets:   targets,
+			Exception: exceptions[idx].checkException(check.Status, false),
+		}
+	}
+
+	if len(check.Targets) == 0 {
+		if idx, ok := matchingException(rule.Target{}); ok {
+			return []Check{newCheck(idx, check.Targets)}
+		}
+		return []Check{check}
+	}
+
+	var (
+		order     []int
+		grouped   = map[int][]rule.Target{}
+		unmatched []rule.Target
+	)
+	for _, target := range check.Targets {
+		idx, ok := matchingException(target)
+		if !ok {
+			unmatched = append(unmatched, target)
+			continue
+		}
+		if _, ok := grouped[idx]; !ok {
+			order = append(order, idx)
+		}
+		grouped[idx] = append(grouped[idx], target)
+	}
+
+	if len(order) == 0 {
+		return []Check{check}
+	}
+
+	var checks []Check
+	if len(unmatched) > 0 {
+		check.Targets = unmatched
+		checks = append(checks, check)
+	}
+	for _, idx := range order {
+		checks = append(checks, newCheck(idx, grouped[idx]))
+	}
+	return checks
+}
+
+func equalExceptions(a, b Exception) bool {
+	return a.ProviderID == b.ProviderID &&
+		a.RulesetID == b.RulesetID &&
+		a.RulesetVersion == b.RulesetVersion &&
+		a.RuleID == b.RuleID &&
+		a.Justification == b.Justification &&
+		a.Owner == b.Owner &&
+		a.Ticket == b.Ticket &&
+		a.ExpiresAt.Equal(b.ExpiresAt) &&
+		slices.EqualFunc(a.Targets, b.Targets, func(t1, t2 rule.Target) bool {
+			return maps.Equal(t1, t2)
+		})
+}
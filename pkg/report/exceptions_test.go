@@ -0,0 +1,230 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package report_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/diki/pkg/report"
+	"github.com/gardener/diki/pkg/rule"
+)
+
+var _ = Describe("exceptions", func() {
+	var (
+		now       = time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+		future    = now.Add(24 * time.Hour)
+		past      = now.Add(-24 * time.Hour)
+		rep       *report.Report
+		newReport = func(checks ...report.Check) *report.Report {
+			return &report.Report{
+				Time:        now,
+				DikiVersion: "1",
+				Providers: []report.Provider{
+					{
+						ID:   "provider-foo",
+						Name: "Provider Foo",
+						Rulesets: []report.Ruleset{
+							{
+								ID:      "ruleset-foo",
+								Name:    "Ruleset Foo",
+								Version: "v1",
+								Rules: []report.Rule{
+									{
+										ID:     "1",
+										Name:   "1",
+										Checks: checks,
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+		}
+		newException = func(expiresAt time.Time, targets ...rule.Target) report.Exception {
+			return report.Exception{
+				ProviderID:    "provider-foo",
+				RulesetID:     "ruleset-foo",
+				RuleID:        "1",
+				Targets:       targets,
+				Justification: "foo",
+				Owner:         "bar",
+				Ticket:        "TICKET-1",
+				ExpiresAt:     expiresAt,
+			}
+		}
+	)
+
+	Describe("#ApplyExceptions", func() {
+		BeforeEach(func() {
+			rep = newReport(
+				report.Check{
+					Status:  rule.Failed,
+					Message: "failed",
+					Targets: []rule.Target{
+						rule.NewTarget("namespace", "foo", "name", "pod-1"),
+						rule.NewTarget("namespace", "bar", "name", "pod-2"),
+					},
+				},
+				report.Check{
+					Status:  rule.Passed,
+					Message: "passed",
+					Targets: []rule.Target{rule.NewTarget("namespace", "foo", "name", "pod-3")},
+				},
+			)
+		})
+
+		It("should accept only the matched targets of failed checks", func() {
+			rep.ApplyExceptions([]report.Exception{newException(future, rule.NewTarget("namespace", "f*"))}, now)
+
+			Expect(rep.Providers[0].Rulesets[0].Rules[0].Checks).To(Equal([]report.Check{
+				{
+					Status:  rule.Failed,
+					Message: "failed",
+					Targets: []rule.Target{rule.NewTarget("namespace", "bar", "name", "pod-2")},
+				},
+				{
+					Status:  rule.Accepted,
+					Message: "failed",
+					Targets: []rule.Target{rule.NewTarget("namespace", "foo", "name", "pod-1")},
+					Exception: &report.CheckException{
+						Justification:  "foo",
+						Owner:          "bar",
+						Ticket:         "TICKET-1",
+						ExpiresAt:      future,
+						OriginalStatus: rule.Failed,
+					},
+				},
+				{
+					Status:  rule.Passed,
+					Message: "passed",
+					Targets: []rule.Target{rule.NewTarget("namespace", "foo", "name", "pod-3")},
+				},
+			}))
+			Expect(rep.ExpiredExceptions).To(BeEmpty())
+		})
+
+		It("should not apply exceptions for other rules", func() {
+			exception := newException(future)
+			exception.RuleID = "2"
+			rep.ApplyExceptions([]report.Exception{exception}, now)
+
+			Expect(rep.Providers[0].Rulesets[0].Rules[0].Checks[0].Status).To(Equal(rule.Failed))
+			Expect(rep.Providers[0].Rulesets[0].Rules[0].Checks[0].Exception).To(BeNil())
+		})
+
+		It("should accept checks without targets when the exception has no target matchers", func() {
+			rep = newReport(report.Check{Status: rule.Warning, Message: "warning"})
+			rep.ApplyExceptions([]report.Exception{newException(future)}, now)
+
+			Expect(rep.Providers[0].Rulesets[0].Rules[0].Checks).To(Equal([]report.Check{
+				{
+					Status:  rule.Accepted,
+					Message: "warning",
+					Exception: &report.CheckException{
+						Justification:  "foo",
+						Owner:          "bar",
+						Ticket:         "TICKET-1",
+						ExpiresAt:      future,
+						OriginalStatus: rule.Warning,
+					},
+				},
+			}))
+		})
+
+		It("should flag checks matched by expired exceptions", func() {
+			exception := newException(past, rule.NewTarget("name", "pod-2"))
+			rep.ApplyExceptions([]report.Exception{exception}, now)
+
+			Expect(rep.Providers[0].Rulesets[0].Rules[0].Checks[:2]).To(Equal([]report.Check{
+				{
+					Status:  rule.Failed,
+					Message: "failed",
+					Targets: []rule.Target{rule.NewTarget("namespace", "foo", "name", "pod-1")},
+				},
+				{
+					Status:  rule.Failed,
+					Message: "failed",
+					Targets: []rule.Target{rule.NewTarget("namespace", "bar", "name", "pod-2")},
+					Exception: &report.CheckException{
+						Justification:  "foo",
+						Owner:          "bar",
+						Ticket:         "TICKET-1",
+						ExpiresAt:      past,
+						OriginalStatus: rule.Failed,
+						Expired:        true,
+					},
+				},
+			}))
+			Expect(rep.ExpiredExceptions).To(Equal([]report.Exception{exception}))
+		})
+
+		It("should revert checks accepted by exceptions which have expired", func() {
+			rep.ApplyExceptions([]report.Exception{newException(future)}, now)
+			Expect(rep.Providers[0].Rulesets[0].Rules[0].Checks[0].Status).To(Equal(rule.Accepted))
+
+			rep.ApplyExceptions(nil, future)
+			Expect(rep.Providers[0].Rulesets[0].Rules[0].Checks[0].Status).To(Equal(rule.Failed))
+			Expect(rep.Providers[0].Rulesets[0].Rules[0].Checks[0].Exception.Expired).To(BeTrue())
+		})
+	})
+
+	Describe("#Validate", func() {
+		It("should not return errors for correctly defined exceptions", func() {
+			exceptions := report.Exceptions{Exceptions: []report.Exception{newException(future, rule.NewTarget("name", "foo-*"))}}
+
+			Expect(exceptions.Validate()).To(BeEmpty())
+		})
+
+		It("should return errors for incorrectly defined exceptions", func() {
+			exceptions := report.Exceptions{Exceptions: []report.Exception{
+				{
+					ProviderID: "provider-foo",
+					Targets:    []rule.Target{{}, rule.NewTarget("name", "[")},
+				},
+			}}
+
+			Expect(exceptions.Validate()).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("exceptions[0].rulesetID"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("exceptions[0].ruleID"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("exceptions[0].justification"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("exceptions[0].owner"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("exceptions[0].ticket"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("exceptions[0].expiresAt"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("exceptions[0].targets[0]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("exceptions[0].targets[1][name]"),
+				})),
+			))
+		})
+	})
+})
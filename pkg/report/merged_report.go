@@ -25,6 +25,8 @@ type MergedReport struct {
 	DikiVersion string           `json:"dikiVersion"`
 	Metadata    map[string]any   `json:"metadata,omitempty"`
 	Providers   []MergedProvider `json:"providers"`
+	// InputDigests contains the digests of the merged reports.
+	InputDigests []string `json:"inputDigests,omitempty"`
 }
 
 // MergedProvider contains information from multiple reports about
@@ -187,29 +189,34 @@ func MergeReport(reports []*Report, distinctByAttrs map[string]string) (*MergedR
 		}
 
 		for key, mergedProvider := range mergedReport.Providers {
-			idx := slices.IndexFunc(report.Providers, func(p Provider) bool {
-				return p.ID == mergedProvider.ID
-			})
+			found := false
+			// a report contains several results of a provider which checks several targets, e.g. many shoots
+			for _, provider := range report.Providers {
+				if provider.ID != mergedProvider.ID {
+					continue
+				}
+				found = true
 
-			if idx == -1 {
-				return nil, fmt.Errorf("provider %s not found in at least 1 of the selected reports", mergedProvider.ID)
-			}
+				if mergedReport.Providers[key].Name == "" {
+					mergedReport.Providers[key].Name = provider.Name
+				}
 
-			if mergedReport.Providers[key].Name == "" {
-				mergedReport.Providers[key].Name = report.Providers[idx].Name
-			}
+				uniqueAttr := provider.Metadata[mergedProvider.DistinctBy]
+				if uniqueAttr == "" {
+					return nil, fmt.Errorf("distinct attribute %s is empty in at least 1 of the selected reports", mergedProvider.DistinctBy)
+				}
 
-			uniqueAttr := report.Providers[idx].Metadata[mergedProvider.DistinctBy]
-			if uniqueAttr == "" {
-				return nil, fmt.Errorf("distinct attribute %s is empty in at least 1 of the selected reports", mergedProvider.DistinctBy)
-			}
+				if _, ok := mergedProvider.Metadata[uniqueAttr]; ok {
+					return nil, fmt.Errorf("distinct attribute %s is not unique", mergedProvider.DistinctBy)
+				}
 
-			if _, ok := mergedProvider.Metadata[uniqueAttr]; ok {
-				return nil, fmt.Errorf("distinct attribute %s is not unique", mergedProvider.DistinctBy)
+				mergedProvider.Metadata[uniqueAttr] = provider.Metadata
+				mergedProvider.Metadata[uniqueAttr]["time"] = report.Time.Format("01-02-2006 15:04:05")
 			}
 
-			mergedProvider.Metadata[uniqueAttr] = report.Providers[idx].Metadata
-			mergedProvider.Metadata[uniqueAttr]["time"] = report.Time.Format("01-02-2006 15:04:05")
+			if !found {
+				return nil, fmt.Errorf("provider %s not found in at least 1 of the selected reports", mergedProvider.ID)
+			}
 		}
 	}
 	for _, report := range reports {
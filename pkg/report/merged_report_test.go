@@ -274,6 +274,19 @@ var _ = Describe("merged report", func() {
 			Expect(err).To(BeNil())
 		})
 
+		It("should merge the results of a provider which checks several targets in 1 report", func() {
+			expectedMergedReport, err := report.MergeReport([]*report.Report{&simpleReport1, &simpleReport2}, map[string]string{providerID: "id"})
+			Expect(err).NotTo(HaveOccurred())
+
+			multiTargetReport := simpleReport1
+			multiTargetReport.Providers = append(multiTargetReport.Providers, simpleReport2.Providers...)
+			mergedReport, err := report.MergeReport([]*report.Report{&multiTargetReport}, map[string]string{providerID: "id"})
+			Expect(err).NotTo(HaveOccurred())
+
+			expectedMergedReport.Time = mergedReport.Time
+			Expect(mergedReport).To(Equal(expectedMergedReport))
+		})
+
 		It("should correctly merge 2 reports with different rulesets", func() {
 			simpleReport2.Providers[0].Rulesets[0].ID = "ruleset-bar"
 			simpleReport2.Providers[0].Rulesets[0].Name = "Ruleset Bar"
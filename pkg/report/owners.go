@@ -0,0 +1,295 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package report
+
+import (
+	"cmp"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/diki/pkg/rule"
+)
+
+// UnknownOwner is the owner of targets which cannot be attributed
+// to any owner when no default owner is configured.
+const UnknownOwner = "unowned"
+
+// OwnersIndexName is the file name, without extension, of the index which lists the owner reports.
+// Owner reports never use it.
+const OwnersIndexName = "index"
+
+var unsafeFileNameChars = regexp.MustCompile(`[^a-zA-Z0-9._-]`)
+
+// Owners describes how the targets of report checks are attributed to owners.
+type Owners struct {
+	// Mappings statically attribute namespaces and nodes to owners.
+	// They take precedence over the owners determined from cluster metadata.
+	Mappings []OwnerMapping `json:"mappings,omitempty" yaml:"mappings,omitempty"`
+	// NamespaceLabel is the namespace label key whose value is the owner of the namespace.
+	NamespaceLabel string `json:"namespaceLabel,omitempty" yaml:"namespaceLabel,omitempty"`
+	// NamespaceAnnotation is the namespace annotation key whose value is the owner of the namespace.
+	// It is only used if the namespace does not have the NamespaceLabel.
+	NamespaceAnnotation string `json:"namespaceAnnotation,omitempty" yaml:"namespaceAnnotation,omitempty"`
+	// NodeLabel is the node label key, e.g. a node pool label, whose value is the owner of the node.
+	NodeLabel string `json:"nodeLabel,omitempty" yaml:"nodeLabel,omitempty"`
+	// DefaultOwner is the owner of targets which cannot be attributed to any owner.
+	// Defaults to [UnknownOwner].
+	DefaultOwner string `json:"defaultOwner,omitempty" yaml:"defaultOwner,omitempty"`
+}
+
+// OwnerMapping attributes the namespaces and nodes matched by
+// the specified [path.Match] patterns to an owner.
+type OwnerMapping struct {
+	Owner      string   `json:"owner" yaml:"owner"`
+	Namespaces []string `json:"namespaces,omitempty" yaml:"namespaces,omitempty"`
+	Nodes      []string `json:"nodes,omitempty" yaml:"nodes,omitempty"`
+}
+
+// Validate validates that the owners configuration is correctly defined.
+func (o *Owners) Validate() field.ErrorList {
+	var (
+		allErrs      field.ErrorList
+		mappingsPath = field.NewPath("mappings")
+	)
+
+	for idx, mapping := range o.Mappings {
+		mappingPath := mappingsPath.Index(idx)
+		if len(mapping.Owner) == 0 {
+			allErrs = append(allErrs, field.Required(mappingPath.Child("owner"), "must not be empty"))
+		}
+		if len(mapping.Namespaces) == 0 && len(mapping.Nodes) == 0 {
+			allErrs = append(allErrs, field.Required(mappingPath, "either namespaces or nodes must be set"))
+		}
+		for pIdx, pattern := range mapping.Namespaces {
+			if _, err := path.Match(pattern, ""); err != nil {
+				allErrs = append(allErrs, field.Invalid(mappingPath.Child("namespaces").Index(pIdx), pattern, err.Error()))
+			}
+		}
+		for pIdx, pattern := range mapping.Nodes {
+			if _, err := path.Match(pattern, ""); err != nil {
+				allErrs = append(allErrs, field.Invalid(mappingPath.Child("nodes").Index(pIdx), pattern, err.Error()))
+			}
+		}
+	}
+
+	if len(o.NamespaceLabel) > 0 {
+		allErrs = append(allErrs, metav1validation.ValidateLabelName(o.NamespaceLabel, field.NewPath("namespaceLabel"))...)
+	}
+	if len(o.NodeLabel) > 0 {
+		allErrs = append(allErrs, metav1validation.ValidateLabelName(o.NodeLabel, field.NewPath("nodeLabel"))...)
+	}
+	return allErrs
+}
+
+// OwnerResolver attributes check targets to owners.
+type OwnerResolver struct {
+	Owners Owners
+	// NamespaceOwners maps namespace names to owners determined from cluster metadata.
+	NamespaceOwners map[string]string
+	// NodeOwners maps node names to owners determined from cluster metadata.
+	NodeOwners map[string]string
+}
+
+// Owner returns the owner of the target. Targets are attributed by their namespace
+// or, for nodes, by their node name.
+func (r *OwnerResolver) Owner(target rule.Target) string {
+	namespace, node := target["namespace"], target["node"]
+	if target["kind"] == "Node" {
+		namespace, node = "", target["name"]
+	}
+
+	for _, mapping := range r.Owners.Mappings {
+		if len(namespace) > 0 && matchesAny(mapping.Namespaces, namespace) {
+			return mapping.Owner
+		}
+		if len(node) > 0 && matchesAny(mapping.Nodes, node) {
+			return mapping.Owner
+		}
+	}
+
+	if owner, ok := r.NamespaceOwners[namespace]; ok && len(namespace) > 0 {
+		return owner
+	}
+	if owner, ok := r.NodeOwners[node]; ok && len(node) > 0 {
+		return owner
+	}
+	return cmp.Or(r.Owners.DefaultOwner, UnknownOwner)
+}
+
+func matchesAny(patterns []string, value string) bool {
+	return slices.ContainsFunc(patterns, func(pattern string) bool {
+		matched, err := path.Match(pattern, value)
+		return err == nil && matched
+	})
+}
+
+// SplitByOwner returns one report per owner which contains only the targets attributed
+// to that owner. Checks without targets are attributed to the default owner.
+func (r *Report) SplitByOwner(resolver *OwnerResolver) map[string]*Report {
+	var (
+		reports      = map[string]*Report{}
+		defaultOwner = cmp.Or(resolver.Owners.DefaultOwner, UnknownOwner)
+	)
+
+	ownerReport := func(owner string) *Report {
+		if rep, ok := reports[owner]; ok {
+			return rep
+		}
+		rep := &Report{
+			Time:        r.Time,
+			MinStatus:   r.MinStatus,
+			DikiVersion: r.DikiVersion,
+			Metadata:    r.Metadata,
+			Providers:   make([]Provider, 0, len(r.Providers)),
+		}
+		for _, provider := range r.Providers {
+			p := Provider{
+				ID:       provider.ID,
+				Name:     provider.Name,
+				Metadata: provider.Metadata,
+				Rulesets: make([]Ruleset, 0, len(provider.Rulesets)),
+			}
+			for _, ruleset := range provider.Rulesets {
+				p.Rulesets = append(p.Rulesets, Ruleset{
+					ID:      ruleset.ID,
+					Name:    ruleset.Name,
+					Version: ruleset.Version,
+					Rules:   []Rule{},
+				})
+			}
+			rep.Providers = append(rep.Providers, p)
+		}
+		reports[owner] = rep
+		return rep
+	}
+
+	addCheck := func(owner string, pIdx, rsIdx int, rl Rule, check Check) {
+		rules := &ownerReport(owner).Providers[pIdx].Rulesets[rsIdx].Rules
+		idx := slices.IndexFunc(*rules, func(ownerRule Rule) bool {
+			return ownerRule.ID == rl.ID
+		})
+		if idx < 0 {
+			idx = len(*rules)
+			*rules = append(*rules, Rule{ID: rl.ID, Name: rl.Name, Severity: rl.Severity, Checks: []Check{}})
+		}
+		(*rules)[idx].Checks = append((*rules)[idx].Checks, check)
+	}
+
+	for pIdx, provider := range r.Providers {
+		for rsIdx, ruleset := range provider.Rulesets {
+			for _, rl := range ruleset.Rules {
+				for _, check := range rl.Checks {
+					if len(check.Targets) == 0 {
+						addCheck(defaultOwner, pIdx, rsIdx, rl, check)
+						continue
+					}
+
+					ownerTargets := map[string][]rule.Target{}
+					for _, target := range check.Targets {
+						owner := resolver.Owner(target)
+						ownerTargets[owner] = append(ownerTargets[owner], target)
+					}
+					for _, owner := range sortedKeys(ownerTargets) {
+						ownerCheck := check
+						ownerCheck.Targets = ownerTargets[owner]
+						addCheck(owner, pIdx, rsIdx, rl, ownerCheck)
+					}
+				}
+			}
+		}
+	}
+	return reports
+}
+
+// OwnersIndex lists the reports created for every owner.
+type OwnersIndex struct {
+	Time        time.Time          `json:"time"`
+	DikiVersion string             `json:"dikiVersion"`
+	Owners      []OwnersIndexEntry `json:"owners"`
+}
+
+// OwnersIndexEntry describes the report of a single owner.
+type OwnersIndexEntry struct {
+	Owner string `json:"owner"`
+	// Path is the location of the owner's report relative to the index.
+	Path string `json:"path"`
+	// Summary contains the number of checks per status.
+	Summary map[rule.Status]int `json:"summary"`
+}
+
+// NewOwnersIndex creates an OwnersIndex for the given owner reports.
+// The paths of the reports are determined by the pathFunc.
+func NewOwnersIndex(reports map[string]*Report, pathFunc func(owner string) string) *OwnersIndex {
+	index := &OwnersIndex{Owners: make([]OwnersIndexEntry, 0, len(reports))}
+	for _, owner := range sortedKeys(reports) {
+		rep := reports[owner]
+		index.Time, index.DikiVersion = rep.Time, rep.DikiVersion
+
+		summary := map[rule.Status]int{}
+		for _, provider := range rep.Providers {
+			for _, ruleset := range provider.Rulesets {
+				for _, rl := range ruleset.Rules {
+					for _, check := range rl.Checks {
+						summary[check.Status]++
+					}
+				}
+			}
+		}
+		index.Owners = append(index.Owners, OwnersIndexEntry{
+			Owner:   owner,
+			Path:    pathFunc(owner),
+			Summary: summary,
+		})
+	}
+	return index
+}
+
+// OwnerFileNames returns a unique file name with the given extension for every owner.
+// Characters which are unsafe in file names are replaced. If the names of owners collide after the replacement,
+// compared case-insensitively, or with the [OwnersIndexName], a short hash of the owner is appended to them.
+func OwnerFileNames(owners []string, extension string) map[string]string {
+	var (
+		baseNames = make(map[string]string, len(owners))
+		counts    = map[string]int{strings.ToLower(OwnersIndexName): 1}
+		fileNames = make(map[string]string, len(owners))
+	)
+	for _, owner := range owners {
+		baseName := unsafeFileNameChars.ReplaceAllString(owner, "_")
+		baseNames[owner] = baseName
+		counts[strings.ToLower(baseName)]++
+	}
+
+	for owner, baseName := range baseNames {
+		if counts[strings.ToLower(baseName)] > 1 {
+			sum := sha256.Sum256([]byte(owner))
+			baseName = fmt.Sprintf("%s-%s", baseName, hex.EncodeToString(sum[:])[:8])
+		}
+		fileNames[owner] = fmt.Sprintf("%s.%s", baseName, extension)
+	}
+	return fileNames
+}
+
+// ownersIndexSummaryText returns a summary string with the number of checks per status.
+func ownersIndexSummaryText(entry OwnersIndexEntry) string {
+	summaryText := ""
+	for _, status := range rule.Statuses() {
+		if num := entry.Summary[status]; num != 0 {
+			if len(summaryText) > 0 {
+				summaryText += ", "
+			}
+			summaryText += fmt.Sprintf("%dx %s %c", num, status, rule.StatusIcon(status))
+		}
+	}
+	return summaryText
+}
@@ -0,0 +1,162 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package report_test
+
+import (
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/diki/pkg/report"
+	"github.com/gardener/diki/pkg/rule"
+)
+
+var _ = Describe("owners", func() {
+	var resolver *report.OwnerResolver
+
+	BeforeEach(func() {
+		resolver = &report.OwnerResolver{
+			Owners: report.Owners{
+				Mappings: []report.OwnerMapping{
+					{Owner: "team-platform", Namespaces: []string{"kube-*"}},
+					{Owner: "team-infra", Nodes: []string{"infra-*"}},
+				},
+			},
+			NamespaceOwners: map[string]string{"payments": "team-payments", "kube-system": "team-foo"},
+			NodeOwners:      map[string]string{"node-1": "team-nodes"},
+		}
+	})
+
+	Describe("#Owner", func() {
+		DescribeTable("should attribute targets to owners",
+			func(target rule.Target, expectedOwner string) {
+				Expect(resolver.Owner(target)).To(Equal(expectedOwner))
+			},
+			Entry("static namespace mapping", rule.NewTarget("namespace", "kube-system", "kind", "Pod", "name", "foo"), "team-platform"),
+			Entry("namespace metadata", rule.NewTarget("namespace", "payments", "kind", "Pod", "name", "foo"), "team-payments"),
+			Entry("static node mapping", rule.NewTarget("kind", "Node", "name", "infra-1"), "team-infra"),
+			Entry("node metadata", rule.NewTarget("kind", "Node", "name", "node-1", "namespace", "kube-system"), "team-nodes"),
+			Entry("node key", rule.NewTarget("namespace", "foo", "node", "node-1"), "team-nodes"),
+			Entry("unknown owner", rule.NewTarget("namespace", "foo"), report.UnknownOwner),
+		)
+
+		It("should use the default owner", func() {
+			resolver.Owners.DefaultOwner = "team-security"
+			Expect(resolver.Owner(rule.NewTarget("namespace", "foo"))).To(Equal("team-security"))
+		})
+	})
+
+	Describe("#SplitByOwner", func() {
+		It("should create one report per owner", func() {
+			rep := &report.Report{
+				Time:        time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC),
+				DikiVersion: "1",
+				Providers: []report.Provider{
+					{
+						ID: "provider-foo",
+						Rulesets: []report.Ruleset{
+							{
+								ID:      "ruleset-foo",
+								Version: "v1",
+								Rules: []report.Rule{
+									{
+										ID: "1",
+										Checks: []report.Check{
+											{
+												Status:  rule.Failed,
+												Message: "foo",
+												Targets: []rule.Target{
+													rule.NewTarget("namespace", "payments", "name", "pod-1"),
+													rule.NewTarget("namespace", "kube-system", "name", "pod-2"),
+												},
+											},
+											{Status: rule.Passed, Message: "bar"},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+
+			reports := rep.SplitByOwner(resolver)
+			Expect(reports).To(HaveLen(3))
+			Expect(reports["team-payments"].Providers[0].Rulesets[0].Rules[0].Checks).To(Equal([]report.Check{
+				{Status: rule.Failed, Message: "foo", Targets: []rule.Target{rule.NewTarget("namespace", "payments", "name", "pod-1")}},
+			}))
+			Expect(reports["team-platform"].Providers[0].Rulesets[0].Rules[0].Checks).To(Equal([]report.Check{
+				{Status: rule.Failed, Message: "foo", Targets: []rule.Target{rule.NewTarget("namespace", "kube-system", "name", "pod-2")}},
+			}))
+			Expect(reports[report.UnknownOwner].Providers[0].Rulesets[0].Rules[0].Checks).To(Equal([]report.Check{
+				{Status: rule.Passed, Message: "bar"},
+			}))
+
+			index := report.NewOwnersIndex(reports, func(owner string) string { return owner + ".html" })
+			Expect(index.Owners).To(Equal([]report.OwnersIndexEntry{
+				{Owner: "team-payments", Path: "team-payments.html", Summary: map[rule.Status]int{rule.Failed: 1}},
+				{Owner: "team-platform", Path: "team-platform.html", Summary: map[rule.Status]int{rule.Failed: 1}},
+				{Owner: report.UnknownOwner, Path: report.UnknownOwner + ".html", Summary: map[rule.Status]int{rule.Passed: 1}},
+			}))
+		})
+	})
+
+	Describe("#OwnerFileNames", func() {
+		It("should return unique file names for the owners", func() {
+			fileNames := report.OwnerFileNames([]string{"team-foo", "team/bar", "team:bar", "Team_Bar", "index", "INDEX", "team baz"}, "html")
+
+			Expect(fileNames).To(HaveLen(7))
+			Expect(fileNames["team-foo"]).To(Equal("team-foo.html"))
+			Expect(fileNames["team baz"]).To(Equal("team_baz.html"))
+			Expect(fileNames["team/bar"]).To(MatchRegexp(`^team_bar-[0-9a-f]{8}\.html$`))
+			Expect(fileNames["team:bar"]).To(MatchRegexp(`^team_bar-[0-9a-f]{8}\.html$`))
+			Expect(fileNames["Team_Bar"]).To(MatchRegexp(`^Team_Bar-[0-9a-f]{8}\.html$`))
+			Expect(fileNames["index"]).To(MatchRegexp(`^index-[0-9a-f]{8}\.html$`))
+			Expect(fileNames["INDEX"]).To(MatchRegexp(`^INDEX-[0-9a-f]{8}\.html$`))
+
+			unique := map[string]struct{}{}
+			for _, fileName := range fileNames {
+				unique[strings.ToLower(fileName)] = struct{}{}
+			}
+			Expect(unique).To(HaveLen(7))
+			Expect(report.OwnerFileNames([]string{"team:bar", "team/bar"}, "html")).To(HaveKeyWithValue("team/bar", fileNames["team/bar"]))
+		})
+	})
+
+	Describe("#Validate", func() {
+		It("should return errors for incorrectly defined owners", func() {
+			owners := report.Owners{
+				Mappings: []report.OwnerMapping{
+					{Namespaces: []string{"["}},
+					{Owner: "foo"},
+				},
+				NamespaceLabel: "foo bar",
+			}
+
+			Expect(owners.Validate()).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("mappings[0].owner"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("mappings[0].namespaces[0]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("mappings[1]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("namespaceLabel"),
+				})),
+			))
+		})
+	})
+})
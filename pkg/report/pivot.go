@@ -0,0 +1,316 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package report
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gardener/diki/pkg/rule"
+)
+
+// PivotKey is the target property by which checks are grouped in a PivotReport.
+type PivotKey string
+
+const (
+	// PivotByNamespace groups checks by the namespace of their targets.
+	PivotByNamespace PivotKey = "namespace"
+	// PivotByNode groups checks by the node of their targets.
+	PivotByNode PivotKey = "node"
+	// PivotByWorkload groups checks by the workload owning their targets.
+	PivotByWorkload PivotKey = "workload"
+)
+
+// PivotKeys returns all supported pivot keys.
+func PivotKeys() []PivotKey {
+	return []PivotKey{PivotByNamespace, PivotByNode, PivotByWorkload}
+}
+
+var workloadKinds = []string{"Pod", "ReplicaSet", "Deployment", "DaemonSet", "StatefulSet", "Job", "CronJob"}
+
+// generatedNameAlphabet contains the characters of the random suffixes which
+// Kubernetes controllers append to the names of the objects they create.
+const generatedNameAlphabet = "bcdfghjklmnpqrstvwxz2456789"
+
+// PivotReport contains the checks of one or more reports
+// grouped by a property of their targets.
+type PivotReport struct {
+	Time        time.Time      `json:"time"`
+	MinStatus   rule.Status    `json:"minStatus,omitempty"`
+	DikiVersion string         `json:"dikiVersion"`
+	Metadata    map[string]any `json:"metadata,omitempty"`
+	GroupBy     PivotKey       `json:"groupBy"`
+	Groups      []PivotGroup   `json:"groups"`
+}
+
+// PivotGroup contains all checks with targets that share the same pivot value.
+// Checks with targets that do not have a pivot value are grouped with an empty value.
+type PivotGroup struct {
+	Value  string       `json:"value"`
+	Checks []PivotCheck `json:"checks"`
+}
+
+// PivotCheck is a check together with the rule it originates from.
+// It contains only the targets which belong to its group.
+type PivotCheck struct {
+	// Report is the distinct value of the report the check originates from.
+	// It is only set for pivots of merged reports.
+	Report         string             `json:"report,omitempty"`
+	ProviderID     string             `json:"providerID"`
+	RulesetID      string             `json:"rulesetID"`
+	RulesetVersion string             `json:"rulesetVersion"`
+	RuleID         string             `json:"ruleID"`
+	RuleName       string             `json:"ruleName"`
+	Severity       rule.SeverityLevel `json:"severity,omitempty"`
+	Status         rule.Status        `json:"status"`
+	Message        string             `json:"message"`
+	Targets        []rule.Target      `json:"targets,omitempty"`
+}
+
+// Pivot groups the checks of the report by the given target property.
+func (r *Report) Pivot(key PivotKey) (*PivotReport, error) {
+	if !slices.Contains(PivotKeys(), key) {
+		return nil, fmt.Errorf("not supported pivot key %s", key)
+	}
+
+	pivot := newPivotReport(r.Time, r.MinStatus, r.DikiVersion, r.Metadata, key)
+	for _, provider := range r.Providers {
+		for _, ruleset := range provider.Rulesets {
+			for _, rl := range ruleset.Rules {
+				for _, check := range rl.Checks {
+					pivot.addCheck(PivotCheck{
+						ProviderID:     provider.ID,
+						RulesetID:      ruleset.ID,
+						RulesetVersion: ruleset.Version,
+						RuleID:         rl.ID,
+						RuleName:       rl.Name,
+						Severity:       rl.Severity,
+						Status:         check.Status,
+						Message:        check.Message,
+					}, check.Targets)
+				}
+			}
+		}
+	}
+	pivot.sort()
+	return pivot, nil
+}
+
+// Pivot groups the checks of the merged report by the given target property.
+func (mr *MergedReport) Pivot(key PivotKey) (*PivotReport, error) {
+	if !slices.Contains(PivotKeys(), key) {
+		return nil, fmt.Errorf("not supported pivot key %s", key)
+	}
+
+	pivot := newPivotReport(mr.Time, mr.MinStatus, mr.DikiVersion, mr.Metadata, key)
+	for _, provider := range mr.Providers {
+		for _, ruleset := range provider.Rulesets {
+			for _, rl := range ruleset.Rules {
+				for _, check := range rl.Checks {
+					for _, reportID := range sortedKeys(check.ReportsTargets) {
+						pivot.addCheck(PivotCheck{
+							Report:         reportID,
+							ProviderID:     provider.ID,
+							RulesetID:      ruleset.ID,
+							RulesetVersion: ruleset.Version,
+							RuleID:         rl.ID,
+							RuleName:       rl.Name,
+							Severity:       rl.Severity,
+							Status:         check.Status,
+							Message:        check.Message,
+						}, check.ReportsTargets[reportID])
+					}
+				}
+			}
+		}
+	}
+	pivot.sort()
+	return pivot, nil
+}
+
+func newPivotReport(t time.Time, minStatus rule.Status, dikiVersion string, metadata map[string]any, key PivotKey) *PivotReport {
+	return &PivotReport{
+		Time:        t,
+		MinStatus:   minStatus,
+		DikiVersion: dikiVersion,
+		Metadata:    metadata,
+		GroupBy:     key,
+		Groups:      []PivotGroup{},
+	}
+}
+
+// addCheck adds the check to the groups of its targets.
+func (p *PivotReport) addCheck(check PivotCheck, targets []rule.Target) {
+	groupedTargets := map[string][]rule.Target{}
+	if len(targets) == 0 {
+		groupedTargets[""] = nil
+	}
+	for _, target := range targets {
+		value := pivotValue(p.GroupBy, target)
+		groupedTargets[value] = append(groupedTargets[value], target)
+	}
+
+	for _, value := range sortedKeys(groupedTargets) {
+		groupCheck := check
+		groupCheck.Targets = groupedTargets[value]
+
+		idx := slices.IndexFunc(p.Groups, func(g PivotGroup) bool {
+			return g.Value == value
+		})
+		if idx < 0 {
+			idx = len(p.Groups)
+			p.Groups = append(p.Groups, PivotGroup{Value: value})
+		}
+		p.Groups[idx].Checks = append(p.Groups[idx].Checks, groupCheck)
+	}
+}
+
+// sort orders the groups by value with the group of targets without a value last.
+func (p *PivotReport) sort() {
+	slices.SortFunc(p.Groups, func(a, b PivotGroup) int {
+		switch {
+		case a.Value == b.Value:
+			return 0
+		case len(a.Value) == 0:
+			return 1
+		case len(b.Value) == 0:
+			return -1
+		}
+		return cmp.Compare(a.Value, b.Value)
+	})
+}
+
+// pivotValue returns the value of the target for the given pivot key.
+func pivotValue(key PivotKey, target rule.Target) string {
+	switch key {
+	case PivotByNamespace:
+		return target["namespace"]
+	case PivotByNode:
+		if target["kind"] == "Node" {
+			return target["name"]
+		}
+		return target["node"]
+	case PivotByWorkload:
+		if !slices.Contains(workloadKinds, target["kind"]) || len(target["name"]) == 0 {
+			return ""
+		}
+		kind, name := workloadOwner(target)
+		if len(target["namespace"]) == 0 {
+			return fmt.Sprintf("%s/%s", kind, name)
+		}
+		return fmt.Sprintf("%s/%s/%s", target["namespace"], kind, name)
+	default:
+		return ""
+	}
+}
+
+// workloadOwner resolves the top-level workload which owns the object of the given target.
+// Rules can reference the owner of an object with the ownerKind and ownerName target keys.
+// Owners are only derived from generated names when the target is a Pod which is owned by a ReplicaSet or Job,
+// since only the names of their Pods, of the ReplicaSets of Deployments and of the Jobs of CronJobs are generated
+// by the Kubernetes controllers. Other names can contain dash-separated parts which only look generated, e.g. api-v2.
+func workloadOwner(target rule.Target) (string, string) {
+	kind, name := target["kind"], target["name"]
+	ownerKind, ownerName := target["ownerKind"], target["ownerName"]
+
+	if kind != "Pod" || (ownerKind != "ReplicaSet" && ownerKind != "Job") {
+		if len(ownerKind) > 0 && len(ownerName) > 0 {
+			return ownerKind, ownerName
+		}
+		return kind, name
+	}
+
+	if len(ownerName) == 0 {
+		var ok bool
+		if ownerName, ok = trimGeneratedSuffix(name, isRandomSuffix(5, 5)); !ok {
+			return kind, name
+		}
+	}
+
+	switch ownerKind {
+	case "ReplicaSet":
+		if deployment, ok := trimGeneratedSuffix(ownerName, isRandomSuffix(6, 10)); ok {
+			return "Deployment", deployment
+		}
+	case "Job":
+		if cronJob, ok := trimGeneratedSuffix(ownerName, isScheduledTime); ok {
+			return "CronJob", cronJob
+		}
+	}
+	return ownerKind, ownerName
+}
+
+// trimGeneratedSuffix returns the name without its last dash-separated part if the part is generated.
+func trimGeneratedSuffix(name string, generated func(string) bool) (string, bool) {
+	idx := strings.LastIndex(name, "-")
+	if idx <= 0 || !generated(name[idx+1:]) {
+		return "", false
+	}
+	return name[:idx], true
+}
+
+// isRandomSuffix returns a function which reports whether a suffix is a random string of minLen to maxLen
+// characters, like the pod template hashes of replica sets and the generated names of pods.
+func isRandomSuffix(minLen, maxLen int) func(string) bool {
+	return func(suffix string) bool {
+		if len(suffix) < minLen || len(suffix) > maxLen {
+			return false
+		}
+		for _, c := range suffix {
+			if !strings.ContainsRune(generatedNameAlphabet, c) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// isScheduledTime reports whether a suffix is the scheduled time in minutes
+// which is appended to the names of the jobs of cron jobs.
+func isScheduledTime(suffix string) bool {
+	if len(suffix) < 8 {
+		return false
+	}
+	for _, c := range suffix {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// pivotGroupSummaryText returns a summary string with the number of checks per status.
+func pivotGroupSummaryText(group PivotGroup) string {
+	summaryText := ""
+	for _, status := range rule.Statuses() {
+		num := 0
+		for _, check := range group.Checks {
+			if check.Status == status {
+				num++
+			}
+		}
+		if num != 0 {
+			if len(summaryText) > 0 {
+				summaryText = fmt.Sprintf("%s, ", summaryText)
+			}
+			summaryText = fmt.Sprintf("%s%dx %s %c", summaryText, num, status, rule.StatusIcon(status))
+		}
+	}
+	return summaryText
+}
+
+// pivotChecksWithStatus returns all checks of the group with the given status.
+func pivotChecksWithStatus(group PivotGroup, status rule.Status) []PivotCheck {
+	var checks []PivotCheck
+	for _, check := range group.Checks {
+		if check.Status == status {
+			checks = append(checks, check)
+		}
+	}
+	return checks
+}
@@ -0,0 +1,224 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package report_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/diki/pkg/report"
+	"github.com/gardener/diki/pkg/rule"
+)
+
+var _ = Describe("pivot", func() {
+	var (
+		reportTime = time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+		newReport  = func(checks ...report.Check) *report.Report {
+			return &report.Report{
+				Time:        reportTime,
+				DikiVersion: "1",
+				Providers: []report.Provider{
+					{
+						ID:       "provider-foo",
+						Metadata: map[string]string{"id": "cluster-1"},
+						Rulesets: []report.Ruleset{
+							{
+								ID:      "ruleset-foo",
+								Version: "v1",
+								Rules: []report.Rule{
+									{
+										ID:       "1",
+										Name:     "rule 1",
+										Severity: rule.SeverityHigh,
+										Checks:   checks,
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+		}
+		newPivotCheck = func(status rule.Status, message string, targets ...rule.Target) report.PivotCheck {
+			return report.PivotCheck{
+				ProviderID:     "provider-foo",
+				RulesetID:      "ruleset-foo",
+				RulesetVersion: "v1",
+				RuleID:         "1",
+				RuleName:       "rule 1",
+				Severity:       rule.SeverityHigh,
+				Status:         status,
+				Message:        message,
+				Targets:        targets,
+			}
+		}
+		rep *report.Report
+	)
+
+	BeforeEach(func() {
+		rep = newReport(
+			report.Check{
+				Status:  rule.Failed,
+				Message: "foo",
+				Targets: []rule.Target{
+					rule.NewTarget("namespace", "ns-1", "kind", "Pod", "name", "pod-1", "container", "c"),
+					rule.NewTarget("namespace", "ns-2", "kind", "ReplicaSet", "name", "rs-1"),
+					rule.NewTarget("kind", "Node", "name", "node-1"),
+				},
+			},
+			report.Check{
+				Status:  rule.Passed,
+				Message: "bar",
+				Targets: []rule.Target{
+					rule.NewTarget("namespace", "ns-1", "kind", "Pod", "name", "pod-1"),
+				},
+			},
+			report.Check{
+				Status:  rule.Errored,
+				Message: "baz",
+			},
+		)
+	})
+
+	Describe("#Pivot", func() {
+		It("should group checks by namespace", func() {
+			pivot, err := rep.Pivot(report.PivotByNamespace)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(pivot.GroupBy).To(Equal(report.PivotByNamespace))
+			Expect(pivot.Groups).To(Equal([]report.PivotGroup{
+				{
+					Value: "ns-1",
+					Checks: []report.PivotCheck{
+						newPivotCheck(rule.Failed, "foo", rule.NewTarget("namespace", "ns-1", "kind", "Pod", "name", "pod-1", "container", "c")),
+						newPivotCheck(rule.Passed, "bar", rule.NewTarget("namespace", "ns-1", "kind", "Pod", "name", "pod-1")),
+					},
+				},
+				{
+					Value: "ns-2",
+					Checks: []report.PivotCheck{
+						newPivotCheck(rule.Failed, "foo", rule.NewTarget("namespace", "ns-2", "kind", "ReplicaSet", "name", "rs-1")),
+					},
+				},
+				{
+					Value: "",
+					Checks: []report.PivotCheck{
+						newPivotCheck(rule.Failed, "foo", rule.NewTarget("kind", "Node", "name", "node-1")),
+						newPivotCheck(rule.Errored, "baz"),
+					},
+				},
+			}))
+		})
+
+		It("should group checks by node", func() {
+			pivot, err := rep.Pivot(report.PivotByNode)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(pivot.Groups).To(HaveLen(2))
+			Expect(pivot.Groups[0].Value).To(Equal("node-1"))
+			Expect(pivot.Groups[0].Checks).To(Equal([]report.PivotCheck{
+				newPivotCheck(rule.Failed, "foo", rule.NewTarget("kind", "Node", "name", "node-1")),
+			}))
+			Expect(pivot.Groups[1].Value).To(BeEmpty())
+		})
+
+		It("should group checks by workload", func() {
+			pivot, err := rep.Pivot(report.PivotByWorkload)
+			Expect(err).ToNot(HaveOccurred())
+
+			var values []string
+			for _, group := range pivot.Groups {
+				values = append(values, group.Value)
+			}
+			Expect(values).To(Equal([]string{"ns-1/Pod/pod-1", "ns-2/ReplicaSet/rs-1", ""}))
+			Expect(pivot.Groups[0].Checks).To(HaveLen(2))
+		})
+
+		It("should group checks by the workload owning their targets", func() {
+			rep = newReport(
+				report.Check{
+					Status:  rule.Failed,
+					Message: "foo",
+					Targets: []rule.Target{
+						rule.NewTarget("namespace", "ns-1", "kind", "Pod", "name", "foo-6d4cf56db6-x2x9z", "ownerKind", "ReplicaSet"),
+						rule.NewTarget("namespace", "ns-1", "kind", "Pod", "name", "foo-6d4cf56db6-r7k2q", "ownerKind", "ReplicaSet", "ownerName", "foo-6d4cf56db6"),
+						rule.NewTarget("namespace", "ns-1", "kind", "ReplicaSet", "name", "foo-6d4cf56db6", "ownerKind", "Deployment", "ownerName", "foo"),
+						rule.NewTarget("namespace", "ns-1", "kind", "Deployment", "name", "foo"),
+						rule.NewTarget("namespace", "ns-1", "kind", "Pod", "name", "bar-29391240-xk2lp", "ownerKind", "Job"),
+						rule.NewTarget("namespace", "ns-1", "kind", "Pod", "name", "etcd-main-0", "ownerKind", "StatefulSet", "ownerName", "etcd-main"),
+					},
+				},
+			)
+
+			pivot, err := rep.Pivot(report.PivotByWorkload)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(pivot.Groups).To(Equal([]report.PivotGroup{
+				{
+					Value: "ns-1/CronJob/bar",
+					Checks: []report.PivotCheck{
+						newPivotCheck(rule.Failed, "foo", rule.NewTarget("namespace", "ns-1", "kind", "Pod", "name", "bar-29391240-xk2lp", "ownerKind", "Job")),
+					},
+				},
+				{
+					Value: "ns-1/Deployment/foo",
+					Checks: []report.PivotCheck{
+						newPivotCheck(rule.Failed, "foo",
+							rule.NewTarget("namespace", "ns-1", "kind", "Pod", "name", "foo-6d4cf56db6-x2x9z", "ownerKind", "ReplicaSet"),
+							rule.NewTarget("namespace", "ns-1", "kind", "Pod", "name", "foo-6d4cf56db6-r7k2q", "ownerKind", "ReplicaSet", "ownerName", "foo-6d4cf56db6"),
+							rule.NewTarget("namespace", "ns-1", "kind", "ReplicaSet", "name", "foo-6d4cf56db6", "ownerKind", "Deployment", "ownerName", "foo"),
+							rule.NewTarget("namespace", "ns-1", "kind", "Deployment", "name", "foo"),
+						),
+					},
+				},
+				{
+					Value: "ns-1/StatefulSet/etcd-main",
+					Checks: []report.PivotCheck{
+						newPivotCheck(rule.Failed, "foo", rule.NewTarget("namespace", "ns-1", "kind", "Pod", "name", "etcd-main-0", "ownerKind", "StatefulSet", "ownerName", "etcd-main")),
+					},
+				},
+			}))
+		})
+
+		DescribeTable("should not derive owners from names which only look generated",
+			func(target rule.Target, expectedValue string) {
+				rep = newReport(report.Check{Status: rule.Failed, Message: "foo", Targets: []rule.Target{target}})
+
+				pivot, err := rep.Pivot(report.PivotByWorkload)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(pivot.Groups).To(HaveLen(1))
+				Expect(pivot.Groups[0].Value).To(Equal(expectedValue))
+			},
+			Entry("replica set without owner", rule.NewTarget("namespace", "ns-1", "kind", "ReplicaSet", "name", "api-v2"), "ns-1/ReplicaSet/api-v2"),
+			Entry("job without owner", rule.NewTarget("namespace", "ns-1", "kind", "Job", "name", "backup-20260101"), "ns-1/Job/backup-20260101"),
+			Entry("pod without owner", rule.NewTarget("namespace", "ns-1", "kind", "Pod", "name", "api-v2-x2x9z"), "ns-1/Pod/api-v2-x2x9z"),
+			Entry("pod of a stateful set", rule.NewTarget("namespace", "ns-1", "kind", "Pod", "name", "api-v2", "ownerKind", "StatefulSet"), "ns-1/Pod/api-v2"),
+			Entry("pod of a replica set without deployment", rule.NewTarget("namespace", "ns-1", "kind", "Pod", "name", "api-v2-x2x9z", "ownerKind", "ReplicaSet"), "ns-1/ReplicaSet/api-v2"),
+			Entry("pod with non generated name", rule.NewTarget("namespace", "ns-1", "kind", "Pod", "name", "api-v2", "ownerKind", "ReplicaSet"), "ns-1/Pod/api-v2"),
+		)
+
+		It("should return an error for unknown pivot keys", func() {
+			_, err := rep.Pivot(report.PivotKey("foo"))
+			Expect(err).To(MatchError("not supported pivot key foo"))
+		})
+
+		It("should group checks of merged reports", func() {
+			mergedReport, err := report.MergeReport([]*report.Report{rep}, map[string]string{"provider-foo": "id"})
+			Expect(err).ToNot(HaveOccurred())
+
+			pivot, err := mergedReport.Pivot(report.PivotByNamespace)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(pivot.Groups).To(HaveLen(3))
+			Expect(pivot.Groups[1].Value).To(Equal("ns-2"))
+			Expect(pivot.Groups[1].Checks).To(HaveLen(1))
+			Expect(pivot.Groups[1].Checks[0].Report).To(Equal("cluster-1"))
+			Expect(pivot.Groups[1].Checks[0].Targets).To(Equal([]rule.Target{rule.NewTarget("namespace", "ns-2", "kind", "ReplicaSet", "name", "rs-1")}))
+		})
+	})
+})
@@ -0,0 +1,165 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package report
+
+import (
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"text/tabwriter"
+	"time"
+)
+
+// RuleProfile describes the execution of a single rule run.
+type RuleProfile struct {
+	ProviderID     string        `json:"providerID"`
+	RulesetID      string        `json:"rulesetID"`
+	RulesetVersion string        `json:"rulesetVersion"`
+	RuleID         string        `json:"ruleID"`
+	StartTime      time.Time     `json:"startTime"`
+	EndTime        time.Time     `json:"endTime"`
+	Duration       time.Duration `json:"duration"`
+	Retries        int           `json:"retries"`
+	OpsPods        int           `json:"opsPods"`
+	Worker         int           `json:"worker"`
+}
+
+// Profile lists the rule runs of a report sorted by their duration in descending order.
+type Profile struct {
+	StartTime time.Time     `json:"startTime"`
+	EndTime   time.Time     `json:"endTime"`
+	Rules     []RuleProfile `json:"rules"`
+}
+
+// NewProfile creates a Profile from the provenance of a report.
+// If top is positive only the top slowest rule runs are listed.
+func NewProfile(r *Report, top int) (*Profile, error) {
+	if r.Provenance == nil {
+		return nil, errors.New("report does not contain provenance")
+	}
+
+	profile := &Profile{
+		StartTime: r.Provenance.StartTime,
+		EndTime:   r.Provenance.EndTime,
+		Rules:     []RuleProfile{},
+	}
+	for _, provider := range r.Provenance.Providers {
+		for _, ruleset := range provider.Rulesets {
+			for _, timing := range ruleset.Rules {
+				profile.Rules = append(profile.Rules, RuleProfile{
+					ProviderID:     provider.ID,
+					RulesetID:      ruleset.ID,
+					RulesetVersion: ruleset.Version,
+					RuleID:         timing.ID,
+					StartTime:      timing.StartTime,
+					EndTime:        timing.EndTime,
+					Duration:       timing.EndTime.Sub(timing.StartTime),
+					Retries:        timing.Retries,
+					OpsPods:        timing.OpsPods,
+					Worker:         timing.Worker,
+				})
+			}
+		}
+	}
+
+	slices.SortStableFunc(profile.Rules, func(a, b RuleProfile) int {
+		return cmp.Compare(b.Duration, a.Duration)
+	})
+	if top > 0 && len(profile.Rules) > top {
+		profile.Rules = profile.Rules[:top]
+	}
+	return profile, nil
+}
+
+// WriteTable writes the profile as a human readable table.
+func (p *Profile) WriteTable(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	if _, err := fmt.Fprintln(tw, "DURATION\tPROVIDER\tRULESET\tRULE\tRETRIES\tOPS PODS\tWORKER"); err != nil {
+		return err
+	}
+	for _, rp := range p.Rules {
+		if _, err := fmt.Fprintf(tw, "%s\t%s\t%s %s\t%s\t%d\t%d\t%d\n",
+			rp.Duration.Round(time.Millisecond), rp.ProviderID, rp.RulesetID, rp.RulesetVersion, rp.RuleID, rp.Retries, rp.OpsPods, rp.Worker,
+		); err != nil {
+			return err
+		}
+	}
+	return tw.Flush()
+}
+
+// traceEvent is an event of the Chrome trace event format.
+// https://docs.google.com/document/d/1CvAClvFfyA5R-PhYUmn5OOQtYMH4h6I0nSsKchNAySU
+type traceEvent struct {
+	Name      string         `json:"name"`
+	Category  string         `json:"cat,omitempty"`
+	Phase     string         `json:"ph"`
+	Timestamp int64          `json:"ts"`
+	Duration  int64          `json:"dur,omitempty"`
+	ProcessID int            `json:"pid"`
+	ThreadID  int            `json:"tid"`
+	Args      map[string]any `json:"args,omitempty"`
+}
+
+// WriteChromeTrace writes the timeline of all rule runs of a report in the Chrome trace event format.
+// Every ruleset run is shown as a process and every worker as a thread of that process.
+func WriteChromeTrace(w io.Writer, r *Report) error {
+	if r.Provenance == nil {
+		return errors.New("report does not contain provenance")
+	}
+
+	var (
+		startTime = r.Provenance.StartTime
+		events    = []traceEvent{}
+		pid       = 0
+	)
+	microseconds := func(t time.Time) int64 {
+		return t.Sub(startTime).Microseconds()
+	}
+
+	for _, provider := range r.Provenance.Providers {
+		for _, ruleset := range provider.Rulesets {
+			pid++
+			events = append(events, traceEvent{
+				Name:      "process_name",
+				Phase:     "M",
+				ProcessID: pid,
+				Args:      map[string]any{"name": fmt.Sprintf("%s/%s %s", provider.ID, ruleset.ID, ruleset.Version)},
+			})
+
+			workers := map[int]struct{}{}
+			for _, timing := range ruleset.Rules {
+				if _, ok := workers[timing.Worker]; !ok {
+					workers[timing.Worker] = struct{}{}
+					events = append(events, traceEvent{
+						Name:      "thread_name",
+						Phase:     "M",
+						ProcessID: pid,
+						ThreadID:  timing.Worker,
+						Args:      map[string]any{"name": fmt.Sprintf("worker %d", timing.Worker)},
+					})
+				}
+
+				events = append(events, traceEvent{
+					Name:      timing.ID,
+					Category:  "rule",
+					Phase:     "X",
+					Timestamp: microseconds(timing.StartTime),
+					Duration:  timing.EndTime.Sub(timing.StartTime).Microseconds(),
+					ProcessID: pid,
+					ThreadID:  timing.Worker,
+					Args:      map[string]any{"retries": timing.Retries, "opsPods": timing.OpsPods},
+				})
+			}
+		}
+	}
+
+	return json.NewEncoder(w).Encode(map[string]any{
+		"traceEvents":     events,
+		"displayTimeUnit": "ms",
+	})
+}
@@ -0,0 +1,115 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package report_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/diki/pkg/report"
+)
+
+var _ = Describe("profile", func() {
+	var (
+		startTime = time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+		rep       *report.Report
+	)
+
+	BeforeEach(func() {
+		rep = &report.Report{
+			Provenance: &report.Provenance{
+				StartTime: startTime,
+				EndTime:   startTime.Add(time.Minute),
+				Providers: []report.ProviderProvenance{
+					{
+						ID: "provider-foo",
+						Rulesets: []report.RulesetProvenance{
+							{
+								ID:      "ruleset-foo",
+								Version: "v1",
+								Rules: []report.RuleTiming{
+									{ID: "1", StartTime: startTime, EndTime: startTime.Add(time.Second), Worker: 0},
+									{ID: "2", StartTime: startTime, EndTime: startTime.Add(3 * time.Second), Retries: 2, OpsPods: 3, Worker: 1},
+									{ID: "3", StartTime: startTime.Add(time.Second), EndTime: startTime.Add(3 * time.Second), OpsPods: 1, Worker: 0},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	})
+
+	Describe("#NewProfile", func() {
+		It("should return an error when the report does not contain provenance", func() {
+			_, err := report.NewProfile(&report.Report{}, 0)
+			Expect(err).To(MatchError("report does not contain provenance"))
+		})
+
+		It("should list the rules sorted by duration", func() {
+			profile, err := report.NewProfile(rep, 0)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(profile.Rules).To(HaveLen(3))
+			Expect(profile.Rules[0]).To(Equal(report.RuleProfile{
+				ProviderID:     "provider-foo",
+				RulesetID:      "ruleset-foo",
+				RulesetVersion: "v1",
+				RuleID:         "2",
+				StartTime:      startTime,
+				EndTime:        startTime.Add(3 * time.Second),
+				Duration:       3 * time.Second,
+				Retries:        2,
+				OpsPods:        3,
+				Worker:         1,
+			}))
+			Expect(profile.Rules[1].RuleID).To(Equal("3"))
+			Expect(profile.Rules[2].RuleID).To(Equal("1"))
+		})
+
+		It("should limit the listed rules", func() {
+			profile, err := report.NewProfile(rep, 1)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(profile.Rules).To(HaveLen(1))
+			Expect(profile.Rules[0].RuleID).To(Equal("2"))
+		})
+
+		It("should write the profile as a table", func() {
+			profile, err := report.NewProfile(rep, 1)
+			Expect(err).ToNot(HaveOccurred())
+
+			var buf bytes.Buffer
+			Expect(profile.WriteTable(&buf)).To(Succeed())
+			Expect(buf.String()).To(Equal("DURATION  PROVIDER      RULESET         RULE  RETRIES  OPS PODS  WORKER\n" +
+				"3s        provider-foo  ruleset-foo v1  2     2        3         1\n"))
+		})
+	})
+
+	Describe("#WriteChromeTrace", func() {
+		It("should write the timeline of the rule runs", func() {
+			var buf bytes.Buffer
+			Expect(report.WriteChromeTrace(&buf, rep)).To(Succeed())
+
+			trace := struct {
+				TraceEvents []map[string]any `json:"traceEvents"`
+			}{}
+			Expect(json.Unmarshal(buf.Bytes(), &trace)).To(Succeed())
+
+			Expect(trace.TraceEvents).To(ConsistOf(
+				map[string]any{"name": "process_name", "ph": "M", "ts": 0.0, "pid": 1.0, "tid": 0.0, "args": map[string]any{"name": "provider-foo/ruleset-foo v1"}},
+				map[string]any{"name": "thread_name", "ph": "M", "ts": 0.0, "pid": 1.0, "tid": 0.0, "args": map[string]any{"name": "worker 0"}},
+				map[string]any{"name": "thread_name", "ph": "M", "ts": 0.0, "pid": 1.0, "tid": 1.0, "args": map[string]any{"name": "worker 1"}},
+				map[string]any{"name": "1", "cat": "rule", "ph": "X", "ts": 0.0, "dur": 1e6, "pid": 1.0, "tid": 0.0, "args": map[string]any{"retries": 0.0, "opsPods": 0.0}},
+				map[string]any{"name": "2", "cat": "rule", "ph": "X", "ts": 0.0, "dur": 3e6, "pid": 1.0, "tid": 1.0, "args": map[string]any{"retries": 2.0, "opsPods": 3.0}},
+				map[string]any{"name": "3", "cat": "rule", "ph": "X", "ts": 1e6, "dur": 2e6, "pid": 1.0, "tid": 0.0, "args": map[string]any{"retries": 0.0, "opsPods": 1.0}},
+			))
+		})
+	})
+})
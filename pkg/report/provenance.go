@@ -0,0 +1,364 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package report
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gardener/diki/pkg/config"
+	"github.com/gardener/diki/pkg/provider"
+	"github.com/gardener/diki/pkg/ruleset"
+)
+
+// ProvenanceConfigMode describes how the configuration of a run is recorded in the report provenance.
+type ProvenanceConfigMode string
+
+const (
+	// ProvenanceConfigRedacted records the hashes and a copy of the configuration
+	// with the values of sensitive keys redacted. Keys are sensitive if they contain e.g. "password",
+	// "secret", "token", "privateKey" or "credential" and do not end with "file", "path" or "ref".
+	// The values of all other keys, e.g. names, namespaces and file paths, are recorded as they are.
+	// The mode only applies to the copies of the provider and ruleset args and rule options, the
+	// targets, cluster versions and skip justifications are always recorded. Use [Redactor] to
+	// pseudonymise them before the report is shared.
+	ProvenanceConfigRedacted ProvenanceConfigMode = "redacted"
+	// ProvenanceConfigFull records the hashes and a full copy of the configuration.
+	ProvenanceConfigFull ProvenanceConfigMode = "full"
+	// ProvenanceConfigHash records only the hashes of the configuration.
+	ProvenanceConfigHash ProvenanceConfigMode = "hash"
+)
+
+// ProvenanceConfigModes returns all supported provenance config modes.
+func ProvenanceConfigModes() []ProvenanceConfigMode {
+	return []ProvenanceConfigMode{ProvenanceConfigRedacted, ProvenanceConfigFull, ProvenanceConfigHash}
+}
+
+// Provenance describes how the results of a report were produced.
+type Provenance struct {
+	StartTime time.Time `json:"startTime"`
+	EndTime   time.Time `json:"endTime"`
+	// OpsImage is the image used for the privileged pods of rules.
+	OpsImage string `json:"opsImage,omitempty"`
+	// Snapshot is set if the rules were evaluated against a snapshot instead of the live clusters.
+	Snapshot  *SnapshotProvenance  `json:"snapshot,omitempty"`
+	Providers []ProviderProvenance `json:"providers"`
+}
+
+// SnapshotProvenance describes the snapshot against which the rules were evaluated.
+type SnapshotProvenance struct {
+	CreationTime time.Time `json:"creationTime"`
+	DikiVersion  string    `json:"dikiVersion,omitempty"`
+}
+
+// ProviderProvenance describes the run of a provider.
+type ProviderProvenance struct {
+	ID string `json:"id"`
+	// Target contains the project and name of the shoot if the provider reports one result per shoot.
+	Target    map[string]string `json:"target,omitempty"`
+	StartTime time.Time         `json:"startTime"`
+	EndTime   time.Time         `json:"endTime"`
+	// ClusterVersions contains the Kubernetes server versions of the checked clusters by cluster name.
+	ClusterVersions map[string]string   `json:"clusterVersions,omitempty"`
+	ArgsHash        string              `json:"argsHash,omitempty"`
+	Args            any                 `json:"args,omitempty"`
+	Rulesets        []RulesetProvenance `json:"rulesets"`
+}
+
+// RulesetProvenance describes the run of a ruleset.
+type RulesetProvenance struct {
+	ID              string        `json:"id"`
+	Version         string        `json:"version"`
+	StartTime       time.Time     `json:"startTime"`
+	EndTime         time.Time     `json:"endTime"`
+	ArgsHash        string        `json:"argsHash,omitempty"`
+	Args            any           `json:"args,omitempty"`
+	RuleOptionsHash string        `json:"ruleOptionsHash,omitempty"`
+	RuleOptions     any           `json:"ruleOptions,omitempty"`
+	SkippedRules    []SkippedRule `json:"skippedRules,omitempty"`
+	Rules           []RuleTiming  `json:"rules,omitempty"`
+}
+
+// SkippedRule is a rule skipped by the configuration.
+type SkippedRule struct {
+	RuleID        string `json:"ruleID"`
+	Justification string `json:"justification,omitempty"`
+}
+
+// RuleTiming contains the start and end time of a rule run
+// together with the operations performed during the run.
+type RuleTiming struct {
+	ID        string    `json:"id"`
+	StartTime time.Time `json:"startTime"`
+	EndTime   time.Time `json:"endTime"`
+	Retries   int       `json:"retries,omitempty"`
+	OpsPods   int       `json:"opsPods,omitempty"`
+	Worker    int       `json:"worker"`
+}
+
+// RunProvenance contains the information about a run
+// from which the [Provenance] of a report is created.
+type RunProvenance struct {
+	Config     *config.DikiConfig
+	ConfigMode ProvenanceConfigMode
+	StartTime  time.Time
+	OpsImage   string
+	// ClusterVersions contains the Kubernetes server versions of the checked clusters by provider ID.
+	ClusterVersions map[string]map[string]string
+	// Snapshot is set if the rules were evaluated against a snapshot.
+	Snapshot *SnapshotProvenance
+}
+
+var _ ReportOption = &RunProvenance{}
+
+// ApplyToReport implements ReportOption.
+func (rp *RunProvenance) ApplyToReport(opts *ReportOptions) {
+	opts.RunProvenance = rp
+}
+
+// newProvenance creates the provenance of the providers and rulesets contained in the results.
+func newProvenance(results []provider.ProviderResult, rp *RunProvenance, endTime time.Time) *Provenance {
+	provenance := &Provenance{
+		StartTime: rp.StartTime,
+		EndTime:   endTime,
+		OpsImage:  rp.OpsImage,
+		Snapshot:  rp.Snapshot,
+		Providers: make([]ProviderProvenance, 0, len(results)),
+	}
+
+	for _, providerResult := range results {
+		// the results of all shoots of a provider are produced with the same configuration
+		var providerConfig config.ProviderConfig
+		if rp.Config != nil {
+			if idx := slices.IndexFunc(rp.Config.Providers, func(p config.ProviderConfig) bool {
+				return p.ID == providerResult.ProviderID
+			}); idx >= 0 {
+				providerConfig = rp.Config.Providers[idx]
+			}
+		}
+
+		providerProvenance := ProviderProvenance{
+			ID:              providerResult.ProviderID,
+			Target:          providerTarget(providerResult.Metadata),
+			StartTime:       providerResult.StartTime,
+			EndTime:         providerResult.EndTime,
+			ClusterVersions: rp.ClusterVersions[providerResult.ProviderID],
+			Rulesets:        make([]RulesetProvenance, 0, len(providerResult.RulesetResults)),
+		}
+		providerProvenance.ArgsHash, providerProvenance.Args = recordConfig(providerConfig.Args, rp.ConfigMode)
+
+		for _, rulesetResult := range providerResult.RulesetResults {
+			providerProvenance.Rulesets = append(providerProvenance.Rulesets, newRulesetProvenance(rulesetResult, providerConfig.Rulesets, rp.ConfigMode))
+		}
+		slices.SortFunc(providerProvenance.Rulesets, func(a, b RulesetProvenance) int {
+			return cmp.Or(cmp.Compare(a.ID, b.ID), cmp.Compare(a.Version, b.Version))
+		})
+		provenance.Providers = append(provenance.Providers, providerProvenance)
+	}
+	return provenance
+}
+
+func newRulesetProvenance(result ruleset.RulesetResult, rulesetConfigs []config.RulesetConfig, mode ProvenanceConfigMode) RulesetProvenance {
+	var rulesetConfig config.RulesetConfig
+	if idx := slices.IndexFunc(rulesetConfigs, func(r config.RulesetConfig) bool {
+		return r.ID == result.RulesetID && r.Version == result.RulesetVersion
+	}); idx >= 0 {
+		rulesetConfig = rulesetConfigs[idx]
+	}
+
+	rulesetProvenance := RulesetProvenance{
+		ID:        result.RulesetID,
+		Version:   result.RulesetVersion,
+		StartTime: result.StartTime,
+		EndTime:   result.EndTime,
+		Rules:     make([]RuleTiming, 0, len(result.RuleResults)),
+	}
+	rulesetProvenance.ArgsHash, rulesetProvenance.Args = recordConfig(rulesetConfig.Args, mode)
+	if len(rulesetConfig.RuleOptions) > 0 {
+		rulesetProvenance.RuleOptionsHash, rulesetProvenance.RuleOptions = recordConfig(rulesetConfig.RuleOptions, mode)
+	}
+
+	for _, ruleOptions := range rulesetConfig.RuleOptions {
+		if ruleOptions.Skip != nil && ruleOptions.Skip.Enabled {
+			rulesetProvenance.SkippedRules = append(rulesetProvenance.SkippedRules, SkippedRule{
+				RuleID:        ruleOptions.RuleID,
+				Justification: ruleOptions.Skip.Justification,
+			})
+		}
+	}
+	slices.SortFunc(rulesetProvenance.SkippedRules, func(a, b SkippedRule) int {
+		return cmp.Compare(a.RuleID, b.RuleID)
+	})
+
+	for _, ruleResult := range result.RuleResults {
+		rulesetProvenance.Rules = append(rulesetProvenance.Rules, RuleTiming{
+			ID:        ruleResult.RuleID,
+			StartTime: ruleResult.StartTime,
+			EndTime:   ruleResult.EndTime,
+			Retries:   ruleResult.Retries,
+			OpsPods:   ruleResult.OpsPods,
+			Worker:    ruleResult.Worker,
+		})
+	}
+	slices.SortFunc(rulesetProvenance.Rules, func(a, b RuleTiming) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+	return rulesetProvenance
+}
+
+// recordConfig returns the hash of the configuration and,
+// depending on the mode, a full or redacted copy of it.
+// The hash is always computed from the full configuration, so that changes of redacted values remain visible.
+// The redacted copy replaces the string values of sensitive keys with [RedactedValue] and keeps their structure.
+func recordConfig(conf any, mode ProvenanceConfigMode) (string, any) {
+	if conf == nil {
+		return "", nil
+	}
+
+	data, err := json.Marshal(conf)
+	if err != nil {
+		return "", nil
+	}
+
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return "", nil
+	}
+
+	hash := Digest(data)
+	switch mode {
+	case ProvenanceConfigFull:
+		return hash, value
+	case ProvenanceConfigHash:
+		return hash, nil
+	default:
+		return hash, redactValue(value)
+	}
+}
+
+// ConfigChange describes a change of the run configuration between two reports.
+type ConfigChange struct {
+	RulesetID string `json:"rulesetID,omitempty"`
+	// Field is the changed configuration, e.g. "args", "ruleOptions" or "clusterVersion/shoot".
+	Field string `json:"field"`
+	Old   string `json:"old,omitempty"`
+	New   string `json:"new,omitempty"`
+}
+
+// getConfigChanges returns the configuration changes of a provider between two reports.
+func getConfigChanges(oldProvenance, newProvenance *Provenance, key providerKey) []ConfigChange {
+	if oldProvenance == nil || newProvenance == nil {
+		return nil
+	}
+
+	var changes []ConfigChange
+	if oldProvenance.OpsImage != newProvenance.OpsImage {
+		changes = append(changes, ConfigChange{Field: "opsImage", Old: oldProvenance.OpsImage, New: newProvenance.OpsImage})
+	}
+
+	findProvider := func(provenance *Provenance) ProviderProvenance {
+		if idx := slices.IndexFunc(provenance.Providers, func(p ProviderProvenance) bool {
+			return newProviderKey(p.ID, p.Target) == key
+		}); idx >= 0 {
+			return provenance.Providers[idx]
+		}
+		return ProviderProvenance{}
+	}
+	oldProvider, newProvider := findProvider(oldProvenance), findProvider(newProvenance)
+
+	clusters := slices.Sorted(maps.Keys(oldProvider.ClusterVersions))
+	for cluster := range newProvider.ClusterVersions {
+		if _, ok := oldProvider.ClusterVersions[cluster]; !ok {
+			clusters = append(clusters, cluster)
+		}
+	}
+	slices.Sort(clusters)
+	for _, cluster := range clusters {
+		if oldVersion, newVersion := oldProvider.ClusterVersions[cluster], newProvider.ClusterVersions[cluster]; oldVersion != newVersion {
+			changes = append(changes, ConfigChange{Field: "clusterVersion/" + cluster, Old: oldVersion, New: newVersion})
+		}
+	}
+
+	if oldProvider.ArgsHash != newProvider.ArgsHash {
+		changes = append(changes, ConfigChange{Field: "args", Old: oldProvider.ArgsHash, New: newProvider.ArgsHash})
+	}
+
+	rulesetVersions := func(p ProviderProvenance) map[string][]string {
+		versions := map[string][]string{}
+		for _, rs := range p.Rulesets {
+			versions[rs.ID] = append(versions[rs.ID], rs.Version)
+		}
+		return versions
+	}
+	oldVersions, newVersions := rulesetVersions(oldProvider), rulesetVersions(newProvider)
+	rulesetIDs := slices.Sorted(maps.Keys(oldVersions))
+	for id := range newVersions {
+		if _, ok := oldVersions[id]; !ok {
+			rulesetIDs = append(rulesetIDs, id)
+		}
+	}
+	slices.Sort(rulesetIDs)
+
+	for _, id := range rulesetIDs {
+		if oldVersion, newVersion := strings.Join(oldVersions[id], ", "), strings.Join(newVersions[id], ", "); oldVersion != newVersion {
+			changes = append(changes, ConfigChange{RulesetID: id, Field: "version", Old: oldVersion, New: newVersion})
+			continue
+		}
+
+		for _, newRuleset := range newProvider.Rulesets {
+			if newRuleset.ID == id && !slices.ContainsFunc(oldProvider.Rulesets, func(r RulesetProvenance) bool {
+				return r.ID == id && r.Version == newRuleset.Version
+			}) {
+				changes = append(changes, ConfigChange{RulesetID: id, Field: "version", New: newRuleset.Version})
+			}
+		}
+
+		for _, oldRuleset := range oldProvider.Rulesets {
+			if oldRuleset.ID != id {
+				continue
+			}
+			idx := slices.IndexFunc(newProvider.Rulesets, func(r RulesetProvenance) bool {
+				return r.ID == id && r.Version == oldRuleset.Version
+			})
+			if idx < 0 {
+				changes = append(changes, ConfigChange{RulesetID: id, Field: "version", Old: oldRuleset.Version})
+				continue
+			}
+			newRuleset := newProvider.Rulesets[idx]
+
+			if oldRuleset.ArgsHash != newRuleset.ArgsHash {
+				changes = append(changes, ConfigChange{RulesetID: id, Field: "args", Old: oldRuleset.ArgsHash, New: newRuleset.ArgsHash})
+			}
+			if oldRuleset.RuleOptionsHash != newRuleset.RuleOptionsHash {
+				changes = append(changes, ConfigChange{RulesetID: id, Field: "ruleOptions", Old: oldRuleset.RuleOptionsHash, New: newRuleset.RuleOptionsHash})
+			}
+			if oldSkipped, newSkipped := skippedRuleIDs(oldRuleset.SkippedRules), skippedRuleIDs(newRuleset.SkippedRules); oldSkipped != newSkipped {
+				changes = append(changes, ConfigChange{RulesetID: id, Field: "skippedRules", Old: oldSkipped, New: newSkipped})
+			}
+		}
+	}
+	return changes
+}
+
+func skippedRuleIDs(skippedRules []SkippedRule) string {
+	ids := make([]string, 0, len(skippedRules))
+	for _, skippedRule := range skippedRules {
+		ids = append(ids, skippedRule.RuleID)
+	}
+	return strings.Join(ids, ", ")
+}
+
+// durationText returns the rounded duration between the start and end time.
+func durationText(startTime, endTime time.Time) string {
+	if startTime.IsZero() || endTime.IsZero() {
+		return "unknown"
+	}
+	return fmt.Sprint(endTime.Sub(startTime).Round(time.Millisecond))
+}
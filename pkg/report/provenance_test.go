@@ -0,0 +1,173 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package report_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/diki/pkg/config"
+	"github.com/gardener/diki/pkg/provider"
+	"github.com/gardener/diki/pkg/report"
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/ruleset"
+)
+
+var _ = Describe("provenance", func() {
+	var (
+		startTime = time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+		results   []provider.ProviderResult
+		newConfig = func(password string, skip bool) *config.DikiConfig {
+			return &config.DikiConfig{
+				Providers: []config.ProviderConfig{
+					{
+						ID:   "provider-foo",
+						Args: map[string]any{"kubeconfigPath": "/foo"},
+						Rulesets: []config.RulesetConfig{
+							{
+								ID:      "ruleset-foo",
+								Version: "v1",
+								Args:    map[string]any{"password": password},
+								RuleOptions: []config.RuleOptionsConfig{
+									{RuleID: "2", Skip: &config.RuleOptionSkipConfig{Enabled: skip, Justification: "bar"}},
+								},
+							},
+						},
+					},
+				},
+			}
+		}
+		newRunProvenance = func(conf *config.DikiConfig, mode report.ProvenanceConfigMode, clusterVersion string) *report.RunProvenance {
+			return &report.RunProvenance{
+				Config:          conf,
+				ConfigMode:      mode,
+				StartTime:       startTime,
+				OpsImage:        "foo/diki-ops:v1",
+				ClusterVersions: map[string]map[string]string{"provider-foo": {"cluster": clusterVersion}},
+			}
+		}
+	)
+
+	BeforeEach(func() {
+		results = []provider.ProviderResult{
+			{
+				ProviderID: "provider-foo",
+				StartTime:  startTime,
+				EndTime:    startTime.Add(time.Minute),
+				RulesetResults: []ruleset.RulesetResult{
+					{
+						RulesetID:      "ruleset-foo",
+						RulesetVersion: "v1",
+						StartTime:      startTime,
+						EndTime:        startTime.Add(time.Minute),
+						RuleResults: []rule.RuleResult{
+							{RuleID: "2", CheckResults: []rule.CheckResult{rule.SkippedCheckResult("bar", rule.NewTarget())}, StartTime: startTime, EndTime: startTime.Add(time.Second)},
+							{RuleID: "1", CheckResults: []rule.CheckResult{rule.PassedCheckResult("foo", rule.NewTarget())}, StartTime: startTime, EndTime: startTime.Add(time.Second)},
+						},
+					},
+				},
+			},
+		}
+	})
+
+	Describe("#FromProviderResults", func() {
+		It("should not record provenance without run provenance", func() {
+			rep := report.FromProviderResults(results)
+			Expect(rep.Provenance).To(BeNil())
+		})
+
+		It("should record the provenance of the run", func() {
+			rep := report.FromProviderResults(results, newRunProvenance(newConfig("secret", true), report.ProvenanceConfigRedacted, "v1.33.0"))
+
+			Expect(rep.Provenance).ToNot(BeNil())
+			Expect(rep.Provenance.StartTime).To(Equal(startTime))
+			Expect(rep.Provenance.EndTime).To(Equal(rep.Time))
+			Expect(rep.Provenance.OpsImage).To(Equal("foo/diki-ops:v1"))
+			Expect(rep.Provenance.Providers).To(HaveLen(1))
+
+			providerProvenance := rep.Provenance.Providers[0]
+			Expect(providerProvenance.ID).To(Equal("provider-foo"))
+			Expect(providerProvenance.ClusterVersions).To(Equal(map[string]string{"cluster": "v1.33.0"}))
+			Expect(providerProvenance.ArgsHash).To(HavePrefix("sha256:"))
+			Expect(providerProvenance.Args).To(Equal(map[string]any{"kubeconfigPath": "/foo"}))
+			Expect(providerProvenance.Rulesets).To(HaveLen(1))
+
+			rulesetProvenance := providerProvenance.Rulesets[0]
+			Expect(rulesetProvenance.ID).To(Equal("ruleset-foo"))
+			Expect(rulesetProvenance.Version).To(Equal("v1"))
+			Expect(rulesetProvenance.Args).To(Equal(map[string]any{"password": report.RedactedValue}))
+			Expect(rulesetProvenance.RuleOptionsHash).To(HavePrefix("sha256:"))
+			Expect(rulesetProvenance.SkippedRules).To(Equal([]report.SkippedRule{{RuleID: "2", Justification: "bar"}}))
+			Expect(rulesetProvenance.Rules).To(Equal([]report.RuleTiming{
+				{ID: "1", StartTime: startTime, EndTime: startTime.Add(time.Second)},
+				{ID: "2", StartTime: startTime, EndTime: startTime.Add(time.Second)},
+			}))
+		})
+
+		It("should record the configuration depending on the mode", func() {
+			rep := report.FromProviderResults(results, newRunProvenance(newConfig("secret", true), report.ProvenanceConfigFull, "v1.33.0"))
+			Expect(rep.Provenance.Providers[0].Rulesets[0].Args).To(Equal(map[string]any{"password": "secret"}))
+
+			hashRep := report.FromProviderResults(results, newRunProvenance(newConfig("secret", true), report.ProvenanceConfigHash, "v1.33.0"))
+			Expect(hashRep.Provenance.Providers[0].Rulesets[0].Args).To(BeNil())
+			Expect(hashRep.Provenance.Providers[0].Rulesets[0].ArgsHash).To(Equal(rep.Provenance.Providers[0].Rulesets[0].ArgsHash))
+		})
+	})
+
+	Describe("#CreateDifference", func() {
+		It("should not report configuration changes without provenance", func() {
+			diff, err := report.CreateDifference(*report.FromProviderResults(results), *report.FromProviderResults(results), "")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(diff.Providers[0].ConfigChanges).To(BeNil())
+		})
+
+		It("should report configuration changes", func() {
+			oldReport := report.FromProviderResults(results, newRunProvenance(newConfig("foo", true), report.ProvenanceConfigHash, "v1.33.0"))
+			newReport := report.FromProviderResults(results, newRunProvenance(newConfig("bar", false), report.ProvenanceConfigHash, "v1.34.0"))
+
+			diff, err := report.CreateDifference(*oldReport, *newReport, "")
+			Expect(err).ToNot(HaveOccurred())
+
+			oldRuleset, newRuleset := oldReport.Provenance.Providers[0].Rulesets[0], newReport.Provenance.Providers[0].Rulesets[0]
+			Expect(diff.Providers[0].ConfigChanges).To(Equal([]report.ConfigChange{
+				{Field: "clusterVersion/cluster", Old: "v1.33.0", New: "v1.34.0"},
+				{RulesetID: "ruleset-foo", Field: "args", Old: oldRuleset.ArgsHash, New: newRuleset.ArgsHash},
+				{RulesetID: "ruleset-foo", Field: "ruleOptions", Old: oldRuleset.RuleOptionsHash, New: newRuleset.RuleOptionsHash},
+				{RulesetID: "ruleset-foo", Field: "skippedRules", Old: "2"},
+			}))
+		})
+
+		It("should report changed ruleset versions", func() {
+			oldReport := report.FromProviderResults(results, newRunProvenance(newConfig("foo", true), report.ProvenanceConfigHash, "v1.33.0"))
+			results[0].RulesetResults[0].RulesetVersion = "v2"
+			newReport := report.FromProviderResults(results, newRunProvenance(newConfig("foo", true), report.ProvenanceConfigHash, "v1.33.0"))
+
+			diff, err := report.CreateDifference(*oldReport, *newReport, "")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(diff.Providers[0].ConfigChanges).To(Equal([]report.ConfigChange{
+				{RulesetID: "ruleset-foo", Field: "version", Old: "v1", New: "v2"},
+			}))
+		})
+
+		It("should report added and removed rulesets with the same versions", func() {
+			oldReport := report.FromProviderResults(results, newRunProvenance(newConfig("foo", true), report.ProvenanceConfigHash, "v1.33.0"))
+			newReport := report.FromProviderResults(results, newRunProvenance(newConfig("foo", true), report.ProvenanceConfigHash, "v1.33.0"))
+			oldReport.Provenance.Providers[0].Rulesets[0].Version = "v1, v2"
+			v2Ruleset := newReport.Provenance.Providers[0].Rulesets[0]
+			v2Ruleset.Version = "v2"
+			newReport.Provenance.Providers[0].Rulesets = append(newReport.Provenance.Providers[0].Rulesets, v2Ruleset)
+
+			diff, err := report.CreateDifference(*oldReport, *newReport, "")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(diff.Providers[0].ConfigChanges).To(Equal([]report.ConfigChange{
+				{RulesetID: "ruleset-foo", Field: "version", New: "v1"},
+				{RulesetID: "ruleset-foo", Field: "version", New: "v2"},
+				{RulesetID: "ruleset-foo", Field: "version", Old: "v1, v2"},
+			}))
+		})
+	})
+})
@@ -0,0 +1,203 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package report
+
+import (
+	"cmp"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/gardener/diki/pkg/rule"
+)
+
+// DefaultRedactedTargetKeys are the target keys redacted when no keys are specified.
+// The free text "details" of targets usually contains names and paths, so it is redacted as a whole.
+var DefaultRedactedTargetKeys = []string{"namespace", "name", "node", "image", "details"}
+
+// ownerField is the field of the pseudonyms of acceptance and exception owners.
+const ownerField = "owner"
+
+// Redactor pseudonymises target values and metadata fields of reports.
+// Pseudonyms are derived from the redaction key, the field and the value,
+// so that the same value is replaced by the same pseudonym in every report
+// redacted with the same key. This keeps merges and differences of
+// redacted reports consistent.
+type Redactor struct {
+	key          []byte
+	targetKeys   []string
+	metadataKeys []string
+	pseudonyms   map[string]string
+}
+
+// NewRedactor creates a Redactor which pseudonymises the given target keys
+// and metadata keys using the redaction key.
+func NewRedactor(key []byte, targetKeys, metadataKeys []string) *Redactor {
+	return &Redactor{
+		key:          key,
+		targetKeys:   targetKeys,
+		metadataKeys: metadataKeys,
+		pseudonyms:   map[string]string{},
+	}
+}
+
+// Pseudonym returns the pseudonym of the value of the given field.
+func (r *Redactor) Pseudonym(field, value string) string {
+	if len(value) == 0 {
+		return value
+	}
+
+	mac := hmac.New(sha256.New, r.key)
+	mac.Write([]byte(field))
+	mac.Write([]byte{0})
+	mac.Write([]byte(value))
+	pseudonym := fmt.Sprintf("%s-%s", field, hex.EncodeToString(mac.Sum(nil))[:12])
+	r.pseudonyms[pseudonym] = value
+	return pseudonym
+}
+
+// Pseudonyms returns the original values of all pseudonyms created by the Redactor.
+func (r *Redactor) Pseudonyms() map[string]string {
+	return r.pseudonyms
+}
+
+// Redact replaces the configured target values and metadata fields of the report
+// with pseudonyms. The owners of acceptances and exceptions are always pseudonymised.
+// Values which are redacted anywhere are also replaced in the messages of checks and upcoming expirations
+// and in the justifications of skipped rules. The provenance keeps the hashes of the configuration
+// and the cluster versions, its shoot targets are redacted like metadata and the copies of the configuration are removed.
+// Structure, severities and statuses are kept. The report is modified in place.
+func (r *Redactor) Redact(rep *Report) {
+	for key, value := range rep.Metadata {
+		if slices.Contains(r.metadataKeys, key) {
+			rep.Metadata[key] = r.Pseudonym(key, fmt.Sprint(value))
+		}
+	}
+	// the evidence bundle is not redacted and must not be shared
+	rep.EvidenceDir = ""
+
+	for pIdx := range rep.Providers {
+		provider := &rep.Providers[pIdx]
+		provider.Metadata = r.redactValues(provider.Metadata, r.metadataKeys)
+		for rsIdx := range provider.Rulesets {
+			for rIdx := range provider.Rulesets[rsIdx].Rules {
+				checks := provider.Rulesets[rsIdx].Rules[rIdx].Checks
+				for cIdx := range checks {
+					for tIdx, target := range checks[cIdx].Targets {
+						checks[cIdx].Targets[tIdx] = r.redactTarget(target)
+					}
+					for eIdx := range checks[cIdx].Evidence {
+						checks[cIdx].Evidence[eIdx].Target = r.redactTarget(checks[cIdx].Evidence[eIdx].Target)
+						checks[cIdx].Evidence[eIdx].Path = ""
+					}
+					if checks[cIdx].Acceptance != nil {
+						acceptance := *checks[cIdx].Acceptance
+						acceptance.Owner = r.Pseudonym(ownerField, acceptance.Owner)
+						checks[cIdx].Acceptance = &acceptance
+					}
+					if checks[cIdx].Exception != nil {
+						exception := *checks[cIdx].Exception
+						exception.Owner = r.Pseudonym(ownerField, exception.Owner)
+						checks[cIdx].Exception = &exception
+					}
+				}
+			}
+		}
+	}
+
+	for eIdx := range rep.ExpiredExceptions {
+		for tIdx, target := range rep.ExpiredExceptions[eIdx].Targets {
+			rep.ExpiredExceptions[eIdx].Targets[tIdx] = r.redactTarget(target)
+		}
+		rep.ExpiredExceptions[eIdx].Owner = r.Pseudonym(ownerField, rep.ExpiredExceptions[eIdx].Owner)
+	}
+
+	for uIdx := range rep.UpcomingExpirations {
+		rep.UpcomingExpirations[uIdx].Owner = r.Pseudonym(ownerField, rep.UpcomingExpirations[uIdx].Owner)
+	}
+
+	if rep.Provenance != nil {
+		for pIdx := range rep.Provenance.Providers {
+			provider := &rep.Provenance.Providers[pIdx]
+			provider.Target = r.redactValues(provider.Target, r.metadataKeys)
+			// copies of the configuration can contain arbitrary names, only their hashes are kept
+			provider.Args = nil
+			for rsIdx := range provider.Rulesets {
+				provider.Rulesets[rsIdx].Args = nil
+				provider.Rulesets[rsIdx].RuleOptions = nil
+			}
+		}
+	}
+
+	// messages are redacted once all values of the report are known
+	replacer := r.valueReplacer()
+	for pIdx := range rep.Providers {
+		for rsIdx := range rep.Providers[pIdx].Rulesets {
+			for rIdx := range rep.Providers[pIdx].Rulesets[rsIdx].Rules {
+				checks := rep.Providers[pIdx].Rulesets[rsIdx].Rules[rIdx].Checks
+				for cIdx := range checks {
+					checks[cIdx].Message = replacer.Replace(checks[cIdx].Message)
+				}
+			}
+		}
+	}
+	for uIdx := range rep.UpcomingExpirations {
+		rep.UpcomingExpirations[uIdx].Message = replacer.Replace(rep.UpcomingExpirations[uIdx].Message)
+	}
+	if rep.Provenance != nil {
+		for pIdx := range rep.Provenance.Providers {
+			for rsIdx := range rep.Provenance.Providers[pIdx].Rulesets {
+				skippedRules := rep.Provenance.Providers[pIdx].Rulesets[rsIdx].SkippedRules
+				for sIdx := range skippedRules {
+					skippedRules[sIdx].Justification = replacer.Replace(skippedRules[sIdx].Justification)
+				}
+			}
+		}
+	}
+}
+
+// valueReplacer returns a replacer which replaces all values redacted so far with their pseudonyms.
+// Longer values are replaced first, so that values containing other values are replaced as a whole.
+// Values redacted for several fields are replaced by the smallest of their pseudonyms.
+func (r *Redactor) valueReplacer() *strings.Replacer {
+	valuePseudonyms := map[string]string{}
+	for _, pseudonym := range slices.Sorted(maps.Keys(r.pseudonyms)) {
+		if _, ok := valuePseudonyms[r.pseudonyms[pseudonym]]; !ok {
+			valuePseudonyms[r.pseudonyms[pseudonym]] = pseudonym
+		}
+	}
+
+	values := slices.SortedFunc(maps.Keys(valuePseudonyms), func(a, b string) int {
+		return cmp.Or(cmp.Compare(len(b), len(a)), cmp.Compare(a, b))
+	})
+	oldnew := make([]string, 0, 2*len(values))
+	for _, value := range values {
+		oldnew = append(oldnew, value, valuePseudonyms[value])
+	}
+	return strings.NewReplacer(oldnew...)
+}
+
+func (r *Redactor) redactTarget(target rule.Target) rule.Target {
+	return r.redactValues(target, r.targetKeys)
+}
+
+func (r *Redactor) redactValues(values map[string]string, keys []string) map[string]string {
+	if values == nil {
+		return nil
+	}
+
+	redacted := make(map[string]string, len(values))
+	for key, value := range values {
+		if slices.Contains(keys, key) {
+			value = r.Pseudonym(key, value)
+		}
+		redacted[key] = value
+	}
+	return redacted
+}
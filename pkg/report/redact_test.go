@@ -0,0 +1,218 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package report_test
+
+import (
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/diki/pkg/report"
+	"github.com/gardener/diki/pkg/rule"
+)
+
+var _ = Describe("redact", func() {
+	var (
+		key       = []byte("foo")
+		newReport = func(namespace string) *report.Report {
+			return &report.Report{
+				Time:        time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC),
+				DikiVersion: "1",
+				Metadata:    map[string]any{"cluster": "prod", "foo": "bar"},
+				EvidenceDir: "/tmp/evidence",
+				Providers: []report.Provider{
+					{
+						ID:       "provider-foo",
+						Metadata: map[string]string{"id": "cluster-1", "foo": "bar"},
+						Rulesets: []report.Ruleset{
+							{
+								ID:      "ruleset-foo",
+								Version: "v1",
+								Rules: []report.Rule{
+									{
+										ID:       "1",
+										Severity: rule.SeverityHigh,
+										Checks: []report.Check{
+											{
+												Status:  rule.Failed,
+												Message: "foo",
+												Targets: []rule.Target{
+													rule.NewTarget("namespace", namespace, "kind", "Pod", "name", "pod-1"),
+													rule.NewTarget("kind", "Node", "name", "node-1"),
+												},
+												Evidence: []report.EvidenceReference{
+													{Name: "bar", Target: rule.NewTarget("name", "node-1"), Path: "sha256/foo.json.gz", Digest: "sha256:foo"},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+		}
+	)
+
+	It("should pseudonymise the configured keys", func() {
+		redactor := report.NewRedactor(key, []string{"namespace", "name"}, []string{"id", "cluster"})
+		rep := newReport("ns-1")
+		redactor.Redact(rep)
+
+		namespacePseudonym := redactor.Pseudonym("namespace", "ns-1")
+		podPseudonym := redactor.Pseudonym("name", "pod-1")
+		nodePseudonym := redactor.Pseudonym("name", "node-1")
+		Expect(namespacePseudonym).To(MatchRegexp(`^namespace-[0-9a-f]{12}$`))
+		Expect(podPseudonym).ToNot(Equal(nodePseudonym))
+
+		Expect(rep.Metadata).To(Equal(map[string]any{"cluster": redactor.Pseudonym("cluster", "prod"), "foo": "bar"}))
+		Expect(rep.EvidenceDir).To(BeEmpty())
+		Expect(rep.Providers[0].Metadata).To(Equal(map[string]string{"id": redactor.Pseudonym("id", "cluster-1"), "foo": "bar"}))
+
+		check := rep.Providers[0].Rulesets[0].Rules[0].Checks[0]
+		Expect(check.Status).To(Equal(rule.Failed))
+		Expect(check.Message).To(Equal("foo"))
+		Expect(check.Targets).To(Equal([]rule.Target{
+			rule.NewTarget("namespace", namespacePseudonym, "kind", "Pod", "name", podPseudonym),
+			rule.NewTarget("kind", "Node", "name", nodePseudonym),
+		}))
+		Expect(check.Evidence).To(Equal([]report.EvidenceReference{
+			{Name: "bar", Target: rule.NewTarget("name", nodePseudonym), Digest: "sha256:foo"},
+		}))
+
+		Expect(redactor.Pseudonyms()).To(HaveKeyWithValue(namespacePseudonym, "ns-1"))
+		Expect(redactor.Pseudonyms()).To(HaveKeyWithValue(nodePseudonym, "node-1"))
+	})
+
+	It("should redact known values in check messages", func() {
+		redactor := report.NewRedactor(key, []string{"namespace", "name"}, []string{"id"})
+		rep := newReport("ns-1")
+		check := &rep.Providers[0].Rulesets[0].Rules[0].Checks[0]
+		check.Message = "Pod pod-1 in namespace ns-1 of cluster-1 runs on node-1, pod-10 is ignored."
+		check.Targets = append(check.Targets, rule.NewTarget("name", "pod-10"))
+		redactor.Redact(rep)
+
+		Expect(check.Message).To(Equal(fmt.Sprintf("Pod %s in namespace %s of %s runs on %s, %s is ignored.",
+			redactor.Pseudonym("name", "pod-1"),
+			redactor.Pseudonym("namespace", "ns-1"),
+			redactor.Pseudonym("id", "cluster-1"),
+			redactor.Pseudonym("name", "node-1"),
+			redactor.Pseudonym("name", "pod-10"),
+		)))
+	})
+
+	It("should redact the details of targets by default", func() {
+		redactor := report.NewRedactor(key, report.DefaultRedactedTargetKeys, nil)
+		rep := newReport("ns-1")
+		check := &rep.Providers[0].Rulesets[0].Rules[0].Checks[0]
+		check.Targets = []rule.Target{rule.NewTarget("kind", "Node", "name", "node-1", "details", "fileName: /var/lib/node-1/kubelet.conf")}
+		redactor.Redact(rep)
+
+		Expect(check.Targets).To(Equal([]rule.Target{rule.NewTarget(
+			"kind", "Node",
+			"name", redactor.Pseudonym("name", "node-1"),
+			"details", redactor.Pseudonym("details", "fileName: /var/lib/node-1/kubelet.conf"),
+		)}))
+	})
+
+	It("should redact the provenance and the acceptances of the report", func() {
+		redactor := report.NewRedactor(key, report.DefaultRedactedTargetKeys, []string{"project", "shootName"})
+		rep := newReport("ns-1")
+		expiresAt := time.Date(2026, time.February, 1, 0, 0, 0, 0, time.UTC)
+		check := &rep.Providers[0].Rulesets[0].Rules[0].Checks[0]
+		check.Acceptance = &rule.Acceptance{Source: rule.AcceptanceSourceRuleOption, Owner: "team-foo", ExpiresAt: &expiresAt}
+		rep.Providers[0].Metadata = map[string]string{"project": "project-1", "shootName": "shoot-1"}
+		rep.ExpiredExceptions = []report.Exception{{ProviderID: "provider-foo", RuleID: "1", Owner: "team-bar"}}
+		rep.UpcomingExpirations = []report.UpcomingExpiration{
+			{ProviderID: "provider-foo", RuleID: "1", Message: "Pod pod-1 of shoot-1 is accepted.", Owner: "team-foo", ExpiresAt: expiresAt},
+		}
+		rep.Provenance = &report.Provenance{
+			Providers: []report.ProviderProvenance{
+				{
+					ID:              "provider-foo",
+					Target:          map[string]string{"project": "project-1", "shootName": "shoot-1"},
+					ClusterVersions: map[string]string{"garden": "v1.33.0"},
+					ArgsHash:        "sha256:foo",
+					Args:            map[string]any{"shootName": "shoot-1"},
+					Rulesets: []report.RulesetProvenance{
+						{
+							ID:              "ruleset-foo",
+							Version:         "v1",
+							ArgsHash:        "sha256:bar",
+							Args:            map[string]any{"namespace": "ns-1"},
+							RuleOptionsHash: "sha256:baz",
+							RuleOptions:     []any{map[string]any{"name": "pod-1"}},
+							SkippedRules:    []report.SkippedRule{{RuleID: "2", Justification: "node-1 is skipped"}},
+						},
+					},
+				},
+			},
+		}
+		redactor.Redact(rep)
+
+		var (
+			ownerPseudonym = redactor.Pseudonym("owner", "team-foo")
+			shootPseudonym = redactor.Pseudonym("shootName", "shoot-1")
+		)
+		Expect(check.Acceptance).To(Equal(&rule.Acceptance{Source: rule.AcceptanceSourceRuleOption, Owner: ownerPseudonym, ExpiresAt: &expiresAt}))
+		Expect(rep.ExpiredExceptions[0].Owner).To(Equal(redactor.Pseudonym("owner", "team-bar")))
+		Expect(rep.UpcomingExpirations).To(Equal([]report.UpcomingExpiration{
+			{
+				ProviderID: "provider-foo",
+				RuleID:     "1",
+				Message:    fmt.Sprintf("Pod %s of %s is accepted.", redactor.Pseudonym("name", "pod-1"), shootPseudonym),
+				Owner:      ownerPseudonym,
+				ExpiresAt:  expiresAt,
+			},
+		}))
+		Expect(rep.Provenance.Providers).To(Equal([]report.ProviderProvenance{
+			{
+				ID:              "provider-foo",
+				Target:          map[string]string{"project": redactor.Pseudonym("project", "project-1"), "shootName": shootPseudonym},
+				ClusterVersions: map[string]string{"garden": "v1.33.0"},
+				ArgsHash:        "sha256:foo",
+				Rulesets: []report.RulesetProvenance{
+					{
+						ID:              "ruleset-foo",
+						Version:         "v1",
+						ArgsHash:        "sha256:bar",
+						RuleOptionsHash: "sha256:baz",
+						SkippedRules:    []report.SkippedRule{{RuleID: "2", Justification: fmt.Sprintf("%s is skipped", redactor.Pseudonym("name", "node-1"))}},
+					},
+				},
+			},
+		}))
+		Expect(rep.Provenance.Providers[0].Target).To(Equal(rep.Providers[0].Metadata))
+	})
+
+	It("should create consistent pseudonyms for the same key", func() {
+		rep1, rep2 := newReport("ns-1"), newReport("ns-1")
+		report.NewRedactor(key, report.DefaultRedactedTargetKeys, []string{"id"}).Redact(rep1)
+		report.NewRedactor(key, report.DefaultRedactedTargetKeys, []string{"id"}).Redact(rep2)
+		Expect(rep1).To(Equal(rep2))
+
+		rep3 := newReport("ns-1")
+		report.NewRedactor([]byte("bar"), report.DefaultRedactedTargetKeys, []string{"id"}).Redact(rep3)
+		Expect(rep3.Providers[0].Metadata["id"]).ToNot(Equal(rep1.Providers[0].Metadata["id"]))
+	})
+
+	It("should keep redacted reports mergeable and comparable", func() {
+		redactor := report.NewRedactor(key, report.DefaultRedactedTargetKeys, []string{"id"})
+		oldReport, newReport := newReport("ns-1"), newReport("ns-2")
+		redactor.Redact(oldReport)
+		redactor.Redact(newReport)
+
+		_, err := report.MergeReport([]*report.Report{oldReport}, map[string]string{"provider-foo": "id"})
+		Expect(err).ToNot(HaveOccurred())
+
+		diff, err := report.CreateDifference(*oldReport, *newReport, "")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(diff.Providers[0].OldMetadata["id"]).To(Equal(redactor.Pseudonym("id", "cluster-1")))
+		Expect(diff.Providers[0].NewMetadata["id"]).To(Equal(diff.Providers[0].OldMetadata["id"]))
+	})
+})
@@ -0,0 +1,166 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package report
+
+import (
+	"cmp"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gardener/diki/pkg/rule"
+)
+
+// ExceptionsRegister lists all accepted and skipped checks of a report
+// grouped by their justification.
+type ExceptionsRegister struct {
+	Groups []ExceptionsRegisterGroup `json:"groups"`
+}
+
+// ExceptionsRegisterGroup contains all register entries with the same justification.
+type ExceptionsRegisterGroup struct {
+	Justification string                    `json:"justification"`
+	Entries       []ExceptionsRegisterEntry `json:"entries"`
+}
+
+// ExceptionsRegisterEntry describes a single accepted or skipped check.
+type ExceptionsRegisterEntry struct {
+	ProviderID     string                `json:"providerID"`
+	RulesetID      string                `json:"rulesetID"`
+	RulesetVersion string                `json:"rulesetVersion"`
+	RuleID         string                `json:"ruleID"`
+	RuleName       string                `json:"ruleName"`
+	Status         rule.Status           `json:"status"`
+	Targets        []rule.Target         `json:"targets,omitempty"`
+	Source         rule.AcceptanceSource `json:"source"`
+	Owner          string                `json:"owner,omitempty"`
+	Ticket         string                `json:"ticket,omitempty"`
+	ExpiresAt      *time.Time            `json:"expiresAt,omitempty"`
+}
+
+// NewExceptionsRegister creates an ExceptionsRegister from the checks of a report.
+// It contains all Accepted and Skipped checks as well as the checks of skipped rules.
+func NewExceptionsRegister(r *Report) *ExceptionsRegister {
+	var (
+		register = &ExceptionsRegister{Groups: []ExceptionsRegisterGroup{}}
+		groups   = map[string]int{}
+	)
+
+	for _, provider := range r.Providers {
+		for _, ruleset := range provider.Rulesets {
+			for _, rl := range ruleset.Rules {
+				for _, check := range rl.Checks {
+					entry, justification, ok := registerEntry(check)
+					if !ok {
+						continue
+					}
+					entry.ProviderID = provider.ID
+					entry.RulesetID = ruleset.ID
+					entry.RulesetVersion = ruleset.Version
+					entry.RuleID = rl.ID
+					entry.RuleName = rl.Name
+
+					idx, found := groups[justification]
+					if !found {
+						idx = len(register.Groups)
+						groups[justification] = idx
+						register.Groups = append(register.Groups, ExceptionsRegisterGroup{Justification: justification})
+					}
+					register.Groups[idx].Entries = append(register.Groups[idx].Entries, entry)
+				}
+			}
+		}
+	}
+
+	slices.SortStableFunc(register.Groups, func(a, b ExceptionsRegisterGroup) int {
+		return cmp.Compare(a.Justification, b.Justification)
+	})
+	return register
+}
+
+// registerEntry returns the register entry and justification of a check.
+// It returns false if the check is neither accepted nor skipped.
+func registerEntry(check Check) (ExceptionsRegisterEntry, string, bool) {
+	entry := ExceptionsRegisterEntry{
+		Status:  check.Status,
+		Targets: check.Targets,
+	}
+
+	switch {
+	case check.Exception != nil && !check.Exception.Expired:
+		expiresAt := check.Exception.ExpiresAt
+		entry.Source = rule.AcceptanceSourceExceptionsFile
+		entry.Owner = check.Exception.Owner
+		entry.Ticket = check.Exception.Ticket
+		entry.ExpiresAt = &expiresAt
+		return entry, check.Exception.Justification, true
+	case check.Status != rule.Accepted && check.Status != rule.Skipped && check.Status != rule.NotImplemented:
+		return ExceptionsRegisterEntry{}, "", false
+	case check.Acceptance != nil && len(check.Acceptance.Source) > 0:
+		entry.Source = check.Acceptance.Source
+		entry.Owner = check.Acceptance.Owner
+		entry.ExpiresAt = check.Acceptance.ExpiresAt
+	case check.Status == rule.Accepted:
+		entry.Source = rule.AcceptanceSourceRuleOption
+	default:
+		entry.Source = rule.AcceptanceSourceBuiltIn
+	}
+	return entry, check.Message, true
+}
+
+// WriteCSV writes the register in csv format with one row per entry.
+func (er *ExceptionsRegister) WriteCSV(w io.Writer) error {
+	csvWriter := csv.NewWriter(w)
+	if err := csvWriter.Write([]string{
+		"justification", "source", "providerID", "rulesetID", "rulesetVersion", "ruleID", "ruleName", "status", "targets", "owner", "ticket", "expiresAt",
+	}); err != nil {
+		return err
+	}
+
+	for _, group := range er.Groups {
+		for _, entry := range group.Entries {
+			expiresAt := ""
+			if entry.ExpiresAt != nil {
+				expiresAt = entry.ExpiresAt.UTC().Format(time.RFC3339)
+			}
+			if err := csvWriter.Write([]string{
+				group.Justification,
+				string(entry.Source),
+				entry.ProviderID,
+				entry.RulesetID,
+				entry.RulesetVersion,
+				entry.RuleID,
+				entry.RuleName,
+				string(entry.Status),
+				targetsText(entry.Targets),
+				entry.Owner,
+				entry.Ticket,
+				expiresAt,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	csvWriter.Flush()
+	return csvWriter.Error()
+}
+
+// targetsText returns the targets as text with sorted keys,
+// e.g. "name=foo,namespace=bar; name=baz".
+func targetsText(targets []rule.Target) string {
+	texts := make([]string, 0, len(targets))
+	for _, target := range targets {
+		pairs := make([]string, 0, len(target))
+		for _, key := range sortedKeys(target) {
+			pairs = append(pairs, fmt.Sprintf("%s=%s", key, target[key]))
+		}
+		texts = append(texts, strings.Join(pairs, ","))
+	}
+	return strings.Join(texts, "; ")
+}
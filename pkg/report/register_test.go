@@ -0,0 +1,138 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package report_test
+
+import (
+	"bytes"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/diki/pkg/report"
+	"github.com/gardener/diki/pkg/rule"
+)
+
+var _ = Describe("register", func() {
+	var (
+		expiresAt = time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+		rep       *report.Report
+	)
+
+	BeforeEach(func() {
+		rep = &report.Report{
+			Time:        expiresAt,
+			DikiVersion: "1",
+			Providers: []report.Provider{
+				{
+					ID: "provider-foo",
+					Rulesets: []report.Ruleset{
+						{
+							ID:      "ruleset-foo",
+							Version: "v1",
+							Rules: []report.Rule{
+								{
+									ID:   "1",
+									Name: "rule 1",
+									Checks: []report.Check{
+										{
+											Status:     rule.Accepted,
+											Message:    "foo",
+											Targets:    []rule.Target{rule.NewTarget("namespace", "bar", "name", "baz")},
+											Acceptance: &rule.Acceptance{Source: rule.AcceptanceSourceRuleOption, Owner: "team-foo", ExpiresAt: &expiresAt},
+										},
+										{
+											Status:  rule.Failed,
+											Message: "failed",
+										},
+										{
+											Status:  rule.Accepted,
+											Message: "failed",
+											Exception: &report.CheckException{
+												Justification:  "bar",
+												Owner:          "team-bar",
+												Ticket:         "TICKET-1",
+												ExpiresAt:      expiresAt,
+												OriginalStatus: rule.Failed,
+											},
+										},
+									},
+								},
+								{
+									ID:   "2",
+									Name: "rule 2",
+									Checks: []report.Check{
+										{
+											Status:     rule.NotImplemented,
+											Message:    "foo",
+											Acceptance: &rule.Acceptance{Source: rule.AcceptanceSourceBuiltIn},
+										},
+										{
+											Status:  rule.Skipped,
+											Message: "baz",
+										},
+										{
+											Status:  rule.Accepted,
+											Message: "qux",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	})
+
+	Describe("#NewExceptionsRegister", func() {
+		It("should group accepted and skipped checks by justification", func() {
+			register := report.NewExceptionsRegister(rep)
+
+			Expect(register.Groups).To(Equal([]report.ExceptionsRegisterGroup{
+				{
+					Justification: "bar",
+					Entries: []report.ExceptionsRegisterEntry{
+						{ProviderID: "provider-foo", RulesetID: "ruleset-foo", RulesetVersion: "v1", RuleID: "1", RuleName: "rule 1", Status: rule.Accepted, Source: rule.AcceptanceSourceExceptionsFile, Owner: "team-bar", Ticket: "TICKET-1", ExpiresAt: &expiresAt},
+					},
+				},
+				{
+					Justification: "baz",
+					Entries: []report.ExceptionsRegisterEntry{
+						{ProviderID: "provider-foo", RulesetID: "ruleset-foo", RulesetVersion: "v1", RuleID: "2", RuleName: "rule 2", Status: rule.Skipped, Source: rule.AcceptanceSourceBuiltIn},
+					},
+				},
+				{
+					Justification: "foo",
+					Entries: []report.ExceptionsRegisterEntry{
+						{ProviderID: "provider-foo", RulesetID: "ruleset-foo", RulesetVersion: "v1", RuleID: "1", RuleName: "rule 1", Status: rule.Accepted, Targets: []rule.Target{rule.NewTarget("namespace", "bar", "name", "baz")}, Source: rule.AcceptanceSourceRuleOption, Owner: "team-foo", ExpiresAt: &expiresAt},
+						{ProviderID: "provider-foo", RulesetID: "ruleset-foo", RulesetVersion: "v1", RuleID: "2", RuleName: "rule 2", Status: rule.NotImplemented, Source: rule.AcceptanceSourceBuiltIn},
+					},
+				},
+				{
+					Justification: "qux",
+					Entries: []report.ExceptionsRegisterEntry{
+						{ProviderID: "provider-foo", RulesetID: "ruleset-foo", RulesetVersion: "v1", RuleID: "2", RuleName: "rule 2", Status: rule.Accepted, Source: rule.AcceptanceSourceRuleOption},
+					},
+				},
+			}))
+		})
+	})
+
+	Describe("#WriteCSV", func() {
+		It("should write one row per entry", func() {
+			var buf bytes.Buffer
+			Expect(report.NewExceptionsRegister(rep).WriteCSV(&buf)).To(Succeed())
+
+			Expect(buf.String()).To(Equal(`justification,source,providerID,rulesetID,rulesetVersion,ruleID,ruleName,status,targets,owner,ticket,expiresAt
+bar,ExceptionsFile,provider-foo,ruleset-foo,v1,1,rule 1,Accepted,,team-bar,TICKET-1,2026-01-01T00:00:00Z
+baz,BuiltIn,provider-foo,ruleset-foo,v1,2,rule 2,Skipped,,,,
+foo,RuleOption,provider-foo,ruleset-foo,v1,1,rule 1,Accepted,"name=baz,namespace=bar",team-foo,,2026-01-01T00:00:00Z
+foo,BuiltIn,provider-foo,ruleset-foo,v1,2,rule 2,Not Implemented,,,,
+qux,RuleOption,provider-foo,ruleset-foo,v1,2,rule 2,Accepted,,,,
+`))
+		})
+	})
+})
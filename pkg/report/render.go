@@ -25,6 +25,10 @@ const (
 	tmplMergedReportPath     = "templates/html/merged_report.html"
 	tmplDifferenceReportName = "difference_report"
 	tmplDifferenceReportPath = "templates/html/difference_report.html"
+	tmplPivotReportName      = "pivot_report"
+	tmplPivotReportPath      = "templates/html/pivot_report.html"
+	tmplOwnersIndexName      = "owners_index"
+	tmplOwnersIndexPath      = "templates/html/owners_index.html"
 	tmplStylesPath           = "templates/html/_styles.tpl"
 )
 
@@ -50,7 +54,7 @@ func NewHTMLRenderer() (*HTMLRenderer, error) {
 		_, ok := m[k]
 		return ok
 	}
-	yamlFormat := func(m map[string]any) string {
+	yamlFormat := func(m any) string {
 		yaml, err := yaml.Marshal(m)
 		if err != nil {
 			return err.Error()
@@ -69,6 +73,9 @@ func NewHTMLRenderer() (*HTMLRenderer, error) {
 		"rulesWithStatus":    rulesWithStatus,
 		"sortedMapKeys":      sortedKeys[string],
 		"ruleTitle":          ruleTitle,
+		"exceptionsRegister": NewExceptionsRegister,
+		"targetsText":        targetsText,
+		"duration":           durationText,
 	}).ParseFS(files, tmplReportPath, tmplStylesPath)
 	if err != nil {
 		return nil, err
@@ -109,6 +116,30 @@ func NewHTMLRenderer() (*HTMLRenderer, error) {
 	}
 	templates[tmplDifferenceReportName] = parsedDifferenceReport
 
+	parsedPivotReport, err := template.New(tmplPivotReportName+".html").Funcs(template.FuncMap{
+		"getStatuses":           rule.Statuses,
+		"statusIcon":            rule.StatusIcon,
+		"statusDescription":     rule.StatusDescription,
+		"time":                  convTimeFunc,
+		"yamlFormat":            yamlFormat,
+		"pivotGroupSummaryText": pivotGroupSummaryText,
+		"pivotChecksWithStatus": pivotChecksWithStatus,
+		"ruleTitle":             ruleTitle,
+	}).ParseFS(files, tmplPivotReportPath, tmplStylesPath)
+	if err != nil {
+		return nil, err
+	}
+	templates[tmplPivotReportName] = parsedPivotReport
+
+	parsedOwnersIndex, err := template.New(tmplOwnersIndexName+".html").Funcs(template.FuncMap{
+		"time":                   convTimeFunc,
+		"ownersIndexSummaryText": ownersIndexSummaryText,
+	}).ParseFS(files, tmplOwnersIndexPath, tmplStylesPath)
+	if err != nil {
+		return nil, err
+	}
+	templates[tmplOwnersIndexName] = parsedOwnersIndex
+
 	return &HTMLRenderer{
 		templates: templates,
 	}, nil
@@ -123,6 +154,10 @@ func (r *HTMLRenderer) Render(w io.Writer, report any) error {
 		return r.templates[tmplMergedReportName].Execute(w, rep)
 	case *DifferenceReportsWrapper:
 		return r.templates[tmplDifferenceReportName].Execute(w, rep)
+	case *PivotReport:
+		return r.templates[tmplPivotReportName].Execute(w, rep)
+	case *OwnersIndex:
+		return r.templates[tmplOwnersIndexName].Execute(w, rep)
 	default:
 		return fmt.Errorf("unsupported report type: %T", report)
 	}
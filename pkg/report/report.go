@@ -28,6 +28,17 @@ type Report struct {
 	DikiVersion string         `json:"dikiVersion"`
 	Metadata    map[string]any `json:"metadata,omitempty"`
 	Providers   []Provider     `json:"providers"`
+	// ExpiredExceptions contains the expired exceptions which matched
+	// at least one check when exceptions were applied to the report.
+	ExpiredExceptions []Exception `json:"expiredExceptions,omitempty"`
+	// UpcomingExpirations contains the acceptances from rule options
+	// which were still valid at the time of the report.
+	UpcomingExpirations []UpcomingExpiration `json:"upcomingExpirations,omitempty"`
+	// EvidenceDir is the directory of the evidence bundle
+	// which contains the evidence referenced by the checks.
+	EvidenceDir string `json:"evidenceDir,omitempty"`
+	// Provenance describes the configuration and timings of the run which produced the report.
+	Provenance *Provenance `json:"provenance,omitempty"`
 }
 
 // Provider contains information about a known provider
@@ -39,6 +50,38 @@ type Provider struct {
 	Rulesets []Ruleset         `json:"rulesets"`
 }
 
+const (
+	// metadataProject and metadataShootName are the metadata keys with which providers
+	// which report one result per shoot, e.g. the garden provider, identify the shoot.
+	metadataProject   = "project"
+	metadataShootName = "shootName"
+)
+
+// providerKey identifies the result of a provider in a report. A provider can report
+// one result per shoot, so the id is combined with the project and shoot of the result.
+type providerKey struct {
+	id, project, shoot string
+}
+
+func newProviderKey(id string, metadata map[string]string) providerKey {
+	return providerKey{id: id, project: metadata[metadataProject], shoot: metadata[metadataShootName]}
+}
+
+// providerTarget returns the metadata which identifies the shoot of a provider result.
+// It returns nil if the result does not belong to a shoot.
+func providerTarget(metadata map[string]string) map[string]string {
+	var target map[string]string
+	for _, key := range []string{metadataProject, metadataShootName} {
+		if value, ok := metadata[key]; ok {
+			if target == nil {
+				target = map[string]string{}
+			}
+			target[key] = value
+		}
+	}
+	return target
+}
+
 // Ruleset contains information about a rule set and its rules.
 type Ruleset struct {
 	ID      string `json:"id"`
@@ -57,15 +100,21 @@ type Rule struct {
 
 // Check is the result of a single Rule check.
 type Check struct {
-	Status  rule.Status   `json:"status"`
-	Message string        `json:"message"`
-	Targets []rule.Target `json:"targets,omitempty"`
+	Status     rule.Status         `json:"status"`
+	Message    string              `json:"message"`
+	Targets    []rule.Target       `json:"targets,omitempty"`
+	Exception  *CheckException     `json:"exception,omitempty"`
+	Acceptance *rule.Acceptance    `json:"acceptance,omitempty"`
+	Evidence   []EvidenceReference `json:"evidence,omitempty"`
 }
 
 // ReportOptions are options that can be applied to a Report.
 type ReportOptions struct {
-	MinStatus rule.Status
-	Metadata  map[string]any
+	MinStatus             rule.Status
+	Metadata              map[string]any
+	AcceptanceGracePeriod time.Duration
+	EvidenceBundle        *EvidenceBundle
+	RunProvenance         *RunProvenance
 }
 
 // ReportOption defines a single option that can be applied to a Report.
@@ -104,12 +153,19 @@ func FromProviderResults(results []provider.ProviderResult, options ...ReportOpt
 		Metadata:    opts.Metadata,
 		Providers:   make([]Provider, 0, len(results)),
 	}
+	report.UpcomingExpirations = getUpcomingExpirations(results, report.Time)
+	if opts.EvidenceBundle != nil {
+		report.EvidenceDir = opts.EvidenceBundle.Dir()
+	}
+	if opts.RunProvenance != nil {
+		report.Provenance = newProvenance(results, opts.RunProvenance, report.Time)
+	}
 	for _, providerResult := range results {
 		p := Provider{
 			ID:       providerResult.ProviderID,
 			Name:     providerResult.ProviderName,
 			Metadata: providerResult.Metadata,
-			Rulesets: getRulesets(providerResult.RulesetResults, opts),
+			Rulesets: getRulesets(providerResult.RulesetResults, report.Time, opts),
 		}
 		report.Providers = append(report.Providers, p)
 	}
@@ -203,46 +259,54 @@ func rulesWithStatus(ruleset *Ruleset, status rule.Status) []Rule {
 	return result
 }
 
-func getRulesets(rulesetResults []ruleset.RulesetResult, opts *ReportOptions) []Ruleset {
+func getRulesets(rulesetResults []ruleset.RulesetResult, now time.Time, opts *ReportOptions) []Ruleset {
 	rulesets := make([]Ruleset, 0, len(rulesetResults))
 	for _, rulesetResult := range rulesetResults {
 		rs := Ruleset{
 			ID:      rulesetResult.RulesetID,
 			Name:    rulesetResult.RulesetName,
 			Version: rulesetResult.RulesetVersion,
-			Rules:   getRules(rulesetResult.RuleResults, opts),
+			Rules:   getRules(rulesetResult.RuleResults, now, opts),
 		}
 		rulesets = append(rulesets, rs)
 	}
 	return rulesets
 }
 
-func getRules(ruleResults []rule.RuleResult, opts *ReportOptions) []Rule {
+func getRules(ruleResults []rule.RuleResult, now time.Time, opts *ReportOptions) []Rule {
 	rules := make([]Rule, 0, len(ruleResults))
 	for _, ruleResult := range ruleResults {
 		r := Rule{
 			ID:       ruleResult.RuleID,
 			Name:     ruleResult.RuleName,
 			Severity: ruleResult.Severity,
-			Checks:   getChecks(ruleResult.CheckResults, opts),
+			Checks:   getChecks(ruleResult.CheckResults, now, opts),
 		}
 		rules = append(rules, r)
 	}
 	return rules
 }
 
-func getChecks(checkResults []rule.CheckResult, opts *ReportOptions) []Check {
+func getChecks(checkResults []rule.CheckResult, now time.Time, opts *ReportOptions) []Check {
 	groupedChecks := map[string]*Check{}
 	for _, checkResult := range checkResults {
+		checkResult = expireAcceptance(checkResult, now, opts.AcceptanceGracePeriod)
 		if opts.MinStatus != "" && checkResult.Status.Less(opts.MinStatus) {
 			continue
 		}
 		key := fmt.Sprintf("%s--%s", checkResult.Status, checkResult.Message)
+		if checkResult.Acceptance != nil {
+			key = fmt.Sprintf("%s--%s--%s", key, checkResult.Acceptance.Source, checkResult.Acceptance.Owner)
+			if checkResult.Acceptance.ExpiresAt != nil {
+				key = fmt.Sprintf("%s--%s", key, checkResult.Acceptance.ExpiresAt.Format(time.RFC3339))
+			}
+		}
 		check, ok := groupedChecks[key]
 		if !ok {
 			check := &Check{
-				Status:  checkResult.Status,
-				Message: checkResult.Message,
+				Status:     checkResult.Status,
+				Message:    checkResult.Message,
+				Acceptance: checkResult.Acceptance,
 			}
 
 			if len(checkResult.Target) > 0 {
@@ -252,6 +316,12 @@ func getChecks(checkResults []rule.CheckResult, opts *ReportOptions) []Check {
 		} else if len(checkResult.Target) > 0 {
 			check.Targets = append(check.Targets, checkResult.Target)
 		}
+
+		if opts.EvidenceBundle != nil {
+			for _, evidence := range checkResult.Evidence {
+				groupedChecks[key].Evidence = append(groupedChecks[key].Evidence, opts.EvidenceBundle.Add(checkResult.Target, evidence))
+			}
+		}
 	}
 
 	checks := make([]Check, 0, len(groupedChecks))
@@ -0,0 +1,306 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package report
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// SignatureFormat is the format in which report signatures are written.
+type SignatureFormat string
+
+const (
+	// SignatureFormatRaw is a detached signature over the report content.
+	SignatureFormatRaw SignatureFormat = "raw"
+	// SignatureFormatDSSE is a DSSE envelope containing an in-toto statement
+	// with the digest of the report as subject.
+	SignatureFormatDSSE SignatureFormat = "dsse"
+)
+
+const (
+	// InTotoPayloadType is the DSSE payload type of in-toto statements.
+	InTotoPayloadType = "application/vnd.in-toto+json"
+	// InTotoStatementType is the type of in-toto statements.
+	InTotoStatementType = "https://in-toto.io/Statement/v1"
+	// ReportPredicateType is the in-toto predicate type of signed Diki reports.
+	ReportPredicateType = "https://github.com/gardener/diki/report/v1"
+)
+
+// Signature is a detached signature of a report.
+type Signature struct {
+	KeyID     string `json:"keyID"`
+	Digest    string `json:"digest"`
+	Signature []byte `json:"signature"`
+}
+
+// Envelope is a DSSE envelope.
+type Envelope struct {
+	PayloadType string              `json:"payloadType"`
+	Payload     []byte              `json:"payload"`
+	Signatures  []EnvelopeSignature `json:"signatures"`
+}
+
+// EnvelopeSignature is a single signature of a DSSE envelope.
+type EnvelopeSignature struct {
+	KeyID string `json:"keyid"`
+	Sig   []byte `json:"sig"`
+}
+
+// Statement is an in-toto statement describing a signed report.
+type Statement struct {
+	Type          string         `json:"_type"`
+	Subject       []Subject      `json:"subject"`
+	PredicateType string         `json:"predicateType"`
+	Predicate     map[string]any `json:"predicate,omitempty"`
+}
+
+// Subject is an artifact described by an in-toto statement.
+type Subject struct {
+	Name   string            `json:"name"`
+	Digest map[string]string `json:"digest"`
+}
+
+// Signer signs reports with an ed25519 or ECDSA private key.
+type Signer struct {
+	key   crypto.Signer
+	keyID string
+}
+
+// Verifier verifies report signatures with an ed25519 or ECDSA public key.
+type Verifier struct {
+	key   crypto.PublicKey
+	keyID string
+}
+
+// Digest returns the sha256 digest of the data in "sha256:<hex>" form.
+func Digest(data []byte) string {
+	sum := sha256.Sum256(data)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+// NewSignerFromFile creates a Signer from a PEM encoded PKCS #8 private key file.
+func NewSignerFromFile(path string) (*Signer, error) {
+	block, err := readPEM(path)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key: %w", err)
+	}
+
+	var signer crypto.Signer
+	switch k := key.(type) {
+	case ed25519.PrivateKey:
+		signer = k
+	case *ecdsa.PrivateKey:
+		signer = k
+	default:
+		return nil, fmt.Errorf("unsupported private key type %T", key)
+	}
+
+	keyID, err := keyID(signer.Public())
+	if err != nil {
+		return nil, err
+	}
+	return &Signer{key: signer, keyID: keyID}, nil
+}
+
+// NewVerifierFromFile creates a Verifier from a PEM encoded PKIX public key file.
+func NewVerifierFromFile(path string) (*Verifier, error) {
+	block, err := readPEM(path)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse public key: %w", err)
+	}
+
+	switch key.(type) {
+	case ed25519.PublicKey, *ecdsa.PublicKey:
+	default:
+		return nil, fmt.Errorf("unsupported public key type %T", key)
+	}
+
+	keyID, err := keyID(key)
+	if err != nil {
+		return nil, err
+	}
+	return &Verifier{key: key, keyID: keyID}, nil
+}
+
+// KeyID returns the id of the signer's key.
+func (s *Signer) KeyID() string {
+	return s.keyID
+}
+
+// KeyID returns the id of the verifier's key.
+func (v *Verifier) KeyID() string {
+	return v.keyID
+}
+
+// Sign signs the report data and returns the signature in the given format.
+// The name is used as subject name of DSSE envelopes.
+func (s *Signer) Sign(data []byte, name string, format SignatureFormat) ([]byte, error) {
+	switch format {
+	case SignatureFormatRaw, "":
+		sig, err := s.sign(data)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(Signature{KeyID: s.keyID, Digest: Digest(data), Signature: sig})
+	case SignatureFormatDSSE:
+		sum := sha256.Sum256(data)
+		payload, err := json.Marshal(Statement{
+			Type:          InTotoStatementType,
+			Subject:       []Subject{{Name: name, Digest: map[string]string{"sha256": hex.EncodeToString(sum[:])}}},
+			PredicateType: ReportPredicateType,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		sig, err := s.sign(preAuthEncoding(InTotoPayloadType, payload))
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(Envelope{
+			PayloadType: InTotoPayloadType,
+			Payload:     payload,
+			Signatures:  []EnvelopeSignature{{KeyID: s.keyID, Sig: sig}},
+		})
+	default:
+		return nil, fmt.Errorf("not supported signature format %s", format)
+	}
+}
+
+// Verify checks that the signature was created by the verifier's key
+// for the given report data. Both raw signatures and DSSE envelopes are supported.
+func (v *Verifier) Verify(data, signature []byte) error {
+	envelope := &Envelope{}
+	if err := json.Unmarshal(signature, envelope); err != nil {
+		return fmt.Errorf("failed to unmarshal signature: %w", err)
+	}
+
+	if len(envelope.PayloadType) == 0 {
+		sig := &Signature{}
+		if err := json.Unmarshal(signature, sig); err != nil {
+			return fmt.Errorf("failed to unmarshal signature: %w", err)
+		}
+		if sig.KeyID != v.keyID {
+			return fmt.Errorf("report is signed by key %s, expected key %s", sig.KeyID, v.keyID)
+		}
+		if digest := Digest(data); sig.Digest != digest {
+			return fmt.Errorf("report digest %s does not match signed digest %s", digest, sig.Digest)
+		}
+		return v.verify(data, sig.Signature)
+	}
+
+	if envelope.PayloadType != InTotoPayloadType {
+		return fmt.Errorf("not supported payload type %s", envelope.PayloadType)
+	}
+
+	var verifyErr error = errors.New("envelope does not contain signatures")
+	for _, sig := range envelope.Signatures {
+		if sig.KeyID != v.keyID {
+			verifyErr = fmt.Errorf("report is signed by key %s, expected key %s", sig.KeyID, v.keyID)
+			continue
+		}
+		if verifyErr = v.verify(preAuthEncoding(envelope.PayloadType, envelope.Payload), sig.Sig); verifyErr == nil {
+			break
+		}
+	}
+	if verifyErr != nil {
+		return verifyErr
+	}
+
+	statement := &Statement{}
+	if err := json.Unmarshal(envelope.Payload, statement); err != nil {
+		return fmt.Errorf("failed to unmarshal statement: %w", err)
+	}
+	if statement.Type != InTotoStatementType || statement.PredicateType != ReportPredicateType {
+		return fmt.Errorf("not supported statement type %s with predicate type %s", statement.Type, statement.PredicateType)
+	}
+
+	digest := Digest(data)
+	for _, subject := range statement.Subject {
+		if "sha256:"+subject.Digest["sha256"] == digest {
+			return nil
+		}
+	}
+	return fmt.Errorf("report digest %s does not match any signed subject", digest)
+}
+
+func (s *Signer) sign(data []byte) ([]byte, error) {
+	switch k := s.key.(type) {
+	case ed25519.PrivateKey:
+		return k.Sign(rand.Reader, data, crypto.Hash(0))
+	case *ecdsa.PrivateKey:
+		sum := sha256.Sum256(data)
+		return ecdsa.SignASN1(rand.Reader, k, sum[:])
+	default:
+		return nil, fmt.Errorf("unsupported private key type %T", s.key)
+	}
+}
+
+func (v *Verifier) verify(data, sig []byte) error {
+	var valid bool
+	switch k := v.key.(type) {
+	case ed25519.PublicKey:
+		valid = ed25519.Verify(k, data, sig)
+	case *ecdsa.PublicKey:
+		sum := sha256.Sum256(data)
+		valid = ecdsa.VerifyASN1(k, sum[:], sig)
+	default:
+		return fmt.Errorf("unsupported public key type %T", v.key)
+	}
+
+	if !valid {
+		return errors.New("invalid signature")
+	}
+	return nil
+}
+
+// preAuthEncoding returns the DSSE pre-authentication encoding of the payload.
+func preAuthEncoding(payloadType string, payload []byte) []byte {
+	return fmt.Appendf(nil, "DSSEv1 %d %s %d %s", len(payloadType), payloadType, len(payload), payload)
+}
+
+func keyID(publicKey crypto.PublicKey) (string, error) {
+	der, err := x509.MarshalPKIXPublicKey(publicKey)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(der)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func readPEM(path string) (*pem.Block, error) {
+	data, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM data found in %s", path)
+	}
+	return block, nil
+}
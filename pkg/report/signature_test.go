@@ -0,0 +1,108 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package report_test
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/diki/pkg/report"
+)
+
+var _ = Describe("signature", func() {
+	var (
+		data    = []byte(`{"time":"2026-01-01T00:00:00Z","dikiVersion":"1","providers":[]}`)
+		dir     string
+		keyPair = func(name string, privateKey crypto.Signer) (string, string) {
+			privateDER, err := x509.MarshalPKCS8PrivateKey(privateKey)
+			Expect(err).ToNot(HaveOccurred())
+			publicDER, err := x509.MarshalPKIXPublicKey(privateKey.Public())
+			Expect(err).ToNot(HaveOccurred())
+
+			privatePath, publicPath := filepath.Join(dir, name+".key"), filepath.Join(dir, name+".pub")
+			Expect(os.WriteFile(privatePath, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateDER}), 0600)).To(Succeed())
+			Expect(os.WriteFile(publicPath, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicDER}), 0600)).To(Succeed())
+			return privatePath, publicPath
+		}
+	)
+
+	BeforeEach(func() {
+		dir = GinkgoT().TempDir()
+	})
+
+	DescribeTable("#Sign and #Verify",
+		func(newKey func() crypto.Signer, format report.SignatureFormat) {
+			privatePath, publicPath := keyPair("foo", newKey())
+			signer, err := report.NewSignerFromFile(privatePath)
+			Expect(err).ToNot(HaveOccurred())
+			verifier, err := report.NewVerifierFromFile(publicPath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(signer.KeyID()).To(Equal(verifier.KeyID()))
+
+			signature, err := signer.Sign(data, "report.json", format)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(verifier.Verify(data, signature)).To(Succeed())
+			Expect(verifier.Verify(append(data, ' '), signature)).To(MatchError(ContainSubstring("digest")))
+
+			_, otherPublicPath := keyPair("bar", newKey())
+			otherVerifier, err := report.NewVerifierFromFile(otherPublicPath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(otherVerifier.Verify(data, signature)).To(MatchError(ContainSubstring("expected key")))
+		},
+		Entry("ed25519 raw signature", func() crypto.Signer {
+			_, key, _ := ed25519.GenerateKey(rand.Reader)
+			return key
+		}, report.SignatureFormatRaw),
+		Entry("ecdsa raw signature", func() crypto.Signer {
+			key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			return key
+		}, report.SignatureFormatRaw),
+		Entry("ed25519 dsse envelope", func() crypto.Signer {
+			_, key, _ := ed25519.GenerateKey(rand.Reader)
+			return key
+		}, report.SignatureFormatDSSE),
+		Entry("ecdsa dsse envelope", func() crypto.Signer {
+			key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			return key
+		}, report.SignatureFormatDSSE),
+	)
+
+	It("should reject tampered signatures", func() {
+		_, key, err := ed25519.GenerateKey(rand.Reader)
+		Expect(err).ToNot(HaveOccurred())
+		privatePath, publicPath := keyPair("foo", key)
+		signer, err := report.NewSignerFromFile(privatePath)
+		Expect(err).ToNot(HaveOccurred())
+		verifier, err := report.NewVerifierFromFile(publicPath)
+		Expect(err).ToNot(HaveOccurred())
+
+		signatureData, err := signer.Sign(data, "report.json", report.SignatureFormatRaw)
+		Expect(err).ToNot(HaveOccurred())
+
+		signature := &report.Signature{}
+		Expect(json.Unmarshal(signatureData, signature)).To(Succeed())
+		signature.Signature[0] ^= 0xff
+		signatureData, err = json.Marshal(signature)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(verifier.Verify(data, signatureData)).To(MatchError("invalid signature"))
+	})
+
+	It("should compute sha256 digests", func() {
+		Expect(report.Digest([]byte("foo"))).To(Equal("sha256:2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae"))
+	})
+})
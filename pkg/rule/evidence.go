@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rule
+
+import "context"
+
+// Evidence is raw data, e.g. a retrieved configuration or Kubernetes objects,
+// which was evaluated by a rule check.
+type Evidence struct {
+	// Name describes the evidence, e.g. "kubelet-config".
+	Name string
+	// Data is the evaluated data. It must be serializable to json.
+	Data any
+}
+
+type evidenceCollectionKey struct{}
+
+// ContextWithEvidenceCollection returns a context which enables the
+// collection of evidence by rules run with it.
+func ContextWithEvidenceCollection(ctx context.Context) context.Context {
+	return context.WithValue(ctx, evidenceCollectionKey{}, true)
+}
+
+// EvidenceCollectionEnabled returns true if rules should collect evidence.
+func EvidenceCollectionEnabled(ctx context.Context) bool {
+	enabled, _ := ctx.Value(evidenceCollectionKey{}).(bool)
+	return enabled
+}
+
+// WithEvidence returns a copy of the [CheckResult] with the given evidence
+// attached if evidence collection is enabled in the context.
+// Otherwise the check result is returned unchanged.
+func (c CheckResult) WithEvidence(ctx context.Context, name string, data any) CheckResult {
+	if !EvidenceCollectionEnabled(ctx) {
+		return c
+	}
+
+	evidence := make([]Evidence, 0, len(c.Evidence)+1)
+	evidence = append(evidence, c.Evidence...)
+	c.Evidence = append(evidence, Evidence{Name: name, Data: data})
+	return c
+}
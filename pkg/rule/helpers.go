@@ -80,3 +80,13 @@ func AcceptedCheckResult(message string, target Target) CheckResult {
 		Target:  target,
 	}
 }
+
+// AcceptedCheckResultWithAcceptance returns a [CheckResult] with Accepted status and the given message, target and acceptance properties
+func AcceptedCheckResultWithAcceptance(message string, target Target, acceptance *Acceptance) CheckResult {
+	return CheckResult{
+		Status:     Accepted,
+		Message:    message,
+		Target:     target,
+		Acceptance: acceptance,
+	}
+}
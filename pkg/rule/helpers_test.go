@@ -6,6 +6,7 @@ package rule_test
 
 import (
 	"context"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -29,6 +30,20 @@ var _ = Describe("utils", func() {
 		})
 	})
 
+	Describe("#AcceptedCheckResultWithAcceptance", func() {
+		It("should return the correct check result", func() {
+			expiresAt := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+			acceptance := &rule.Acceptance{Owner: "foo", ExpiresAt: &expiresAt}
+
+			Expect(rule.AcceptedCheckResultWithAcceptance("foo", rule.NewTarget("cluster", "bar"), acceptance)).To(Equal(rule.CheckResult{
+				Status:     rule.Accepted,
+				Message:    "foo",
+				Target:     rule.NewTarget("cluster", "bar"),
+				Acceptance: acceptance,
+			}))
+		})
+	})
+
 	DescribeTable("#GetCheckResult",
 		func(checkResultFunc func(message string, target rule.Target) rule.CheckResult, message string, target rule.Target, expectedCheckResult rule.CheckResult) {
 			checkResult := checkResultFunc(message, target)
@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rule
+
+import "context"
+
+// RunInfo identifies a single rule run.
+type RunInfo struct {
+	RulesetID      string
+	RulesetVersion string
+	RuleID         string
+	RuleName       string
+}
+
+// RunHooks are called by rulesets around each rule run.
+// They can be called concurrently from several workers. Nil hooks are skipped.
+type RunHooks struct {
+	// OnStart is called before a rule is run.
+	OnStart func(info RunInfo)
+	// OnFinish is called after a rule has finished with its result or error.
+	OnFinish func(info RunInfo, result RuleResult, err error)
+}
+
+type runHooksKey struct{}
+
+// ContextWithRunHooks returns a context which carries the given [RunHooks].
+func ContextWithRunHooks(ctx context.Context, hooks *RunHooks) context.Context {
+	return context.WithValue(ctx, runHooksKey{}, hooks)
+}
+
+// RunHooksFromContext returns the [RunHooks] of the context or nil if there are none.
+func RunHooksFromContext(ctx context.Context) *RunHooks {
+	hooks, _ := ctx.Value(runHooksKey{}).(*RunHooks)
+	return hooks
+}
+
+// Start calls the OnStart hook if it is set.
+func (h *RunHooks) Start(info RunInfo) {
+	if h != nil && h.OnStart != nil {
+		h.OnStart(info)
+	}
+}
+
+// Finish calls the OnFinish hook if it is set.
+func (h *RunHooks) Finish(info RunInfo, result RuleResult, err error) {
+	if h != nil && h.OnFinish != nil {
+		h.OnFinish(info, result, err)
+	}
+}
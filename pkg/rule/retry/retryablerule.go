@@ -91,6 +91,7 @@ func (rr *RetryableRule) Run(ctx context.Context) (rule.RuleResult, error) {
 			time.Sleep(sleepDuration)
 
 			rr.Logger.Info("retrying run", "retry_attempt", i+1)
+			rule.RunStatsFromContext(ctx).AddRetry()
 		}
 	}
 	return res, err
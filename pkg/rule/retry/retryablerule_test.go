@@ -70,10 +70,12 @@ var _ = Describe("retryablerule", func() {
 				retry.WithLogger(testLogger),
 			)
 
-			_, err := rr.Run(ctx)
+			stats := &rule.RunStats{}
+			_, err := rr.Run(rule.ContextWithRunStats(ctx, stats))
 
 			Expect(err).To(BeNil())
 			Expect(counter).To(Equal(expectedCounter))
+			Expect(stats.Retries()).To(Equal(expectedCounter - 1))
 		},
 			Entry("should hit maxRetry when retry condition is always met", trueRetryCondition, 2, 3),
 			Entry("should not retry when retry condition is not met", falseRetryCondition, 7, 1),
@@ -8,6 +8,7 @@ import (
 	"context"
 	"maps"
 	"slices"
+	"time"
 )
 
 // Rule defines what is considered a rule in the context of Diki.
@@ -22,6 +23,14 @@ type RuleResult struct {
 	RuleID, RuleName string
 	Severity         SeverityLevel
 	CheckResults     []CheckResult
+	// StartTime and EndTime are the times at which the rule run started and finished.
+	StartTime, EndTime time.Time
+	// Retries is the number of times the rule run was retried.
+	Retries int
+	// OpsPods is the number of ops pods created during the rule run.
+	OpsPods int
+	// Worker is the index of the worker which ran the rule.
+	Worker int
 }
 
 // SeverityLevel defines the levels that can describe the importance of a Rule.
@@ -79,6 +88,37 @@ type CheckResult struct {
 	Status  Status
 	Message string
 	Target  Target
+	// Acceptance contains optional properties of the configuration that accepted the check.
+	Acceptance *Acceptance
+	// Evidence contains the raw data evaluated by the check.
+	// It is only collected when enabled with [ContextWithEvidenceCollection].
+	Evidence []Evidence `json:"-"`
+}
+
+// Acceptance contains the origin and optional properties which limit the validity of a check acceptance.
+type Acceptance struct {
+	Source    AcceptanceSource `json:"source,omitempty"`
+	Owner     string           `json:"owner,omitempty"`
+	ExpiresAt *time.Time       `json:"expiresAt,omitempty"`
+}
+
+// AcceptanceSource describes where an acceptance or skip originates from.
+type AcceptanceSource string
+
+const (
+	// AcceptanceSourceRuleOption is the source of checks accepted by rule options.
+	AcceptanceSourceRuleOption AcceptanceSource = "RuleOption"
+	// AcceptanceSourceSkipConfig is the source of rules skipped by a rule skip configuration.
+	AcceptanceSourceSkipConfig AcceptanceSource = "SkipConfig"
+	// AcceptanceSourceExceptionsFile is the source of checks accepted by an exceptions file.
+	AcceptanceSourceExceptionsFile AcceptanceSource = "ExceptionsFile"
+	// AcceptanceSourceBuiltIn is the source of checks and rules accepted by a provider or rule.
+	AcceptanceSourceBuiltIn AcceptanceSource = "BuiltIn"
+)
+
+// IsExpired returns true if the acceptance has an expiration date that is not after the given time.
+func (a *Acceptance) IsExpired(now time.Time) bool {
+	return a != nil && a.ExpiresAt != nil && !now.Before(*a.ExpiresAt)
 }
 
 // Status of a CheckResult
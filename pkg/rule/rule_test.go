@@ -5,6 +5,9 @@
 package rule_test
 
 import (
+	"context"
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
@@ -20,6 +23,81 @@ var _ = Describe("rule", func() {
 		Entry("Accepted should not be less than Passed", rule.Accepted, rule.Passed, false),
 	)
 
+	Describe("#Acceptance.IsExpired", func() {
+		var (
+			now       = time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+			future    = now.Add(time.Hour)
+			past      = now.Add(-time.Hour)
+			nilAccept *rule.Acceptance
+		)
+
+		It("should not expire acceptances without expiration date", func() {
+			Expect(nilAccept.IsExpired(now)).To(BeFalse())
+			Expect((&rule.Acceptance{Owner: "foo"}).IsExpired(now)).To(BeFalse())
+		})
+
+		It("should correctly report expired acceptances", func() {
+			Expect((&rule.Acceptance{ExpiresAt: &future}).IsExpired(now)).To(BeFalse())
+			Expect((&rule.Acceptance{ExpiresAt: &now}).IsExpired(now)).To(BeTrue())
+			Expect((&rule.Acceptance{ExpiresAt: &past}).IsExpired(now)).To(BeTrue())
+		})
+	})
+
+	DescribeTable("#NewSkipRule",
+		func(status rule.Status, options []rule.SkipRuleOption, expectedAcceptance *rule.Acceptance) {
+			ruleResult, err := rule.NewSkipRule("foo", "bar", "baz", status, options...).Run(context.Background())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ruleResult.CheckResults).To(HaveLen(1))
+			Expect(ruleResult.CheckResults[0].Status).To(Equal(status))
+			Expect(ruleResult.CheckResults[0].Acceptance).To(Equal(expectedAcceptance))
+		},
+		Entry("should mark accepted skip rules as built-in acceptance", rule.Accepted, nil, &rule.Acceptance{Source: rule.AcceptanceSourceBuiltIn}),
+		Entry("should not set an acceptance for skipped rules", rule.Skipped, nil, nil),
+		Entry("should not set an acceptance for not implemented rules", rule.NotImplemented, nil, nil),
+		Entry("should use the configured acceptance", rule.Accepted, []rule.SkipRuleOption{rule.SkipRuleWithAcceptance("foo", nil)},
+			&rule.Acceptance{Source: rule.AcceptanceSourceSkipConfig, Owner: "foo"}),
+	)
+
+	Describe("#CheckResult.WithEvidence", func() {
+		It("should not attach evidence when evidence collection is disabled", func() {
+			checkResult := rule.PassedCheckResult("foo", rule.NewTarget()).WithEvidence(context.Background(), "bar", "baz")
+			Expect(checkResult.Evidence).To(BeNil())
+		})
+
+		It("should attach evidence when evidence collection is enabled", func() {
+			ctx := rule.ContextWithEvidenceCollection(context.Background())
+			checkResult := rule.PassedCheckResult("foo", rule.NewTarget())
+			withEvidence := checkResult.WithEvidence(ctx, "bar", "baz").WithEvidence(ctx, "qux", 1)
+
+			Expect(withEvidence.Evidence).To(Equal([]rule.Evidence{{Name: "bar", Data: "baz"}, {Name: "qux", Data: 1}}))
+			Expect(checkResult.Evidence).To(BeNil())
+		})
+	})
+
+	Describe("#RunStats", func() {
+		It("should count retries and ops pods of the context", func() {
+			stats := &rule.RunStats{}
+			ctx := rule.ContextWithRunStats(context.Background(), stats)
+
+			rule.RunStatsFromContext(ctx).AddRetry()
+			rule.RunStatsFromContext(ctx).AddOpsPod()
+			rule.RunStatsFromContext(ctx).AddOpsPod()
+
+			Expect(stats.Retries()).To(Equal(1))
+			Expect(stats.OpsPods()).To(Equal(2))
+		})
+
+		It("should ignore operations without run stats in the context", func() {
+			stats := rule.RunStatsFromContext(context.Background())
+			Expect(stats).To(BeNil())
+
+			stats.AddRetry()
+			stats.AddOpsPod()
+			Expect(stats.Retries()).To(Equal(0))
+			Expect(stats.OpsPods()).To(Equal(0))
+		})
+	})
+
 	Describe("#Target", func() {
 		It("should correctly initialize", func() {
 			t := rule.NewTarget("foo", "bar", "one", "two")
@@ -6,6 +6,7 @@ package rule
 
 import (
 	"context"
+	"time"
 )
 
 var (
@@ -20,6 +21,7 @@ type SkipRule struct {
 	severity      SeverityLevel
 	justification string
 	status        Status
+	acceptance    *Acceptance
 }
 
 // SkipRuleOption allows to additionally configure a SkipRule.
@@ -32,7 +34,16 @@ func SkipRuleWithSeverity(severity SeverityLevel) SkipRuleOption {
 	}
 }
 
+// SkipRuleWithAcceptance marks a SkipRule as configured by a rule skip configuration
+// with the given optional owner and expiration time.
+func SkipRuleWithAcceptance(owner string, expiresAt *time.Time) SkipRuleOption {
+	return func(skipRule *SkipRule) {
+		skipRule.acceptance = &Acceptance{Source: AcceptanceSourceSkipConfig, Owner: owner, ExpiresAt: expiresAt}
+	}
+}
+
 // NewSkipRule returns a new skipped Rule.
+// The check result of an Accepted skip rule is marked as built-in acceptance unless configured otherwise.
 func NewSkipRule(id, name, justification string, status Status, options ...SkipRuleOption) *SkipRule {
 	skipRule := &SkipRule{
 		id:            id,
@@ -41,6 +52,10 @@ func NewSkipRule(id, name, justification string, status Status, options ...SkipR
 		status:        status,
 	}
 
+	if status == Accepted {
+		skipRule.acceptance = &Acceptance{Source: AcceptanceSourceBuiltIn}
+	}
+
 	for _, option := range options {
 		option(skipRule)
 	}
@@ -68,8 +83,9 @@ func (s *SkipRule) Severity() SeverityLevel {
 func (s *SkipRule) Run(context.Context) (RuleResult, error) {
 	return Result(s, []CheckResult{
 		{
-			Status:  s.status,
-			Message: s.justification,
+			Status:     s.status,
+			Message:    s.justification,
+			Acceptance: s.acceptance,
 		},
 	}...), nil
 }
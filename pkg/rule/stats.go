@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rule
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+// RunStats counts the operations performed during a single rule run.
+// It is safe for concurrent use. Methods of a nil RunStats are no-ops.
+type RunStats struct {
+	retries atomic.Int64
+	opsPods atomic.Int64
+}
+
+type runStatsKey struct{}
+
+// ContextWithRunStats returns a context which carries the given [RunStats].
+func ContextWithRunStats(ctx context.Context, stats *RunStats) context.Context {
+	return context.WithValue(ctx, runStatsKey{}, stats)
+}
+
+// RunStatsFromContext returns the [RunStats] of the context or nil if there are none.
+func RunStatsFromContext(ctx context.Context) *RunStats {
+	stats, _ := ctx.Value(runStatsKey{}).(*RunStats)
+	return stats
+}
+
+// AddRetry records a retry of the rule run.
+func (s *RunStats) AddRetry() {
+	if s != nil {
+		s.retries.Add(1)
+	}
+}
+
+// AddOpsPod records the creation of an ops pod.
+func (s *RunStats) AddOpsPod() {
+	if s != nil {
+		s.opsPods.Add(1)
+	}
+}
+
+// Retries returns the number of recorded retries.
+func (s *RunStats) Retries() int {
+	if s == nil {
+		return 0
+	}
+	return int(s.retries.Load())
+}
+
+// OpsPods returns the number of recorded ops pods.
+func (s *RunStats) OpsPods() int {
+	if s == nil {
+		return 0
+	}
+	return int(s.opsPods.Load())
+}
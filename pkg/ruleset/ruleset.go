@@ -6,6 +6,7 @@ package ruleset
 
 import (
 	"context"
+	"time"
 
 	"github.com/gardener/diki/pkg/rule"
 )
@@ -16,6 +17,8 @@ type RulesetResult struct {
 	RulesetName    string
 	RulesetVersion string
 	RuleResults    []rule.RuleResult
+	// StartTime and EndTime are the times at which the ruleset run started and finished.
+	StartTime, EndTime time.Time
 }
 
 // Ruleset is a set of Rules.
@@ -0,0 +1,117 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package runner
+
+import (
+	"log/slog"
+
+	"github.com/gardener/diki/pkg/registry"
+	"github.com/gardener/diki/pkg/report"
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/snapshot"
+)
+
+// RuleEvent identifies the rule run for which a hook is called.
+type RuleEvent struct {
+	// ProviderID is the id of the provider which runs the rule.
+	ProviderID string
+	rule.RunInfo
+}
+
+// Hooks are called around the rule runs of the providers.
+// They can be called concurrently. Nil hooks are skipped.
+type Hooks struct {
+	// OnRuleStart is called before a rule is run.
+	OnRuleStart func(event RuleEvent)
+	// OnRuleFinish is called after a rule has finished with its result or error.
+	OnRuleFinish func(event RuleEvent, result rule.RuleResult, err error)
+}
+
+// Option is a function that configures a run.
+type Option func(*options)
+
+type options struct {
+	registry             *registry.Registry
+	logger               *slog.Logger
+	hooks                Hooks
+	providerID           string
+	rulesetID            string
+	rulesetVersion       string
+	snapshot             *snapshot.Snapshot
+	evidenceBundle       *report.EvidenceBundle
+	provenanceConfigMode report.ProvenanceConfigMode
+}
+
+func newOptions(opts ...Option) *options {
+	o := &options{
+		logger:               slog.Default(),
+		provenanceConfigMode: report.ProvenanceConfigRedacted,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// WithRegistry sets the registry with which the configured providers are created.
+// It defaults to the registry of the built-in providers.
+func WithRegistry(r *registry.Registry) Option {
+	return func(o *options) {
+		o.registry = r
+	}
+}
+
+// WithLogger sets the logger of the runner and the created providers. It defaults to [slog.Default].
+func WithLogger(logger *slog.Logger) Option {
+	return func(o *options) {
+		o.logger = logger
+	}
+}
+
+// WithHooks sets the hooks which are called around the rule runs.
+func WithHooks(hooks Hooks) Option {
+	return func(o *options) {
+		o.hooks = hooks
+	}
+}
+
+// WithProvider restricts the run to the provider with the given ID.
+// All configured providers are run if it is not set.
+func WithProvider(providerID string) Option {
+	return func(o *options) {
+		o.providerID = providerID
+	}
+}
+
+// WithRuleset restricts the run to a single ruleset of the selected provider.
+func WithRuleset(rulesetID, rulesetVersion string) Option {
+	return func(o *options) {
+		o.rulesetID = rulesetID
+		o.rulesetVersion = rulesetVersion
+	}
+}
+
+// WithSnapshot evaluates the rules against the snapshot instead of the live clusters.
+// The providers are created with the kubeconfigs recorded in the snapshot, while the report keeps the given configuration.
+func WithSnapshot(s *snapshot.Snapshot) Option {
+	return func(o *options) {
+		o.snapshot = s
+	}
+}
+
+// WithEvidenceBundle enables the collection of evidence, which is written to the bundle when the report is created.
+func WithEvidenceBundle(bundle *report.EvidenceBundle) Option {
+	return func(o *options) {
+		o.evidenceBundle = bundle
+	}
+}
+
+// WithProvenanceConfigMode sets how the configuration is recorded in the report provenance.
+// It defaults to [report.ProvenanceConfigRedacted].
+func WithProvenanceConfigMode(mode report.ProvenanceConfigMode) Option {
+	return func(o *options) {
+		o.provenanceConfigMode = mode
+	}
+}
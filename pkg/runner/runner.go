@@ -0,0 +1,305 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package runner runs diki from Go programs. The diki command line is a client of this package.
+package runner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"time"
+
+	"gopkg.in/yaml.v3"
+	"k8s.io/component-base/version"
+
+	"github.com/gardener/diki/imagevector"
+	"github.com/gardener/diki/pkg/config"
+	"github.com/gardener/diki/pkg/provider"
+	"github.com/gardener/diki/pkg/provider/builder"
+	"github.com/gardener/diki/pkg/provider/gardener"
+	"github.com/gardener/diki/pkg/report"
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/ruleset"
+	"github.com/gardener/diki/pkg/shared/images"
+	"github.com/gardener/diki/pkg/snapshot"
+)
+
+// Run creates the providers of the configuration, runs their rulesets and returns the report of the run.
+// All configured providers are run unless a provider is selected with [WithProvider].
+func Run(ctx context.Context, cfg config.DikiConfig, opts ...Option) (*report.Report, error) {
+	o := newOptions(opts...)
+	if err := o.validate(); err != nil {
+		return nil, err
+	}
+	if (len(o.rulesetID) > 0 || len(o.rulesetVersion) > 0) && len(o.providerID) == 0 {
+		return nil, errors.New("a provider has to be selected to run a single ruleset")
+	}
+
+	startTime := time.Now().UTC()
+	ctx, providers, cleanup, err := o.setup(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	defer cleanup()
+
+	var providerResults []provider.ProviderResult
+	if len(o.providerID) == 0 {
+		for _, providerID := range slices.Sorted(maps.Keys(providers)) {
+			res, err := runAll(o.contextWithHooks(ctx, providerID), providers[providerID])
+			if err != nil {
+				return nil, err
+			}
+			providerResults = append(providerResults, res...)
+		}
+	} else {
+		p, ok := providers[o.providerID]
+		if !ok {
+			return nil, fmt.Errorf("unknown provider: %s", o.providerID)
+		}
+
+		providerCtx := o.contextWithHooks(ctx, p.ID())
+		if len(o.rulesetID) == 0 {
+			providerResults, err = runAll(providerCtx, p)
+		} else {
+			providerResults, err = runRuleset(providerCtx, p, o.rulesetID, o.rulesetVersion)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var reportOpts []report.ReportOption
+	if cfg.Output != nil && len(cfg.Output.MinStatus) > 0 {
+		reportOpts = append(reportOpts, report.MinStatus(cfg.Output.MinStatus))
+	}
+	if len(cfg.Metadata) > 0 {
+		reportOpts = append(reportOpts, report.Metadata(cfg.Metadata))
+	}
+	if cfg.Acceptances != nil {
+		reportOpts = append(reportOpts, report.AcceptanceGracePeriod(cfg.Acceptances.ExpirationGracePeriod))
+	}
+	if o.evidenceBundle != nil {
+		reportOpts = append(reportOpts, o.evidenceBundle)
+	}
+	reportOpts = append(reportOpts, runProvenance(ctx, &cfg, providers, providerResults, startTime, o))
+	return report.FromProviderResults(providerResults, reportOpts...), nil
+}
+
+// RunRule creates the providers of the configuration and runs a single rule of a ruleset of the provider with the given ID.
+// The provider and ruleset selected with [WithProvider] and [WithRuleset] are ignored.
+func RunRule(ctx context.Context, cfg config.DikiConfig, providerID, rulesetID, rulesetVersion, ruleID string, opts ...Option) (rule.RuleResult, error) {
+	o := newOptions(opts...)
+	if err := o.validate(); err != nil {
+		return rule.RuleResult{}, err
+	}
+
+	ctx, providers, cleanup, err := o.setup(ctx, cfg)
+	if err != nil {
+		return rule.RuleResult{}, err
+	}
+	defer cleanup()
+
+	p, ok := providers[providerID]
+	if !ok {
+		return rule.RuleResult{}, fmt.Errorf("unknown provider: %s", providerID)
+	}
+
+	// rulesets do not call the hooks when a single rule is run
+	event := RuleEvent{ProviderID: p.ID(), RunInfo: rule.RunInfo{RulesetID: rulesetID, RulesetVersion: rulesetVersion, RuleID: ruleID}}
+	o.hooks.ruleStart(event)
+	res, err := p.RunRule(ctx, rulesetID, rulesetVersion, ruleID)
+	if len(res.RuleName) > 0 {
+		event.RuleName = res.RuleName
+	}
+	o.hooks.ruleFinish(event, res, err)
+	return res, err
+}
+
+func (o *options) validate() error {
+	if len(o.rulesetID) > 0 && len(o.rulesetVersion) == 0 {
+		return errors.New("ruleset version has to be set along with ruleset id")
+	}
+	if len(o.rulesetID) == 0 && len(o.rulesetVersion) > 0 {
+		return errors.New("ruleset id has to be set along with ruleset version")
+	}
+	if !slices.Contains(report.ProvenanceConfigModes(), o.provenanceConfigMode) {
+		return fmt.Errorf("not supported provenance config mode %s. Choose one of 'redacted', 'full' or 'hash'", o.provenanceConfigMode)
+	}
+	return nil
+}
+
+// setup creates the providers and returns the context with which they are run.
+// The returned cleanup function closes the providers and removes the temporary files of the run.
+func (o *options) setup(ctx context.Context, cfg config.DikiConfig) (context.Context, map[string]provider.Provider, func(), error) {
+	cleanup := func() {}
+	providersConfig := &cfg
+	if o.snapshot != nil {
+		// the kubeconfig paths of the provider arguments are replaced, so that the configuration is copied first
+		data, err := yaml.Marshal(cfg)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		providersConfig = &config.DikiConfig{}
+		if err := yaml.Unmarshal(data, providersConfig); err != nil {
+			return nil, nil, nil, err
+		}
+
+		if err := gardener.ValidateSnapshotConfig(providersConfig); err != nil {
+			return nil, nil, nil, err
+		}
+
+		kubeconfigDir, err := os.MkdirTemp("", "diki-snapshot-")
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		cleanup = func() {
+			if err := os.RemoveAll(kubeconfigDir); err != nil {
+				o.logger.Error("failed to remove snapshot kubeconfigs", "error", err)
+			}
+		}
+		if err := o.snapshot.UseKubeconfigs(providersConfig, kubeconfigDir); err != nil {
+			cleanup()
+			return nil, nil, nil, err
+		}
+		ctx = snapshot.ContextWithSnapshot(ctx, o.snapshot)
+	}
+
+	if o.evidenceBundle != nil {
+		ctx = rule.ContextWithEvidenceCollection(ctx)
+	}
+
+	r := o.registry
+	if r == nil {
+		var err error
+		if r, err = builder.NewRegistry(); err != nil {
+			cleanup()
+			return nil, nil, nil, err
+		}
+	}
+
+	providers, err := r.NewProviders(providersConfig, o.logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, nil, err
+	}
+
+	removeFiles := cleanup
+	cleanup = func() {
+		if err := provider.Close(slices.Collect(maps.Values(providers))...); err != nil {
+			o.logger.Error("failed to close providers", "error", err)
+		}
+		removeFiles()
+	}
+	return ctx, providers, cleanup, nil
+}
+
+// contextWithHooks returns a context which carries the hooks for the rule runs of the provider.
+func (o *options) contextWithHooks(ctx context.Context, providerID string) context.Context {
+	if o.hooks.OnRuleStart == nil && o.hooks.OnRuleFinish == nil {
+		return ctx
+	}
+	return rule.ContextWithRunHooks(ctx, &rule.RunHooks{
+		OnStart: func(info rule.RunInfo) {
+			o.hooks.ruleStart(RuleEvent{ProviderID: providerID, RunInfo: info})
+		},
+		OnFinish: func(info rule.RunInfo, result rule.RuleResult, err error) {
+			o.hooks.ruleFinish(RuleEvent{ProviderID: providerID, RunInfo: info}, result, err)
+		},
+	})
+}
+
+func (h Hooks) ruleStart(event RuleEvent) {
+	if h.OnRuleStart != nil {
+		h.OnRuleStart(event)
+	}
+}
+
+func (h Hooks) ruleFinish(event RuleEvent, result rule.RuleResult, err error) {
+	if h.OnRuleFinish != nil {
+		h.OnRuleFinish(event, result, err)
+	}
+}
+
+// runAll executes all rulesets of a provider.
+// Providers which check several targets return one result per target.
+func runAll(ctx context.Context, p provider.Provider) ([]provider.ProviderResult, error) {
+	if mp, ok := p.(provider.MultiTargetProvider); ok {
+		return mp.RunAllPerTarget(ctx)
+	}
+
+	res, err := p.RunAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return []provider.ProviderResult{res}, nil
+}
+
+// runRuleset executes a ruleset of a provider.
+// Providers which check several targets return one result per target.
+func runRuleset(ctx context.Context, p provider.Provider, rulesetID, rulesetVersion string) ([]provider.ProviderResult, error) {
+	if mp, ok := p.(provider.MultiTargetProvider); ok {
+		return mp.RunRulesetPerTarget(ctx, rulesetID, rulesetVersion)
+	}
+
+	res, err := p.RunRuleset(ctx, rulesetID, rulesetVersion)
+	if err != nil {
+		return nil, err
+	}
+	return []provider.ProviderResult{{ProviderID: p.ID(), ProviderName: p.Name(), Metadata: p.Metadata(), RulesetResults: []ruleset.RulesetResult{res}, StartTime: res.StartTime, EndTime: res.EndTime}}, nil
+}
+
+// runProvenance collects the provenance of the run for the providers contained in the results.
+// Cluster versions which cannot be retrieved are logged and omitted.
+func runProvenance(
+	ctx context.Context,
+	dikiConfig *config.DikiConfig,
+	providers map[string]provider.Provider,
+	providerResults []provider.ProviderResult,
+	startTime time.Time,
+	o *options,
+) *report.RunProvenance {
+	rp := &report.RunProvenance{
+		Config:          dikiConfig,
+		ConfigMode:      o.provenanceConfigMode,
+		StartTime:       startTime,
+		ClusterVersions: map[string]map[string]string{},
+	}
+
+	if s := snapshot.FromContext(ctx); s.Replay() {
+		rp.Snapshot = &report.SnapshotProvenance{
+			CreationTime: s.Manifest.CreationTime,
+			DikiVersion:  s.Manifest.DikiVersion,
+		}
+	} else if image, err := imagevector.ImageVector().FindImage(images.DikiOpsImageName); err != nil {
+		o.logger.Error("failed to find ops image", "error", err)
+	} else {
+		image.WithOptionalTag(version.Get().GitVersion)
+		rp.OpsImage = image.String()
+	}
+
+	// providers which check several targets return several results
+	checkedProviders := map[string]struct{}{}
+	for _, providerResult := range providerResults {
+		if _, ok := checkedProviders[providerResult.ProviderID]; ok {
+			continue
+		}
+		checkedProviders[providerResult.ProviderID] = struct{}{}
+
+		versioner, ok := providers[providerResult.ProviderID].(provider.ClusterVersioner)
+		if !ok {
+			continue
+		}
+		versions, err := versioner.ClusterVersions(ctx)
+		if err != nil {
+			o.logger.Error("failed to get cluster versions", "provider", providerResult.ProviderID, "error", err)
+			continue
+		}
+		rp.ClusterVersions[providerResult.ProviderID] = versions
+	}
+	return rp
+}
@@ -0,0 +1,398 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package runner_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"slices"
+	"sync"
+	"sync/atomic"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+
+	"github.com/gardener/diki/pkg/config"
+	"github.com/gardener/diki/pkg/provider"
+	"github.com/gardener/diki/pkg/registry"
+	"github.com/gardener/diki/pkg/report"
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/ruleset"
+	"github.com/gardener/diki/pkg/runner"
+	sharedprovider "github.com/gardener/diki/pkg/shared/provider"
+	sharedruleset "github.com/gardener/diki/pkg/shared/ruleset"
+	"github.com/gardener/diki/pkg/snapshot"
+)
+
+var logger = slog.New(slog.DiscardHandler)
+
+type fakeRule struct {
+	id string
+}
+
+func (r *fakeRule) ID() string   { return r.id }
+func (r *fakeRule) Name() string { return "Rule " + r.id }
+func (r *fakeRule) Run(_ context.Context) (rule.RuleResult, error) {
+	if r.id == "err" {
+		return rule.RuleResult{}, errors.New("foo")
+	}
+	return rule.RuleResult{
+		RuleID:       r.id,
+		RuleName:     r.Name(),
+		CheckResults: []rule.CheckResult{rule.PassedCheckResult("passed", rule.NewTarget())},
+	}, nil
+}
+
+type fakeRuleset struct {
+	id    string
+	rules map[string]rule.Rule
+}
+
+func (r *fakeRuleset) ID() string      { return r.id }
+func (r *fakeRuleset) Name() string    { return "Ruleset " + r.id }
+func (r *fakeRuleset) Version() string { return "v1" }
+func (r *fakeRuleset) Run(ctx context.Context) (ruleset.RulesetResult, error) {
+	return sharedruleset.Run(ctx, r, r.rules, 1, logger)
+}
+func (r *fakeRuleset) RunRule(ctx context.Context, id string) (rule.RuleResult, error) {
+	rr, ok := r.rules[id]
+	if !ok {
+		return rule.RuleResult{}, fmt.Errorf("rule with id %s is not registered in the ruleset", id)
+	}
+	return rr.Run(ctx)
+}
+
+type fakeProvider struct {
+	id       string
+	rulesets map[string]ruleset.Ruleset
+	closed   bool
+}
+
+func (p *fakeProvider) ID() string                  { return p.id }
+func (p *fakeProvider) Name() string                { return "Provider " + p.id }
+func (p *fakeProvider) Metadata() map[string]string { return nil }
+func (p *fakeProvider) RunAll(ctx context.Context) (provider.ProviderResult, error) {
+	return sharedprovider.RunAll(ctx, p, p.rulesets, logger)
+}
+func (p *fakeProvider) RunRuleset(ctx context.Context, rulesetID, rulesetVersion string) (ruleset.RulesetResult, error) {
+	rs, ok := p.rulesets[rulesetID+"--"+rulesetVersion]
+	if !ok {
+		return ruleset.RulesetResult{}, fmt.Errorf("ruleset with id %s and version %s does not exist", rulesetID, rulesetVersion)
+	}
+	return rs.Run(ctx)
+}
+func (p *fakeProvider) RunRule(ctx context.Context, rulesetID, rulesetVersion, ruleID string) (rule.RuleResult, error) {
+	rs, ok := p.rulesets[rulesetID+"--"+rulesetVersion]
+	if !ok {
+		return rule.RuleResult{}, fmt.Errorf("ruleset with id %s and version %s does not exist", rulesetID, rulesetVersion)
+	}
+	return rs.RunRule(ctx, ruleID)
+}
+
+func (p *fakeProvider) Close() error {
+	p.closed = true
+	return nil
+}
+
+func (p *fakeProvider) AddRulesets(rulesets ...ruleset.Ruleset) error {
+	for _, rs := range rulesets {
+		p.rulesets[rs.ID()+"--"+rs.Version()] = rs
+	}
+	return nil
+}
+
+// newFakeRegistry returns a registry with fake provider types for the given ids.
+// The rule ids of a ruleset are taken from its rule options.
+func newFakeRegistry(providerIDs ...string) *registry.Registry {
+	r := registry.New()
+	for _, providerID := range providerIDs {
+		providerType := registry.NewProvider(providerID, "Provider "+providerID, func(conf config.ProviderConfig, _ *slog.Logger) (*fakeProvider, error) {
+			return &fakeProvider{id: conf.ID, rulesets: map[string]ruleset.Ruleset{}}, nil
+		})
+		for _, rulesetID := range []string{"one", "two"} {
+			Expect(providerType.RegisterRulesets(registry.Ruleset[*fakeProvider]{
+				ID: rulesetID,
+				FromConfig: func(_ *fakeProvider, conf config.RulesetConfig, _ *slog.Logger, _ *field.Path) (ruleset.Ruleset, error) {
+					rs := &fakeRuleset{id: conf.ID, rules: map[string]rule.Rule{}}
+					for _, ruleOption := range conf.RuleOptions {
+						rs.rules[ruleOption.RuleID] = &fakeRule{id: ruleOption.RuleID}
+					}
+					return rs, nil
+				},
+			})).To(Succeed())
+		}
+		Expect(r.Register(providerType)).To(Succeed())
+	}
+	return r
+}
+
+var _ = Describe("#Run", func() {
+	var (
+		ctx  context.Context
+		cfg  config.DikiConfig
+		opts []runner.Option
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		cfg = config.DikiConfig{
+			Providers: []config.ProviderConfig{
+				{
+					ID: "foo",
+					Rulesets: []config.RulesetConfig{
+						{ID: "one", Version: "v1", RuleOptions: []config.RuleOptionsConfig{{RuleID: "1"}, {RuleID: "2"}}},
+						{ID: "two", Version: "v1", RuleOptions: []config.RuleOptionsConfig{{RuleID: "3"}}},
+					},
+				},
+				{
+					ID: "bar",
+					Rulesets: []config.RulesetConfig{
+						{ID: "one", Version: "v1", RuleOptions: []config.RuleOptionsConfig{{RuleID: "1"}}},
+					},
+				},
+			},
+			Metadata: map[string]any{"foo": "bar"},
+		}
+		opts = []runner.Option{
+			runner.WithLogger(logger),
+			runner.WithRegistry(newFakeRegistry("foo", "bar")),
+		}
+	})
+
+	rulesOf := func(rep *report.Report) map[string][]string {
+		rules := map[string][]string{}
+		for _, p := range rep.Providers {
+			for _, rs := range p.Rulesets {
+				for _, r := range rs.Rules {
+					rules[p.ID+"/"+rs.ID] = append(rules[p.ID+"/"+rs.ID], r.ID)
+				}
+			}
+		}
+		for _, ruleIDs := range rules {
+			slices.Sort(ruleIDs)
+		}
+		return rules
+	}
+
+	It("should run all providers", func() {
+		rep, err := runner.Run(ctx, cfg, opts...)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(rep.Providers).To(HaveLen(2))
+		Expect(rep.Providers[0].ID).To(Equal("bar"))
+		Expect(rep.Providers[1].ID).To(Equal("foo"))
+		Expect(rulesOf(rep)).To(Equal(map[string][]string{
+			"bar/one": {"1"},
+			"foo/one": {"1", "2"},
+			"foo/two": {"3"},
+		}))
+		Expect(rep.Metadata).To(Equal(map[string]any{"foo": "bar"}))
+		Expect(rep.Provenance).ToNot(BeNil())
+		Expect(rep.Provenance.Providers).To(HaveLen(2))
+	})
+
+	It("should run the selected provider", func() {
+		rep, err := runner.Run(ctx, cfg, append(opts, runner.WithProvider("foo"))...)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(rulesOf(rep)).To(Equal(map[string][]string{
+			"foo/one": {"1", "2"},
+			"foo/two": {"3"},
+		}))
+	})
+
+	It("should run the selected ruleset", func() {
+		rep, err := runner.Run(ctx, cfg, append(opts, runner.WithProvider("foo"), runner.WithRuleset("two", "v1"))...)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(rulesOf(rep)).To(Equal(map[string][]string{
+			"foo/two": {"3"},
+		}))
+	})
+
+	It("should call the hooks for every rule", func() {
+		var (
+			mu       sync.Mutex
+			started  []runner.RuleEvent
+			finished []runner.RuleEvent
+		)
+		hooks := runner.Hooks{
+			OnRuleStart: func(event runner.RuleEvent) {
+				mu.Lock()
+				defer mu.Unlock()
+				started = append(started, event)
+			},
+			OnRuleFinish: func(event runner.RuleEvent, result rule.RuleResult, err error) {
+				mu.Lock()
+				defer mu.Unlock()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.RuleID).To(Equal(event.RuleID))
+				finished = append(finished, event)
+			},
+		}
+
+		_, err := runner.Run(ctx, cfg, append(opts, runner.WithHooks(hooks))...)
+		Expect(err).ToNot(HaveOccurred())
+
+		expectedEvents := []runner.RuleEvent{
+			{ProviderID: "bar", RunInfo: rule.RunInfo{RulesetID: "one", RulesetVersion: "v1", RuleID: "1", RuleName: "Rule 1"}},
+			{ProviderID: "foo", RunInfo: rule.RunInfo{RulesetID: "one", RulesetVersion: "v1", RuleID: "1", RuleName: "Rule 1"}},
+			{ProviderID: "foo", RunInfo: rule.RunInfo{RulesetID: "one", RulesetVersion: "v1", RuleID: "2", RuleName: "Rule 2"}},
+			{ProviderID: "foo", RunInfo: rule.RunInfo{RulesetID: "two", RulesetVersion: "v1", RuleID: "3", RuleName: "Rule 3"}},
+		}
+		Expect(started).To(ConsistOf(expectedEvents))
+		Expect(finished).To(ConsistOf(expectedEvents))
+	})
+
+	It("should pass rule errors to the finish hook", func() {
+		cfg.Providers[1].Rulesets[0].RuleOptions = append(cfg.Providers[1].Rulesets[0].RuleOptions, config.RuleOptionsConfig{RuleID: "err"})
+
+		var ruleErr error
+		hooks := runner.Hooks{
+			OnRuleFinish: func(event runner.RuleEvent, _ rule.RuleResult, err error) {
+				if event.RuleID == "err" {
+					ruleErr = err
+				}
+			},
+		}
+
+		_, err := runner.Run(ctx, cfg, append(opts, runner.WithProvider("bar"), runner.WithHooks(hooks))...)
+		Expect(err).To(MatchError(ContainSubstring("rule with id err errored: foo")))
+		Expect(ruleErr).To(MatchError("foo"))
+	})
+
+	DescribeTable("should return an error for invalid options",
+		func(runOpts []runner.Option, expectedErr string) {
+			_, err := runner.Run(ctx, cfg, append(opts, runOpts...)...)
+			Expect(err).To(MatchError(expectedErr))
+		},
+		Entry("unknown provider", []runner.Option{runner.WithProvider("baz")}, "unknown provider: baz"),
+		Entry("ruleset without provider", []runner.Option{runner.WithRuleset("one", "v1")}, "a provider has to be selected to run a single ruleset"),
+		Entry("ruleset without version", []runner.Option{runner.WithProvider("foo"), runner.WithRuleset("one", "")}, "ruleset version has to be set along with ruleset id"),
+		Entry("ruleset version without id", []runner.Option{runner.WithProvider("foo"), runner.WithRuleset("", "v1")}, "ruleset id has to be set along with ruleset version"),
+		Entry("provenance config mode", []runner.Option{runner.WithProvenanceConfigMode("foo")}, "not supported provenance config mode foo. Choose one of 'redacted', 'full' or 'hash'"),
+	)
+
+	It("should return an error for unknown provider identifiers", func() {
+		_, err := runner.Run(ctx, cfg, runner.WithLogger(logger), runner.WithRegistry(newFakeRegistry("foo")))
+		Expect(err).To(MatchError("unknown provider identifier: bar"))
+	})
+
+	It("should close the providers after the run", func() {
+		var created []*fakeProvider
+		providerType := registry.NewProvider("foo", "Provider foo", func(conf config.ProviderConfig, _ *slog.Logger) (*fakeProvider, error) {
+			p := &fakeProvider{id: conf.ID, rulesets: map[string]ruleset.Ruleset{}}
+			created = append(created, p)
+			return p, nil
+		})
+		Expect(providerType.RegisterRulesets(registry.Ruleset[*fakeProvider]{
+			ID: "one",
+			FromConfig: func(_ *fakeProvider, conf config.RulesetConfig, _ *slog.Logger, _ *field.Path) (ruleset.Ruleset, error) {
+				return &fakeRuleset{id: conf.ID, rules: map[string]rule.Rule{"1": &fakeRule{id: "1"}}}, nil
+			},
+		})).To(Succeed())
+		r := registry.New()
+		Expect(r.Register(providerType)).To(Succeed())
+
+		_, err := runner.Run(ctx, config.DikiConfig{Providers: []config.ProviderConfig{{ID: "foo", Rulesets: []config.RulesetConfig{{ID: "one", Version: "v1"}}}}}, runner.WithLogger(logger), runner.WithRegistry(r))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(created).To(HaveLen(1))
+		Expect(created[0].closed).To(BeTrue())
+	})
+
+	Describe("with a snapshot", func() {
+		It("should not request credentials through the garden cluster", func() {
+			var gardenRequests atomic.Int32
+			garden := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				gardenRequests.Add(1)
+				w.WriteHeader(http.StatusForbidden)
+			}))
+			DeferCleanup(garden.Close)
+
+			gardenKubeconfigPath := filepath.Join(GinkgoT().TempDir(), "garden.yaml")
+			Expect(clientcmd.WriteToFile(clientcmdapi.Config{
+				Clusters:       map[string]*clientcmdapi.Cluster{"garden": {Server: garden.URL}},
+				AuthInfos:      map[string]*clientcmdapi.AuthInfo{"garden": {Token: "token"}},
+				Contexts:       map[string]*clientcmdapi.Context{"garden": {Cluster: "garden", AuthInfo: "garden"}},
+				CurrentContext: "garden",
+			}, gardenKubeconfigPath)).To(Succeed())
+
+			gardenerCfg := config.DikiConfig{
+				Providers: []config.ProviderConfig{
+					{
+						ID: "gardener",
+						Args: map[string]any{
+							"gardenKubeconfigPath": gardenKubeconfigPath,
+							"projectNamespace":     "garden-bar",
+							"shootName":            "foo",
+						},
+					},
+				},
+			}
+			s := snapshot.New()
+			Expect(s.AddConfig(&gardenerCfg)).To(Succeed())
+			snapshotDir := GinkgoT().TempDir()
+			Expect(s.WriteDir(snapshotDir)).To(Succeed())
+			replaySnapshot, err := snapshot.Load(snapshotDir)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = runner.Run(ctx, gardenerCfg, runner.WithLogger(logger), runner.WithSnapshot(replaySnapshot))
+			Expect(err).To(MatchError(ContainSubstring("provider gardener requests the cluster credentials through the garden cluster, which is not supported when evaluating a snapshot")))
+			Expect(gardenRequests.Load()).To(BeZero())
+		})
+	})
+})
+
+var _ = Describe("#RunRule", func() {
+	var cfg config.DikiConfig
+
+	BeforeEach(func() {
+		cfg = config.DikiConfig{
+			Providers: []config.ProviderConfig{
+				{
+					ID: "foo",
+					Rulesets: []config.RulesetConfig{
+						{ID: "one", Version: "v1", RuleOptions: []config.RuleOptionsConfig{{RuleID: "1"}, {RuleID: "2"}}},
+					},
+				},
+			},
+		}
+	})
+
+	It("should run a single rule and call the hooks", func() {
+		var started, finished []runner.RuleEvent
+		hooks := runner.Hooks{
+			OnRuleStart:  func(event runner.RuleEvent) { started = append(started, event) },
+			OnRuleFinish: func(event runner.RuleEvent, _ rule.RuleResult, _ error) { finished = append(finished, event) },
+		}
+
+		res, err := runner.RunRule(context.Background(), cfg, "foo", "one", "v1", "2",
+			runner.WithLogger(logger),
+			runner.WithRegistry(newFakeRegistry("foo")),
+			runner.WithHooks(hooks),
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.RuleID).To(Equal("2"))
+
+		Expect(started).To(Equal([]runner.RuleEvent{{ProviderID: "foo", RunInfo: rule.RunInfo{RulesetID: "one", RulesetVersion: "v1", RuleID: "2"}}}))
+		Expect(finished).To(Equal([]runner.RuleEvent{{ProviderID: "foo", RunInfo: rule.RunInfo{RulesetID: "one", RulesetVersion: "v1", RuleID: "2", RuleName: "Rule 2"}}}))
+	})
+
+	It("should return an error for unknown providers", func() {
+		_, err := runner.RunRule(context.Background(), cfg, "bar", "one", "v1", "1",
+			runner.WithLogger(logger),
+			runner.WithRegistry(newFakeRegistry("foo")),
+		)
+		Expect(err).To(MatchError("unknown provider: bar"))
+	})
+})
@@ -9,6 +9,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/option"
+	sharedoption "github.com/gardener/diki/pkg/shared/ruleset/option"
 )
 
 // ClusterObjectSelector contains generalized options for matching entities by their attribute labels.
@@ -61,11 +62,29 @@ func (s *NamespacedObjectSelector) Validate(fldPath *field.Path) field.ErrorList
 // AcceptedClusterObject contains generalized properties for accepting object.
 type AcceptedClusterObject struct {
 	ClusterObjectSelector
+	sharedoption.Expiration
 	Justification string `json:"justification" yaml:"justification"`
 }
 
+var _ option.Option = (*AcceptedClusterObject)(nil)
+
+// Validate validates that option configurations are correctly defined.
+func (o *AcceptedClusterObject) Validate(fldPath *field.Path) field.ErrorList {
+	allErrs := o.ClusterObjectSelector.Validate(fldPath)
+	return append(allErrs, o.Expiration.Validate(fldPath)...)
+}
+
 // AcceptedNamespacedObject contains generalized properties for accepting namespaced object.
 type AcceptedNamespacedObject struct {
 	NamespacedObjectSelector
+	sharedoption.Expiration
 	Justification string `json:"justification" yaml:"justification"`
 }
+
+var _ option.Option = (*AcceptedNamespacedObject)(nil)
+
+// Validate validates that option configurations are correctly defined.
+func (o *AcceptedNamespacedObject) Validate(fldPath *field.Path) field.ErrorList {
+	allErrs := o.NamespacedObjectSelector.Validate(fldPath)
+	return append(allErrs, o.Expiration.Validate(fldPath)...)
+}
@@ -9,9 +9,15 @@ import (
 	"errors"
 	"fmt"
 	"maps"
+	"time"
 
+	"k8s.io/client-go/rest"
+
+	"github.com/gardener/diki/pkg/kubernetes/pod"
+	kubeutils "github.com/gardener/diki/pkg/kubernetes/utils"
 	"github.com/gardener/diki/pkg/provider"
 	"github.com/gardener/diki/pkg/ruleset"
+	"github.com/gardener/diki/pkg/snapshot"
 )
 
 // Logger is a minimalistic logger interface.
@@ -31,6 +37,18 @@ func RunAll(ctx context.Context, p provider.Provider, rulesets map[string]rulese
 		ProviderID:     p.ID(),
 		Metadata:       maps.Clone(p.Metadata()),
 		RulesetResults: make([]ruleset.RulesetResult, 0, len(rulesets)),
+		StartTime:      time.Now().UTC(),
+	}
+
+	// rulesets of the provider share a point-in-time view of the cluster objects
+	if kubeutils.ObjectCacheFromContext(ctx) == nil {
+		ctx = kubeutils.ContextWithObjectCache(ctx, kubeutils.NewObjectCache())
+	}
+	// rulesets of the provider share one privileged pod per node
+	if pod.NodePodPoolFromContext(ctx) == nil {
+		pool := pod.NewNodePodPool()
+		ctx = pod.ContextWithNodePodPool(ctx, pool)
+		defer ClosePodPool(pool, log)
 	}
 
 	var errAgg error
@@ -56,5 +74,48 @@ func RunAll(ctx context.Context, p provider.Provider, rulesets map[string]rulese
 	if errAgg != nil {
 		return provider.ProviderResult{}, errAgg
 	}
+	result.EndTime = time.Now().UTC()
 	return result, nil
 }
+
+// ClosePodPool deletes the pods of a [pod.NodePodPool].
+// It does not use the run context, so that pods are also deleted when the run is canceled.
+func ClosePodPool(pool *pod.NodePodPool, log Logger) {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+
+	if err := pool.Close(ctx); err != nil {
+		log.Error("failed to delete pooled pods", "error", err)
+	}
+}
+
+// ClusterVersions is a sample implementation for a [provider.ClusterVersioner].
+// It returns the Kubernetes server versions of the clusters by cluster name.
+// If the context carries a [snapshot.Snapshot], the versions are recorded in it or served from it.
+func ClusterVersions(ctx context.Context, configs map[string]*rest.Config) (map[string]string, error) {
+	s := snapshot.FromContext(ctx)
+	versions := make(map[string]string, len(configs))
+	for name, config := range configs {
+		if config == nil {
+			continue
+		}
+		if s.Replay() {
+			version, ok := s.ClusterVersion(config.Host)
+			if !ok {
+				return nil, fmt.Errorf("server version of cluster %s is %w", name, snapshot.ErrNotInSnapshot)
+			}
+			versions[name] = version
+			continue
+		}
+
+		version, err := kubeutils.GetServerVersion(config)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get server version of cluster %s: %w", name, err)
+		}
+		if s != nil {
+			s.SetClusterVersion(config.Host, version)
+		}
+		versions[name] = version
+	}
+	return versions, nil
+}
@@ -10,6 +10,8 @@ import (
 	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	sharedoption "github.com/gardener/diki/pkg/shared/ruleset/option"
 )
 
 // Option that can be validated in order to ensure
@@ -88,6 +90,22 @@ func (o FileOwnerOptions) Validate(fldPath *field.Path) field.ErrorList {
 	return allErrs
 }
 
+// Owners returns the expected user and group owners of files.
+// Users and groups default to root when they are not set.
+func (o *FileOwnerOptions) Owners() ([]string, []string) {
+	var users, groups []string
+	if o != nil {
+		users, groups = o.ExpectedFileOwner.Users, o.ExpectedFileOwner.Groups
+	}
+	if len(users) == 0 {
+		users = []string{"0"}
+	}
+	if len(groups) == 0 {
+		groups = []string{"0"}
+	}
+	return users, groups
+}
+
 // Options242414 contains options for rule 242414
 type Options242414 struct {
 	AcceptedPods []AcceptedPods242414 `json:"acceptedPods" yaml:"acceptedPods"`
@@ -98,6 +116,7 @@ var _ Option = (*Options242414)(nil)
 // AcceptedPods242414 contains option specifications for accepted pods
 type AcceptedPods242414 struct {
 	PodSelector
+	sharedoption.Expiration
 	Justification string  `json:"justification" yaml:"justification"`
 	Ports         []int32 `json:"ports" yaml:"ports"`
 }
@@ -109,7 +128,8 @@ func (o Options242414) Validate(fldPath *field.Path) field.ErrorList {
 		acceptedPodsPath = fldPath.Child("acceptedPods")
 	)
 	for idx, p := range o.AcceptedPods {
-		allErrs = append(allErrs, p.Validate(acceptedPodsPath.Index(idx))...)
+		allErrs = append(allErrs, p.PodSelector.Validate(acceptedPodsPath.Index(idx))...)
+		allErrs = append(allErrs, p.Expiration.Validate(acceptedPodsPath.Index(idx))...)
 		if len(p.Ports) == 0 {
 			allErrs = append(allErrs, field.Required(acceptedPodsPath.Index(idx).Child("ports"), "must not be empty"))
 		}
@@ -132,6 +152,7 @@ var _ Option = (*Options242415)(nil)
 // AcceptedPods242415 contains option specifications for accepted pods
 type AcceptedPods242415 struct {
 	PodSelector
+	sharedoption.Expiration
 	Justification        string   `json:"justification" yaml:"justification"`
 	EnvironmentVariables []string `json:"environmentVariables" yaml:"environmentVariables"`
 }
@@ -143,7 +164,8 @@ func (o Options242415) Validate(fldPath *field.Path) field.ErrorList {
 		acceptedPodsPath = fldPath.Child("acceptedPods")
 	)
 	for idx, p := range o.AcceptedPods {
-		allErrs = append(allErrs, p.Validate(acceptedPodsPath.Index(idx))...)
+		allErrs = append(allErrs, p.PodSelector.Validate(acceptedPodsPath.Index(idx))...)
+		allErrs = append(allErrs, p.Expiration.Validate(acceptedPodsPath.Index(idx))...)
 		if len(p.EnvironmentVariables) == 0 {
 			allErrs = append(allErrs, field.Required(acceptedPodsPath.Index(idx).Child("environmentVariables"), "must not be empty"))
 		}
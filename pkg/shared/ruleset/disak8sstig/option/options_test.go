@@ -42,6 +42,18 @@ var _ = Describe("options", func() {
 			))
 		})
 	})
+	Describe("#FileOwnerOptionsOwners", func() {
+		It("should default the owners to root", func() {
+			var options *option.FileOwnerOptions
+			users, groups := options.Owners()
+			Expect(users).To(Equal([]string{"0"}))
+			Expect(groups).To(Equal([]string{"0"}))
+
+			users, groups = (&option.FileOwnerOptions{ExpectedFileOwner: option.ExpectedOwner{Groups: []string{"1000"}}}).Owners()
+			Expect(users).To(Equal([]string{"0"}))
+			Expect(groups).To(Equal([]string{"1000"}))
+		})
+	})
 	Describe("#ValidatePodSelector", func() {
 		It("should correctly validate labels", func() {
 			podAttributes := []option.PodSelector{
@@ -25,6 +25,8 @@ type Rule242376 struct {
 	Namespace      string
 	DeploymentName string
 	ContainerName  string
+	// Source resolves the component. Namespace, DeploymentName and ContainerName are not used when it is set.
+	Source kubeutils.ComponentSource
 }
 
 func (r *Rule242376) ID() string {
@@ -41,35 +43,23 @@ func (r *Rule242376) Severity() rule.SeverityLevel {
 
 func (r *Rule242376) Run(ctx context.Context) (rule.RuleResult, error) {
 	const option = "tls-min-version"
-	deploymentName := "kube-controller-manager"
-	containerName := "kube-controller-manager"
 
-	if r.DeploymentName != "" {
-		deploymentName = r.DeploymentName
-	}
+	source := componentSource(r.Source, r.Client, r.Namespace, r.DeploymentName, r.ContainerName, "kube-controller-manager")
 
-	if r.ContainerName != "" {
-		containerName = r.ContainerName
-	}
-
-	target := rule.NewTarget("name", deploymentName, "namespace", r.Namespace, "kind", "Deployment")
-
-	optSlice, err := kubeutils.GetCommandOptionFromDeployment(ctx, r.Client, deploymentName, containerName, r.Namespace, option)
-	if err != nil {
-		return rule.Result(r, rule.ErroredCheckResult(err.Error(), target)), nil
-	}
-
-	// empty options are allowed because min version defaults to TLS 1.2
-	switch {
-	case len(optSlice) == 0:
-		return rule.Result(r, rule.PassedCheckResult(fmt.Sprintf("Option %s has not been set.", option), target)), nil
-	case len(optSlice) > 1:
-		return rule.Result(r, rule.WarningCheckResult(fmt.Sprintf("Option %s has been set more than once in container command.", option), target)), nil
-	case slices.Contains([]string{"VersionTLS10", "VersionTLS11"}, optSlice[0]):
-		return rule.Result(r, rule.FailedCheckResult(fmt.Sprintf("Option %s set to not allowed value.", option), target)), nil
-	case slices.Contains([]string{"VersionTLS12", "VersionTLS13"}, optSlice[0]):
-		return rule.Result(r, rule.PassedCheckResult(fmt.Sprintf("Option %s set to allowed value.", option), target)), nil
-	default:
-		return rule.Result(r, rule.WarningCheckResult(fmt.Sprintf("Option %s has been set to unknown value.", option), target)), nil
-	}
+	return rule.Result(r, checkComponent(ctx, source, func(instance kubeutils.ComponentInstance, target rule.Target) []rule.CheckResult {
+		optSlice := instance.FlagValues(option)
+		// empty options are allowed because min version defaults to TLS 1.2
+		switch {
+		case len(optSlice) == 0:
+			return []rule.CheckResult{rule.PassedCheckResult(fmt.Sprintf("Option %s has not been set.", option), target)}
+		case len(optSlice) > 1:
+			return []rule.CheckResult{rule.WarningCheckResult(fmt.Sprintf("Option %s has been set more than once in container command.", option), target)}
+		case slices.Contains([]string{"VersionTLS10", "VersionTLS11"}, optSlice[0]):
+			return []rule.CheckResult{rule.FailedCheckResult(fmt.Sprintf("Option %s set to not allowed value.", option), target)}
+		case slices.Contains([]string{"VersionTLS12", "VersionTLS13"}, optSlice[0]):
+			return []rule.CheckResult{rule.PassedCheckResult(fmt.Sprintf("Option %s set to allowed value.", option), target)}
+		default:
+			return []rule.CheckResult{rule.WarningCheckResult(fmt.Sprintf("Option %s has been set to unknown value.", option), target)}
+		}
+	})...), nil
 }
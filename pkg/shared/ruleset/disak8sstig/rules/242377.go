@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kubeutils "github.com/gardener/diki/pkg/kubernetes/utils"
+	"github.com/gardener/diki/pkg/rule"
+)
+
+var (
+	_ rule.Rule     = &Rule242377{}
+	_ rule.Severity = &Rule242377{}
+)
+
+type Rule242377 struct {
+	Client         client.Client
+	Namespace      string
+	DeploymentName string
+	ContainerName  string
+	// Source resolves the component. Namespace, DeploymentName and ContainerName are not used when it is set.
+	Source kubeutils.ComponentSource
+}
+
+func (r *Rule242377) ID() string {
+	return ID242377
+}
+
+func (r *Rule242377) Name() string {
+	return "The Kubernetes Scheduler must use TLS 1.2, at a minimum, to protect the confidentiality of sensitive data during electronic dissemination."
+}
+
+func (r *Rule242377) Severity() rule.SeverityLevel {
+	return rule.SeverityMedium
+}
+
+func (r *Rule242377) Run(ctx context.Context) (rule.RuleResult, error) {
+	const option = "tls-min-version"
+
+	source := componentSource(r.Source, r.Client, r.Namespace, r.DeploymentName, r.ContainerName, "kube-scheduler")
+
+	return rule.Result(r, checkComponent(ctx, source, func(instance kubeutils.ComponentInstance, target rule.Target) []rule.CheckResult {
+		optSlice := instance.FlagValues(option)
+		// empty options are allowed because min version defaults to TLS 1.2
+		switch {
+		case len(optSlice) == 0:
+			return []rule.CheckResult{rule.PassedCheckResult(fmt.Sprintf("Option %s has not been set.", option), target)}
+		case len(optSlice) > 1:
+			return []rule.CheckResult{rule.WarningCheckResult(fmt.Sprintf("Option %s has been set more than once in container command.", option), target)}
+		case slices.Contains([]string{"VersionTLS10", "VersionTLS11"}, optSlice[0]):
+			return []rule.CheckResult{rule.FailedCheckResult(fmt.Sprintf("Option %s set to not allowed value.", option), target)}
+		case slices.Contains([]string{"VersionTLS12", "VersionTLS13"}, optSlice[0]):
+			return []rule.CheckResult{rule.PassedCheckResult(fmt.Sprintf("Option %s set to allowed value.", option), target)}
+		default:
+			return []rule.CheckResult{rule.WarningCheckResult(fmt.Sprintf("Option %s has been set to unknown value.", option), target)}
+		}
+	})...), nil
+}
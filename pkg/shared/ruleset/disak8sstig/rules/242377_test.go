@@ -0,0 +1,124 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rules_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kubeutils "github.com/gardener/diki/pkg/kubernetes/utils"
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/rules"
+)
+
+var _ = Describe("#242377", func() {
+	var (
+		fakeClient client.Client
+		ctx        = context.TODO()
+		staticPod  *corev1.Pod
+		source     kubeutils.ComponentSource
+		target     = rule.NewTarget("name", "kube-scheduler-node01", "namespace", "kube-system", "kind", "Pod")
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().Build()
+		source = &kubeutils.StaticPodSource{
+			Client:        fakeClient,
+			Namespace:     "kube-system",
+			Selector:      labels.SelectorFromSet(labels.Set{"component": "kube-scheduler", "tier": "control-plane"}),
+			ContainerName: "kube-scheduler",
+		}
+		staticPod = &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "kube-scheduler-node01",
+				Namespace: "kube-system",
+				Labels: map[string]string{
+					"component": "kube-scheduler",
+					"tier":      "control-plane",
+				},
+				Annotations: map[string]string{
+					corev1.MirrorPodAnnotationKey: "foo",
+				},
+			},
+			Spec: corev1.PodSpec{
+				NodeName: "node01",
+				Containers: []corev1.Container{
+					{
+						Name: "kube-scheduler",
+					},
+				},
+			},
+		}
+	})
+
+	It("should resolve the kube-scheduler from its deployment by default", func() {
+		r := &rules.Rule242377{Client: fakeClient, Namespace: "foo"}
+
+		ruleResult, err := r.Run(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ruleResult.CheckResults).To(Equal([]rule.CheckResult{
+			rule.ErroredCheckResult("deployments.apps \"kube-scheduler\" not found", rule.NewTarget("name", "kube-scheduler", "namespace", "foo", "kind", "Deployment")),
+		}))
+	})
+
+	It("should error when the static pods are not found", func() {
+		r := &rules.Rule242377{Source: source}
+
+		ruleResult, err := r.Run(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ruleResult.CheckResults).To(Equal([]rule.CheckResult{
+			{
+				Status:  rule.Errored,
+				Message: "pods not found",
+				Target:  rule.NewTarget("namespace", "kube-system", "selector", "component=kube-scheduler,tier=control-plane"),
+			},
+		}))
+	})
+
+	DescribeTable("Run cases",
+		func(command []string, expectedCheckResults []rule.CheckResult) {
+			staticPod.Spec.Containers[0].Command = command
+			Expect(fakeClient.Create(ctx, staticPod)).To(Succeed())
+
+			r := &rules.Rule242377{Source: source}
+			ruleResult, err := r.Run(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ruleResult.CheckResults).To(Equal(expectedCheckResults))
+		},
+
+		Entry("should pass when tls-min-version is not set",
+			[]string{"--foo=bar"},
+			[]rule.CheckResult{
+				{Status: rule.Passed, Message: "Option tls-min-version has not been set.", Target: target},
+			}),
+		Entry("should pass when tls-min-version is set to an allowed value",
+			[]string{"--tls-min-version=VersionTLS12"},
+			[]rule.CheckResult{
+				{Status: rule.Passed, Message: "Option tls-min-version set to allowed value.", Target: target},
+			}),
+		Entry("should fail when tls-min-version is set to a not allowed value",
+			[]string{"--tls-min-version=VersionTLS11"},
+			[]rule.CheckResult{
+				{Status: rule.Failed, Message: "Option tls-min-version set to not allowed value.", Target: target},
+			}),
+		Entry("should warn when tls-min-version is set to an unknown value",
+			[]string{"--tls-min-version=foo"},
+			[]rule.CheckResult{
+				{Status: rule.Warning, Message: "Option tls-min-version has been set to unknown value.", Target: target},
+			}),
+		Entry("should warn when tls-min-version is set more than once",
+			[]string{"--tls-min-version=VersionTLS12", "--tls-min-version=VersionTLS13"},
+			[]rule.CheckResult{
+				{Status: rule.Warning, Message: "Option tls-min-version has been set more than once in container command.", Target: target},
+			}),
+	)
+})
@@ -8,9 +8,6 @@ import (
 	"context"
 	"strings"
 
-	"gopkg.in/yaml.v3"
-	appsv1 "k8s.io/api/apps/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gardener/diki/pkg/kubernetes/config"
@@ -28,6 +25,9 @@ type Rule242380 struct {
 	Namespace             string
 	StatefulSetETCDMain   string
 	StatefulSetETCDEvents string
+	// Sources resolve the etcd instances. Namespace, StatefulSetETCDMain and
+	// StatefulSetETCDEvents are not used when they are set.
+	Sources []kubeutils.ComponentSource
 }
 
 func (r *Rule242380) ID() string {
@@ -43,54 +43,15 @@ func (r *Rule242380) Severity() rule.SeverityLevel {
 }
 
 func (r *Rule242380) Run(ctx context.Context) (rule.RuleResult, error) {
-	var checkResults []rule.CheckResult
-	etcdMain := "etcd-main"
-	etcdEvents := "etcd-events"
-
-	if r.StatefulSetETCDMain != "" {
-		etcdMain = r.StatefulSetETCDMain
-	}
-
-	if r.StatefulSetETCDEvents != "" {
-		etcdEvents = r.StatefulSetETCDEvents
-	}
-
-	checkResults = append(checkResults, r.checkStatefulSet(ctx, etcdMain))
-	checkResults = append(checkResults, r.checkStatefulSet(ctx, etcdEvents))
-
+	sources := etcdSources(r.Sources, r.Client, r.Namespace, r.StatefulSetETCDMain, r.StatefulSetETCDEvents)
+	checkResults := checkETCD(ctx, r.Client, sources, r.checkConfig, func(instance kubeutils.ComponentInstance, target rule.Target) rule.CheckResult {
+		// peer-auto-tls defaults to allowed value false
+		return checkBoolOption("peer-auto-tls", instance.FlagValues("peer-auto-tls"), false, rule.Passed, target)
+	})
 	return rule.Result(r, checkResults...), nil
 }
 
-func (r *Rule242380) checkStatefulSet(ctx context.Context, statefulSetName string) rule.CheckResult {
-	statefulSet := &appsv1.StatefulSet{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      statefulSetName,
-			Namespace: r.Namespace,
-		},
-	}
-
-	target := kubeutils.TargetWithK8sObject(rule.NewTarget(), metav1.TypeMeta{Kind: "StatefulSet"}, statefulSet.ObjectMeta)
-
-	if err := r.Client.Get(ctx, client.ObjectKeyFromObject(statefulSet), statefulSet); err != nil {
-		return rule.ErroredCheckResult(err.Error(), target)
-	}
-
-	volume, found := kubeutils.GetVolumeFromStatefulSet(statefulSet, "etcd-config-file")
-	if !found {
-		return rule.ErroredCheckResult("StatefulSet does not contain volume with name: etcd-config-file.", target)
-	}
-
-	configByteSlice, err := kubeutils.GetFileDataFromVolume(ctx, r.Client, r.Namespace, volume, "etcd.conf.yaml")
-	if err != nil {
-		return rule.ErroredCheckResult(err.Error(), target)
-	}
-
-	config := &config.EtcdConfig{}
-	err = yaml.Unmarshal(configByteSlice, config)
-	if err != nil {
-		return rule.ErroredCheckResult(err.Error(), target)
-	}
-
+func (r *Rule242380) checkConfig(config *config.EtcdConfig, target rule.Target) rule.CheckResult {
 	// We do not check the command-line flags and environment variables,
 	// since they are ignored when a config file is set. ref https://etcd.io/docs/v3.5/op-guide/configuration/
 	if len(strings.Split(config.InitialCluster, ",")) == 1 {
@@ -16,6 +16,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	kubeutils "github.com/gardener/diki/pkg/kubernetes/utils"
 	"github.com/gardener/diki/pkg/rule"
 	"github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/rules"
 )
@@ -192,4 +193,34 @@ peer-transport-security:
 			},
 			BeNil()),
 	)
+
+	describeStaticPodSource(func(c client.Client, sources []kubeutils.ComponentSource) rule.Rule {
+		return &rules.Rule242380{Client: c, Sources: sources}
+	},
+		Entry("should return passed when peer-auto-tls is not set",
+			[]string{"--foo=bar"},
+			[]rule.CheckResult{
+				{Status: rule.Passed, Message: "Option peer-auto-tls has not been set.", Target: staticPodTarget},
+			}),
+		Entry("should pass when peer-auto-tls is set to false",
+			[]string{"--peer-auto-tls=false"},
+			[]rule.CheckResult{
+				{Status: rule.Passed, Message: "Option peer-auto-tls set to allowed value.", Target: staticPodTarget},
+			}),
+		Entry("should fail when peer-auto-tls is set to true",
+			[]string{"--peer-auto-tls=true"},
+			[]rule.CheckResult{
+				{Status: rule.Failed, Message: "Option peer-auto-tls set to not allowed value.", Target: staticPodTarget},
+			}),
+		Entry("should warn when peer-auto-tls is set to a non boolean value",
+			[]string{"--peer-auto-tls=foo"},
+			[]rule.CheckResult{
+				{Status: rule.Warning, Message: "Option peer-auto-tls set to neither 'true' nor 'false'.", Target: staticPodTarget},
+			}),
+		Entry("should warn when peer-auto-tls is set more than once",
+			[]string{"--peer-auto-tls=false", "--peer-auto-tls=false"},
+			[]rule.CheckResult{
+				{Status: rule.Warning, Message: "Option peer-auto-tls has been set more than once in container command.", Target: staticPodTarget},
+			}),
+	)
 })
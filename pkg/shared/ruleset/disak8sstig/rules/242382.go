@@ -10,8 +10,6 @@ import (
 	"slices"
 	"strings"
 
-	appsv1 "k8s.io/api/apps/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	apiserverv1beta1 "k8s.io/apiserver/pkg/apis/apiserver/v1beta1"
 	"k8s.io/client-go/kubernetes/scheme"
@@ -33,6 +31,8 @@ type Rule242382 struct {
 	DeploymentName     string
 	ContainerName      string
 	ExpectedStartModes []string
+	// Source resolves the component. Namespace, DeploymentName and ContainerName are not used when it is set.
+	Source kubeutils.ComponentSource
 }
 
 func (r *Rule242382) ID() string {
@@ -52,97 +52,68 @@ func (r *Rule242382) Run(ctx context.Context) (rule.RuleResult, error) {
 		authorizationModeOpt   = "authorization-mode"
 		authorizationConfigOpt = "authorization-config"
 	)
-	var (
-		deploymentName     = "kube-apiserver"
-		containerName      = "kube-apiserver"
-		expectedStartModes = []string{"Node", "RBAC"}
-	)
-
-	if r.DeploymentName != "" {
-		deploymentName = r.DeploymentName
-	}
-
-	if r.ContainerName != "" {
-		containerName = r.ContainerName
-	}
+	expectedStartModes := []string{"Node", "RBAC"}
 
 	if len(r.ExpectedStartModes) != 0 {
 		expectedStartModes = r.ExpectedStartModes
 	}
 
-	target := rule.NewTarget("name", deploymentName, "namespace", r.Namespace, "kind", "Deployment")
-
-	authzConfigOptSlice, err := kubeutils.GetCommandOptionFromDeployment(ctx, r.Client, deploymentName, containerName, r.Namespace, authorizationConfigOpt)
-	if err != nil {
-		return rule.Result(r, rule.ErroredCheckResult(err.Error(), target)), nil
-	}
-
-	switch {
-	case len(authzConfigOptSlice) > 1:
-		return rule.Result(r, rule.WarningCheckResult(fmt.Sprintf("Option %s has been set more than once in container command.", authorizationConfigOpt), target)), nil
-	case len(authzConfigOptSlice) == 1 && strings.TrimSpace(authzConfigOptSlice[0]) == "":
-		return rule.Result(r, rule.FailedCheckResult(fmt.Sprintf("Option %s is empty.", authorizationConfigOpt), target)), nil
-	case len(authzConfigOptSlice) == 1:
-		return r.checkAuthzConfig(ctx, deploymentName, containerName, authzConfigOptSlice[0], expectedStartModes), nil
-	default:
-	}
-
-	authzModeOptSlice, err := kubeutils.GetCommandOptionFromDeployment(ctx, r.Client, deploymentName, containerName, r.Namespace, authorizationModeOpt)
-	if err != nil {
-		return rule.Result(r, rule.ErroredCheckResult(err.Error(), target)), nil
-	}
+	source := componentSource(r.Source, r.Client, r.Namespace, r.DeploymentName, r.ContainerName, "kube-apiserver")
+
+	return rule.Result(r, checkComponent(ctx, source, func(instance kubeutils.ComponentInstance, target rule.Target) []rule.CheckResult {
+		authzConfigOptSlice := instance.FlagValues(authorizationConfigOpt)
+		switch {
+		case len(authzConfigOptSlice) > 1:
+			return []rule.CheckResult{rule.WarningCheckResult(fmt.Sprintf("Option %s has been set more than once in container command.", authorizationConfigOpt), target)}
+		case len(authzConfigOptSlice) == 1 && strings.TrimSpace(authzConfigOptSlice[0]) == "":
+			return []rule.CheckResult{rule.FailedCheckResult(fmt.Sprintf("Option %s is empty.", authorizationConfigOpt), target)}
+		case len(authzConfigOptSlice) == 1:
+			return []rule.CheckResult{r.checkAuthzConfig(ctx, instance, authzConfigOptSlice[0], expectedStartModes)}
+		default:
+		}
 
-	// option defaults to not allowed value AlwaysAllow
-	switch {
-	case len(authzModeOptSlice) == 0:
-		return rule.Result(r, rule.FailedCheckResult(fmt.Sprintf("Option %s has not been set.", authorizationModeOpt), target)), nil
-	case len(authzModeOptSlice) > 1:
-		return rule.Result(r, rule.WarningCheckResult(fmt.Sprintf("Option %s has been set more than once in container command.", authorizationModeOpt), target)), nil
-	case slices.Contains(strings.Split(authzModeOptSlice[0], ","), "AlwaysAllow"):
-		return rule.Result(r, rule.FailedCheckResult(fmt.Sprintf("Option %s set to not allowed value.", authorizationModeOpt), target)), nil
-	case utils.StartsWith(strings.Split(authzModeOptSlice[0], ","), expectedStartModes...):
-		return rule.Result(r, rule.PassedCheckResult(fmt.Sprintf("Option %s set to expected value.", authorizationModeOpt), target)), nil
-	default:
-		return rule.Result(r, rule.FailedCheckResult(fmt.Sprintf("Option %s set to not expected value.", authorizationModeOpt), target)), nil
-	}
+		authzModeOptSlice := instance.FlagValues(authorizationModeOpt)
+		// option defaults to not allowed value AlwaysAllow
+		switch {
+		case len(authzModeOptSlice) == 0:
+			return []rule.CheckResult{rule.FailedCheckResult(fmt.Sprintf("Option %s has not been set.", authorizationModeOpt), target)}
+		case len(authzModeOptSlice) > 1:
+			return []rule.CheckResult{rule.WarningCheckResult(fmt.Sprintf("Option %s has been set more than once in container command.", authorizationModeOpt), target)}
+		case slices.Contains(strings.Split(authzModeOptSlice[0], ","), "AlwaysAllow"):
+			return []rule.CheckResult{rule.FailedCheckResult(fmt.Sprintf("Option %s set to not allowed value.", authorizationModeOpt), target)}
+		case utils.StartsWith(strings.Split(authzModeOptSlice[0], ","), expectedStartModes...):
+			return []rule.CheckResult{rule.PassedCheckResult(fmt.Sprintf("Option %s set to expected value.", authorizationModeOpt), target)}
+		default:
+			return []rule.CheckResult{rule.FailedCheckResult(fmt.Sprintf("Option %s set to not expected value.", authorizationModeOpt), target)}
+		}
+	})...), nil
 }
 
-func (r *Rule242382) checkAuthzConfig(ctx context.Context, deploymentName, containerName, volumePath string, expectedModes []string) rule.RuleResult {
-	deploymentTarget := rule.NewTarget("name", deploymentName, "namespace", r.Namespace, "kind", "Deployment")
+// checkAuthzConfig checks the authorization configuration file of a kube-apiserver instance.
+func (r *Rule242382) checkAuthzConfig(ctx context.Context, instance kubeutils.ComponentInstance, filePath string, expectedModes []string) rule.CheckResult {
 	authzConfigTarget := rule.NewTarget("kind", "AuthorizationConfiguration")
 
-	kubeAPIDeployment := &appsv1.Deployment{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      deploymentName,
-			Namespace: r.Namespace,
-		},
-	}
-	if err := r.Client.Get(ctx, client.ObjectKeyFromObject(kubeAPIDeployment), kubeAPIDeployment); err != nil {
-		return rule.Result(r, rule.ErroredCheckResult(err.Error(), deploymentTarget))
-	}
-
-	authorizationConfigByteSlice, err := kubeutils.GetVolumeConfigByteSliceByMountPath(ctx, r.Client, kubeAPIDeployment, containerName, volumePath)
+	authorizationConfigByteSlice, err := kubeutils.ReadComponentFile(ctx, r.Client, nil, instance, filePath)
 	if err != nil {
-		return rule.Result(r, rule.ErroredCheckResult(err.Error(), deploymentTarget))
+		return rule.ErroredCheckResult(err.Error(), instance.Target)
 	}
 
 	authorizationConfig := apiserverv1beta1.AuthorizationConfiguration{}
-	_, _, err = serializer.NewCodecFactory(scheme.Scheme).UniversalDeserializer().Decode(authorizationConfigByteSlice, nil, &authorizationConfig)
-	if err != nil {
-		return rule.Result(r, rule.ErroredCheckResult(err.Error(), authzConfigTarget))
+	if _, _, err = serializer.NewCodecFactory(scheme.Scheme).UniversalDeserializer().Decode(authorizationConfigByteSlice, nil, &authorizationConfig); err != nil {
+		return rule.ErroredCheckResult(err.Error(), authzConfigTarget)
 	}
 
 	var modes []string
 	for _, authorizer := range authorizationConfig.Authorizers {
 		if authorizer.Type == "AlwaysAllow" {
-			return rule.Result(r, rule.FailedCheckResult("AuthorizationConfiguration has not allowed mode type set.", authzConfigTarget))
+			return rule.FailedCheckResult("AuthorizationConfiguration has not allowed mode type set.", authzConfigTarget)
 		}
 		modes = append(modes, authorizer.Type)
 	}
 
 	if utils.StartsWith(modes, expectedModes...) {
-		return rule.Result(r, rule.PassedCheckResult("AuthorizationConfiguration has expected start mode types set.", authzConfigTarget))
+		return rule.PassedCheckResult("AuthorizationConfiguration has expected start mode types set.", authzConfigTarget)
 	}
 
-	return rule.Result(r, rule.FailedCheckResult("AuthorizationConfiguration does not have expected start mode types set.", authzConfigTarget))
+	return rule.FailedCheckResult("AuthorizationConfiguration does not have expected start mode types set.", authzConfigTarget)
 }
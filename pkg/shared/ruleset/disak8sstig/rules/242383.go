@@ -22,6 +22,7 @@ import (
 	kubeutils "github.com/gardener/diki/pkg/kubernetes/utils"
 	"github.com/gardener/diki/pkg/rule"
 	"github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/option"
+	sharedoption "github.com/gardener/diki/pkg/shared/ruleset/option"
 )
 
 var (
@@ -42,6 +43,7 @@ var _ option.Option = (*Options242383)(nil)
 
 type AcceptedResources242383 struct {
 	ObjectSelector
+	sharedoption.Expiration
 	Justification string `json:"justification" yaml:"justification"`
 	Status        string `json:"status" yaml:"status"`
 }
@@ -52,7 +54,8 @@ func (o Options242383) Validate(fldPath *field.Path) field.ErrorList {
 		acceptedResourcesPath = fldPath.Child("acceptedResources")
 	)
 	for idx, r := range o.AcceptedResources {
-		allErrs = append(allErrs, r.Validate(acceptedResourcesPath.Index(idx))...)
+		allErrs = append(allErrs, r.ObjectSelector.Validate(acceptedResourcesPath.Index(idx))...)
+		allErrs = append(allErrs, r.Expiration.Validate(acceptedResourcesPath.Index(idx))...)
 		if !slices.Contains([]string{"Passed", "Accepted"}, r.Status) && len(r.Status) > 0 {
 			allErrs = append(allErrs, field.Invalid(acceptedResourcesPath.Index(idx).Child("status"), r.Status, "must be one of 'Passed' or 'Accepted'"))
 		}
@@ -199,7 +202,7 @@ func (r *Rule242383) Run(ctx context.Context) (rule.RuleResult, error) {
 				if len(msg) == 0 {
 					msg = "Accepted user resource in system namespaces."
 				}
-				checkResults = append(checkResults, rule.AcceptedCheckResult(msg, target))
+				checkResults = append(checkResults, rule.AcceptedCheckResultWithAcceptance(msg, target, acceptedResource.Acceptance()))
 			default:
 				checkResults = append(checkResults, rule.WarningCheckResult(fmt.Sprintf("unrecognized status: %s", status), target))
 			}
@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"context"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kubeutils "github.com/gardener/diki/pkg/kubernetes/utils"
+	"github.com/gardener/diki/pkg/rule"
+)
+
+var (
+	_ rule.Rule     = &Rule242384{}
+	_ rule.Severity = &Rule242384{}
+)
+
+type Rule242384 struct {
+	Client         client.Client
+	Namespace      string
+	DeploymentName string
+	ContainerName  string
+	// Source resolves the component. Namespace, DeploymentName and ContainerName are not used when it is set.
+	Source kubeutils.ComponentSource
+}
+
+func (r *Rule242384) ID() string {
+	return ID242384
+}
+
+func (r *Rule242384) Name() string {
+	return "The Kubernetes Scheduler must have secure binding."
+}
+
+func (r *Rule242384) Severity() rule.SeverityLevel {
+	return rule.SeverityMedium
+}
+
+func (r *Rule242384) Run(ctx context.Context) (rule.RuleResult, error) {
+	const option = "bind-address"
+
+	source := componentSource(r.Source, r.Client, r.Namespace, r.DeploymentName, r.ContainerName, "kube-scheduler")
+
+	return rule.Result(r, checkComponent(ctx, source, func(instance kubeutils.ComponentInstance, target rule.Target) []rule.CheckResult {
+		optSlice := instance.FlagValues(option)
+		// option defaults to not allowed value 0.0.0.0
+		switch {
+		case len(optSlice) == 0:
+			return []rule.CheckResult{rule.FailedCheckResult(fmt.Sprintf("Option %s has not been set.", option), target)}
+		case len(optSlice) > 1:
+			return []rule.CheckResult{rule.WarningCheckResult(fmt.Sprintf("Option %s has been set more than once in container command.", option), target)}
+		case optSlice[0] == "127.0.0.1":
+			return []rule.CheckResult{rule.PassedCheckResult(fmt.Sprintf("Option %s set to allowed value.", option), target)}
+		default:
+			return []rule.CheckResult{rule.FailedCheckResult(fmt.Sprintf("Option %s set to not allowed value.", option), target)}
+		}
+	})...), nil
+}
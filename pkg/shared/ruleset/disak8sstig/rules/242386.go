@@ -24,6 +24,8 @@ type Rule242386 struct {
 	Namespace      string
 	DeploymentName string
 	ContainerName  string
+	// Source resolves the component. Namespace, DeploymentName and ContainerName are not used when it is set.
+	Source kubeutils.ComponentSource
 }
 
 func (r *Rule242386) ID() string {
@@ -40,27 +42,16 @@ func (r *Rule242386) Severity() rule.SeverityLevel {
 
 func (r *Rule242386) Run(ctx context.Context) (rule.RuleResult, error) {
 	const optName = "insecure-port"
-	deploymentName := "kube-apiserver"
-	containerName := "kube-apiserver"
 
-	if r.DeploymentName != "" {
-		deploymentName = r.DeploymentName
-	}
+	source := componentSource(r.Source, r.Client, r.Namespace, r.DeploymentName, r.ContainerName, "kube-apiserver")
 
-	if r.ContainerName != "" {
-		containerName = r.ContainerName
-	}
-	target := rule.NewTarget("kind", "Deployment", "name", deploymentName, "namespace", r.Namespace)
+	return rule.Result(r, checkComponent(ctx, source, func(instance kubeutils.ComponentInstance, target rule.Target) []rule.CheckResult {
+		insecurePortOptionSlice := instance.FlagValues(optName)
+		if len(insecurePortOptionSlice) == 0 {
+			return []rule.CheckResult{rule.PassedCheckResult(fmt.Sprintf("Option %s not set.", optName), target)}
+		}
 
-	insecurePortOptionSlice, err := kubeutils.GetCommandOptionFromDeployment(ctx, r.Client, deploymentName, containerName, r.Namespace, optName)
-	if err != nil {
-		return rule.Result(r, rule.ErroredCheckResult(err.Error(), target)), nil
-	}
-
-	if len(insecurePortOptionSlice) == 0 {
-		return rule.Result(r, rule.PassedCheckResult(fmt.Sprintf("Option %s not set.", optName), target)), nil
-	}
-
-	// insecure-port is deprecated but still needed for health checks. ref https://github.com/kubernetes/kubernetes/issues/43784
-	return rule.Result(r, rule.FailedCheckResult(fmt.Sprintf("Option %s set.", optName), target)), nil
+		// insecure-port is deprecated but still needed for health checks. ref https://github.com/kubernetes/kubernetes/issues/43784
+		return []rule.CheckResult{rule.FailedCheckResult(fmt.Sprintf("Option %s set.", optName), target)}
+	})...), nil
 }
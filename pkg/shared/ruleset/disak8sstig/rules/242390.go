@@ -10,8 +10,6 @@ import (
 	"slices"
 
 	"gopkg.in/yaml.v3"
-	appsv1 "k8s.io/api/apps/v1"
-	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	apiserverv1beta1 "k8s.io/apiserver/pkg/apis/apiserver/v1beta1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -19,6 +17,7 @@ import (
 	kubeutils "github.com/gardener/diki/pkg/kubernetes/utils"
 	"github.com/gardener/diki/pkg/rule"
 	"github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/option"
+	sharedoption "github.com/gardener/diki/pkg/shared/ruleset/option"
 )
 
 var (
@@ -32,7 +31,9 @@ type Rule242390 struct {
 	Namespace      string
 	DeploymentName string
 	ContainerName  string
-	Options        *Options242390
+	// Source resolves the component. Namespace, DeploymentName and ContainerName are not used when it is set.
+	Source  kubeutils.ComponentSource
+	Options *Options242390
 }
 
 type Options242390 struct {
@@ -40,6 +41,7 @@ type Options242390 struct {
 }
 
 type AcceptedEndpoint struct {
+	sharedoption.Expiration
 	Path string `yaml:"path" json:"path"`
 }
 
@@ -57,6 +59,7 @@ func (o Options242390) Validate(fldPath *field.Path) field.ErrorList {
 		if len(e.Path) == 0 {
 			allErrs = append(allErrs, field.Required(acceptedEndpointsPath.Index(i).Child("path"), "must not be empty"))
 		}
+		allErrs = append(allErrs, e.Expiration.Validate(acceptedEndpointsPath.Index(i))...)
 	}
 
 	return allErrs
@@ -80,93 +83,67 @@ func (r *Rule242390) Run(ctx context.Context) (rule.RuleResult, error) {
 		authenticationConfigOption = "authentication-config"
 	)
 
-	deploymentName := "kube-apiserver"
-	containerName := "kube-apiserver"
-
-	if r.DeploymentName != "" {
-		deploymentName = r.DeploymentName
-	}
-
-	if r.ContainerName != "" {
-		containerName = r.ContainerName
-	}
-	target := rule.NewTarget("name", deploymentName, "namespace", r.Namespace, "kind", "Deployment")
-
-	optSlice, err := kubeutils.GetCommandOptionFromDeployment(ctx, r.Client, deploymentName, containerName, r.Namespace, anonymousAuthOption)
-	if err != nil {
-		return rule.Result(r, rule.ErroredCheckResult(err.Error(), target)), nil
-	}
-
-	if len(optSlice) > 0 {
-		switch {
-		case len(optSlice) > 1:
-			return rule.Result(r, rule.WarningCheckResult(fmt.Sprintf("Option %s has been set more than once in container command.", anonymousAuthOption), target)), nil
-		case optSlice[0] == "true":
-			return rule.Result(r, rule.FailedCheckResult(fmt.Sprintf("Option %s set to not allowed value.", anonymousAuthOption), target)), nil
-		case optSlice[0] == "false":
-			return rule.Result(r, rule.PassedCheckResult(fmt.Sprintf("Option %s set to allowed value.", anonymousAuthOption), target)), nil
-		default:
-			return rule.Result(r, rule.WarningCheckResult(fmt.Sprintf("Option %s set to neither 'true' nor 'false'.", anonymousAuthOption), target)), nil
+	source := componentSource(r.Source, r.Client, r.Namespace, r.DeploymentName, r.ContainerName, "kube-apiserver")
+
+	return rule.Result(r, checkComponent(ctx, source, func(instance kubeutils.ComponentInstance, target rule.Target) []rule.CheckResult {
+		optSlice := instance.FlagValues(anonymousAuthOption)
+		if len(optSlice) > 0 {
+			switch {
+			case len(optSlice) > 1:
+				return []rule.CheckResult{rule.WarningCheckResult(fmt.Sprintf("Option %s has been set more than once in container command.", anonymousAuthOption), target)}
+			case optSlice[0] == "true":
+				return []rule.CheckResult{rule.FailedCheckResult(fmt.Sprintf("Option %s set to not allowed value.", anonymousAuthOption), target)}
+			case optSlice[0] == "false":
+				return []rule.CheckResult{rule.PassedCheckResult(fmt.Sprintf("Option %s set to allowed value.", anonymousAuthOption), target)}
+			default:
+				return []rule.CheckResult{rule.WarningCheckResult(fmt.Sprintf("Option %s set to neither 'true' nor 'false'.", anonymousAuthOption), target)}
+			}
 		}
-	}
-
-	optSlice, err = kubeutils.GetCommandOptionFromDeployment(ctx, r.Client, deploymentName, containerName, r.Namespace, authenticationConfigOption)
-	if err != nil {
-		return rule.Result(r, rule.ErroredCheckResult(err.Error(), target)), nil
-	}
-
-	if len(optSlice) == 0 {
-		return rule.Result(r, rule.WarningCheckResult(fmt.Sprintf("Neither options %s nor %s have been set.", anonymousAuthOption, authenticationConfigOption), target)), nil
-	}
 
-	if len(optSlice) > 1 {
-		return rule.Result(r, rule.WarningCheckResult(fmt.Sprintf("Option %s has been set more than once in container command.", authenticationConfigOption), target)), nil
-	}
-
-	kubeAPIServerDeployment := &appsv1.Deployment{
-		ObjectMeta: v1.ObjectMeta{
-			Name:      deploymentName,
-			Namespace: r.Namespace,
-		},
-	}
+		optSlice = instance.FlagValues(authenticationConfigOption)
 
-	if err := r.Client.Get(ctx, client.ObjectKeyFromObject(kubeAPIServerDeployment), kubeAPIServerDeployment); err != nil {
-		return rule.Result(r, rule.ErroredCheckResult(err.Error(), target)), nil
-	}
+		if len(optSlice) == 0 {
+			return []rule.CheckResult{rule.WarningCheckResult(fmt.Sprintf("Neither options %s nor %s have been set.", anonymousAuthOption, authenticationConfigOption), target)}
+		}
 
-	bytes, err := kubeutils.GetVolumeConfigByteSliceByMountPath(ctx, r.Client, kubeAPIServerDeployment, containerName, optSlice[0])
-	if err != nil {
-		return rule.Result(r, rule.ErroredCheckResult(err.Error(), target)), nil
-	}
+		if len(optSlice) > 1 {
+			return []rule.CheckResult{rule.WarningCheckResult(fmt.Sprintf("Option %s has been set more than once in container command.", authenticationConfigOption), target)}
+		}
 
-	authConfig := &apiserverv1beta1.AuthenticationConfiguration{}
-	if err := yaml.Unmarshal(bytes, authConfig); err != nil {
-		return rule.Result(r, rule.ErroredCheckResult(err.Error(), target)), nil
-	}
+		bytes, err := kubeutils.ReadComponentFile(ctx, r.Client, nil, instance, optSlice[0])
+		if err != nil {
+			return []rule.CheckResult{rule.ErroredCheckResult(err.Error(), target)}
+		}
 
-	switch {
-	case authConfig.Anonymous == nil:
-		return rule.Result(r, rule.FailedCheckResult("The authentication configuration does not explicitly disable anonymous authentication.", target)), nil
-	case authConfig.Anonymous != nil && authConfig.Anonymous.Enabled:
-		if r.Options == nil || len(authConfig.Anonymous.Conditions) == 0 {
-			return rule.Result(r, rule.FailedCheckResult("The authentication configuration has anonymous authentication enabled.", target)), nil
+		authConfig := &apiserverv1beta1.AuthenticationConfiguration{}
+		if err := yaml.Unmarshal(bytes, authConfig); err != nil {
+			return []rule.CheckResult{rule.ErroredCheckResult(err.Error(), target)}
 		}
 
-		var checkResults []rule.CheckResult
+		switch {
+		case authConfig.Anonymous == nil:
+			return []rule.CheckResult{rule.FailedCheckResult("The authentication configuration does not explicitly disable anonymous authentication.", target)}
+		case authConfig.Anonymous != nil && authConfig.Anonymous.Enabled:
+			if r.Options == nil || len(authConfig.Anonymous.Conditions) == 0 {
+				return []rule.CheckResult{rule.FailedCheckResult("The authentication configuration has anonymous authentication enabled.", target)}
+			}
 
-		for _, condition := range authConfig.Anonymous.Conditions {
-			endpointTarget := target.With("details", fmt.Sprintf("endpoint: %s", condition.Path))
-			if slices.ContainsFunc(r.Options.AcceptedEndpoints, func(acceptedPath AcceptedEndpoint) bool {
-				return acceptedPath.Path == condition.Path
-			}) {
-				checkResults = append(checkResults, rule.AcceptedCheckResult("Anonymous authentication is accepted for the specified endpoints of the kube-apiserver.", endpointTarget))
-			} else {
-				checkResults = append(checkResults, rule.FailedCheckResult("Anonymous authentication is enabled for specific endpoints of the kube-apiserver.", endpointTarget))
+			var checkResults []rule.CheckResult
+
+			for _, condition := range authConfig.Anonymous.Conditions {
+				endpointTarget := target.With("details", fmt.Sprintf("endpoint: %s", condition.Path))
+				if idx := slices.IndexFunc(r.Options.AcceptedEndpoints, func(acceptedPath AcceptedEndpoint) bool {
+					return acceptedPath.Path == condition.Path
+				}); idx >= 0 {
+					checkResults = append(checkResults, rule.AcceptedCheckResultWithAcceptance("Anonymous authentication is accepted for the specified endpoints of the kube-apiserver.", endpointTarget, r.Options.AcceptedEndpoints[idx].Acceptance()))
+				} else {
+					checkResults = append(checkResults, rule.FailedCheckResult("Anonymous authentication is enabled for specific endpoints of the kube-apiserver.", endpointTarget))
+				}
 			}
-		}
 
-		return rule.Result(r, checkResults...), nil
-	default:
-		return rule.Result(r, rule.PassedCheckResult("The authentication configuration has anonymous authentication disabled.", target)), nil
-	}
+			return checkResults
+		default:
+			return []rule.CheckResult{rule.PassedCheckResult("The authentication configuration has anonymous authentication disabled.", target)}
+		}
+	})...), nil
 }
@@ -6,6 +6,7 @@ package rules_test
 
 import (
 	"context"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -20,6 +21,7 @@ import (
 
 	"github.com/gardener/diki/pkg/rule"
 	"github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/rules"
+	sharedoption "github.com/gardener/diki/pkg/shared/ruleset/option"
 )
 
 var _ = Describe("#242390", func() {
@@ -50,6 +52,7 @@ anonymous:
 	var (
 		fakeClient client.Client
 		ctx        = context.TODO()
+		expiresAt  = time.Date(2027, time.January, 1, 0, 0, 0, 0, time.UTC)
 		namespace  = "foo"
 
 		ksDeployment *appsv1.Deployment
@@ -356,7 +359,8 @@ anonymous:
 						Path: "/readyz",
 					},
 					{
-						Path: "/livez",
+						Path:       "/livez",
+						Expiration: sharedoption.Expiration{Owner: "foo", ExpiresAt: &expiresAt},
 					},
 					{
 						Path: "/fooz",
@@ -365,7 +369,7 @@ anonymous:
 			},
 			[]rule.CheckResult{
 				{Status: rule.Accepted, Message: "Anonymous authentication is accepted for the specified endpoints of the kube-apiserver.", Target: target.With("details", "endpoint: /healthz")},
-				{Status: rule.Accepted, Message: "Anonymous authentication is accepted for the specified endpoints of the kube-apiserver.", Target: target.With("details", "endpoint: /livez")},
+				{Status: rule.Accepted, Message: "Anonymous authentication is accepted for the specified endpoints of the kube-apiserver.", Target: target.With("details", "endpoint: /livez"), Acceptance: &rule.Acceptance{Source: rule.AcceptanceSourceRuleOption, Owner: "foo", ExpiresAt: &expiresAt}},
 				{Status: rule.Accepted, Message: "Anonymous authentication is accepted for the specified endpoints of the kube-apiserver.", Target: target.With("details", "endpoint: /readyz")},
 			},
 			BeNil()),
@@ -498,7 +502,8 @@ anonymous:
 						Path: "",
 					},
 					{
-						Path: "/barz",
+						Path:       "/barz",
+						Expiration: sharedoption.Expiration{ExpiresAt: &expiresAt},
 					},
 				},
 			}
@@ -511,6 +516,11 @@ anonymous:
 					"Field":  Equal("foo.acceptedEndpoints[1].path"),
 					"Detail": Equal("must not be empty"),
 				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeRequired),
+					"Field":  Equal("foo.acceptedEndpoints[2].owner"),
+					"Detail": Equal("must be set when expiresAt is set"),
+				})),
 			))
 		})
 	})
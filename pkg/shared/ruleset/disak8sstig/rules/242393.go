@@ -39,6 +39,9 @@ type Rule242393 struct {
 	PodContext pod.PodContext
 	Options    *Options242393
 	Logger     provider.Logger
+	// PodExecutor checks the single host on which it executes commands.
+	// The nodes of the cluster are not checked when it is set.
+	PodExecutor pod.PodExecutor
 }
 
 type Options242393 struct {
@@ -64,6 +67,10 @@ func (r *Rule242393) Severity() rule.SeverityLevel {
 }
 
 func (r *Rule242393) Run(ctx context.Context) (rule.RuleResult, error) {
+	if r.PodExecutor != nil {
+		return rule.Result(r, r.checkHost(ctx, r.PodExecutor, rule.NewTarget(), rule.NewTarget())), nil
+	}
+
 	var (
 		checkResults []rule.CheckResult
 		nodeLabels   []string
@@ -121,31 +128,33 @@ func (r *Rule242393) Run(ctx context.Context) (rule.RuleResult, error) {
 			continue
 		}
 
-		commandResult, err := podExecutor.Execute(ctx, "/bin/sh", `ss -tulpn | grep "LISTEN" | grep -E ":22(\s|$)" || true`)
-		if err != nil {
-			checkResults = append(checkResults, rule.ErroredCheckResult(err.Error(), execPodTarget))
-			continue
-		}
-		if strings.TrimSpace(commandResult) != "" {
-			checkResults = append(checkResults, rule.FailedCheckResult("SSH daemon started on port 22", nodeTarget))
-			continue
-		}
+		execPodTarget = execPodTarget.With("name", pod.PodName(podExecutor, podName))
 
-		commandResult, err = podExecutor.Execute(ctx, "/bin/sh", `systemctl is-active sshd || true`)
-		if err != nil {
-			checkResults = append(checkResults, rule.ErroredCheckResult(err.Error(), execPodTarget))
-			continue
-		}
-		if strings.TrimSpace(strings.ToLower(commandResult)) == "inactive" {
-			checkResults = append(checkResults, rule.PassedCheckResult("SSH daemon service not installed", nodeTarget))
-			continue
-		}
-		if strings.TrimSpace(strings.ToLower(commandResult)) == "active" {
-			checkResults = append(checkResults, rule.FailedCheckResult("SSH daemon active", nodeTarget))
-			continue
-		}
-		checkResults = append(checkResults, rule.PassedCheckResult("SSH daemon inactive (or could not be probed)", nodeTarget))
+		checkResults = append(checkResults, r.checkHost(ctx, podExecutor, nodeTarget, execPodTarget))
 	}
 
 	return rule.Result(r, checkResults...), nil
 }
+
+// checkHost checks the sshd service of the host on which podExecutor executes commands.
+func (r *Rule242393) checkHost(ctx context.Context, podExecutor pod.PodExecutor, hostTarget, execPodTarget rule.Target) rule.CheckResult {
+	commandResult, err := podExecutor.Execute(ctx, "/bin/sh", `ss -tulpn | grep "LISTEN" | grep -E ":22(\s|$)" || true`)
+	if err != nil {
+		return rule.ErroredCheckResult(err.Error(), execPodTarget)
+	}
+	if strings.TrimSpace(commandResult) != "" {
+		return rule.FailedCheckResult("SSH daemon started on port 22", hostTarget)
+	}
+
+	commandResult, err = podExecutor.Execute(ctx, "/bin/sh", `systemctl is-active sshd || true`)
+	if err != nil {
+		return rule.ErroredCheckResult(err.Error(), execPodTarget)
+	}
+	if strings.TrimSpace(strings.ToLower(commandResult)) == "inactive" {
+		return rule.PassedCheckResult("SSH daemon service not installed", hostTarget)
+	}
+	if strings.TrimSpace(strings.ToLower(commandResult)) == "active" {
+		return rule.FailedCheckResult("SSH daemon active", hostTarget)
+	}
+	return rule.PassedCheckResult("SSH daemon inactive (or could not be probed)", hostTarget)
+}
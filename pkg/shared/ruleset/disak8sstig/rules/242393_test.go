@@ -122,4 +122,46 @@ var _ = Describe("#242393", func() {
 				rule.PassedCheckResult("SSH daemon inactive (or could not be probed)", rule.NewTarget("kind", "Node", "name", "node4")),
 			}),
 	)
+
+	It("should reference the pod which executed the commands in check results", func() {
+		r := &rules.Rule242393{
+			Logger:     testLogger,
+			InstanceID: instanceID,
+			Client:     fakeClient,
+			PodContext: fakepod.NewFakeSimplePodContext([][]string{{""}}, [][]error{{errors.New("foo")}}).WithPodName("diki-node-xxxxxxxxxx"),
+			Options:    &rules.Options242393{NodeGroupByLabels: []string{"foo"}},
+		}
+
+		ruleResult, err := r.Run(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ruleResult.CheckResults).To(ContainElement(
+			rule.ErroredCheckResult("foo", rule.NewTarget("name", "diki-node-xxxxxxxxxx", "namespace", "kube-system", "kind", "Pod")),
+		))
+	})
+
+	Describe("with a pod executor", func() {
+		DescribeTable("Run cases",
+			func(executeReturnString []string, executeReturnError []error, expectedCheckResults []rule.CheckResult) {
+				r := &rules.Rule242393{
+					PodExecutor: fakepod.NewFakePodExecutor(executeReturnString, executeReturnError),
+				}
+
+				ruleResult, err := r.Run(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(ruleResult.CheckResults).To(Equal(expectedCheckResults))
+			},
+			Entry("should fail when sshd listens on port 22",
+				[]string{"tcp LISTEN 0 128 0.0.0.0:22 0.0.0.0:*"}, []error{nil},
+				[]rule.CheckResult{rule.FailedCheckResult("SSH daemon started on port 22", rule.NewTarget())}),
+			Entry("should fail when sshd is active",
+				[]string{"", "active\n"}, []error{nil, nil},
+				[]rule.CheckResult{rule.FailedCheckResult("SSH daemon active", rule.NewTarget())}),
+			Entry("should pass when sshd is inactive",
+				[]string{"", "inactive\n"}, []error{nil, nil},
+				[]rule.CheckResult{rule.PassedCheckResult("SSH daemon service not installed", rule.NewTarget())}),
+			Entry("should return errored result when the command fails",
+				[]string{""}, []error{errors.New("foo")},
+				[]rule.CheckResult{rule.ErroredCheckResult("foo", rule.NewTarget())}),
+		)
+	})
 })
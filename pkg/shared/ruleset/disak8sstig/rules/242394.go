@@ -39,6 +39,12 @@ type Rule242394 struct {
 	PodContext pod.PodContext
 	Options    *Options242394
 	Logger     provider.Logger
+	// PodExecutor checks the single host on which it executes commands.
+	// The nodes of the cluster are not checked when it is set.
+	PodExecutor pod.PodExecutor
+	// Root is the directory in which the root filesystem of the host checked by PodExecutor is found.
+	// The unit files below it are inspected without a running systemd.
+	Root string
 }
 
 type Options242394 struct {
@@ -64,6 +70,10 @@ func (r *Rule242394) Severity() rule.SeverityLevel {
 }
 
 func (r *Rule242394) Run(ctx context.Context) (rule.RuleResult, error) {
+	if r.PodExecutor != nil {
+		return rule.Result(r, r.checkHost(ctx, r.PodExecutor, rule.NewTarget(), rule.NewTarget())), nil
+	}
+
 	var (
 		checkResults []rule.CheckResult
 		nodeLabels   []string
@@ -121,31 +131,37 @@ func (r *Rule242394) Run(ctx context.Context) (rule.RuleResult, error) {
 			continue
 		}
 
-		commandResult, err := podExecutor.Execute(ctx, "/bin/sh", `ss -tulpn | grep "LISTEN" | grep -E ":22(\s|$)" || true`)
-		if err != nil {
-			checkResults = append(checkResults, rule.ErroredCheckResult(err.Error(), execPodTarget))
-			continue
-		}
-		if strings.TrimSpace(commandResult) != "" {
-			checkResults = append(checkResults, rule.FailedCheckResult("SSH daemon started on port 22", nodeTarget))
-			continue
-		}
+		execPodTarget = execPodTarget.With("name", pod.PodName(podExecutor, podName))
 
-		commandResult, err = podExecutor.Execute(ctx, "/bin/sh", `systemctl is-enabled sshd || true`)
-		if err != nil {
-			if strings.HasSuffix(strings.TrimSpace(strings.ToLower(err.Error())), "no such file or directory") {
-				checkResults = append(checkResults, rule.PassedCheckResult("SSH daemon service not installed", nodeTarget))
-			} else {
-				checkResults = append(checkResults, rule.ErroredCheckResult(err.Error(), execPodTarget))
-			}
-			continue
-		}
-		if strings.TrimSpace(strings.ToLower(commandResult)) == "alias" {
-			checkResults = append(checkResults, rule.FailedCheckResult("SSH daemon enabled", nodeTarget))
-			continue
-		}
-		checkResults = append(checkResults, rule.PassedCheckResult("SSH daemon disabled (or could not be probed)", nodeTarget))
+		checkResults = append(checkResults, r.checkHost(ctx, podExecutor, nodeTarget, execPodTarget))
 	}
 
 	return rule.Result(r, checkResults...), nil
 }
+
+// checkHost checks the sshd service of the host on which podExecutor executes commands.
+func (r *Rule242394) checkHost(ctx context.Context, podExecutor pod.PodExecutor, hostTarget, execPodTarget rule.Target) rule.CheckResult {
+	commandResult, err := podExecutor.Execute(ctx, "/bin/sh", `ss -tulpn | grep "LISTEN" | grep -E ":22(\s|$)" || true`)
+	if err != nil {
+		return rule.ErroredCheckResult(err.Error(), execPodTarget)
+	}
+	if strings.TrimSpace(commandResult) != "" {
+		return rule.FailedCheckResult("SSH daemon started on port 22", hostTarget)
+	}
+
+	command := `systemctl is-enabled sshd || true`
+	if len(r.Root) > 0 && r.Root != "/" {
+		command = fmt.Sprintf("systemctl --root=%s is-enabled sshd || true", r.Root)
+	}
+	commandResult, err = podExecutor.Execute(ctx, "/bin/sh", command)
+	if err != nil {
+		if strings.HasSuffix(strings.TrimSpace(strings.ToLower(err.Error())), "no such file or directory") {
+			return rule.PassedCheckResult("SSH daemon service not installed", hostTarget)
+		}
+		return rule.ErroredCheckResult(err.Error(), execPodTarget)
+	}
+	if slices.Contains([]string{"enabled", "alias"}, strings.TrimSpace(strings.ToLower(commandResult))) {
+		return rule.FailedCheckResult("SSH daemon enabled", hostTarget)
+	}
+	return rule.PassedCheckResult("SSH daemon disabled (or could not be probed)", hostTarget)
+}
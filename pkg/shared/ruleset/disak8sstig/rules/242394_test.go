@@ -122,4 +122,37 @@ var _ = Describe("#242394", func() {
 				rule.PassedCheckResult("SSH daemon service not installed", rule.NewTarget("kind", "Node", "name", "node4")),
 			}),
 	)
+
+	Describe("with a pod executor", func() {
+		DescribeTable("Run cases",
+			func(executeReturnString []string, executeReturnError []error, expectedCheckResults []rule.CheckResult) {
+				r := &rules.Rule242394{
+					PodExecutor: fakepod.NewFakePodExecutor(executeReturnString, executeReturnError),
+					Root:        "/host",
+				}
+
+				ruleResult, err := r.Run(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(ruleResult.CheckResults).To(Equal(expectedCheckResults))
+			},
+			Entry("should fail when sshd is enabled",
+				[]string{"", "enabled\n"}, []error{nil, nil},
+				[]rule.CheckResult{rule.FailedCheckResult("SSH daemon enabled", rule.NewTarget())}),
+			Entry("should fail when sshd is an alias of an enabled service",
+				[]string{"", "alias\n"}, []error{nil, nil},
+				[]rule.CheckResult{rule.FailedCheckResult("SSH daemon enabled", rule.NewTarget())}),
+			Entry("should pass when sshd is disabled",
+				[]string{"", "disabled\n"}, []error{nil, nil},
+				[]rule.CheckResult{rule.PassedCheckResult("SSH daemon disabled (or could not be probed)", rule.NewTarget())}),
+			Entry("should pass when sshd is not installed",
+				[]string{"", ""}, []error{nil, errors.New("Failed to get unit file state for sshd.service: No such file or directory\n")},
+				[]rule.CheckResult{rule.PassedCheckResult("SSH daemon service not installed", rule.NewTarget())}),
+			Entry("should fail when sshd listens on port 22",
+				[]string{"tcp LISTEN 0 128 0.0.0.0:22 0.0.0.0:*"}, []error{nil},
+				[]rule.CheckResult{rule.FailedCheckResult("SSH daemon started on port 22", rule.NewTarget())}),
+			Entry("should return errored result when the command fails",
+				[]string{""}, []error{errors.New("foo")},
+				[]rule.CheckResult{rule.ErroredCheckResult("foo", rule.NewTarget())}),
+		)
+	})
 })
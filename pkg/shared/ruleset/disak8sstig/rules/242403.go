@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"strings"
 
 	"gopkg.in/yaml.v3"
 	appsv1 "k8s.io/api/apps/v1"
@@ -15,8 +16,10 @@ import (
 	auditv1 "k8s.io/apiserver/pkg/apis/audit/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/gardener/diki/pkg/kubernetes/pod"
 	kubeutils "github.com/gardener/diki/pkg/kubernetes/utils"
 	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/shared/provider"
 )
 
 var (
@@ -28,6 +31,14 @@ type Rule242403 struct {
 	Client         client.Client
 	Namespace      string
 	DeploymentName string
+	// Source resolves the kube-apiserver. The audit policy is then read from the file
+	// of the audit-policy-file option and Namespace and DeploymentName are not used.
+	Source kubeutils.ComponentSource
+	// InstanceID, PodContext and Logger are used to read the audit policy from
+	// the node of a kube-apiserver instance of the Source.
+	InstanceID string
+	PodContext pod.PodContext
+	Logger     provider.Logger
 }
 
 func (r *Rule242403) ID() string {
@@ -43,6 +54,10 @@ func (r *Rule242403) Severity() rule.SeverityLevel {
 }
 
 func (r *Rule242403) Run(ctx context.Context) (rule.RuleResult, error) {
+	if r.Source != nil {
+		return rule.Result(r, r.checkSource(ctx)...), nil
+	}
+
 	const (
 		mountName = "audit-policy-config"
 		fileName  = "audit-policy.yaml"
@@ -76,16 +91,50 @@ func (r *Rule242403) Run(ctx context.Context) (rule.RuleResult, error) {
 		return rule.Result(r, rule.ErroredCheckResult(err.Error(), target)), nil
 	}
 
+	return rule.Result(r, r.checkAuditPolicy(auditPolicyByteSlice, target)), nil
+}
+
+func (r *Rule242403) checkSource(ctx context.Context) []rule.CheckResult {
+	const option = "audit-policy-file"
+
+	fileReader := opsPodFileReader{
+		ruleID:     r.ID(),
+		instanceID: r.InstanceID,
+		client:     r.Client,
+		podContext: r.PodContext,
+		logger:     r.Logger,
+	}
+
+	return checkComponent(ctx, r.Source, func(instance kubeutils.ComponentInstance, target rule.Target) []rule.CheckResult {
+		optSlice := instance.FlagValues(option)
+		switch {
+		case len(optSlice) == 0:
+			return []rule.CheckResult{rule.FailedCheckResult(fmt.Sprintf("Option %s has not been set.", option), target)}
+		case len(optSlice) > 1:
+			return []rule.CheckResult{rule.WarningCheckResult(fmt.Sprintf("Option %s has been set more than once in container command.", option), target)}
+		case strings.TrimSpace(optSlice[0]) == "":
+			return []rule.CheckResult{rule.FailedCheckResult(fmt.Sprintf("Option %s is empty.", option), target)}
+		}
+
+		auditPolicyByteSlice, err := fileReader.read(ctx, instance, optSlice[0])
+		if err != nil {
+			return []rule.CheckResult{rule.ErroredCheckResult(err.Error(), target)}
+		}
+		return []rule.CheckResult{r.checkAuditPolicy(auditPolicyByteSlice, target)}
+	})
+}
+
+func (r *Rule242403) checkAuditPolicy(auditPolicyByteSlice []byte, target rule.Target) rule.CheckResult {
 	auditPolicy := &auditv1.Policy{}
-	if err = yaml.Unmarshal(auditPolicyByteSlice, auditPolicy); err != nil {
-		return rule.Result(r, rule.ErroredCheckResult(err.Error(), target)), nil
+	if err := yaml.Unmarshal(auditPolicyByteSlice, auditPolicy); err != nil {
+		return rule.ErroredCheckResult(err.Error(), target)
 	}
 
 	if r.isPolicyConformant(auditPolicy) {
-		return rule.Result(r, rule.PassedCheckResult("Audit log policy file is conformant with required specification.", target)), nil
+		return rule.PassedCheckResult("Audit log policy file is conformant with required specification.", target)
 	}
 
-	return rule.Result(r, rule.FailedCheckResult("Audit log policy file is not conformant with required specification.", target)), nil
+	return rule.FailedCheckResult("Audit log policy file is not conformant with required specification.", target)
 }
 
 func (r *Rule242403) isPolicyConformant(auditPolicy *auditv1.Policy) bool {
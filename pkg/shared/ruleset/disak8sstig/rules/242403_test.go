@@ -6,6 +6,7 @@ package rules_test
 
 import (
 	"context"
+	"errors"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -16,6 +17,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	fakestrgen "github.com/gardener/diki/pkg/internal/stringgen/fake"
+	fakepod "github.com/gardener/diki/pkg/kubernetes/pod/fake"
+	kubeutils "github.com/gardener/diki/pkg/kubernetes/utils"
 	"github.com/gardener/diki/pkg/rule"
 	"github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/rules"
 )
@@ -167,4 +171,107 @@ rules:
 			},
 			BeNil()),
 	)
+
+	Describe("with a static pod source", func() {
+		var (
+			kapiPod   *corev1.Pod
+			dikiPod   *corev1.Pod
+			source    kubeutils.ComponentSource
+			podTarget = rule.NewTarget("name", "kube-apiserver-node01", "namespace", "kube-system", "kind", "Pod")
+		)
+
+		BeforeEach(func() {
+			rules.Generator = &fakestrgen.FakeRandString{Rune: 'a'}
+			source = newStaticPodSource(fakeClient, "kube-apiserver", "kube-apiserver")
+
+			kapiPod = newStaticPod("kube-apiserver")
+			kapiPod.Spec.Containers[0].VolumeMounts = []corev1.VolumeMount{{Name: "audit", MountPath: "/etc/kubernetes/audit"}}
+			kapiPod.Spec.Volumes = []corev1.Volume{
+				{
+					Name: "audit",
+					VolumeSource: corev1.VolumeSource{
+						HostPath: &corev1.HostPathVolumeSource{
+							Path: "/etc/kubernetes/audit-policies",
+						},
+					},
+				},
+			}
+
+			dikiPod = &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "diki-242403-aaaaaaaaaa",
+					Namespace: "kube-system",
+				},
+				Status: corev1.PodStatus{
+					ContainerStatuses: []corev1.ContainerStatus{
+						{
+							Name:        "test",
+							ContainerID: "containerd://bar",
+						},
+					},
+				},
+			}
+		})
+
+		DescribeTable("Run cases",
+			func(command []string, executeReturnString [][]string, executeReturnError [][]error, expectedCheckResults []rule.CheckResult) {
+				kapiPod.Spec.Containers[0].Command = command
+				Expect(fakeClient.Create(ctx, kapiPod)).To(Succeed())
+				Expect(fakeClient.Create(ctx, dikiPod)).To(Succeed())
+
+				r := &rules.Rule242403{
+					Logger:     testLogger,
+					Client:     fakeClient,
+					Source:     source,
+					PodContext: fakepod.NewFakeSimplePodContext(executeReturnString, executeReturnError),
+				}
+
+				ruleResult, err := r.Run(ctx)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ruleResult.CheckResults).To(Equal(expectedCheckResults))
+			},
+			Entry("should fail when the audit policy file is not set",
+				[]string{"kube-apiserver"},
+				[][]string{},
+				[][]error{},
+				[]rule.CheckResult{
+					rule.FailedCheckResult("Option audit-policy-file has not been set.", podTarget),
+				}),
+			Entry("should warn when the audit policy file is set more than once",
+				[]string{"kube-apiserver", "--audit-policy-file=/etc/kubernetes/audit/policy.yaml", "--audit-policy-file=/etc/kubernetes/audit/policy.yaml"},
+				[][]string{},
+				[][]error{},
+				[]rule.CheckResult{
+					rule.WarningCheckResult("Option audit-policy-file has been set more than once in container command.", podTarget),
+				}),
+			Entry("should pass when the audit policy is conformant",
+				[]string{"kube-apiserver", "--audit-policy-file=/etc/kubernetes/audit/policy.yaml"},
+				[][]string{{allowedAuditPolicy}},
+				[][]error{{nil}},
+				[]rule.CheckResult{
+					rule.PassedCheckResult("Audit log policy file is conformant with required specification.", podTarget),
+				}),
+			Entry("should fail when the audit policy is not conformant",
+				[]string{"kube-apiserver", "--audit-policy-file=/etc/kubernetes/audit/policy.yaml"},
+				[][]string{{notAllowedRuleLevelAuditPolicy}},
+				[][]error{{nil}},
+				[]rule.CheckResult{
+					rule.FailedCheckResult("Audit log policy file is not conformant with required specification.", podTarget),
+				}),
+			Entry("should error when the audit policy file is not mounted",
+				[]string{"kube-apiserver", "--audit-policy-file=/etc/audit/policy.yaml"},
+				[][]string{{}},
+				[][]error{{}},
+				[]rule.CheckResult{
+					rule.ErroredCheckResult("cannot find volume with path /etc/audit/policy.yaml", podTarget),
+				}),
+			Entry("should error when the audit policy file cannot be read",
+				[]string{"kube-apiserver", "--audit-policy-file=/etc/kubernetes/audit/policy.yaml"},
+				[][]string{{""}},
+				[][]error{{errors.New("foo")}},
+				[]rule.CheckResult{
+					rule.ErroredCheckResult("foo", podTarget),
+				}),
+		)
+	})
 })
@@ -116,6 +116,8 @@ func (r *Rule242407) Run(ctx context.Context) (rule.RuleResult, error) {
 			continue
 		}
 
+		execPodTarget = execPodTarget.With("name", pod.PodName(podExecutor, podName))
+
 		if kubeletServicePath, err = podExecutor.Execute(ctx, "/bin/sh", "systemctl show -P FragmentPath kubelet.service"); err != nil {
 			checkResults = append(checkResults, rule.ErroredCheckResult(fmt.Sprintf("could not find kubelet.service path: %s", err.Error()), execPodTarget))
 			continue
@@ -20,6 +20,7 @@ import (
 	kubeutils "github.com/gardener/diki/pkg/kubernetes/utils"
 	"github.com/gardener/diki/pkg/rule"
 	"github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/option"
+	sharedoption "github.com/gardener/diki/pkg/shared/ruleset/option"
 )
 
 var (
@@ -40,6 +41,7 @@ var _ option.Option = (*Options242417)(nil)
 
 type AcceptedPods242417 struct {
 	option.PodSelector
+	sharedoption.Expiration
 	Justification string `json:"justification" yaml:"justification"`
 	Status        string `json:"status" yaml:"status"`
 }
@@ -51,7 +53,8 @@ func (o Options242417) Validate(fldPath *field.Path) field.ErrorList {
 	)
 
 	for idx, p := range o.AcceptedPods {
-		allErrs = append(allErrs, p.Validate(acceptedPodsPath.Index(idx))...)
+		allErrs = append(allErrs, p.PodSelector.Validate(acceptedPodsPath.Index(idx))...)
+		allErrs = append(allErrs, p.Expiration.Validate(acceptedPodsPath.Index(idx))...)
 		if !slices.Contains([]string{"Passed", "Accepted"}, p.Status) && len(p.Status) > 0 {
 			allErrs = append(allErrs, field.Invalid(acceptedPodsPath.Index(idx).Child("status"), p.Status, "must be one of 'Passed' or 'Accepted'"))
 		}
@@ -134,7 +137,7 @@ func (r *Rule242417) Run(ctx context.Context) (rule.RuleResult, error) {
 				if len(msg) == 0 {
 					msg = "Accepted user pod in system namespaces."
 				}
-				checkResults = append(checkResults, rule.AcceptedCheckResult(msg, target))
+				checkResults = append(checkResults, rule.AcceptedCheckResultWithAcceptance(msg, target, acceptedPod.Acceptance()))
 			default:
 				checkResults = append(checkResults, rule.WarningCheckResult(fmt.Sprintf("unrecognized status: %s", status), target))
 			}
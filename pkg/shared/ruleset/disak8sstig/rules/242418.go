@@ -27,6 +27,8 @@ type Rule242418 struct {
 	Namespace      string
 	DeploymentName string
 	ContainerName  string
+	// Source resolves the component. Namespace, DeploymentName and ContainerName are not used when it is set.
+	Source kubeutils.ComponentSource
 }
 
 func (r *Rule242418) ID() string {
@@ -42,57 +44,44 @@ func (r *Rule242418) Severity() rule.SeverityLevel {
 }
 
 func (r *Rule242418) Run(ctx context.Context) (rule.RuleResult, error) {
-	var (
-		option         = "tls-cipher-suites"
-		deploymentName = "kube-apiserver"
-		containerName  = "kube-apiserver"
-	)
-
-	if r.DeploymentName != "" {
-		deploymentName = r.DeploymentName
-	}
-
-	if r.ContainerName != "" {
-		containerName = r.ContainerName
-	}
-	target := rule.NewTarget("name", deploymentName, "namespace", r.Namespace, "kind", "Deployment")
-
-	optSlice, err := kubeutils.GetCommandOptionFromDeployment(ctx, r.Client, deploymentName, containerName, r.Namespace, option)
-	if err != nil {
-		return rule.Result(r, rule.ErroredCheckResult(err.Error(), target)), nil
-	}
-
-	if len(optSlice) == 0 {
-		return rule.Result(r, rule.WarningCheckResult(fmt.Sprintf("Option %s has not been set.", option), target)), nil
-	}
-
-	if len(optSlice) > 1 {
-		return rule.Result(r, rule.WarningCheckResult(fmt.Sprintf("Option %s has been set more than once in container command.", option), target)), nil
-	}
-
-	var (
-		ciphers         = strings.Split(optSlice[0], ",")
-		requiredCiphers = []string{
-			"TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256",
-			"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256",
-			"TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384",
-			"TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384",
+	const option = "tls-cipher-suites"
+
+	source := componentSource(r.Source, r.Client, r.Namespace, r.DeploymentName, r.ContainerName, "kube-apiserver")
+
+	return rule.Result(r, checkComponent(ctx, source, func(instance kubeutils.ComponentInstance, target rule.Target) []rule.CheckResult {
+		optSlice := instance.FlagValues(option)
+		if len(optSlice) == 0 {
+			return []rule.CheckResult{rule.WarningCheckResult(fmt.Sprintf("Option %s has not been set.", option), target)}
 		}
-		unallowedCiphers = []string{
-			"TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA",
-			"TLS_ECDHE_ECDSA_WITH_AES_256_CBC_SHA",
-			"TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA",
-			"TLS_ECDHE_RSA_WITH_AES_256_CBC_SHA",
+
+		if len(optSlice) > 1 {
+			return []rule.CheckResult{rule.WarningCheckResult(fmt.Sprintf("Option %s has been set more than once in container command.", option), target)}
 		}
-	)
 
-	for _, suite := range tls.InsecureCipherSuites() {
-		unallowedCiphers = append(unallowedCiphers, suite.Name)
-	}
+		var (
+			ciphers         = strings.Split(optSlice[0], ",")
+			requiredCiphers = []string{
+				"TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256",
+				"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256",
+				"TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384",
+				"TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384",
+			}
+			unallowedCiphers = []string{
+				"TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA",
+				"TLS_ECDHE_ECDSA_WITH_AES_256_CBC_SHA",
+				"TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA",
+				"TLS_ECDHE_RSA_WITH_AES_256_CBC_SHA",
+			}
+		)
+
+		for _, suite := range tls.InsecureCipherSuites() {
+			unallowedCiphers = append(unallowedCiphers, suite.Name)
+		}
 
-	if utils.Subset(requiredCiphers, ciphers) && !utils.Intersect(unallowedCiphers, ciphers) {
-		return rule.Result(r, rule.PassedCheckResult(fmt.Sprintf("Option %s set to allowed values.", option), target)), nil
-	}
+		if utils.Subset(requiredCiphers, ciphers) && !utils.Intersect(unallowedCiphers, ciphers) {
+			return []rule.CheckResult{rule.PassedCheckResult(fmt.Sprintf("Option %s set to allowed values.", option), target)}
+		}
 
-	return rule.Result(r, rule.FailedCheckResult(fmt.Sprintf("Option %s set to not allowed values.", option), target)), nil
+		return []rule.CheckResult{rule.FailedCheckResult(fmt.Sprintf("Option %s set to not allowed values.", option), target)}
+	})...), nil
 }
@@ -25,6 +25,8 @@ type Rule242419 struct {
 	Namespace      string
 	DeploymentName string
 	ContainerName  string
+	// Source resolves the component. Namespace, DeploymentName and ContainerName are not used when it is set.
+	Source kubeutils.ComponentSource
 }
 
 func (r *Rule242419) ID() string {
@@ -41,32 +43,21 @@ func (r *Rule242419) Severity() rule.SeverityLevel {
 
 func (r *Rule242419) Run(ctx context.Context) (rule.RuleResult, error) {
 	const option = "client-ca-file"
-	deploymentName := "kube-apiserver"
-	containerName := "kube-apiserver"
 
-	if r.DeploymentName != "" {
-		deploymentName = r.DeploymentName
-	}
-
-	if r.ContainerName != "" {
-		containerName = r.ContainerName
-	}
-	target := rule.NewTarget("name", deploymentName, "namespace", r.Namespace, "kind", "Deployment")
-
-	optSlice, err := kubeutils.GetCommandOptionFromDeployment(ctx, r.Client, deploymentName, containerName, r.Namespace, option)
-	if err != nil {
-		return rule.Result(r, rule.ErroredCheckResult(err.Error(), target)), nil
-	}
-
-	// setting option is required
-	switch {
-	case len(optSlice) == 0:
-		return rule.Result(r, rule.FailedCheckResult(fmt.Sprintf("Option %s has not been set.", option), target)), nil
-	case len(optSlice) > 1:
-		return rule.Result(r, rule.WarningCheckResult(fmt.Sprintf("Option %s has been set more than once in container command.", option), target)), nil
-	case strings.TrimSpace(optSlice[0]) == "":
-		return rule.Result(r, rule.FailedCheckResult(fmt.Sprintf("Option %s is empty.", option), target)), nil
-	default:
-		return rule.Result(r, rule.PassedCheckResult(fmt.Sprintf("Option %s set.", option), target)), nil
-	}
+	source := componentSource(r.Source, r.Client, r.Namespace, r.DeploymentName, r.ContainerName, "kube-apiserver")
+
+	return rule.Result(r, checkComponent(ctx, source, func(instance kubeutils.ComponentInstance, target rule.Target) []rule.CheckResult {
+		optSlice := instance.FlagValues(option)
+		// setting option is required
+		switch {
+		case len(optSlice) == 0:
+			return []rule.CheckResult{rule.FailedCheckResult(fmt.Sprintf("Option %s has not been set.", option), target)}
+		case len(optSlice) > 1:
+			return []rule.CheckResult{rule.WarningCheckResult(fmt.Sprintf("Option %s has been set more than once in container command.", option), target)}
+		case strings.TrimSpace(optSlice[0]) == "":
+			return []rule.CheckResult{rule.FailedCheckResult(fmt.Sprintf("Option %s is empty.", option), target)}
+		default:
+			return []rule.CheckResult{rule.PassedCheckResult(fmt.Sprintf("Option %s set.", option), target)}
+		}
+	})...), nil
 }
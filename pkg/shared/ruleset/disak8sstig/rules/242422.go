@@ -25,6 +25,8 @@ type Rule242422 struct {
 	Namespace      string
 	DeploymentName string
 	ContainerName  string
+	// Source resolves the component. Namespace, DeploymentName and ContainerName are not used when it is set.
+	Source kubeutils.ComponentSource
 }
 
 func (r *Rule242422) ID() string {
@@ -44,50 +46,36 @@ func (r *Rule242422) Run(ctx context.Context) (rule.RuleResult, error) {
 		certOptName = "tls-cert-file"
 		keyOptName  = "tls-private-key-file"
 	)
-	deploymentName := "kube-apiserver"
-	containerName := "kube-apiserver"
 
-	if r.DeploymentName != "" {
-		deploymentName = r.DeploymentName
-	}
-
-	if r.ContainerName != "" {
-		containerName = r.ContainerName
-	}
-	var checkResults []rule.CheckResult
-	target := rule.NewTarget("name", deploymentName, "namespace", r.Namespace, "kind", "Deployment")
-
-	tlsCertFileOptionSlice, err := kubeutils.GetCommandOptionFromDeployment(ctx, r.Client, deploymentName, containerName, r.Namespace, certOptName)
-	if err != nil {
-		return rule.Result(r, rule.ErroredCheckResult(err.Error(), target)), nil
-	}
-
-	switch {
-	case len(tlsCertFileOptionSlice) == 0:
-		checkResults = append(checkResults, rule.FailedCheckResult(fmt.Sprintf("Option %s has not been set.", certOptName), target))
-	case len(tlsCertFileOptionSlice) > 1:
-		checkResults = append(checkResults, rule.WarningCheckResult(fmt.Sprintf("Option %s has been set more than once in container command.", certOptName), target))
-	case strings.TrimSpace(tlsCertFileOptionSlice[0]) == "":
-		checkResults = append(checkResults, rule.FailedCheckResult(fmt.Sprintf("Option %s is empty.", certOptName), target))
-	default:
-		checkResults = append(checkResults, rule.PassedCheckResult(fmt.Sprintf("Option %s set.", certOptName), target))
-	}
-
-	tlsPrivateKeyFileOptionSlice, err := kubeutils.GetCommandOptionFromDeployment(ctx, r.Client, deploymentName, containerName, r.Namespace, keyOptName)
-	if err != nil {
-		return rule.Result(r, rule.ErroredCheckResult(err.Error(), target)), nil
-	}
-
-	switch {
-	case len(tlsPrivateKeyFileOptionSlice) == 0:
-		checkResults = append(checkResults, rule.FailedCheckResult(fmt.Sprintf("Option %s has not been set.", keyOptName), target))
-	case len(tlsPrivateKeyFileOptionSlice) > 1:
-		checkResults = append(checkResults, rule.WarningCheckResult(fmt.Sprintf("Option %s has been set more than once in container command.", keyOptName), target))
-	case strings.TrimSpace(tlsPrivateKeyFileOptionSlice[0]) == "":
-		checkResults = append(checkResults, rule.FailedCheckResult(fmt.Sprintf("Option %s is empty.", keyOptName), target))
-	default:
-		checkResults = append(checkResults, rule.PassedCheckResult(fmt.Sprintf("Option %s set.", keyOptName), target))
-	}
-
-	return rule.Result(r, checkResults...), nil
+	source := componentSource(r.Source, r.Client, r.Namespace, r.DeploymentName, r.ContainerName, "kube-apiserver")
+
+	return rule.Result(r, checkComponent(ctx, source, func(instance kubeutils.ComponentInstance, target rule.Target) []rule.CheckResult {
+		var checkResults []rule.CheckResult
+
+		tlsCertFileOptionSlice := instance.FlagValues(certOptName)
+		switch {
+		case len(tlsCertFileOptionSlice) == 0:
+			checkResults = append(checkResults, rule.FailedCheckResult(fmt.Sprintf("Option %s has not been set.", certOptName), target))
+		case len(tlsCertFileOptionSlice) > 1:
+			checkResults = append(checkResults, rule.WarningCheckResult(fmt.Sprintf("Option %s has been set more than once in container command.", certOptName), target))
+		case strings.TrimSpace(tlsCertFileOptionSlice[0]) == "":
+			checkResults = append(checkResults, rule.FailedCheckResult(fmt.Sprintf("Option %s is empty.", certOptName), target))
+		default:
+			checkResults = append(checkResults, rule.PassedCheckResult(fmt.Sprintf("Option %s set.", certOptName), target))
+		}
+
+		tlsPrivateKeyFileOptionSlice := instance.FlagValues(keyOptName)
+		switch {
+		case len(tlsPrivateKeyFileOptionSlice) == 0:
+			checkResults = append(checkResults, rule.FailedCheckResult(fmt.Sprintf("Option %s has not been set.", keyOptName), target))
+		case len(tlsPrivateKeyFileOptionSlice) > 1:
+			checkResults = append(checkResults, rule.WarningCheckResult(fmt.Sprintf("Option %s has been set more than once in container command.", keyOptName), target))
+		case strings.TrimSpace(tlsPrivateKeyFileOptionSlice[0]) == "":
+			checkResults = append(checkResults, rule.FailedCheckResult(fmt.Sprintf("Option %s is empty.", keyOptName), target))
+		default:
+			checkResults = append(checkResults, rule.PassedCheckResult(fmt.Sprintf("Option %s set.", keyOptName), target))
+		}
+
+		return checkResults
+	})...), nil
 }
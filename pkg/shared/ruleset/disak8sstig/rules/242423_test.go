@@ -16,6 +16,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	kubeutils "github.com/gardener/diki/pkg/kubernetes/utils"
 	"github.com/gardener/diki/pkg/rule"
 	"github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/rules"
 )
@@ -167,4 +168,34 @@ client-transport-security:
 			},
 			BeNil()),
 	)
+
+	describeStaticPodSource(func(c client.Client, sources []kubeutils.ComponentSource) rule.Rule {
+		return &rules.Rule242423{Client: c, Sources: sources}
+	},
+		Entry("should return failed when client-cert-auth is not set",
+			[]string{"--foo=bar"},
+			[]rule.CheckResult{
+				{Status: rule.Failed, Message: "Option client-cert-auth has not been set.", Target: staticPodTarget},
+			}),
+		Entry("should pass when client-cert-auth is set to true",
+			[]string{"--client-cert-auth=true"},
+			[]rule.CheckResult{
+				{Status: rule.Passed, Message: "Option client-cert-auth set to allowed value.", Target: staticPodTarget},
+			}),
+		Entry("should fail when client-cert-auth is set to false",
+			[]string{"--client-cert-auth=false"},
+			[]rule.CheckResult{
+				{Status: rule.Failed, Message: "Option client-cert-auth set to not allowed value.", Target: staticPodTarget},
+			}),
+		Entry("should warn when client-cert-auth is set to a non boolean value",
+			[]string{"--client-cert-auth=foo"},
+			[]rule.CheckResult{
+				{Status: rule.Warning, Message: "Option client-cert-auth set to neither 'true' nor 'false'.", Target: staticPodTarget},
+			}),
+		Entry("should warn when client-cert-auth is set more than once",
+			[]string{"--client-cert-auth=true", "--client-cert-auth=true"},
+			[]rule.CheckResult{
+				{Status: rule.Warning, Message: "Option client-cert-auth has been set more than once in container command.", Target: staticPodTarget},
+			}),
+	)
 })
@@ -16,6 +16,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	kubeutils "github.com/gardener/diki/pkg/kubernetes/utils"
 	"github.com/gardener/diki/pkg/rule"
 	"github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/rules"
 )
@@ -175,4 +176,29 @@ client-transport-security:
 			},
 			BeNil()),
 	)
+
+	describeStaticPodSource(func(c client.Client, sources []kubeutils.ComponentSource) rule.Rule {
+		return &rules.Rule242427{Client: c, Sources: sources}
+	},
+		Entry("should fail when key-file is not set",
+			[]string{"--foo=bar"},
+			[]rule.CheckResult{
+				{Status: rule.Failed, Message: "Option key-file has not been set.", Target: staticPodTarget},
+			}),
+		Entry("should pass when key-file is set",
+			[]string{"--key-file=/etc/kubernetes/pki/foo"},
+			[]rule.CheckResult{
+				{Status: rule.Passed, Message: "Option key-file set.", Target: staticPodTarget},
+			}),
+		Entry("should fail when key-file is empty",
+			[]string{"--key-file"},
+			[]rule.CheckResult{
+				{Status: rule.Failed, Message: "Option key-file is empty.", Target: staticPodTarget},
+			}),
+		Entry("should warn when key-file is set more than once",
+			[]string{"--key-file=foo", "--key-file=bar"},
+			[]rule.CheckResult{
+				{Status: rule.Warning, Message: "Option key-file has been set more than once in container command.", Target: staticPodTarget},
+			}),
+	)
 })
@@ -16,6 +16,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	kubeutils "github.com/gardener/diki/pkg/kubernetes/utils"
 	"github.com/gardener/diki/pkg/rule"
 	"github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/rules"
 )
@@ -174,4 +175,29 @@ client-transport-security:
 			},
 			BeNil()),
 	)
+
+	describeStaticPodSource(func(c client.Client, sources []kubeutils.ComponentSource) rule.Rule {
+		return &rules.Rule242428{Client: c, Sources: sources}
+	},
+		Entry("should fail when cert-file is not set",
+			[]string{"--foo=bar"},
+			[]rule.CheckResult{
+				{Status: rule.Failed, Message: "Option cert-file has not been set.", Target: staticPodTarget},
+			}),
+		Entry("should pass when cert-file is set",
+			[]string{"--cert-file=/etc/kubernetes/pki/foo"},
+			[]rule.CheckResult{
+				{Status: rule.Passed, Message: "Option cert-file set.", Target: staticPodTarget},
+			}),
+		Entry("should fail when cert-file is empty",
+			[]string{"--cert-file"},
+			[]rule.CheckResult{
+				{Status: rule.Failed, Message: "Option cert-file is empty.", Target: staticPodTarget},
+			}),
+		Entry("should warn when cert-file is set more than once",
+			[]string{"--cert-file=foo", "--cert-file=bar"},
+			[]rule.CheckResult{
+				{Status: rule.Warning, Message: "Option cert-file has been set more than once in container command.", Target: staticPodTarget},
+			}),
+	)
 })
@@ -16,6 +16,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	kubeutils "github.com/gardener/diki/pkg/kubernetes/utils"
 	"github.com/gardener/diki/pkg/rule"
 	"github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/rules"
 )
@@ -192,4 +193,29 @@ peer-transport-security:
 			},
 			BeNil()),
 	)
+
+	describeStaticPodSource(func(c client.Client, sources []kubeutils.ComponentSource) rule.Rule {
+		return &rules.Rule242432{Client: c, Sources: sources}
+	},
+		Entry("should fail when peer-cert-file is not set",
+			[]string{"--foo=bar"},
+			[]rule.CheckResult{
+				{Status: rule.Failed, Message: "Option peer-cert-file has not been set.", Target: staticPodTarget},
+			}),
+		Entry("should pass when peer-cert-file is set",
+			[]string{"--peer-cert-file=/etc/kubernetes/pki/foo"},
+			[]rule.CheckResult{
+				{Status: rule.Passed, Message: "Option peer-cert-file set.", Target: staticPodTarget},
+			}),
+		Entry("should fail when peer-cert-file is empty",
+			[]string{"--peer-cert-file"},
+			[]rule.CheckResult{
+				{Status: rule.Failed, Message: "Option peer-cert-file is empty.", Target: staticPodTarget},
+			}),
+		Entry("should warn when peer-cert-file is set more than once",
+			[]string{"--peer-cert-file=foo", "--peer-cert-file=bar"},
+			[]rule.CheckResult{
+				{Status: rule.Warning, Message: "Option peer-cert-file has been set more than once in container command.", Target: staticPodTarget},
+			}),
+	)
 })
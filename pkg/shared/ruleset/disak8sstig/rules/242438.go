@@ -25,6 +25,8 @@ type Rule242438 struct {
 	Namespace      string
 	DeploymentName string
 	ContainerName  string
+	// Source resolves the component. Namespace, DeploymentName and ContainerName are not used when it is set.
+	Source kubeutils.ComponentSource
 }
 
 func (r *Rule242438) ID() string {
@@ -41,40 +43,29 @@ func (r *Rule242438) Severity() rule.SeverityLevel {
 
 func (r *Rule242438) Run(ctx context.Context) (rule.RuleResult, error) {
 	const option = "request-timeout"
-	deploymentName := "kube-apiserver"
-	containerName := "kube-apiserver"
-
-	if r.DeploymentName != "" {
-		deploymentName = r.DeploymentName
-	}
-
-	if r.ContainerName != "" {
-		containerName = r.ContainerName
-	}
-	target := rule.NewTarget("name", deploymentName, "namespace", r.Namespace, "kind", "Deployment")
-
-	optSlice, err := kubeutils.GetCommandOptionFromDeployment(ctx, r.Client, deploymentName, containerName, r.Namespace, option)
-	if err != nil {
-		return rule.Result(r, rule.ErroredCheckResult(err.Error(), target)), nil
-	}
-
-	// if not set defaults to allowed value https://kubernetes.io/docs/reference/command-line-tools-reference/kube-apiserver/
-	if len(optSlice) == 0 {
-		return rule.Result(r, rule.PassedCheckResult(fmt.Sprintf("Option %s has not been set.", option), target.With("details", "defaults to 1m0s"))), nil
-	}
-
-	if len(optSlice) > 1 {
-		return rule.Result(r, rule.WarningCheckResult(fmt.Sprintf("Option %s has been set more than once in container command.", option), target)), nil
-	}
-
-	duration, err := time.ParseDuration(optSlice[0])
-	if err != nil {
-		return rule.Result(r, rule.ErroredCheckResult(err.Error(), target)), nil
-	}
-
-	if duration <= 0 {
-		return rule.Result(r, rule.FailedCheckResult(fmt.Sprintf("Option %s set to not allowed value.", option), target)), nil
-	}
-
-	return rule.Result(r, rule.PassedCheckResult(fmt.Sprintf("Option %s set to allowed value.", option), target)), nil
+
+	source := componentSource(r.Source, r.Client, r.Namespace, r.DeploymentName, r.ContainerName, "kube-apiserver")
+
+	return rule.Result(r, checkComponent(ctx, source, func(instance kubeutils.ComponentInstance, target rule.Target) []rule.CheckResult {
+		optSlice := instance.FlagValues(option)
+		// if not set defaults to allowed value https://kubernetes.io/docs/reference/command-line-tools-reference/kube-apiserver/
+		if len(optSlice) == 0 {
+			return []rule.CheckResult{rule.PassedCheckResult(fmt.Sprintf("Option %s has not been set.", option), target.With("details", "defaults to 1m0s"))}
+		}
+
+		if len(optSlice) > 1 {
+			return []rule.CheckResult{rule.WarningCheckResult(fmt.Sprintf("Option %s has been set more than once in container command.", option), target)}
+		}
+
+		duration, err := time.ParseDuration(optSlice[0])
+		if err != nil {
+			return []rule.CheckResult{rule.ErroredCheckResult(err.Error(), target)}
+		}
+
+		if duration <= 0 {
+			return []rule.CheckResult{rule.FailedCheckResult(fmt.Sprintf("Option %s set to not allowed value.", option), target)}
+		}
+
+		return []rule.CheckResult{rule.PassedCheckResult(fmt.Sprintf("Option %s set to allowed value.", option), target)}
+	})...), nil
 }
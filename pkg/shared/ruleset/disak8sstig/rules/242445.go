@@ -40,6 +40,9 @@ type Rule242445 struct {
 	Logger             provider.Logger
 	ETCDMainSelector   labels.Selector
 	ETCDEventsSelector labels.Selector
+	// Sources resolve the etcd instances whose files are checked.
+	// ETCDMainSelector and ETCDEventsSelector are not used when they are set.
+	Sources []kubeutils.ComponentSource
 }
 
 func (r *Rule242445) ID() string {
@@ -92,20 +95,24 @@ func (r *Rule242445) Run(ctx context.Context) (rule.RuleResult, error) {
 		return rule.Result(r, rule.ErroredCheckResult(err.Error(), target.With("kind", "PodList"))), nil
 	}
 
-	for _, podSelector := range podSelectors {
-		var pods []corev1.Pod
-		for _, p := range allPods {
-			if podSelector.Matches(labels.Set(p.Labels)) && p.Namespace == r.Namespace {
-				pods = append(pods, p)
+	if len(r.Sources) > 0 {
+		checkPods, checkResults = componentPods(ctx, r.Sources)
+	} else {
+		for _, podSelector := range podSelectors {
+			var pods []corev1.Pod
+			for _, p := range allPods {
+				if podSelector.Matches(labels.Set(p.Labels)) && p.Namespace == r.Namespace {
+					pods = append(pods, p)
+				}
 			}
-		}
 
-		if len(pods) == 0 {
-			checkResults = append(checkResults, rule.ErroredCheckResult("pods not found", target.With("namespace", r.Namespace, "selector", podSelector.String())))
-			continue
-		}
+			if len(pods) == 0 {
+				checkResults = append(checkResults, rule.ErroredCheckResult("pods not found", target.With("namespace", r.Namespace, "selector", podSelector.String())))
+				continue
+			}
 
-		checkPods = append(checkPods, pods...)
+			checkPods = append(checkPods, pods...)
+		}
 	}
 
 	if len(checkPods) == 0 {
@@ -151,9 +158,11 @@ func (r *Rule242445) Run(ctx context.Context) (rule.RuleResult, error) {
 			continue
 		}
 
+		execPodTarget = execPodTarget.With("name", pod.PodName(podExecutor, podName))
+
 		execPod := &corev1.Pod{
 			ObjectMeta: metav1.ObjectMeta{
-				Name:      podName,
+				Name:      execPodTarget["name"],
 				Namespace: "kube-system",
 			},
 		}
@@ -21,6 +21,7 @@ import (
 	fakestrgen "github.com/gardener/diki/pkg/internal/stringgen/fake"
 	"github.com/gardener/diki/pkg/kubernetes/pod"
 	fakepod "github.com/gardener/diki/pkg/kubernetes/pod/fake"
+	kubeutils "github.com/gardener/diki/pkg/kubernetes/utils"
 	"github.com/gardener/diki/pkg/rule"
 	"github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/option"
 	"github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/rules"
@@ -176,6 +177,29 @@ var _ = Describe("#242445", func() {
 		}))
 	})
 
+	It("should check the pods of the given sources", func() {
+		Expect(fakeClient.Create(ctx, Node)).To(Succeed())
+		fakePodContext = createStaticPodWithCAKey(ctx, fakeClient, plainPod, "etcd")
+		Expect(fakeClient.Create(ctx, dikiPod)).To(Succeed())
+
+		r := &rules.Rule242445{
+			Logger:     testLogger,
+			InstanceID: instanceID,
+			Client:     fakeClient,
+			PodContext: fakePodContext,
+			Sources:    []kubeutils.ComponentSource{newStaticPodSource(fakeClient, "etcd", "test"), newStaticPodSource(fakeClient, "etcd-events", "test")},
+		}
+
+		ruleResult, err := r.Run(ctx)
+		Expect(err).To(BeNil())
+
+		target := rule.NewTarget("name", nodeName, "namespace", "kube-system", "kind", "Node", "containerName", "test")
+		Expect(ruleResult.CheckResults).To(Equal([]rule.CheckResult{
+			rule.ErroredCheckResult("pods not found", rule.NewTarget("namespace", "kube-system", "selector", "component=etcd-events,tier=control-plane")),
+			rule.PassedCheckResult("File has expected owners", target.With("details", "fileName: /etc/kubernetes/pki/etcd/ca.key, ownerUser: 0, ownerGroup: 0")),
+		}))
+	})
+
 	DescribeTable("Run cases",
 		func(options *option.FileOwnerOptions, executeReturnString [][]string, executeReturnError [][]error, expectedCheckResults []rule.CheckResult) {
 			Expect(fakeClient.Create(ctx, Node)).To(Succeed())
@@ -41,6 +41,9 @@ type Rule242446 struct {
 	DeploymentNames []string
 	Options         *option.FileOwnerOptions
 	Logger          provider.Logger
+	// Sources resolve the control plane components whose files are checked.
+	// Namespace and DeploymentNames are not used when they are set.
+	Sources []kubeutils.ComponentSource
 }
 
 func (r *Rule242446) ID() string {
@@ -83,19 +86,23 @@ func (r *Rule242446) Run(ctx context.Context) (rule.RuleResult, error) {
 	}
 	var checkPods []corev1.Pod
 
-	for _, deploymentName := range deploymentNames {
-		pods, err := kubeutils.GetDeploymentPods(ctx, r.Client, deploymentName, r.Namespace)
-		if err != nil {
-			checkResults = append(checkResults, rule.ErroredCheckResult(err.Error(), target.With("kind", "PodList")))
-			continue
-		}
+	if len(r.Sources) > 0 {
+		checkPods, checkResults = componentPods(ctx, r.Sources)
+	} else {
+		for _, deploymentName := range deploymentNames {
+			pods, err := kubeutils.GetDeploymentPods(ctx, r.Client, deploymentName, r.Namespace)
+			if err != nil {
+				checkResults = append(checkResults, rule.ErroredCheckResult(err.Error(), target.With("kind", "PodList")))
+				continue
+			}
 
-		if len(pods) == 0 {
-			checkResults = append(checkResults, rule.ErroredCheckResult("pods not found for deployment", target.With("name", deploymentName, "kind", "Deployment", "namespace", r.Namespace)))
-			continue
-		}
+			if len(pods) == 0 {
+				checkResults = append(checkResults, rule.ErroredCheckResult("pods not found for deployment", target.With("name", deploymentName, "kind", "Deployment", "namespace", r.Namespace)))
+				continue
+			}
 
-		checkPods = append(checkPods, pods...)
+			checkPods = append(checkPods, pods...)
+		}
 	}
 
 	if len(checkPods) == 0 {
@@ -141,9 +148,11 @@ func (r *Rule242446) Run(ctx context.Context) (rule.RuleResult, error) {
 			continue
 		}
 
+		execPodTarget = execPodTarget.With("name", pod.PodName(podExecutor, podName))
+
 		execPod := &corev1.Pod{
 			ObjectMeta: metav1.ObjectMeta{
-				Name:      podName,
+				Name:      execPodTarget["name"],
 				Namespace: "kube-system",
 			},
 		}
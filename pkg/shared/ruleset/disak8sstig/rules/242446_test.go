@@ -21,6 +21,7 @@ import (
 	fakestrgen "github.com/gardener/diki/pkg/internal/stringgen/fake"
 	"github.com/gardener/diki/pkg/kubernetes/pod"
 	fakepod "github.com/gardener/diki/pkg/kubernetes/pod/fake"
+	kubeutils "github.com/gardener/diki/pkg/kubernetes/utils"
 	"github.com/gardener/diki/pkg/rule"
 	"github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/option"
 	"github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/rules"
@@ -315,6 +316,28 @@ var _ = Describe("#242446", func() {
 		}))
 	})
 
+	It("should check the pods of the given sources", func() {
+		fakePodContext = createStaticPodWithCAKey(ctx, fakeClient, plainPod, "kube-apiserver")
+		Expect(fakeClient.Create(ctx, dikiPod)).To(Succeed())
+
+		r := &rules.Rule242446{
+			Logger:     testLogger,
+			InstanceID: instanceID,
+			Client:     fakeClient,
+			PodContext: fakePodContext,
+			Sources:    []kubeutils.ComponentSource{newStaticPodSource(fakeClient, "kube-apiserver", "test"), newStaticPodSource(fakeClient, "kube-scheduler", "test")},
+		}
+
+		ruleResult, err := r.Run(ctx)
+		Expect(err).To(BeNil())
+
+		target := rule.NewTarget("name", nodeName, "namespace", "kube-system", "kind", "Node", "containerName", "test")
+		Expect(ruleResult.CheckResults).To(Equal([]rule.CheckResult{
+			rule.ErroredCheckResult("pods not found", rule.NewTarget("namespace", "kube-system", "selector", "component=kube-scheduler,tier=control-plane")),
+			rule.PassedCheckResult("File has expected owners", target.With("details", "fileName: /etc/kubernetes/pki/etcd/ca.key, ownerUser: 0, ownerGroup: 0")),
+		}))
+	})
+
 	DescribeTable("Run cases",
 		func(options *option.FileOwnerOptions, executeReturnString [][]string, executeReturnError [][]error, expectedCheckResults []rule.CheckResult) {
 			Expect(fakeClient.Create(ctx, kubeAPIServerPod)).To(Succeed())
@@ -219,7 +219,7 @@ var _ = Describe("#242447", func() {
 		}
 		Expect(fakeClient.Create(ctx, nonValidContainerPod)).To(Succeed())
 
-		fakePodContext = fakepod.NewFakeSimplePodContext([][]string{{mounts, compliantConfigStats, compliantKubeconfigStats, mounts, nonCompliantConfigStats, kubeProxyConfig, nonCompliantKubeconfigStats2}},
+		fakePodContext = fakepod.NewFakeSimplePodContext([][]string{{mounts, compliantConfigStats, compliantKubeconfigStats, mounts, kubeProxyConfig, nonCompliantConfigStats, nonCompliantKubeconfigStats2}},
 			[][]error{{nil, nil, nil, nil, nil, nil, nil}})
 		r := &rules.Rule242447{
 			Logger:     testLogger,
@@ -266,7 +266,7 @@ var _ = Describe("#242447", func() {
 			Expect(ruleResult.CheckResults).To(Equal(expectedCheckResults))
 		},
 		Entry("should return passed checkResults when files have expected permissions", nil,
-			[][]string{{mounts, compliantConfigStats, compliantKubeconfigStats, mounts, compliantConfigStats, kubeProxyConfig, compliantKubeconfigStats2}},
+			[][]string{{mounts, compliantConfigStats, compliantKubeconfigStats, mounts, kubeProxyConfig, compliantConfigStats, compliantKubeconfigStats2}},
 			[][]error{{nil, nil, nil, nil, nil, nil, nil}},
 			[]rule.CheckResult{
 				rule.PassedCheckResult("File has expected permissions", rule.NewTarget("name", "kube-proxy1", "namespace", "kube-system", "kind", "DaemonSet", "details", "fileName: /var/lib/config, permissions: 644")),
@@ -275,7 +275,7 @@ var _ = Describe("#242447", func() {
 				rule.PassedCheckResult("File has expected permissions", rule.NewTarget("name", "kube-proxy2", "namespace", "kube-system", "kind", "DaemonSet", "details", "fileName: /var/lib/kubeconfig2, permissions: 600")),
 			}),
 		Entry("should return failed checkResults when files have too wide permissions", nil,
-			[][]string{{mounts, nonCompliantConfigStats, nonCompliantKubeconfigStats, mounts, nonCompliantConfigStats, kubeProxyConfig, nonCompliantKubeconfigStats2}},
+			[][]string{{mounts, nonCompliantConfigStats, nonCompliantKubeconfigStats, mounts, kubeProxyConfig, nonCompliantConfigStats, nonCompliantKubeconfigStats2}},
 			[][]error{{nil, nil, nil, nil, nil, nil, nil}},
 			[]rule.CheckResult{
 				rule.FailedCheckResult("File has too wide permissions", rule.NewTarget("name", "kube-proxy1", "namespace", "kube-system", "kind", "DaemonSet", "details", "fileName: /var/lib/config, permissions: 664, expectedPermissionsMax: 644")),
@@ -296,7 +296,7 @@ var _ = Describe("#242447", func() {
 				rule.PassedCheckResult("File has expected permissions", rule.NewTarget("name", "kube-proxy1", "namespace", "kube-system", "kind", "DaemonSet", "details", "fileName: /var/lib/kubeconfig, permissions: 600")),
 			}),
 		Entry("should return passed when kubeconfig is created by token", nil,
-			[][]string{{mounts, compliantConfigStats, compliantKubeconfigStats, mounts, compliantConfigStats, ""}},
+			[][]string{{mounts, compliantConfigStats, compliantKubeconfigStats, mounts, ""}},
 			[][]error{{nil, nil, nil, nil, nil, nil}},
 			[]rule.CheckResult{
 				rule.PassedCheckResult("File has expected permissions", rule.NewTarget("name", "kube-proxy1", "namespace", "kube-system", "kind", "DaemonSet", "details", "fileName: /var/lib/config, permissions: 644")),
@@ -7,6 +7,7 @@ package rules
 import (
 	"cmp"
 	"context"
+	"errors"
 	"fmt"
 	"slices"
 	"strings"
@@ -149,9 +150,11 @@ func (r *Rule242448) Run(ctx context.Context) (rule.RuleResult, error) {
 			continue
 		}
 
+		execPodTarget = execPodTarget.With("name", pod.PodName(podExecutor, podName))
+
 		execPod := &corev1.Pod{
 			ObjectMeta: metav1.ObjectMeta{
-				Name:      podName,
+				Name:      execPodTarget["name"],
 				Namespace: "kube-system",
 			},
 		}
@@ -171,8 +174,7 @@ func (r *Rule242448) Run(ctx context.Context) (rule.RuleResult, error) {
 
 		for _, pod := range pods {
 			var (
-				selectedFileStats []intutils.FileStats
-				podTarget         = kubeutils.TargetWithPod(rule.NewTarget(), pod, replicaSets)
+				podTarget = kubeutils.TargetWithPod(rule.NewTarget(), pod, replicaSets)
 			)
 
 			rawKubeProxyCommand, err := kubeutils.GetContainerCommand(pod, kubeProxyContainerNames...)
@@ -205,6 +207,7 @@ func (r *Rule242448) Run(ctx context.Context) (rule.RuleResult, error) {
 				continue
 			}
 
+			var filePaths []string
 			if len(configPath) != 0 {
 				configSourcePath, err := kubeutils.FindFileMountSource(configPath, kubeProxyMounts)
 				if err != nil {
@@ -212,13 +215,7 @@ func (r *Rule242448) Run(ctx context.Context) (rule.RuleResult, error) {
 					continue
 				}
 
-				configFileStats, err := intutils.GetSingleFileStats(ctx, podExecutor, configSourcePath)
-				if err != nil {
-					checkResults = append(checkResults, rule.ErroredCheckResult(err.Error(), execPodTarget))
-					continue
-				}
-
-				selectedFileStats = append(selectedFileStats, configFileStats)
+				filePaths = append(filePaths, configSourcePath)
 
 				// if the --kubeconfig path is not set then we read the configfile to get the kubeconfig
 				// https://github.com/kubernetes/kubernetes/blob/2016fab3085562b4132e6d3774b6ded5ba9939fd/cmd/kube-proxy/app/server.go#L775
@@ -244,14 +241,17 @@ func (r *Rule242448) Run(ctx context.Context) (rule.RuleResult, error) {
 				continue
 			}
 
-			kubeconfigFileStats, err := intutils.GetSingleFileStats(ctx, podExecutor, kubeconfigSourcePath)
+			filePaths = append(filePaths, kubeconfigSourcePath)
+
+			selectedFileStats, errs, err := intutils.GetFilesStats(ctx, podExecutor, filePaths)
+			if err == nil {
+				err = errors.Join(errs...)
+			}
 			if err != nil {
 				checkResults = append(checkResults, rule.ErroredCheckResult(err.Error(), execPodTarget))
 				continue
 			}
 
-			selectedFileStats = append(selectedFileStats, kubeconfigFileStats)
-
 			for _, fileStats := range selectedFileStats {
 				checkResults = append(checkResults,
 					intutils.MatchFileOwnersCases(fileStats, options.ExpectedFileOwner.Users, options.ExpectedFileOwner.Groups, podTarget)...)
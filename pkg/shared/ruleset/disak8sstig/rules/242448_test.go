@@ -220,7 +220,7 @@ var _ = Describe("#242448", func() {
 		}
 		Expect(fakeClient.Create(ctx, nonValidContainerPod)).To(Succeed())
 
-		fakePodContext = fakepod.NewFakeSimplePodContext([][]string{{mounts, compliantConfigStats, compliantKubeconfigStats, mounts, nonCompliantConfigStats, kubeProxyConfig, nonCompliantKubeconfigStats2}},
+		fakePodContext = fakepod.NewFakeSimplePodContext([][]string{{mounts, compliantConfigStats, compliantKubeconfigStats, mounts, kubeProxyConfig, nonCompliantConfigStats, nonCompliantKubeconfigStats2}},
 			[][]error{{nil, nil, nil, nil, nil, nil, nil}})
 		r := &rules.Rule242448{
 			Logger:     testLogger,
@@ -267,7 +267,7 @@ var _ = Describe("#242448", func() {
 			Expect(ruleResult.CheckResults).To(Equal(expectedCheckResults))
 		},
 		Entry("should return passed checkResults when files have expected permissions", nil,
-			[][]string{{mounts, compliantConfigStats, compliantKubeconfigStats, mounts, compliantConfigStats, kubeProxyConfig, compliantKubeconfigStats2}},
+			[][]string{{mounts, compliantConfigStats, compliantKubeconfigStats, mounts, kubeProxyConfig, compliantConfigStats, compliantKubeconfigStats2}},
 			[][]error{{nil, nil, nil, nil, nil, nil, nil}},
 			[]rule.CheckResult{
 				rule.PassedCheckResult("File has expected owners", rule.NewTarget("name", "kube-proxy1", "namespace", "kube-system", "kind", "DaemonSet", "details", "fileName: /var/lib/config, ownerUser: 0, ownerGroup: 0")),
@@ -276,7 +276,7 @@ var _ = Describe("#242448", func() {
 				rule.PassedCheckResult("File has expected owners", rule.NewTarget("name", "kube-proxy2", "namespace", "kube-system", "kind", "DaemonSet", "details", "fileName: /var/lib/kubeconfig2, ownerUser: 0, ownerGroup: 0")),
 			}),
 		Entry("should return failed checkResults when files have too wide permissions", nil,
-			[][]string{{mounts, nonCompliantConfigStats, nonCompliantKubeconfigStats, mounts, nonCompliantConfigStats, kubeProxyConfig, nonCompliantKubeconfigStats2}},
+			[][]string{{mounts, nonCompliantConfigStats, nonCompliantKubeconfigStats, mounts, kubeProxyConfig, nonCompliantConfigStats, nonCompliantKubeconfigStats2}},
 			[][]error{{nil, nil, nil, nil, nil, nil, nil}},
 			[]rule.CheckResult{
 				rule.FailedCheckResult("File has unexpected owner user", rule.NewTarget("name", "kube-proxy1", "namespace", "kube-system", "kind", "DaemonSet", "details", "fileName: /var/lib/config, ownerUser: 1000, expectedOwnerUsers: [0]")),
@@ -305,7 +305,7 @@ var _ = Describe("#242448", func() {
 					},
 				},
 			},
-			[][]string{{mounts, nonCompliantConfigStats, nonCompliantKubeconfigStats, mounts, nonCompliantConfigStats, kubeProxyConfig, nonCompliantKubeconfigStats2}},
+			[][]string{{mounts, nonCompliantConfigStats, nonCompliantKubeconfigStats, mounts, kubeProxyConfig, nonCompliantConfigStats, nonCompliantKubeconfigStats2}},
 			[][]error{{nil, nil, nil, nil, nil, nil, nil}},
 			[]rule.CheckResult{
 				rule.PassedCheckResult("File has expected owners", rule.NewTarget("name", "kube-proxy1", "namespace", "kube-system", "kind", "DaemonSet", "details", "fileName: /var/lib/config, ownerUser: 1000, ownerGroup: 0")),
@@ -314,7 +314,7 @@ var _ = Describe("#242448", func() {
 				rule.FailedCheckResult("File has unexpected owner group", rule.NewTarget("name", "kube-proxy2", "namespace", "kube-system", "kind", "DaemonSet", "details", "fileName: /var/lib/kubeconfig2, ownerGroup: 1000, expectedOwnerGroups: [0 2000]")),
 			}),
 		Entry("should return passed when kubeconfig is created by token", nil,
-			[][]string{{mounts, compliantConfigStats, compliantKubeconfigStats, mounts, compliantConfigStats, ""}},
+			[][]string{{mounts, compliantConfigStats, compliantKubeconfigStats, mounts, ""}},
 			[][]error{{nil, nil, nil, nil, nil, nil}},
 			[]rule.CheckResult{
 				rule.PassedCheckResult("File has expected owners", rule.NewTarget("name", "kube-proxy1", "namespace", "kube-system", "kind", "DaemonSet", "details", "fileName: /var/lib/config, ownerUser: 0, ownerGroup: 0")),
@@ -116,6 +116,8 @@ func (r *Rule242449) Run(ctx context.Context) (rule.RuleResult, error) {
 			continue
 		}
 
+		execPodTarget = execPodTarget.With("name", pod.PodName(podExecutor, podName))
+
 		rawKubeletCommand, err := kubeutils.GetKubeletCommand(ctx, podExecutor)
 		if err != nil {
 			checkResults = append(checkResults, rule.ErroredCheckResult(err.Error(), execPodTarget))
@@ -136,10 +138,10 @@ func (r *Rule242449) Run(ctx context.Context) (rule.RuleResult, error) {
 		var kubeletClientCAFile string
 		switch {
 		case kubeletConfig.Authentication.X509.ClientCAFile == nil:
-			checkResults = append(checkResults, rule.FailedCheckResult("could not find client ca path: client-ca-file not set.", execPodTarget))
+			checkResults = append(checkResults, rule.FailedCheckResult("could not find client ca path: client-ca-file not set.", execPodTarget).WithEvidence(ctx, "kubelet-config", kubeletConfig))
 			continue
 		case strings.TrimSpace(*kubeletConfig.Authentication.X509.ClientCAFile) == "":
-			checkResults = append(checkResults, rule.FailedCheckResult("could not find client ca path: client-ca-file is empty.", execPodTarget))
+			checkResults = append(checkResults, rule.FailedCheckResult("could not find client ca path: client-ca-file is empty.", execPodTarget).WithEvidence(ctx, "kubelet-config", kubeletConfig))
 			continue
 		default:
 			kubeletClientCAFile = *kubeletConfig.Authentication.X509.ClientCAFile
@@ -161,12 +163,16 @@ func (r *Rule242449) Run(ctx context.Context) (rule.RuleResult, error) {
 
 		if exceedFilePermissions {
 			detailedTarget := target.With("details", fmt.Sprintf("fileName: %s, permissions: %s, expectedPermissionsMax: %s", fileStats.Path, fileStats.Permissions, expectedFilePermissionsMax))
-			checkResults = append(checkResults, rule.FailedCheckResult("File has too wide permissions", detailedTarget))
+			checkResults = append(checkResults, rule.FailedCheckResult("File has too wide permissions", detailedTarget).
+				WithEvidence(ctx, "kubelet-config", kubeletConfig).
+				WithEvidence(ctx, "file-stats", fileStats))
 			continue
 		}
 
 		detailedTarget := target.With("details", fmt.Sprintf("fileName: %s, permissions: %s", fileStats.Path, fileStats.Permissions))
-		checkResults = append(checkResults, rule.PassedCheckResult("File has expected permissions", detailedTarget))
+		checkResults = append(checkResults, rule.PassedCheckResult("File has expected permissions", detailedTarget).
+			WithEvidence(ctx, "kubelet-config", kubeletConfig).
+			WithEvidence(ctx, "file-stats", fileStats))
 	}
 
 	return rule.Result(r, checkResults...), nil
@@ -165,10 +165,15 @@ func (r *Rule242453) Run(ctx context.Context) (rule.RuleResult, error) {
 			selectedFilePaths = append(selectedFilePaths, configPath)
 		}
 
-		for _, filePath := range selectedFilePaths {
-			fileStats, err := intutils.GetSingleFileStats(ctx, podExecutor, filePath)
-			if err != nil {
-				checkResults = append(checkResults, rule.ErroredCheckResult(err.Error(), execPodTarget))
+		filesStats, errs, err := intutils.GetFilesStats(ctx, podExecutor, selectedFilePaths)
+		if err != nil {
+			checkResults = append(checkResults, rule.ErroredCheckResult(err.Error(), execPodTarget))
+			continue
+		}
+
+		for i, fileStats := range filesStats {
+			if errs[i] != nil {
+				checkResults = append(checkResults, rule.ErroredCheckResult(errs[i].Error(), execPodTarget))
 				continue
 			}
 
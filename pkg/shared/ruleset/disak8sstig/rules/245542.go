@@ -24,6 +24,8 @@ type Rule245542 struct {
 	Namespace      string
 	DeploymentName string
 	ContainerName  string
+	// Source resolves the component. Namespace, DeploymentName and ContainerName are not used when it is set.
+	Source kubeutils.ComponentSource
 }
 
 func (r *Rule245542) ID() string {
@@ -40,27 +42,16 @@ func (r *Rule245542) Severity() rule.SeverityLevel {
 
 func (r *Rule245542) Run(ctx context.Context) (rule.RuleResult, error) {
 	const optName = "basic-auth-file"
-	deploymentName := "kube-apiserver"
-	containerName := "kube-apiserver"
 
-	if r.DeploymentName != "" {
-		deploymentName = r.DeploymentName
-	}
+	source := componentSource(r.Source, r.Client, r.Namespace, r.DeploymentName, r.ContainerName, "kube-apiserver")
 
-	if r.ContainerName != "" {
-		containerName = r.ContainerName
-	}
-	target := rule.NewTarget("name", deploymentName, "namespace", r.Namespace, "kind", "Deployment")
+	return rule.Result(r, checkComponent(ctx, source, func(instance kubeutils.ComponentInstance, target rule.Target) []rule.CheckResult {
+		basicAuthFileOptionSlice := instance.FlagValues(optName)
+		// empty options are required
+		if len(basicAuthFileOptionSlice) == 0 {
+			return []rule.CheckResult{rule.PassedCheckResult(fmt.Sprintf("Option %s has not been set.", optName), target)}
+		}
 
-	basicAuthFileOptionSlice, err := kubeutils.GetCommandOptionFromDeployment(ctx, r.Client, deploymentName, containerName, r.Namespace, optName)
-	if err != nil {
-		return rule.Result(r, rule.ErroredCheckResult(err.Error(), target)), nil
-	}
-
-	// empty options are required
-	if len(basicAuthFileOptionSlice) == 0 {
-		return rule.Result(r, rule.PassedCheckResult(fmt.Sprintf("Option %s has not been set.", optName), target)), nil
-	}
-
-	return rule.Result(r, rule.FailedCheckResult(fmt.Sprintf("Option %s set.", optName), target)), nil
+		return []rule.CheckResult{rule.FailedCheckResult(fmt.Sprintf("Option %s set.", optName), target)}
+	})...), nil
 }
@@ -9,14 +9,13 @@ import (
 	"fmt"
 	"strings"
 
-	appsv1 "k8s.io/api/apps/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	kubeutils "github.com/gardener/diki/pkg/kubernetes/utils"
 	"github.com/gardener/diki/pkg/rule"
 	"github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/option"
+	sharedoption "github.com/gardener/diki/pkg/shared/ruleset/option"
 )
 
 var (
@@ -30,14 +29,19 @@ type Rule245543 struct {
 	Options        *Options245543
 	DeploymentName string
 	ContainerName  string
+	// Source resolves the component. Namespace, DeploymentName and ContainerName are not used when it is set.
+	Source kubeutils.ComponentSource
 }
 
 type Options245543 struct {
-	AcceptedTokens []struct {
-		User   string `yaml:"user"`
-		UID    string `yaml:"uid"`
-		Groups string `yaml:"groups"`
-	}
+	AcceptedTokens []AcceptedToken245543
+}
+
+type AcceptedToken245543 struct {
+	sharedoption.Expiration
+	User   string `yaml:"user"`
+	UID    string `yaml:"uid"`
+	Groups string `yaml:"groups"`
 }
 
 var _ option.Option = (*Options245543)(nil)
@@ -51,6 +55,7 @@ func (o Options245543) Validate(fldPath *field.Path) field.ErrorList {
 		if len(acceptedToken.UID) == 0 {
 			allErrs = append(allErrs, field.Required(fldPath.Child("acceptedTokens").Index(idx).Child("uid"), "must be set"))
 		}
+		allErrs = append(allErrs, acceptedToken.Expiration.Validate(fldPath.Child("acceptedTokens").Index(idx))...)
 	}
 	return allErrs
 }
@@ -69,93 +74,87 @@ func (r *Rule245543) Severity() rule.SeverityLevel {
 
 func (r *Rule245543) Run(ctx context.Context) (rule.RuleResult, error) {
 	const option = "token-auth-file"
-	deploymentName := "kube-apiserver"
-	containerName := "kube-apiserver"
-
-	if r.DeploymentName != "" {
-		deploymentName = r.DeploymentName
-	}
-
-	if r.ContainerName != "" {
-		containerName = r.ContainerName
-	}
+	source := componentSource(r.Source, r.Client, r.Namespace, r.DeploymentName, r.ContainerName, "kube-apiserver")
 
-	deployment := &appsv1.Deployment{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      deploymentName,
-			Namespace: r.Namespace,
-		},
-	}
-
-	target := kubeutils.TargetWithK8sObject(rule.NewTarget(), metav1.TypeMeta{Kind: "Deployment"}, deployment.ObjectMeta)
-
-	if err := r.Client.Get(ctx, client.ObjectKeyFromObject(deployment), deployment); err != nil {
-		return rule.Result(r, rule.ErroredCheckResult(err.Error(), target)), nil
-	}
-
-	optSlice, err := kubeutils.GetCommandOptionFromDeployment(ctx, r.Client, deploymentName, containerName, r.Namespace, option)
-	if err != nil {
-		return rule.Result(r, rule.ErroredCheckResult(err.Error(), target)), nil
-	}
+	return rule.Result(r, checkComponent(ctx, source, func(instance kubeutils.ComponentInstance, target rule.Target) []rule.CheckResult {
+		optSlice := instance.FlagValues(option)
+		if r.Options == nil {
+			if len(optSlice) == 0 {
+				return []rule.CheckResult{rule.PassedCheckResult(fmt.Sprintf("Option %s has not been set.", option), target)}
+			}
 
-	if r.Options == nil {
-		if len(optSlice) == 0 {
-			return rule.Result(r, rule.PassedCheckResult(fmt.Sprintf("Option %s has not been set.", option), target)), nil
+			return []rule.CheckResult{rule.FailedCheckResult(fmt.Sprintf("Option %s is set.", option), target)}
 		}
 
-		return rule.Result(r, rule.FailedCheckResult(fmt.Sprintf("Option %s is set.", option), target)), nil
-	}
-
-	if len(optSlice) > 1 {
-		return rule.Result(r, rule.WarningCheckResult(fmt.Sprintf("Option %s has been set more than once in container command.", option), target)), nil
-	}
-
-	optionByteSlice, err := kubeutils.GetVolumeConfigByteSliceByMountPath(ctx, r.Client, deployment, containerName, optSlice[0])
-	if err != nil {
-		return rule.Result(r, rule.ErroredCheckResult(err.Error(), target)), nil
-	}
-
-	optionString := string(optionByteSlice)
-	optionStringArray := strings.Split(optionString, "\n")
-	tokens := make([][]string, 0, len(optionStringArray))
-	for _, optionStringLine := range optionStringArray {
-		token := strings.SplitN(optionStringLine, ",", 4)
-		token[0] = "***"
-
-		if len(token) < 3 {
-			return rule.Result(r, rule.FailedCheckResult("Invalid token.", target)), nil
+		if len(optSlice) > 1 {
+			return []rule.CheckResult{rule.WarningCheckResult(fmt.Sprintf("Option %s has been set more than once in container command.", option), target)}
 		}
 
-		// we append an empty string in the end,
-		// because isTokenAccepted expects 4 element array
-		if len(token) == 3 {
-			token = append(token, "")
+		optionByteSlice, err := kubeutils.ReadComponentFile(ctx, r.Client, nil, instance, optSlice[0])
+		if err != nil {
+			return []rule.CheckResult{rule.ErroredCheckResult(err.Error(), target)}
 		}
 
-		// we strip " if present in both ends,
-		// because isTokenAccepted does not expect them
-		trimmedGroups := strings.TrimSpace(token[3])
-		if len(trimmedGroups) >= 2 && trimmedGroups[0] == '"' && trimmedGroups[len(trimmedGroups)-1] == '"' {
-			token[3] = trimmedGroups[1 : len(trimmedGroups)-1]
+		optionString := string(optionByteSlice)
+		optionStringArray := strings.Split(optionString, "\n")
+		tokens := make([][]string, 0, len(optionStringArray))
+		for _, optionStringLine := range optionStringArray {
+			token := strings.SplitN(optionStringLine, ",", 4)
+			token[0] = "***"
+
+			if len(token) < 3 {
+				return []rule.CheckResult{rule.FailedCheckResult("Invalid token.", target)}
+			}
+
+			// we append an empty string in the end,
+			// because acceptedToken expects 4 element array
+			if len(token) == 3 {
+				token = append(token, "")
+			}
+
+			// we strip " if present in both ends,
+			// because acceptedToken does not expect them
+			trimmedGroups := strings.TrimSpace(token[3])
+			if len(trimmedGroups) >= 2 && trimmedGroups[0] == '"' && trimmedGroups[len(trimmedGroups)-1] == '"' {
+				token[3] = trimmedGroups[1 : len(trimmedGroups)-1]
+			}
+			tokens = append(tokens, token)
 		}
-		tokens = append(tokens, token)
-	}
 
-	for _, token := range tokens {
-		if !r.isTokenAccepted([4]string(token)) {
-			return rule.Result(r, rule.FailedCheckResult("Invalid token.", target)), nil
+		var acceptance *rule.Acceptance
+		for _, token := range tokens {
+			acceptedToken := r.acceptedToken([4]string(token))
+			if acceptedToken == nil {
+				return []rule.CheckResult{rule.FailedCheckResult("Invalid token.", target)}
+			}
+			acceptance = earliestAcceptance(acceptance, acceptedToken.Acceptance())
 		}
-	}
 
-	return rule.Result(r, rule.AcceptedCheckResult("All defined tokens are accepted.", target)), nil
+		return []rule.CheckResult{rule.AcceptedCheckResultWithAcceptance("All defined tokens are accepted.", target, acceptance)}
+	})...), nil
 }
 
-func (r *Rule245543) isTokenAccepted(token [4]string) bool {
-	for _, acceptedToken := range r.Options.AcceptedTokens {
+func (r *Rule245543) acceptedToken(token [4]string) *AcceptedToken245543 {
+	for i, acceptedToken := range r.Options.AcceptedTokens {
 		if token[1] == acceptedToken.User && token[2] == acceptedToken.UID && token[3] == acceptedToken.Groups {
-			return true
+			return &r.Options.AcceptedTokens[i]
 		}
 	}
 
-	return false
+	return nil
+}
+
+// earliestAcceptance returns the acceptance which expires first.
+// Acceptances without expiration are only returned if neither of them expires.
+func earliestAcceptance(a, b *rule.Acceptance) *rule.Acceptance {
+	switch {
+	case a == nil:
+		return b
+	case b == nil || b.ExpiresAt == nil:
+		return a
+	case a.ExpiresAt == nil || b.ExpiresAt.Before(*a.ExpiresAt):
+		return b
+	default:
+		return a
+	}
 }
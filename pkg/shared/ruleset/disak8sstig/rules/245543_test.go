@@ -6,6 +6,7 @@ package rules_test
 
 import (
 	"context"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -15,11 +16,13 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"github.com/gardener/diki/pkg/rule"
 	"github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/rules"
+	sharedoption "github.com/gardener/diki/pkg/shared/ruleset/option"
 )
 
 var _ = Describe("#245543", func() {
@@ -42,12 +45,9 @@ bar,for,bar,`
 
 		kapiDeployment *appsv1.Deployment
 		target         = rule.NewTarget("kind", "Deployment", "name", "kube-apiserver", "namespace", namespace)
+		expiresAt      = time.Date(2027, time.January, 1, 0, 0, 0, 0, time.UTC)
 		options        = rules.Options245543{
-			AcceptedTokens: []struct {
-				User   string `yaml:"user"`
-				UID    string `yaml:"uid"`
-				Groups string `yaml:"groups"`
-			}{
+			AcceptedTokens: []rules.AcceptedToken245543{
 				{
 					User: "health-check",
 					UID:  "health-check",
@@ -187,6 +187,34 @@ bar,for,bar,`
 				},
 			},
 			BeNil()),
+		Entry("should return the acceptance which expires first when the accepted tokens expire.",
+			[]string{"--token-auth-file=foo/bar/static_tokens.csv"},
+			&rules.Options245543{
+				AcceptedTokens: []rules.AcceptedToken245543{
+					{
+						User:       "health-check",
+						UID:        "health-check",
+						Expiration: sharedoption.Expiration{Owner: "foo", ExpiresAt: ptr.To(expiresAt.AddDate(0, 1, 0))},
+					},
+					{
+						User:       "root",
+						UID:        "0",
+						Groups:     "group",
+						Expiration: sharedoption.Expiration{Owner: "bar", ExpiresAt: &expiresAt},
+					},
+				},
+			},
+			corev1.Volume{Name: "static-token", VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "foo"}}},
+			&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: namespace}, Data: map[string][]byte{"static_tokens.csv": []byte(acceptedEntries)}},
+			[]rule.CheckResult{
+				{
+					Status:     rule.Accepted,
+					Message:    "All defined tokens are accepted.",
+					Target:     target,
+					Acceptance: &rule.Acceptance{Source: rule.AcceptanceSourceRuleOption, Owner: "bar", ExpiresAt: &expiresAt},
+				},
+			},
+			BeNil()),
 		Entry("should accept when token has been accepted and has more than 1 group.",
 			[]string{"--token-auth-file=foo/bar/static_tokens.csv"}, &options,
 			corev1.Volume{Name: "static-token", VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "foo"}}},
@@ -251,11 +279,7 @@ bar,for,bar,`
 	Describe("#Validate", func() {
 		It("should correctly validate options", func() {
 			options = rules.Options245543{
-				AcceptedTokens: []struct {
-					User   string `yaml:"user"`
-					UID    string `yaml:"uid"`
-					Groups string `yaml:"groups"`
-				}{
+				AcceptedTokens: []rules.AcceptedToken245543{
 					{
 						User: "health-check",
 						UID:  "health-check",
@@ -270,6 +294,11 @@ bar,for,bar,`
 						UID:    "",
 						Groups: "group1,group2,group3",
 					},
+					{
+						User:       "root",
+						UID:        "0",
+						Expiration: sharedoption.Expiration{ExpiresAt: &expiresAt},
+					},
 				},
 			}
 
@@ -285,6 +314,11 @@ bar,for,bar,`
 					"Field":  Equal("foo.acceptedTokens[2].uid"),
 					"Detail": Equal("must be set"),
 				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeRequired),
+					"Field":  Equal("foo.acceptedTokens[3].owner"),
+					"Detail": Equal("must be set when expiresAt is set"),
+				})),
 			))
 		})
 	})
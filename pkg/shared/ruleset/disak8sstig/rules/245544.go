@@ -25,6 +25,8 @@ type Rule245544 struct {
 	Namespace      string
 	DeploymentName string
 	ContainerName  string
+	// Source resolves the component. Namespace, DeploymentName and ContainerName are not used when it is set.
+	Source kubeutils.ComponentSource
 }
 
 func (r *Rule245544) ID() string {
@@ -44,50 +46,36 @@ func (r *Rule245544) Run(ctx context.Context) (rule.RuleResult, error) {
 		certOptName = "kubelet-client-certificate"
 		keyOptName  = "kubelet-client-key"
 	)
-	deploymentName := "kube-apiserver"
-	containerName := "kube-apiserver"
 
-	if r.DeploymentName != "" {
-		deploymentName = r.DeploymentName
-	}
-
-	if r.ContainerName != "" {
-		containerName = r.ContainerName
-	}
-	var checkResults []rule.CheckResult
-	target := rule.NewTarget("name", deploymentName, "namespace", r.Namespace, "kind", "Deployment")
-
-	kubeletClientCertificateOptionSlice, err := kubeutils.GetCommandOptionFromDeployment(ctx, r.Client, deploymentName, containerName, r.Namespace, certOptName)
-	if err != nil {
-		return rule.Result(r, rule.ErroredCheckResult(err.Error(), target)), nil
-	}
-
-	switch {
-	case len(kubeletClientCertificateOptionSlice) == 0:
-		checkResults = append(checkResults, rule.FailedCheckResult(fmt.Sprintf("Option %s has not been set.", certOptName), target))
-	case len(kubeletClientCertificateOptionSlice) > 1:
-		checkResults = append(checkResults, rule.WarningCheckResult(fmt.Sprintf("Option %s has been set more than once in container command.", certOptName), target))
-	case strings.TrimSpace(kubeletClientCertificateOptionSlice[0]) == "":
-		checkResults = append(checkResults, rule.FailedCheckResult(fmt.Sprintf("Option %s is empty.", certOptName), target))
-	default:
-		checkResults = append(checkResults, rule.PassedCheckResult(fmt.Sprintf("Option %s set.", certOptName), target))
-	}
-
-	kubeletClientKeyOptionSlice, err := kubeutils.GetCommandOptionFromDeployment(ctx, r.Client, deploymentName, containerName, r.Namespace, keyOptName)
-	if err != nil {
-		return rule.Result(r, rule.ErroredCheckResult(err.Error(), target)), nil
-	}
-
-	switch {
-	case len(kubeletClientKeyOptionSlice) == 0:
-		checkResults = append(checkResults, rule.FailedCheckResult(fmt.Sprintf("Option %s has not been set.", keyOptName), target))
-	case len(kubeletClientKeyOptionSlice) > 1:
-		checkResults = append(checkResults, rule.WarningCheckResult(fmt.Sprintf("Option %s has been set more than once in container command.", keyOptName), target))
-	case strings.TrimSpace(kubeletClientKeyOptionSlice[0]) == "":
-		checkResults = append(checkResults, rule.FailedCheckResult(fmt.Sprintf("Option %s is empty.", keyOptName), target))
-	default:
-		checkResults = append(checkResults, rule.PassedCheckResult(fmt.Sprintf("Option %s set.", keyOptName), target))
-	}
-
-	return rule.Result(r, checkResults...), nil
+	source := componentSource(r.Source, r.Client, r.Namespace, r.DeploymentName, r.ContainerName, "kube-apiserver")
+
+	return rule.Result(r, checkComponent(ctx, source, func(instance kubeutils.ComponentInstance, target rule.Target) []rule.CheckResult {
+		var checkResults []rule.CheckResult
+
+		kubeletClientCertificateOptionSlice := instance.FlagValues(certOptName)
+		switch {
+		case len(kubeletClientCertificateOptionSlice) == 0:
+			checkResults = append(checkResults, rule.FailedCheckResult(fmt.Sprintf("Option %s has not been set.", certOptName), target))
+		case len(kubeletClientCertificateOptionSlice) > 1:
+			checkResults = append(checkResults, rule.WarningCheckResult(fmt.Sprintf("Option %s has been set more than once in container command.", certOptName), target))
+		case strings.TrimSpace(kubeletClientCertificateOptionSlice[0]) == "":
+			checkResults = append(checkResults, rule.FailedCheckResult(fmt.Sprintf("Option %s is empty.", certOptName), target))
+		default:
+			checkResults = append(checkResults, rule.PassedCheckResult(fmt.Sprintf("Option %s set.", certOptName), target))
+		}
+
+		kubeletClientKeyOptionSlice := instance.FlagValues(keyOptName)
+		switch {
+		case len(kubeletClientKeyOptionSlice) == 0:
+			checkResults = append(checkResults, rule.FailedCheckResult(fmt.Sprintf("Option %s has not been set.", keyOptName), target))
+		case len(kubeletClientKeyOptionSlice) > 1:
+			checkResults = append(checkResults, rule.WarningCheckResult(fmt.Sprintf("Option %s has been set more than once in container command.", keyOptName), target))
+		case strings.TrimSpace(kubeletClientKeyOptionSlice[0]) == "":
+			checkResults = append(checkResults, rule.FailedCheckResult(fmt.Sprintf("Option %s is empty.", keyOptName), target))
+		default:
+			checkResults = append(checkResults, rule.PassedCheckResult(fmt.Sprintf("Option %s set.", keyOptName), target))
+		}
+
+		return checkResults
+	})...), nil
 }
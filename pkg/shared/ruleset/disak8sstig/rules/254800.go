@@ -11,8 +11,6 @@ import (
 	"slices"
 	"strings"
 
-	appsv1 "k8s.io/api/apps/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -38,6 +36,8 @@ type Rule254800 struct {
 	Options        *Options254800
 	DeploymentName string
 	ContainerName  string
+	// Source resolves the component. Namespace, DeploymentName and ContainerName are not used when it is set.
+	Source kubeutils.ComponentSource
 }
 
 type Options254800 struct {
@@ -66,79 +66,57 @@ func (r *Rule254800) Severity() rule.SeverityLevel {
 }
 
 func (r *Rule254800) Run(ctx context.Context) (rule.RuleResult, error) {
-	deploymentName := "kube-apiserver"
-	containerName := "kube-apiserver"
+	source := componentSource(r.Source, r.Client, r.Namespace, r.DeploymentName, r.ContainerName, "kube-apiserver")
 
-	if r.DeploymentName != "" {
-		deploymentName = r.DeploymentName
-	}
-
-	if r.ContainerName != "" {
-		containerName = r.ContainerName
-	}
-	target := rule.NewTarget("name", deploymentName, "namespace", r.Namespace, "kind", "Deployment")
-
-	admissionControlConfigFileOptionSlice, err := kubeutils.GetCommandOptionFromDeployment(ctx, r.Client, deploymentName, containerName, r.Namespace, "admission-control-config-file")
-	if err != nil {
-		return rule.Result(r, rule.ErroredCheckResult(err.Error(), target)), nil
-	}
-
-	if len(admissionControlConfigFileOptionSlice) == 0 {
-		return rule.Result(r, rule.WarningCheckResult("Option admission-control-config-file has not been set.", target)), nil
-	}
-
-	if len(admissionControlConfigFileOptionSlice) > 1 {
-		return rule.Result(r, rule.WarningCheckResult("Option admission-control-config-file has been set more than once in container command.", target)), nil
-	}
+	return rule.Result(r, checkComponent(ctx, source, func(instance kubeutils.ComponentInstance, target rule.Target) []rule.CheckResult {
+		admissionControlConfigFileOptionSlice := instance.FlagValues("admission-control-config-file")
+		if len(admissionControlConfigFileOptionSlice) == 0 {
+			return []rule.CheckResult{rule.WarningCheckResult("Option admission-control-config-file has not been set.", target)}
+		}
 
-	kubeAPIDeployment := &appsv1.Deployment{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      deploymentName,
-			Namespace: r.Namespace,
-		},
-	}
-	if err := r.Client.Get(ctx, client.ObjectKeyFromObject(kubeAPIDeployment), kubeAPIDeployment); err != nil {
-		return rule.Result(r, rule.ErroredCheckResult(err.Error(), target)), nil
-	}
+		if len(admissionControlConfigFileOptionSlice) > 1 {
+			return []rule.CheckResult{rule.WarningCheckResult("Option admission-control-config-file has been set more than once in container command.", target)}
+		}
 
-	volumePath := admissionControlConfigFileOptionSlice[0]
+		volumePath := admissionControlConfigFileOptionSlice[0]
 
-	admissionConfigByteSlice, err := kubeutils.GetVolumeConfigByteSliceByMountPath(ctx, r.Client, kubeAPIDeployment, containerName, volumePath)
-	if err != nil {
-		return rule.Result(r, rule.ErroredCheckResult(err.Error(), target)), nil
-	}
+		admissionConfigByteSlice, err := kubeutils.ReadComponentFile(ctx, r.Client, nil, instance, volumePath)
+		if err != nil {
+			return []rule.CheckResult{rule.ErroredCheckResult(err.Error(), target)}
+		}
 
-	admissionConfig := apiserverv1.AdmissionConfiguration{}
-	_, _, err = serializer.NewCodecFactory(scheme.Scheme).UniversalDeserializer().Decode(admissionConfigByteSlice, nil, &admissionConfig)
-	if err != nil {
-		return rule.Result(r, rule.ErroredCheckResult(err.Error(), target)), nil
-	}
+		admissionConfig := apiserverv1.AdmissionConfiguration{}
+		_, _, err = serializer.NewCodecFactory(scheme.Scheme).UniversalDeserializer().Decode(admissionConfigByteSlice, nil, &admissionConfig)
+		if err != nil {
+			return []rule.CheckResult{rule.ErroredCheckResult(err.Error(), target)}
+		}
 
-	options := cmp.Or(r.Options, &Options254800{MinPodSecurityStandardsProfile: "baseline"})
+		options := cmp.Or(r.Options, &Options254800{MinPodSecurityStandardsProfile: "baseline"})
 
-	for _, plugin := range admissionConfig.Plugins {
-		if plugin.Name == "PodSecurity" {
-			if plugin.Configuration != nil {
-				return rule.Result(r, r.checkPodSecurityConfiguration(plugin.Configuration, options)...), nil
-			}
-			if strings.TrimSpace(plugin.Path) != "" {
-				pluginAdmissionConfigByteSlice, err := kubeutils.GetVolumeConfigByteSliceByMountPath(ctx, r.Client, kubeAPIDeployment, "kube-apiserver", plugin.Path)
-				if err != nil {
-					return rule.Result(r, rule.ErroredCheckResult(err.Error(), target)), nil
+		for _, plugin := range admissionConfig.Plugins {
+			if plugin.Name == "PodSecurity" {
+				if plugin.Configuration != nil {
+					return r.checkPodSecurityConfiguration(plugin.Configuration, options)
 				}
-
-				pluginConfig := admissionapiv1.PodSecurityConfiguration{}
-				_, _, err = serializer.NewCodecFactory(scheme.Scheme).UniversalDeserializer().Decode(pluginAdmissionConfigByteSlice, nil, &pluginConfig)
-				if err != nil {
-					return rule.Result(r, rule.ErroredCheckResult(err.Error(), target)), nil
+				if strings.TrimSpace(plugin.Path) != "" {
+					pluginAdmissionConfigByteSlice, err := kubeutils.ReadComponentFile(ctx, r.Client, nil, instance, plugin.Path)
+					if err != nil {
+						return []rule.CheckResult{rule.ErroredCheckResult(err.Error(), target)}
+					}
+
+					pluginConfig := admissionapiv1.PodSecurityConfiguration{}
+					_, _, err = serializer.NewCodecFactory(scheme.Scheme).UniversalDeserializer().Decode(pluginAdmissionConfigByteSlice, nil, &pluginConfig)
+					if err != nil {
+						return []rule.CheckResult{rule.ErroredCheckResult(err.Error(), target)}
+					}
+
+					return r.checkPrivilegeLevel(pluginConfig, options)
 				}
-
-				return rule.Result(r, r.checkPrivilegeLevel(pluginConfig, options)...), nil
 			}
 		}
-	}
 
-	return rule.Result(r, rule.FailedCheckResult("PodSecurity is not configured", rule.NewTarget())), nil
+		return []rule.CheckResult{rule.FailedCheckResult("PodSecurity is not configured", rule.NewTarget())}
+	})...), nil
 }
 
 func (r *Rule254800) checkPodSecurityConfiguration(pluginConfig *runtime.Unknown, options *Options254800) []rule.CheckResult {
@@ -0,0 +1,132 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/component-base/version"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/diki/imagevector"
+	"github.com/gardener/diki/pkg/kubernetes/pod"
+	kubeutils "github.com/gardener/diki/pkg/kubernetes/utils"
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/shared/images"
+	"github.com/gardener/diki/pkg/shared/provider"
+)
+
+// componentSource returns source when it is set. Otherwise the component is resolved
+// from a Deployment whose name and container name default to the name of the component.
+func componentSource(source kubeutils.ComponentSource, c client.Client, namespace, deploymentName, containerName, component string) kubeutils.ComponentSource {
+	if source != nil {
+		return source
+	}
+
+	return &kubeutils.DeploymentSource{
+		Client:        c,
+		Namespace:     namespace,
+		Name:          cmp.Or(deploymentName, component),
+		ContainerName: cmp.Or(containerName, component),
+	}
+}
+
+// checkComponent calls checkFn for every instance of a component.
+// Instances which cannot be resolved are reported with errored check results.
+func checkComponent(
+	ctx context.Context,
+	source kubeutils.ComponentSource,
+	checkFn func(instance kubeutils.ComponentInstance, target rule.Target) []rule.CheckResult,
+) []rule.CheckResult {
+	instances, err := source.Instances(ctx)
+	if err != nil {
+		return []rule.CheckResult{rule.ErroredCheckResult(err.Error(), source.Target())}
+	}
+
+	var checkResults []rule.CheckResult
+	for _, instance := range instances {
+		if instance.Err != nil {
+			checkResults = append(checkResults, rule.ErroredCheckResult(instance.Err.Error(), instance.Target))
+			continue
+		}
+		checkResults = append(checkResults, checkFn(instance, instance.Target)...)
+	}
+	return checkResults
+}
+
+// componentPods returns the pods of the instances of the given sources.
+// Sources which cannot be resolved and instances which do not run in pods
+// are reported with errored check results.
+func componentPods(ctx context.Context, sources []kubeutils.ComponentSource) ([]corev1.Pod, []rule.CheckResult) {
+	var (
+		pods         []corev1.Pod
+		checkResults []rule.CheckResult
+	)
+	for _, source := range sources {
+		instances, err := source.Instances(ctx)
+		if err != nil {
+			checkResults = append(checkResults, rule.ErroredCheckResult(err.Error(), source.Target()))
+			continue
+		}
+
+		for _, instance := range instances {
+			if instance.Pod == nil {
+				checkResults = append(checkResults, rule.ErroredCheckResult("instance does not run in a pod", instance.Target))
+				continue
+			}
+			pods = append(pods, *instance.Pod)
+		}
+	}
+	return pods, checkResults
+}
+
+// opsPodFileReader reads files of component instances. Files which are on the node
+// of an instance are read with an ops pod which is created on the node.
+type opsPodFileReader struct {
+	ruleID     string
+	instanceID string
+	client     client.Client
+	podContext pod.PodContext
+	logger     provider.Logger
+}
+
+func (f opsPodFileReader) read(ctx context.Context, instance kubeutils.ComponentInstance, filePath string) ([]byte, error) {
+	if !instance.OnHost {
+		if volume, _, err := instance.VolumeForPath(filePath); err != nil || volume.HostPath == nil {
+			return kubeutils.ReadComponentFile(ctx, f.client, nil, instance, filePath)
+		}
+	}
+
+	if f.podContext == nil {
+		return nil, fmt.Errorf("file %s can only be read from the node", filePath)
+	}
+
+	image, err := imagevector.ImageVector().FindImage(images.DikiOpsImageName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find image version for %s: %w", images.DikiOpsImageName, err)
+	}
+	image.WithOptionalTag(version.Get().GitVersion)
+
+	podName := fmt.Sprintf("diki-%s-%s", f.ruleID, Generator.Generate(10))
+	defer func() {
+		timeoutCtx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+		defer cancel()
+
+		if err := f.podContext.Delete(timeoutCtx, podName, "kube-system"); err != nil {
+			f.logger.Error(err.Error())
+		}
+	}()
+
+	additionalLabels := map[string]string{pod.LabelInstanceID: f.instanceID}
+	podExecutor, err := f.podContext.Create(ctx, pod.NewPrivilegedPod(podName, "kube-system", image.String(), instance.NodeName, additionalLabels))
+	if err != nil {
+		return nil, err
+	}
+	return kubeutils.ReadComponentFile(ctx, f.client, podExecutor, instance, filePath)
+}
@@ -0,0 +1,117 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/diki/pkg/kubernetes/config"
+	kubeutils "github.com/gardener/diki/pkg/kubernetes/utils"
+	"github.com/gardener/diki/pkg/rule"
+)
+
+// etcdSources returns sources when they are set. Otherwise etcd is resolved
+// from the etcd-main and etcd-events StatefulSets.
+func etcdSources(sources []kubeutils.ComponentSource, c client.Client, namespace, etcdMain, etcdEvents string) []kubeutils.ComponentSource {
+	if len(sources) > 0 {
+		return sources
+	}
+
+	return []kubeutils.ComponentSource{
+		&kubeutils.StatefulSetSource{Client: c, Namespace: namespace, Name: cmp.Or(etcdMain, "etcd-main")},
+		&kubeutils.StatefulSetSource{Client: c, Namespace: namespace, Name: cmp.Or(etcdEvents, "etcd-events")},
+	}
+}
+
+// checkETCD checks every etcd instance of the given sources. Instances which are configured
+// with the etcd-config-file volume are checked with configFn. The command line of all
+// other instances is checked with flagFn.
+func checkETCD(
+	ctx context.Context,
+	c client.Client,
+	sources []kubeutils.ComponentSource,
+	configFn func(config *config.EtcdConfig, target rule.Target) rule.CheckResult,
+	flagFn func(instance kubeutils.ComponentInstance, target rule.Target) rule.CheckResult,
+) []rule.CheckResult {
+	var checkResults []rule.CheckResult
+	for _, source := range sources {
+		checkResults = append(checkResults, checkComponent(ctx, source, func(instance kubeutils.ComponentInstance, target rule.Target) []rule.CheckResult {
+			return []rule.CheckResult{checkETCDInstance(ctx, c, instance, target, configFn, flagFn)}
+		})...)
+	}
+	return checkResults
+}
+
+func checkETCDInstance(
+	ctx context.Context,
+	c client.Client,
+	instance kubeutils.ComponentInstance,
+	target rule.Target,
+	configFn func(config *config.EtcdConfig, target rule.Target) rule.CheckResult,
+	flagFn func(instance kubeutils.ComponentInstance, target rule.Target) rule.CheckResult,
+) rule.CheckResult {
+	idx := slices.IndexFunc(instance.Volumes, func(v corev1.Volume) bool { return v.Name == "etcd-config-file" })
+	if idx < 0 {
+		if len(instance.Command) == 0 {
+			return rule.ErroredCheckResult(fmt.Sprintf("%s does not contain volume with name: etcd-config-file.", target["kind"]), target)
+		}
+		return flagFn(instance, target)
+	}
+
+	configByteSlice, err := kubeutils.GetFileDataFromVolume(ctx, c, instance.Namespace, instance.Volumes[idx], "etcd.conf.yaml")
+	if err != nil {
+		return rule.ErroredCheckResult(err.Error(), target)
+	}
+
+	etcdConfig := &config.EtcdConfig{}
+	if err := yaml.Unmarshal(configByteSlice, etcdConfig); err != nil {
+		return rule.ErroredCheckResult(err.Error(), target)
+	}
+	return configFn(etcdConfig, target)
+}
+
+// checkRequiredOption checks that an option is set to a non-empty value.
+func checkRequiredOption(option string, values []string, target rule.Target) rule.CheckResult {
+	switch {
+	case len(values) == 0:
+		return rule.FailedCheckResult(fmt.Sprintf("Option %s has not been set.", option), target)
+	case len(values) > 1:
+		return rule.WarningCheckResult(fmt.Sprintf("Option %s has been set more than once in container command.", option), target)
+	case strings.TrimSpace(values[0]) == "":
+		return rule.FailedCheckResult(fmt.Sprintf("Option %s is empty.", option), target)
+	default:
+		return rule.PassedCheckResult(fmt.Sprintf("Option %s set.", option), target)
+	}
+}
+
+// checkBoolOption checks that a boolean option is set to the allowed value.
+// The status of the check if the option is not set is given by notSetStatus.
+func checkBoolOption(option string, values []string, allowedValue bool, notSetStatus rule.Status, target rule.Target) rule.CheckResult {
+	if len(values) == 0 {
+		return rule.CheckResult{Status: notSetStatus, Message: fmt.Sprintf("Option %s has not been set.", option), Target: target}
+	}
+	if len(values) > 1 {
+		return rule.WarningCheckResult(fmt.Sprintf("Option %s has been set more than once in container command.", option), target)
+	}
+
+	value, err := strconv.ParseBool(values[0])
+	switch {
+	case err != nil:
+		return rule.WarningCheckResult(fmt.Sprintf("Option %s set to neither 'true' nor 'false'.", option), target)
+	case value == allowedValue:
+		return rule.PassedCheckResult(fmt.Sprintf("Option %s set to allowed value.", option), target)
+	default:
+		return rule.FailedCheckResult(fmt.Sprintf("Option %s set to not allowed value.", option), target)
+	}
+}
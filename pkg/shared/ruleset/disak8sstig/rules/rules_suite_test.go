@@ -5,17 +5,31 @@
 package rules_test
 
 import (
+	"context"
 	"log/slog"
 	"os"
 	"testing"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	"github.com/gardener/diki/pkg/kubernetes/pod"
+	fakepod "github.com/gardener/diki/pkg/kubernetes/pod/fake"
+	kubeutils "github.com/gardener/diki/pkg/kubernetes/utils"
+	"github.com/gardener/diki/pkg/rule"
 	"github.com/gardener/diki/pkg/shared/provider"
 )
 
-var testLogger provider.Logger
+var (
+	testLogger provider.Logger
+	// staticPodTarget is the target of the etcd static pod used by describeStaticPodSource.
+	staticPodTarget = rule.NewTarget("name", "etcd-node01", "namespace", "kube-system", "kind", "Pod")
+)
 
 func TestRules(t *testing.T) {
 	handler := slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelInfo})
@@ -24,3 +38,100 @@ func TestRules(t *testing.T) {
 	RegisterFailHandler(Fail)
 	RunSpecs(t, "DISA Kubernetes STIG rules Test Suite")
 }
+
+// newStaticPodSource returns a source which selects the static pods of the given control plane component.
+func newStaticPodSource(c client.Client, component, containerName string) kubeutils.ComponentSource {
+	return &kubeutils.StaticPodSource{
+		Client:        c,
+		Namespace:     "kube-system",
+		Selector:      labels.SelectorFromSet(labels.Set{"component": component, "tier": "control-plane"}),
+		ContainerName: containerName,
+	}
+}
+
+// newStaticPod returns the mirror pod of the static pod of the given control plane component on node01.
+func newStaticPod(component string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      component + "-node01",
+			Namespace: "kube-system",
+			Labels: map[string]string{
+				"component": component,
+				"tier":      "control-plane",
+			},
+			Annotations: map[string]string{
+				corev1.MirrorPodAnnotationKey: "foo",
+			},
+		},
+		Spec: corev1.PodSpec{
+			NodeName: "node01",
+			Containers: []corev1.Container{
+				{
+					Name: component,
+				},
+			},
+		},
+	}
+}
+
+// describeStaticPodSource describes the runs of a rule which checks the flags of the etcd static pod.
+// newRule returns the rule checking the given sources, each entry sets the command of the etcd container
+// and expects the check results, targeting staticPodTarget.
+func describeStaticPodSource(newRule func(c client.Client, sources []kubeutils.ComponentSource) rule.Rule, entries ...TableEntry) {
+	Describe("with a static pod source", func() {
+		var (
+			ctx        = context.TODO()
+			fakeClient client.Client
+			staticPod  *corev1.Pod
+			sources    []kubeutils.ComponentSource
+		)
+
+		BeforeEach(func() {
+			fakeClient = fakeclient.NewClientBuilder().Build()
+			staticPod = newStaticPod("etcd")
+			sources = []kubeutils.ComponentSource{newStaticPodSource(fakeClient, "etcd", "etcd")}
+		})
+
+		It("should error when the static pods are not found", func() {
+			ruleResult, err := newRule(fakeClient, sources).Run(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ruleResult.CheckResults).To(Equal([]rule.CheckResult{
+				{
+					Status:  rule.Errored,
+					Message: "pods not found",
+					Target:  rule.NewTarget("namespace", "kube-system", "selector", "component=etcd,tier=control-plane"),
+				},
+			}))
+		})
+
+		args := []any{func(command []string, expectedCheckResults []rule.CheckResult) {
+			staticPod.Spec.Containers[0].Command = command
+			Expect(fakeClient.Create(ctx, staticPod)).To(Succeed())
+
+			ruleResult, err := newRule(fakeClient, sources).Run(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ruleResult.CheckResults).To(Equal(expectedCheckResults))
+		}}
+		for _, entry := range entries {
+			args = append(args, entry)
+		}
+		DescribeTable("Run cases", args...)
+	})
+}
+
+// createStaticPodWithCAKey creates the mirror pod of the static pod of the component from the given pod,
+// which mounts the etcd certificates from its node. It returns a pod context which reports
+// that the etcd CA key in the mounted directory is owned by root and has 600 permissions.
+func createStaticPodWithCAKey(ctx context.Context, c client.Client, plainPod *corev1.Pod, component string) pod.PodContext {
+	staticPod := plainPod.DeepCopy()
+	staticPod.Name = component + "-" + plainPod.Spec.NodeName
+	staticPod.Namespace = "kube-system"
+	staticPod.Labels = map[string]string{"component": component, "tier": "control-plane"}
+	staticPod.Annotations = map[string]string{corev1.MirrorPodAnnotationKey: "foo"}
+	staticPod.OwnerReferences = []metav1.OwnerReference{{APIVersion: "v1", Kind: "Node", Name: plainPod.Spec.NodeName, UID: "1"}}
+	staticPod.Spec.Containers[0].VolumeMounts = []corev1.VolumeMount{{Name: "etcd-certs", MountPath: "/etc/kubernetes/pki/etcd"}}
+	Expect(c.Create(ctx, staticPod)).To(Succeed())
+
+	staticPodMounts := `[{"destination": "/etc/kubernetes/pki/etcd", "source": "/etc/kubernetes/pki/etcd"}]`
+	return fakepod.NewFakeSimplePodContext([][]string{{staticPodMounts, "600\t0\t0\tregular file\t/etc/kubernetes/pki/etcd/ca.key\n"}}, [][]error{{nil, nil}})
+}
@@ -0,0 +1,36 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package option
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/diki/pkg/config"
+	"github.com/gardener/diki/pkg/rule"
+)
+
+// Expiration contains optional properties which limit the validity of an acceptance.
+// It can be embedded in the rule options of any ruleset.
+type Expiration struct {
+	Owner     string     `json:"owner,omitempty" yaml:"owner,omitempty"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty" yaml:"expiresAt,omitempty"`
+}
+
+// Validate validates that option configurations are correctly defined.
+// It applies the same rules as the validation of rule skips, so acceptances which expire must have an owner.
+func (e Expiration) Validate(fldPath *field.Path) field.ErrorList {
+	return config.ValidateAcceptance(e.Owner, e.ExpiresAt, fldPath)
+}
+
+// Acceptance returns the acceptance properties of a rule option described by the Expiration.
+// Returns nil if neither owner nor expiresAt are set.
+func (e Expiration) Acceptance() *rule.Acceptance {
+	if len(e.Owner) == 0 && e.ExpiresAt == nil {
+		return nil
+	}
+	return &rule.Acceptance{Source: rule.AcceptanceSourceRuleOption, Owner: e.Owner, ExpiresAt: e.ExpiresAt}
+}
@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package option_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/shared/ruleset/option"
+)
+
+var _ = Describe("expiration", func() {
+	Describe("#Validate", func() {
+		It("should correctly validate expirations", func() {
+			var (
+				zero      = time.Time{}
+				expiresAt = time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+			)
+			expirations := []option.Expiration{
+				{},
+				{Owner: "foo", ExpiresAt: &expiresAt},
+				{Owner: "  "},
+				{Owner: "foo", ExpiresAt: &zero},
+				{ExpiresAt: &expiresAt},
+			}
+
+			var result field.ErrorList
+			for _, e := range expirations {
+				result = append(result, e.Validate(field.NewPath("foo"))...)
+			}
+
+			Expect(result).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("foo.owner"),
+					"Detail": Equal("must not be blank"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("foo.expiresAt"),
+					"Detail": Equal("must not be zero"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeRequired),
+					"Field":  Equal("foo.owner"),
+					"Detail": Equal("must be set when expiresAt is set"),
+				})),
+			))
+		})
+	})
+
+	Describe("#Acceptance", func() {
+		It("should return the acceptance of the expiration", func() {
+			expiresAt := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+			Expect(option.Expiration{}.Acceptance()).To(BeNil())
+			Expect(option.Expiration{Owner: "foo", ExpiresAt: &expiresAt}.Acceptance()).To(Equal(&rule.Acceptance{
+				Source:    rule.AcceptanceSourceRuleOption,
+				Owner:     "foo",
+				ExpiresAt: &expiresAt,
+			}))
+		})
+	})
+})
@@ -9,7 +9,10 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
+	"github.com/gardener/diki/pkg/kubernetes/pod"
+	kubeutils "github.com/gardener/diki/pkg/kubernetes/utils"
 	"github.com/gardener/diki/pkg/rule"
 	"github.com/gardener/diki/pkg/ruleset"
 	"github.com/gardener/diki/pkg/shared/provider"
@@ -37,6 +40,18 @@ func Run(
 		RulesetID:      r.ID(),
 		RulesetVersion: r.Version(),
 		RuleResults:    make([]rule.RuleResult, 0, len(rules)),
+		StartTime:      time.Now().UTC(),
+	}
+
+	// rules of the ruleset share a point-in-time view of the cluster objects
+	if kubeutils.ObjectCacheFromContext(ctx) == nil {
+		ctx = kubeutils.ContextWithObjectCache(ctx, kubeutils.NewObjectCache())
+	}
+	// rules of the ruleset share one privileged pod per node
+	if pod.NodePodPoolFromContext(ctx) == nil {
+		pool := pod.NewNodePodPool()
+		ctx = pod.ContextWithNodePodPool(ctx, pool)
+		defer provider.ClosePodPool(pool, log)
 	}
 
 	type run struct {
@@ -47,25 +62,34 @@ func Run(
 	rulesCh := make(chan rule.Rule)
 	resultCh := make(chan run)
 
+	hooks := rule.RunHooksFromContext(ctx)
+
 	wg := sync.WaitGroup{}
 	log.Info("starting ruleset run", "number_of_rules", len(rules), "number_of_workers", workers)
 	for i := 0; i < workers; i++ {
 		wg.Add(1)
-		go func() {
+		go func(worker int) {
 			for r := range rulesCh {
 				log.Info("starting rule run", "rule_id", r.ID())
-				res, err := r.Run(ctx)
+				info := rule.RunInfo{RulesetID: result.RulesetID, RulesetVersion: result.RulesetVersion, RuleID: r.ID(), RuleName: r.Name()}
+				hooks.Start(info)
+				stats := &rule.RunStats{}
+				startTime := time.Now().UTC()
+				res, err := r.Run(pod.ContextWithOpsPodCounter(rule.ContextWithRunStats(ctx, stats), stats))
+				res.StartTime, res.EndTime = startTime, time.Now().UTC()
+				res.Retries, res.OpsPods, res.Worker = stats.Retries(), stats.OpsPods(), worker
 				res.RuleID = r.ID()
 				res.RuleName = r.Name()
 
 				if len(res.CheckResults) == 0 {
 					res.CheckResults = append(res.CheckResults, rule.WarningCheckResult("Rule run did not report any status.", rule.NewTarget()))
 				}
+				hooks.Finish(info, res, err)
 
 				resultCh <- run{result: res, err: err}
 			}
 			wg.Done()
-		}()
+		}(i)
 	}
 
 	go func() {
@@ -95,7 +119,7 @@ func Run(
 			log.Error(finishMsg, "rule_id", run.result.RuleID, "remaining", remaining, "error", run.err)
 			err = errors.Join(err, fmt.Errorf("rule with id %s errored: %w", run.result.RuleID, run.err))
 		} else {
-			log.Info(finishMsg, "rule_id", run.result.RuleID, "remaining", remaining)
+			log.Info(finishMsg, "rule_id", run.result.RuleID, "remaining", remaining, "duration", run.result.EndTime.Sub(run.result.StartTime).String(), "retries", run.result.Retries, "ops_pods", run.result.OpsPods)
 			result.RuleResults = append(result.RuleResults, run.result)
 		}
 	}
@@ -108,5 +132,6 @@ func Run(
 	if err != nil {
 		return ruleset.RulesetResult{}, err
 	}
+	result.EndTime = time.Now().UTC()
 	return result, nil
 }
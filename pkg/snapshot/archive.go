@@ -0,0 +1,259 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package snapshot
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const (
+	manifestFileName = "manifest.json"
+	configFileName   = "config.yaml"
+	clustersDir      = "clusters"
+	kubeconfigsDir   = "kubeconfigs"
+)
+
+// archiveFile is a single file of a snapshot archive or directory.
+type archiveFile struct {
+	name string
+	data []byte
+}
+
+// files returns the files in which the snapshot is stored.
+func (s *Snapshot) files() ([]archiveFile, error) {
+	s.mux.Lock()
+	manifest := s.Manifest
+	manifest.FormatVersion = FormatVersion
+	manifest.Kubeconfigs = map[string]string{}
+	var kubeconfigFiles []archiveFile
+	for _, kubeconfigPath := range sortedKeys(s.kubeconfigs) {
+		name := path.Join(kubeconfigsDir, fmt.Sprintf("%d.yaml", len(kubeconfigFiles)))
+		manifest.Kubeconfigs[kubeconfigPath] = name
+		kubeconfigFiles = append(kubeconfigFiles, archiveFile{name: name, data: s.kubeconfigs[kubeconfigPath]})
+	}
+	s.mux.Unlock()
+
+	manifestData, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	files := []archiveFile{{name: manifestFileName, data: manifestData}}
+	if len(s.Config) > 0 {
+		files = append(files, archiveFile{name: configFileName, data: s.Config})
+	}
+	files = append(files, kubeconfigFiles...)
+	for i, cluster := range s.Clusters() {
+		clusterData, err := json.MarshalIndent(cluster, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, archiveFile{name: path.Join(clustersDir, fmt.Sprintf("%d.json", i)), data: clusterData})
+	}
+	return files, nil
+}
+
+// Write writes the snapshot as a gzip compressed tar archive.
+func (s *Snapshot) Write(w io.Writer) error {
+	files, err := s.files()
+	if err != nil {
+		return err
+	}
+
+	gzipWriter := gzip.NewWriter(w)
+	tarWriter := tar.NewWriter(gzipWriter)
+	for _, f := range files {
+		if err := writeTarFile(tarWriter, f.name, f.data, s.Manifest.CreationTime); err != nil {
+			return err
+		}
+	}
+	if err := tarWriter.Close(); err != nil {
+		return err
+	}
+	return gzipWriter.Close()
+}
+
+// WriteFile writes the snapshot archive to a file.
+func (s *Snapshot) WriteFile(filePath string) error {
+	file, err := os.OpenFile(filepath.Clean(filePath), os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	if err := s.Write(file); err != nil {
+		return errors.Join(err, file.Close())
+	}
+	return file.Close()
+}
+
+// WriteDir writes the snapshot as plain files to a directory, e.g. to store it as a test fixture.
+// Files of a previous snapshot in the directory are replaced.
+func (s *Snapshot) WriteDir(dir string) error {
+	files, err := s.files()
+	if err != nil {
+		return err
+	}
+
+	for _, subDir := range []string{clustersDir, kubeconfigsDir} {
+		if err := os.RemoveAll(filepath.Join(dir, subDir)); err != nil {
+			return err
+		}
+	}
+	for _, f := range files {
+		filePath := filepath.Join(dir, filepath.FromSlash(f.name))
+		if err := os.MkdirAll(filepath.Dir(filePath), 0700); err != nil {
+			return err
+		}
+		if err := os.WriteFile(filePath, f.data, 0600); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Read reads a snapshot archive. The returned snapshot serves the recorded data.
+func Read(r io.Reader) (*Snapshot, error) {
+	gzipReader, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer gzipReader.Close()
+
+	var (
+		files     []archiveFile
+		tarReader = tar.NewReader(gzipReader)
+	)
+	for {
+		header, err := tarReader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		data, err := io.ReadAll(tarReader)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, archiveFile{name: header.Name, data: data})
+	}
+	return fromFiles(files)
+}
+
+// ReadFile reads a snapshot archive from a file.
+func ReadFile(filePath string) (*Snapshot, error) {
+	file, err := os.Open(filepath.Clean(filePath))
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return Read(file)
+}
+
+// ReadDir reads a snapshot which was written to a directory with [Snapshot.WriteDir].
+// The returned snapshot serves the recorded data.
+func ReadDir(dir string) (*Snapshot, error) {
+	var files []archiveFile
+	if err := fs.WalkDir(os.DirFS(dir), ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil || !d.Type().IsRegular() {
+			return err
+		}
+		data, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(name)))
+		if err != nil {
+			return err
+		}
+		files = append(files, archiveFile{name: name, data: data})
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return fromFiles(files)
+}
+
+// Load reads a snapshot from a directory or an archive file, depending on the given path.
+func Load(snapshotPath string) (*Snapshot, error) {
+	info, err := os.Stat(snapshotPath)
+	if err != nil {
+		return nil, err
+	}
+	if info.IsDir() {
+		return ReadDir(snapshotPath)
+	}
+	return ReadFile(snapshotPath)
+}
+
+// fromFiles creates a snapshot which serves the data of the given files.
+func fromFiles(files []archiveFile) (*Snapshot, error) {
+	var (
+		s           = New()
+		kubeconfigs = map[string][]byte{}
+		hasManifest bool
+	)
+	s.replay = true
+	for _, f := range files {
+		switch {
+		case f.name == manifestFileName:
+			if err := json.Unmarshal(f.data, &s.Manifest); err != nil {
+				return nil, fmt.Errorf("failed to parse snapshot manifest: %w", err)
+			}
+			hasManifest = true
+		case f.name == configFileName:
+			s.Config = f.data
+		case strings.HasPrefix(f.name, kubeconfigsDir+"/"):
+			kubeconfigs[f.name] = f.data
+		case strings.HasPrefix(f.name, clustersDir+"/"):
+			var cluster Cluster
+			if err := json.Unmarshal(f.data, &cluster); err != nil {
+				return nil, fmt.Errorf("failed to parse snapshot file %s: %w", f.name, err)
+			}
+			if err := s.addCluster(cluster); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if !hasManifest {
+		return nil, errors.New("snapshot does not contain a manifest")
+	}
+	if s.Manifest.FormatVersion != FormatVersion {
+		return nil, fmt.Errorf("snapshot format version %s is not supported", s.Manifest.FormatVersion)
+	}
+	for kubeconfigPath, name := range s.Manifest.Kubeconfigs {
+		data, ok := kubeconfigs[name]
+		if !ok {
+			return nil, fmt.Errorf("snapshot does not contain kubeconfig %s", name)
+		}
+		s.kubeconfigs[kubeconfigPath] = data
+	}
+	return s, nil
+}
+
+func writeTarFile(tarWriter *tar.Writer, name string, data []byte, modTime time.Time) error {
+	if err := tarWriter.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     name,
+		Size:     int64(len(data)),
+		Mode:     0600,
+		ModTime:  modTime,
+	}); err != nil {
+		return err
+	}
+	_, err := tarWriter.Write(data)
+	return err
+}
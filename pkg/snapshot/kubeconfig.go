@@ -0,0 +1,308 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package snapshot
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+
+	"github.com/gardener/diki/pkg/config"
+)
+
+// AddConfig records the diki configuration together with credential-free copies of
+// the kubeconfigs referenced by provider arguments whose names end with "kubeconfigPath".
+// Cluster access blocks, i.e. provider arguments whose names end with "cluster" and which do not
+// reference a kubeconfig, e.g. in-cluster configurations, are recorded as credential-free kubeconfigs as well.
+// The copies contain only the API server address and CA, which are needed to create the providers when the snapshot is evaluated.
+func (s *Snapshot) AddConfig(c *config.DikiConfig) error {
+	data, err := yaml.Marshal(c)
+	if err != nil {
+		return err
+	}
+
+	kubeconfigs := map[string][]byte{}
+	for _, providerConf := range c.Providers {
+		if _, err := replaceKubeconfigPaths(providerConf.Args, func(kubeconfigPath string) (string, error) {
+			kubeconfig, err := credentialFreeKubeconfig(kubeconfigPath)
+			if err != nil {
+				return "", fmt.Errorf("failed to read kubeconfig %s: %w", kubeconfigPath, err)
+			}
+			kubeconfigs[kubeconfigPath] = kubeconfig
+			return kubeconfigPath, nil
+		}); err != nil {
+			return err
+		}
+
+		if _, err := replaceClusterAccess(providerConf.Args, argsPath(providerConf.ID), func(blockPath string, block map[string]any) (any, error) {
+			kubeconfig, err := clusterAccessKubeconfig(block)
+			if err != nil {
+				return nil, fmt.Errorf("failed to resolve cluster %s: %w", blockPath, err)
+			}
+			kubeconfigs[blockPath] = kubeconfig
+			return block, nil
+		}); err != nil {
+			return err
+		}
+	}
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	s.Config = data
+	s.kubeconfigs = kubeconfigs
+	return nil
+}
+
+// DikiConfig returns the diki configuration with which the snapshot was collected.
+func (s *Snapshot) DikiConfig() (*config.DikiConfig, error) {
+	if len(s.Config) == 0 {
+		return nil, fmt.Errorf("configuration is %w", ErrNotInSnapshot)
+	}
+
+	c := &config.DikiConfig{}
+	if err := yaml.Unmarshal(s.Config, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// UseKubeconfigs writes the recorded kubeconfigs to dir and replaces
+// the kubeconfig paths in the provider arguments of the configuration with them.
+// Cluster access blocks which do not reference a kubeconfig are replaced by blocks referencing their recorded kubeconfig.
+func (s *Snapshot) UseKubeconfigs(c *config.DikiConfig, dir string) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	written := map[string]string{}
+	writeKubeconfig := func(key string) (string, error) {
+		if newPath, ok := written[key]; ok {
+			return newPath, nil
+		}
+		kubeconfig, ok := s.kubeconfigs[key]
+		if !ok {
+			return "", fmt.Errorf("kubeconfig %s is %w", key, ErrNotInSnapshot)
+		}
+
+		newPath := filepath.Join(dir, fmt.Sprintf("kubeconfig-%d.yaml", len(written)))
+		if err := os.WriteFile(newPath, kubeconfig, 0600); err != nil {
+			return "", err
+		}
+		written[key] = newPath
+		return newPath, nil
+	}
+
+	for i, providerConf := range c.Providers {
+		// kubeconfig paths are replaced first, so that the replaced cluster access blocks are not replaced again
+		args, err := replaceKubeconfigPaths(providerConf.Args, writeKubeconfig)
+		if err != nil {
+			return err
+		}
+		args, err = replaceClusterAccess(args, argsPath(providerConf.ID), func(blockPath string, block map[string]any) (any, error) {
+			newPath, err := writeKubeconfig(blockPath)
+			if err != nil {
+				return nil, err
+			}
+
+			// only the client settings are kept, the credentials and the API server are taken from the kubeconfig
+			newBlock := map[string]any{"kubeconfigPath": newPath}
+			for _, key := range []string{"qps", "burst"} {
+				if value, ok := block[key]; ok {
+					newBlock[key] = value
+				}
+			}
+			return newBlock, nil
+		})
+		if err != nil {
+			return err
+		}
+		c.Providers[i].Args = args
+	}
+	return nil
+}
+
+// replaceKubeconfigPaths replaces the string values of all keys ending with "kubeconfigPath" in nested provider arguments.
+func replaceKubeconfigPaths(args any, replaceFn func(string) (string, error)) (any, error) {
+	switch v := args.(type) {
+	case map[string]any:
+		for _, key := range sortedKeys(v) {
+			if kubeconfigPath, ok := v[key].(string); ok && len(kubeconfigPath) > 0 && strings.HasSuffix(strings.ToLower(key), "kubeconfigpath") {
+				newPath, err := replaceFn(kubeconfigPath)
+				if err != nil {
+					return nil, err
+				}
+				v[key] = newPath
+				continue
+			}
+			value, err := replaceKubeconfigPaths(v[key], replaceFn)
+			if err != nil {
+				return nil, err
+			}
+			v[key] = value
+		}
+	case []any:
+		for i := range v {
+			value, err := replaceKubeconfigPaths(v[i], replaceFn)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = value
+		}
+	}
+	return args, nil
+}
+
+// replaceClusterAccess replaces the values of all keys ending with "cluster" in nested provider arguments which are
+// cluster access blocks with an in-cluster configuration or a server, but without a kubeconfig path.
+// The blocks are passed to replaceFn together with their path in the arguments.
+func replaceClusterAccess(args any, path string, replaceFn func(string, map[string]any) (any, error)) (any, error) {
+	switch v := args.(type) {
+	case map[string]any:
+		for _, key := range sortedKeys(v) {
+			keyPath := path + "." + key
+			if block, ok := v[key].(map[string]any); ok && strings.HasSuffix(strings.ToLower(key), "cluster") && isClusterAccessWithoutKubeconfig(block) {
+				value, err := replaceFn(keyPath, block)
+				if err != nil {
+					return nil, err
+				}
+				v[key] = value
+				continue
+			}
+			value, err := replaceClusterAccess(v[key], keyPath, replaceFn)
+			if err != nil {
+				return nil, err
+			}
+			v[key] = value
+		}
+	case []any:
+		for i := range v {
+			value, err := replaceClusterAccess(v[i], fmt.Sprintf("%s[%d]", path, i), replaceFn)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = value
+		}
+	}
+	return args, nil
+}
+
+func isClusterAccessWithoutKubeconfig(block map[string]any) bool {
+	kubeconfigPath, _ := block["kubeconfigPath"].(string)
+	inCluster, _ := block["inCluster"].(bool)
+	server, _ := block["server"].(string)
+	return len(kubeconfigPath) == 0 && (inCluster || len(server) > 0)
+}
+
+// argsPath returns the path of the arguments of the provider with the given id,
+// which identifies the recorded kubeconfigs of its cluster access blocks.
+func argsPath(providerID string) string {
+	return fmt.Sprintf("providers[%s].args", providerID)
+}
+
+// clusterAccessKubeconfig returns a kubeconfig with the API server address and CA of a cluster access block.
+func clusterAccessKubeconfig(block map[string]any) ([]byte, error) {
+	data, err := json.Marshal(block)
+	if err != nil {
+		return nil, err
+	}
+	access := config.ClusterAccessConfig{}
+	if err := json.Unmarshal(data, &access); err != nil {
+		return nil, err
+	}
+
+	restConfig := &rest.Config{}
+	if access.InCluster {
+		if restConfig, err = rest.InClusterConfig(); err != nil {
+			return nil, err
+		}
+	}
+	if len(access.Server) > 0 {
+		restConfig.Host = access.Server
+	}
+	if len(access.CAFile) > 0 {
+		restConfig.CAFile, restConfig.CAData = access.CAFile, nil
+	}
+	cluster, err := credentialFreeCluster(restConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	kubeconfig := clientcmdapi.NewConfig()
+	kubeconfig.AuthInfos["snapshot"] = &clientcmdapi.AuthInfo{}
+	kubeconfig.Clusters["cluster"] = cluster
+	kubeconfig.Contexts["cluster"] = &clientcmdapi.Context{Cluster: "cluster", AuthInfo: "snapshot"}
+	kubeconfig.CurrentContext = "cluster"
+	return clientcmd.Write(*kubeconfig)
+}
+
+// credentialFreeCluster returns the API server address and CA of the rest config.
+func credentialFreeCluster(restConfig *rest.Config) (*clientcmdapi.Cluster, error) {
+	caData := restConfig.CAData
+	if len(caData) == 0 && len(restConfig.CAFile) > 0 {
+		var err error
+		if caData, err = os.ReadFile(filepath.Clean(restConfig.CAFile)); err != nil {
+			return nil, err
+		}
+	}
+
+	return &clientcmdapi.Cluster{
+		Server:                   restConfig.Host,
+		CertificateAuthorityData: caData,
+		TLSServerName:            restConfig.ServerName,
+		InsecureSkipTLSVerify:    restConfig.Insecure,
+	}, nil
+}
+
+// credentialFreeKubeconfig returns a kubeconfig with the API server addresses and CAs
+// of all contexts of the kubeconfig at kubeconfigPath, so that any of them can be selected.
+func credentialFreeKubeconfig(kubeconfigPath string) ([]byte, error) {
+	data, err := os.ReadFile(filepath.Clean(kubeconfigPath))
+	if err != nil {
+		return nil, err
+	}
+	rawConfig, err := clientcmd.Load(data)
+	if err != nil {
+		return nil, err
+	}
+
+	kubeconfig := clientcmdapi.NewConfig()
+	kubeconfig.AuthInfos["snapshot"] = &clientcmdapi.AuthInfo{}
+	for _, contextName := range sortedKeys(rawConfig.Contexts) {
+		restConfig, err := clientcmd.NewNonInteractiveClientConfig(*rawConfig, contextName, &clientcmd.ConfigOverrides{}, nil).ClientConfig()
+		if err != nil {
+			if contextName == rawConfig.CurrentContext {
+				return nil, err
+			}
+			continue
+		}
+
+		cluster, err := credentialFreeCluster(restConfig)
+		if err != nil {
+			return nil, err
+		}
+
+		kubeconfig.Clusters[contextName] = cluster
+		kubeconfig.Contexts[contextName] = &clientcmdapi.Context{Cluster: contextName, AuthInfo: "snapshot"}
+	}
+	kubeconfig.CurrentContext = rawConfig.CurrentContext
+	return clientcmd.Write(*kubeconfig)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	slices.SortFunc(keys, cmp.Compare[string])
+	return keys
+}
@@ -0,0 +1,181 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package replay evaluates providers and rulesets from recorded snapshots,
+// so that whole runs can be tested deterministically without cluster access.
+//
+// Fixtures are recorded during a real run with
+//
+//	diki collect --config config.yaml --output testdata/fixture --format directory
+//
+// and the reports of their evaluation are compared to golden reports.
+// Golden reports are created or updated by running the tests with DIKI_UPDATE_GOLDEN=true.
+package replay
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gardener/diki/pkg/provider"
+	"github.com/gardener/diki/pkg/provider/gardener"
+	"github.com/gardener/diki/pkg/registry"
+	"github.com/gardener/diki/pkg/report"
+	"github.com/gardener/diki/pkg/rule"
+	"github.com/gardener/diki/pkg/snapshot"
+)
+
+// UpdateGoldenEnv is the environment variable which instructs [CompareGolden] to write the golden reports instead of comparing them.
+const UpdateGoldenEnv = "DIKI_UPDATE_GOLDEN"
+
+// Run creates the providers of the snapshot configuration and runs all of their rulesets with the recorded data.
+// The returned report is normalized with [Normalize].
+func Run(ctx context.Context, s *snapshot.Snapshot, r *registry.Registry) (*report.Report, error) {
+	if !s.Replay() {
+		return nil, errors.New("snapshot does not serve recorded data")
+	}
+
+	dikiConfig, err := s.DikiConfig()
+	if err != nil {
+		return nil, err
+	}
+	if err := gardener.ValidateSnapshotConfig(dikiConfig); err != nil {
+		return nil, err
+	}
+	kubeconfigDir, err := os.MkdirTemp("", "diki-replay-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(kubeconfigDir)
+
+	if err := s.UseKubeconfigs(dikiConfig, kubeconfigDir); err != nil {
+		return nil, err
+	}
+
+	providers, err := r.NewProviders(dikiConfig, slog.Default())
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := provider.Close(slices.Collect(maps.Values(providers))...); err != nil {
+			slog.Default().Error("failed to close providers", "error", err)
+		}
+	}()
+
+	ctx = snapshot.ContextWithSnapshot(ctx, s)
+	providerResults := make([]provider.ProviderResult, 0, len(providers))
+	for _, providerConfig := range dikiConfig.Providers {
+		res, err := providers[providerConfig.ID].RunAll(ctx)
+		if err != nil {
+			return nil, err
+		}
+		providerResults = append(providerResults, res)
+	}
+
+	var reportOpts []report.ReportOption
+	if dikiConfig.Output != nil && len(dikiConfig.Output.MinStatus) > 0 {
+		reportOpts = append(reportOpts, report.MinStatus(dikiConfig.Output.MinStatus))
+	}
+	if len(dikiConfig.Metadata) > 0 {
+		reportOpts = append(reportOpts, report.Metadata(dikiConfig.Metadata))
+	}
+	rep := report.FromProviderResults(providerResults, reportOpts...)
+	Normalize(rep)
+	return rep, nil
+}
+
+// RunFixture loads the snapshot from fixturePath and evaluates it with [Run].
+func RunFixture(ctx context.Context, fixturePath string, r *registry.Registry) (*report.Report, error) {
+	s, err := snapshot.Load(fixturePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load fixture %s: %w", fixturePath, err)
+	}
+	return Run(ctx, s, r)
+}
+
+// Normalize removes everything from a report which differs between evaluations of the same snapshot.
+// The report time is reset and rulesets, rules, checks and their targets are sorted, since rules run concurrently.
+func Normalize(rep *report.Report) {
+	rep.Time = time.Time{}
+	for i := range rep.Providers {
+		rulesets := rep.Providers[i].Rulesets
+		slices.SortFunc(rulesets, func(a, b report.Ruleset) int {
+			return cmp.Or(cmp.Compare(a.ID, b.ID), cmp.Compare(a.Version, b.Version))
+		})
+		for j := range rulesets {
+			rules := rulesets[j].Rules
+			slices.SortFunc(rules, func(a, b report.Rule) int {
+				return cmp.Compare(a.ID, b.ID)
+			})
+			for k := range rules {
+				checks := rules[k].Checks
+				slices.SortFunc(checks, func(a, b report.Check) int {
+					return cmp.Or(cmp.Compare(a.Status, b.Status), cmp.Compare(a.Message, b.Message))
+				})
+				for l := range checks {
+					// fmt prints maps sorted by key
+					slices.SortStableFunc(checks[l].Targets, func(a, b rule.Target) int {
+						return cmp.Compare(fmt.Sprint(a), fmt.Sprint(b))
+					})
+				}
+			}
+		}
+	}
+}
+
+// CompareGolden compares the JSON encoding of a report with the golden report in goldenPath.
+// If the environment variable [UpdateGoldenEnv] is set to true, the golden report is written instead.
+func CompareGolden(rep *report.Report, goldenPath string) error {
+	data, err := json.MarshalIndent(rep, "", "  ")
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+
+	if os.Getenv(UpdateGoldenEnv) == "true" {
+		if err := os.MkdirAll(filepath.Dir(goldenPath), 0750); err != nil {
+			return err
+		}
+		return os.WriteFile(goldenPath, data, 0600)
+	}
+
+	golden, err := os.ReadFile(filepath.Clean(goldenPath))
+	if errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("golden report %s does not exist, run the tests with %s=true to create it", goldenPath, UpdateGoldenEnv)
+	}
+	if err != nil {
+		return err
+	}
+	if bytes.Equal(golden, data) {
+		return nil
+	}
+
+	var (
+		goldenLines = strings.Split(string(golden), "\n")
+		lines       = strings.Split(string(data), "\n")
+		line        int
+	)
+	for line < len(goldenLines) && line < len(lines) && goldenLines[line] == lines[line] {
+		line++
+	}
+	return fmt.Errorf("report differs from golden report %s in line %d:\n- %s\n+ %s\nrun the tests with %s=true to update it",
+		goldenPath, line+1, lineAt(goldenLines, line), lineAt(lines, line), UpdateGoldenEnv)
+}
+
+func lineAt(lines []string, idx int) string {
+	if idx < len(lines) {
+		return lines[idx]
+	}
+	return "<end of file>"
+}
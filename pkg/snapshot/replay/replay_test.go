@@ -0,0 +1,142 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package replay_test
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/diki/pkg/internal/stringgen"
+	"github.com/gardener/diki/pkg/provider/builder"
+	gardenerrules "github.com/gardener/diki/pkg/provider/gardener/ruleset/disak8sstig/rules"
+	"github.com/gardener/diki/pkg/registry"
+	"github.com/gardener/diki/pkg/report"
+	"github.com/gardener/diki/pkg/rule"
+	sharedrules "github.com/gardener/diki/pkg/shared/ruleset/disak8sstig/rules"
+	"github.com/gardener/diki/pkg/snapshot"
+	"github.com/gardener/diki/pkg/snapshot/replay"
+)
+
+// constantGenerator generates the same string for all ops pods, so that their names do not change between runs.
+type constantGenerator struct{}
+
+func (constantGenerator) Generate(n int) string {
+	return strings.Repeat("x", n)
+}
+
+var _ = Describe("replay", func() {
+	var (
+		ctx = context.Background()
+		r   *registry.Registry
+	)
+
+	BeforeEach(func() {
+		var err error
+		r, err = builder.NewRegistry()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	BeforeEach(func() {
+		DeferCleanup(func(sharedGenerator, gardenerGenerator stringgen.StringGenerator) {
+			sharedrules.Generator, gardenerrules.Generator = sharedGenerator, gardenerGenerator
+		}, sharedrules.Generator, gardenerrules.Generator)
+		sharedrules.Generator, gardenerrules.Generator = constantGenerator{}, constantGenerator{}
+	})
+
+	DescribeTable("#RunFixture",
+		func(fixture string) {
+			rep, err := replay.RunFixture(ctx, filepath.Join("testdata", fixture), r)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(replay.CompareGolden(rep, filepath.Join("testdata", fixture+".golden.json"))).To(Succeed())
+		},
+		Entry("garden provider with security-hardened-shoot-cluster ruleset", "garden"),
+		Entry("managedk8s provider with security-hardened-k8s ruleset", "managedk8s"),
+		Entry("managedk8s provider with disa-kubernetes-stig ruleset", "managedk8s-disak8sstig"),
+	)
+
+	Describe("#Run", func() {
+		It("should not run with a snapshot which records data", func() {
+			_, err := replay.Run(ctx, snapshot.New(), r)
+			Expect(err).To(MatchError("snapshot does not serve recorded data"))
+		})
+	})
+
+	Describe("#Normalize", func() {
+		It("should reset the report time and sort the results", func() {
+			rep := &report.Report{
+				Time: time.Now(),
+				Providers: []report.Provider{
+					{
+						Rulesets: []report.Ruleset{
+							{ID: "foo", Version: "v2"},
+							{
+								ID:      "foo",
+								Version: "v1",
+								Rules: []report.Rule{
+									{ID: "2"},
+									{
+										ID: "1",
+										Checks: []report.Check{
+											{Status: rule.Passed, Message: "foo"},
+											{Status: rule.Failed, Message: "bar", Targets: []rule.Target{{"name": "b"}, {"name": "a"}}},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+
+			replay.Normalize(rep)
+
+			Expect(rep.Time).To(BeZero())
+			Expect(rep.Providers[0].Rulesets).To(HaveLen(2))
+			Expect(rep.Providers[0].Rulesets[0].Version).To(Equal("v1"))
+			Expect(rep.Providers[0].Rulesets[0].Rules[0].ID).To(Equal("1"))
+			Expect(rep.Providers[0].Rulesets[0].Rules[0].Checks).To(Equal([]report.Check{
+				{Status: rule.Failed, Message: "bar", Targets: []rule.Target{{"name": "a"}, {"name": "b"}}},
+				{Status: rule.Passed, Message: "foo"},
+			}))
+		})
+	})
+
+	Describe("#CompareGolden", func() {
+		var (
+			goldenPath string
+			rep        *report.Report
+		)
+
+		BeforeEach(func() {
+			GinkgoT().Setenv(replay.UpdateGoldenEnv, "")
+			goldenPath = filepath.Join(GinkgoT().TempDir(), "report.golden.json")
+			rep = &report.Report{DikiVersion: "v1.0.0", Providers: []report.Provider{{ID: "foo"}}}
+		})
+
+		It("should fail if the golden report does not exist", func() {
+			Expect(replay.CompareGolden(rep, goldenPath)).To(MatchError(ContainSubstring("does not exist")))
+		})
+
+		It("should write the golden report and compare it", func() {
+			GinkgoT().Setenv(replay.UpdateGoldenEnv, "true")
+			Expect(replay.CompareGolden(rep, goldenPath)).To(Succeed())
+			Expect(goldenPath).To(BeAnExistingFile())
+
+			GinkgoT().Setenv(replay.UpdateGoldenEnv, "")
+			Expect(replay.CompareGolden(rep, goldenPath)).To(Succeed())
+
+			rep.Providers[0].ID = "bar"
+			Expect(replay.CompareGolden(rep, goldenPath)).To(MatchError(SatisfyAll(
+				ContainSubstring(`-       "id": "foo",`),
+				ContainSubstring(`+       "id": "bar",`),
+			)))
+		})
+	})
+})
@@ -0,0 +1,441 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package snapshot
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// FormatVersion is the version of the snapshot archive format.
+const FormatVersion = "v1"
+
+// ErrNotInSnapshot is returned when data is requested which was not collected into the snapshot.
+var ErrNotInSnapshot = errors.New("not part of the snapshot")
+
+// Snapshot contains the data which rules read from the checked clusters during a run:
+// Kubernetes objects, outputs of commands executed in ops pods and responses of other API requests.
+// A new snapshot records the data of the run which carries it in its context.
+// A snapshot read from an archive serves the recorded data instead, so that rules can be evaluated without cluster access.
+type Snapshot struct {
+	// Manifest describes the snapshot.
+	Manifest Manifest
+	// Config is the diki configuration with which the snapshot was collected.
+	Config []byte
+
+	replay      bool
+	mux         sync.Mutex
+	clusters    map[string]*clusterData
+	kubeconfigs map[string][]byte
+}
+
+// Manifest describes a snapshot.
+type Manifest struct {
+	FormatVersion string    `json:"formatVersion"`
+	DikiVersion   string    `json:"dikiVersion,omitempty"`
+	CreationTime  time.Time `json:"creationTime"`
+	// Kubeconfigs maps the kubeconfig paths and the paths of the cluster access blocks
+	// of the configuration to the kubeconfig files in the archive.
+	Kubeconfigs map[string]string `json:"kubeconfigs,omitempty"`
+}
+
+// Cluster contains the data recorded from a single cluster.
+type Cluster struct {
+	// Name is the host of the cluster API server.
+	Name       string      `json:"name"`
+	Version    string      `json:"version,omitempty"`
+	Lists      []List      `json:"lists,omitempty"`
+	Objects    []Object    `json:"objects,omitempty"`
+	Executions []Execution `json:"executions,omitempty"`
+	Requests   []Request   `json:"requests,omitempty"`
+	OpsPods    []OpsPod    `json:"opsPods,omitempty"`
+}
+
+// List contains all objects of a list kind.
+type List struct {
+	APIVersion   string          `json:"apiVersion"`
+	Kind         string          `json:"kind"`
+	MetadataOnly bool            `json:"metadataOnly,omitempty"`
+	Data         json.RawMessage `json:"data"`
+}
+
+// Object contains the result of a get request for a single object.
+type Object struct {
+	APIVersion   string          `json:"apiVersion"`
+	Kind         string          `json:"kind"`
+	MetadataOnly bool            `json:"metadataOnly,omitempty"`
+	Namespace    string          `json:"namespace,omitempty"`
+	Name         string          `json:"name"`
+	Data         json.RawMessage `json:"data,omitempty"`
+	// Status is set if the request failed with an API status error, e.g. because the object was not found.
+	Status *metav1.Status `json:"status,omitempty"`
+}
+
+// Execution contains the result of a command executed in an ops pod on a node.
+type Execution struct {
+	Node       string `json:"node,omitempty"`
+	Command    string `json:"command"`
+	CommandArg string `json:"commandArg"`
+	Stdout     string `json:"stdout,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
+
+// Err returns the recorded error of the execution.
+func (e Execution) Err() error {
+	if len(e.Error) == 0 {
+		return nil
+	}
+	return errors.New(e.Error)
+}
+
+// OpsPod is the ops pod which executed the commands on a node.
+type OpsPod struct {
+	Node      string `json:"node"`
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+}
+
+// Request contains the response of an API request which is not served by a Kubernetes client.
+type Request struct {
+	Method      string `json:"method"`
+	URL         string `json:"url"`
+	StatusCode  int    `json:"statusCode,omitempty"`
+	ContentType string `json:"contentType,omitempty"`
+	Body        []byte `json:"body,omitempty"`
+	Error       string `json:"error,omitempty"`
+}
+
+type clusterData struct {
+	version    string
+	lists      map[listKey]List
+	objects    map[objectKey]Object
+	executions map[executionKey]Execution
+	requests   map[requestKey]Request
+	opsPods    map[opsPodKey]OpsPod
+}
+
+type listKey struct {
+	gvk          schema.GroupVersionKind
+	metadataOnly bool
+}
+
+type objectKey struct {
+	gvk             schema.GroupVersionKind
+	metadataOnly    bool
+	namespace, name string
+}
+
+type executionKey struct {
+	node, command, commandArg string
+}
+
+type requestKey struct {
+	method, url string
+}
+
+type opsPodKey struct {
+	node, namespace string
+}
+
+type snapshotContextKey struct{}
+
+// New creates a new empty Snapshot which records data.
+func New() *Snapshot {
+	return &Snapshot{
+		Manifest: Manifest{
+			FormatVersion: FormatVersion,
+			CreationTime:  time.Now().UTC(),
+		},
+		clusters:    map[string]*clusterData{},
+		kubeconfigs: map[string][]byte{},
+	}
+}
+
+// ContextWithSnapshot returns a context which carries the given [Snapshot].
+func ContextWithSnapshot(ctx context.Context, s *Snapshot) context.Context {
+	return context.WithValue(ctx, snapshotContextKey{}, s)
+}
+
+// FromContext returns the [Snapshot] of the context or nil if there is none.
+func FromContext(ctx context.Context) *Snapshot {
+	s, _ := ctx.Value(snapshotContextKey{}).(*Snapshot)
+	return s
+}
+
+// Replay returns true if the snapshot serves recorded data instead of recording it. It is nil-safe.
+func (s *Snapshot) Replay() bool {
+	return s != nil && s.replay
+}
+
+func (s *Snapshot) cluster(name string) *clusterData {
+	c, ok := s.clusters[name]
+	if !ok {
+		c = &clusterData{
+			lists:      map[listKey]List{},
+			objects:    map[objectKey]Object{},
+			executions: map[executionKey]Execution{},
+			requests:   map[requestKey]Request{},
+			opsPods:    map[opsPodKey]OpsPod{},
+		}
+		s.clusters[name] = c
+	}
+	return c
+}
+
+// SetClusterVersion records the Kubernetes server version of a cluster.
+func (s *Snapshot) SetClusterVersion(cluster, version string) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	s.cluster(cluster).version = version
+}
+
+// ClusterVersion returns the recorded Kubernetes server version of a cluster.
+func (s *Snapshot) ClusterVersion(cluster string) (string, bool) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	c, ok := s.clusters[cluster]
+	if !ok || len(c.version) == 0 {
+		return "", false
+	}
+	return c.version, true
+}
+
+// AddList records the JSON encoded list of all objects of a list kind.
+func (s *Snapshot) AddList(cluster string, gvk schema.GroupVersionKind, metadataOnly bool, data []byte) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	apiVersion, kind := gvk.ToAPIVersionAndKind()
+	s.cluster(cluster).lists[listKey{gvk: gvk, metadataOnly: metadataOnly}] = List{
+		APIVersion:   apiVersion,
+		Kind:         kind,
+		MetadataOnly: metadataOnly,
+		Data:         data,
+	}
+}
+
+// List returns the recorded JSON encoded list of a list kind.
+func (s *Snapshot) List(cluster string, gvk schema.GroupVersionKind, metadataOnly bool) ([]byte, bool) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	c, ok := s.clusters[cluster]
+	if !ok {
+		return nil, false
+	}
+	list, ok := c.lists[listKey{gvk: gvk, metadataOnly: metadataOnly}]
+	return list.Data, ok
+}
+
+// AddObject records the result of a get request. Either the JSON encoded object or the status of the failed request is set.
+func (s *Snapshot) AddObject(cluster string, gvk schema.GroupVersionKind, metadataOnly bool, namespace, name string, data []byte, status *metav1.Status) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	apiVersion, kind := gvk.ToAPIVersionAndKind()
+	s.cluster(cluster).objects[objectKey{gvk: gvk, metadataOnly: metadataOnly, namespace: namespace, name: name}] = Object{
+		APIVersion:   apiVersion,
+		Kind:         kind,
+		MetadataOnly: metadataOnly,
+		Namespace:    namespace,
+		Name:         name,
+		Data:         data,
+		Status:       status,
+	}
+}
+
+// Object returns the recorded result of a get request.
+func (s *Snapshot) Object(cluster string, gvk schema.GroupVersionKind, metadataOnly bool, namespace, name string) (Object, bool) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	c, ok := s.clusters[cluster]
+	if !ok {
+		return Object{}, false
+	}
+	object, ok := c.objects[objectKey{gvk: gvk, metadataOnly: metadataOnly, namespace: namespace, name: name}]
+	return object, ok
+}
+
+// AddExecution records the result of a command executed in an ops pod on a node.
+func (s *Snapshot) AddExecution(cluster, node, command, commandArg, stdout string, err error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	execution := Execution{
+		Node:       node,
+		Command:    command,
+		CommandArg: commandArg,
+		Stdout:     stdout,
+	}
+	if err != nil {
+		execution.Error = err.Error()
+	}
+	s.cluster(cluster).executions[executionKey{node: node, command: command, commandArg: commandArg}] = execution
+}
+
+// Execution returns the recorded result of a command executed in an ops pod on a node.
+func (s *Snapshot) Execution(cluster, node, command, commandArg string) (Execution, bool) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	c, ok := s.clusters[cluster]
+	if !ok {
+		return Execution{}, false
+	}
+	execution, ok := c.executions[executionKey{node: node, command: command, commandArg: commandArg}]
+	return execution, ok
+}
+
+// AddRequest records the response of an API request.
+func (s *Snapshot) AddRequest(cluster string, request Request) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	s.cluster(cluster).requests[requestKey{method: request.Method, url: request.URL}] = request
+}
+
+// Request returns the recorded response of an API request.
+func (s *Snapshot) Request(cluster, method, url string) (Request, bool) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	c, ok := s.clusters[cluster]
+	if !ok {
+		return Request{}, false
+	}
+	request, ok := c.requests[requestKey{method: method, url: url}]
+	return request, ok
+}
+
+// AddOpsPod records the name of the ops pod which executed the commands on a node.
+func (s *Snapshot) AddOpsPod(cluster, node, namespace, name string) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	s.cluster(cluster).opsPods[opsPodKey{node: node, namespace: namespace}] = OpsPod{Node: node, Namespace: namespace, Name: name}
+}
+
+// OpsPod returns the recorded name of the ops pod which executed the commands on a node.
+func (s *Snapshot) OpsPod(cluster, node, namespace string) (string, bool) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	c, ok := s.clusters[cluster]
+	if !ok {
+		return "", false
+	}
+	opsPod, ok := c.opsPods[opsPodKey{node: node, namespace: namespace}]
+	return opsPod.Name, ok
+}
+
+// Clusters returns the recorded data of all clusters sorted by cluster name.
+func (s *Snapshot) Clusters() []Cluster {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	clusters := make([]Cluster, 0, len(s.clusters))
+	for name, c := range s.clusters {
+		cluster := Cluster{Name: name, Version: c.version}
+		for _, list := range c.lists {
+			cluster.Lists = append(cluster.Lists, list)
+		}
+		for _, object := range c.objects {
+			cluster.Objects = append(cluster.Objects, object)
+		}
+		for _, execution := range c.executions {
+			cluster.Executions = append(cluster.Executions, execution)
+		}
+		for _, request := range c.requests {
+			cluster.Requests = append(cluster.Requests, request)
+		}
+		for _, opsPod := range c.opsPods {
+			cluster.OpsPods = append(cluster.OpsPods, opsPod)
+		}
+
+		slices.SortFunc(cluster.Lists, func(a, b List) int {
+			return cmp.Or(cmp.Compare(a.APIVersion, b.APIVersion), cmp.Compare(a.Kind, b.Kind), compareBool(a.MetadataOnly, b.MetadataOnly))
+		})
+		slices.SortFunc(cluster.Objects, func(a, b Object) int {
+			return cmp.Or(cmp.Compare(a.APIVersion, b.APIVersion), cmp.Compare(a.Kind, b.Kind), compareBool(a.MetadataOnly, b.MetadataOnly),
+				cmp.Compare(a.Namespace, b.Namespace), cmp.Compare(a.Name, b.Name))
+		})
+		slices.SortFunc(cluster.Executions, func(a, b Execution) int {
+			return cmp.Or(cmp.Compare(a.Node, b.Node), cmp.Compare(a.Command, b.Command), cmp.Compare(a.CommandArg, b.CommandArg))
+		})
+		slices.SortFunc(cluster.Requests, func(a, b Request) int {
+			return cmp.Or(cmp.Compare(a.Method, b.Method), cmp.Compare(a.URL, b.URL))
+		})
+		slices.SortFunc(cluster.OpsPods, func(a, b OpsPod) int {
+			return cmp.Or(cmp.Compare(a.Node, b.Node), cmp.Compare(a.Namespace, b.Namespace))
+		})
+		clusters = append(clusters, cluster)
+	}
+
+	slices.SortFunc(clusters, func(a, b Cluster) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+	return clusters
+}
+
+func (s *Snapshot) addCluster(cluster Cluster) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	if _, ok := s.clusters[cluster.Name]; ok {
+		return fmt.Errorf("cluster %s is contained more than once", cluster.Name)
+	}
+	c := s.cluster(cluster.Name)
+	c.version = cluster.Version
+	for _, list := range cluster.Lists {
+		var err error
+		if list.Data, err = compactJSON(list.Data); err != nil {
+			return fmt.Errorf("list of %s %s of cluster %s is invalid: %w", list.APIVersion, list.Kind, cluster.Name, err)
+		}
+		gvk := schema.FromAPIVersionAndKind(list.APIVersion, list.Kind)
+		c.lists[listKey{gvk: gvk, metadataOnly: list.MetadataOnly}] = list
+	}
+	for _, object := range cluster.Objects {
+		var err error
+		if object.Data, err = compactJSON(object.Data); err != nil {
+			return fmt.Errorf("object %s %s %s/%s of cluster %s is invalid: %w", object.APIVersion, object.Kind, object.Namespace, object.Name, cluster.Name, err)
+		}
+		gvk := schema.FromAPIVersionAndKind(object.APIVersion, object.Kind)
+		c.objects[objectKey{gvk: gvk, metadataOnly: object.MetadataOnly, namespace: object.Namespace, name: object.Name}] = object
+	}
+	for _, execution := range cluster.Executions {
+		c.executions[executionKey{node: execution.Node, command: execution.Command, commandArg: execution.CommandArg}] = execution
+	}
+	for _, request := range cluster.Requests {
+		c.requests[requestKey{method: request.Method, url: request.URL}] = request
+	}
+	for _, opsPod := range cluster.OpsPods {
+		c.opsPods[opsPodKey{node: opsPod.Node, namespace: opsPod.Namespace}] = opsPod
+	}
+	return nil
+}
+
+// compactJSON removes the indentation which is added to the recorded objects when the snapshot is written.
+func compactJSON(data json.RawMessage) (json.RawMessage, error) {
+	if len(data) == 0 {
+		return data, nil
+	}
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, data); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func compareBool(a, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case a:
+		return 1
+	default:
+		return -1
+	}
+}
@@ -0,0 +1,389 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package snapshot_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+
+	"github.com/gardener/diki/pkg/config"
+	"github.com/gardener/diki/pkg/snapshot"
+)
+
+var _ = Describe("snapshot", func() {
+	var (
+		ctx      = context.Background()
+		podGVK   = corev1.SchemeGroupVersion.WithKind("Pod")
+		listGVK  = corev1.SchemeGroupVersion.WithKind("PodList")
+		readBack = func(s *snapshot.Snapshot) *snapshot.Snapshot {
+			var buf bytes.Buffer
+			Expect(s.Write(&buf)).To(Succeed())
+			read, err := snapshot.Read(&buf)
+			Expect(err).ToNot(HaveOccurred())
+			return read
+		}
+	)
+
+	It("should return nil if the context does not carry a snapshot", func() {
+		Expect(snapshot.FromContext(ctx)).To(BeNil())
+		Expect(snapshot.FromContext(ctx).Replay()).To(BeFalse())
+	})
+
+	It("should read the recorded data from the archive", func() {
+		s := snapshot.New()
+		s.Manifest.DikiVersion = "v1.0.0"
+		s.SetClusterVersion("foo", "v1.33.0")
+		s.AddList("foo", listGVK, false, []byte(`{"items":[]}`))
+		s.AddObject("foo", podGVK, false, "bar", "baz", []byte(`{"metadata":{"name":"baz"}}`), nil)
+		s.AddObject("foo", podGVK, false, "bar", "qux", nil, &metav1.Status{Reason: metav1.StatusReasonNotFound, Code: 404})
+		s.AddExecution("foo", "node1", "/bin/sh", "echo foo", "foo\n", nil)
+		s.AddExecution("foo", "node1", "/bin/sh", "exit 1", "", errors.New("bar"))
+		s.AddRequest("bar", snapshot.Request{Method: http.MethodGet, URL: "/version", StatusCode: 200, Body: []byte("{}")})
+		s.AddOpsPod("foo", "node1", "kube-system", "diki-node-foo")
+		Expect(s.Replay()).To(BeFalse())
+
+		read := readBack(s)
+		Expect(read.Replay()).To(BeTrue())
+		Expect(read.Manifest.DikiVersion).To(Equal("v1.0.0"))
+		Expect(read.Manifest.CreationTime).To(BeTemporally("==", s.Manifest.CreationTime))
+		Expect(read.Clusters()).To(Equal(s.Clusters()))
+
+		version, ok := read.ClusterVersion("foo")
+		Expect(ok).To(BeTrue())
+		Expect(version).To(Equal("v1.33.0"))
+
+		data, ok := read.List("foo", listGVK, false)
+		Expect(ok).To(BeTrue())
+		Expect(string(data)).To(Equal(`{"items":[]}`))
+		_, ok = read.List("foo", listGVK, true)
+		Expect(ok).To(BeFalse())
+
+		object, ok := read.Object("foo", podGVK, false, "bar", "qux")
+		Expect(ok).To(BeTrue())
+		Expect(object.Status.Reason).To(Equal(metav1.StatusReasonNotFound))
+
+		execution, ok := read.Execution("foo", "node1", "/bin/sh", "echo foo")
+		Expect(ok).To(BeTrue())
+		Expect(execution.Stdout).To(Equal("foo\n"))
+		Expect(execution.Err()).ToNot(HaveOccurred())
+		execution, ok = read.Execution("foo", "node1", "/bin/sh", "exit 1")
+		Expect(ok).To(BeTrue())
+		Expect(execution.Err()).To(MatchError("bar"))
+		_, ok = read.Execution("foo", "node2", "/bin/sh", "echo foo")
+		Expect(ok).To(BeFalse())
+
+		request, ok := read.Request("bar", http.MethodGet, "/version")
+		Expect(ok).To(BeTrue())
+		Expect(request.Body).To(Equal([]byte("{}")))
+
+		opsPodName, ok := read.OpsPod("foo", "node1", "kube-system")
+		Expect(ok).To(BeTrue())
+		Expect(opsPodName).To(Equal("diki-node-foo"))
+		_, ok = read.OpsPod("foo", "node2", "kube-system")
+		Expect(ok).To(BeFalse())
+	})
+
+	It("should not read archives without a manifest", func() {
+		_, err := snapshot.Read(bytes.NewReader(nil))
+		Expect(err).To(HaveOccurred())
+	})
+
+	Describe("#WriteDir", func() {
+		It("should read the recorded data from the directory", func() {
+			dir := GinkgoT().TempDir()
+			s := snapshot.New()
+			s.AddList("foo", listGVK, false, []byte(`{"items":[]}`))
+			s.AddExecution("foo", "node1", "/bin/sh", "echo foo", "foo\n", nil)
+			Expect(s.WriteDir(dir)).To(Succeed())
+			Expect(filepath.Join(dir, "manifest.json")).To(BeARegularFile())
+			Expect(filepath.Join(dir, "clusters", "0.json")).To(BeARegularFile())
+
+			read, err := snapshot.Load(dir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(read.Replay()).To(BeTrue())
+			Expect(read.Clusters()).To(Equal(s.Clusters()))
+		})
+
+		It("should replace the files of a previous snapshot", func() {
+			dir := GinkgoT().TempDir()
+			s := snapshot.New()
+			s.AddList("foo", listGVK, false, []byte(`{"items":[]}`))
+			s.AddList("bar", listGVK, false, []byte(`{"items":[]}`))
+			Expect(s.WriteDir(dir)).To(Succeed())
+			Expect(snapshot.New().WriteDir(dir)).To(Succeed())
+
+			read, err := snapshot.ReadDir(dir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(read.Clusters()).To(BeEmpty())
+		})
+
+		It("should fail to read cluster files which are not valid JSON", func() {
+			dir := GinkgoT().TempDir()
+			Expect(snapshot.New().WriteDir(dir)).To(Succeed())
+			Expect(os.MkdirAll(filepath.Join(dir, "clusters"), 0700)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(dir, "clusters", "0.json"), []byte(`{"name":"foo","lists":[{"kind":"PodList","data":{"items":}}]}`), 0600)).To(Succeed())
+
+			_, err := snapshot.ReadDir(dir)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("#Load", func() {
+		It("should read snapshot archives", func() {
+			filePath := filepath.Join(GinkgoT().TempDir(), "snapshot.tar.gz")
+			s := snapshot.New()
+			s.AddList("foo", listGVK, false, []byte(`{"items":[]}`))
+			Expect(s.WriteFile(filePath)).To(Succeed())
+
+			read, err := snapshot.Load(filePath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(read.Clusters()).To(Equal(s.Clusters()))
+		})
+	})
+
+	Describe("#AddConfig", func() {
+		It("should record the configuration with credential-free kubeconfigs", func() {
+			dir := GinkgoT().TempDir()
+			kubeconfigPath := filepath.Join(dir, "kubeconfig.yaml")
+			Expect(os.WriteFile(kubeconfigPath, []byte(`apiVersion: v1
+kind: Config
+clusters:
+- name: foo
+  cluster:
+    server: https://foo.example.com
+    certificate-authority-data: Zm9v
+users:
+- name: foo
+  user:
+    token: secret-token
+contexts:
+- name: foo
+  context:
+    cluster: foo
+    user: foo
+current-context: foo
+`), 0600)).To(Succeed())
+
+			s := snapshot.New()
+			Expect(s.AddConfig(&config.DikiConfig{
+				Providers: []config.ProviderConfig{
+					{
+						ID: "foo",
+						Args: map[string]any{
+							"shootKubeconfigPath": kubeconfigPath,
+							"other":               "bar",
+						},
+					},
+				},
+			})).To(Succeed())
+
+			read := readBack(s)
+			c, err := read.DikiConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(c.Providers[0].Args).To(HaveKeyWithValue("shootKubeconfigPath", kubeconfigPath))
+
+			Expect(read.UseKubeconfigs(c, GinkgoT().TempDir())).To(Succeed())
+			newPath := c.Providers[0].Args.(map[string]any)["shootKubeconfigPath"].(string)
+			Expect(newPath).ToNot(Equal(kubeconfigPath))
+			Expect(c.Providers[0].Args).To(HaveKeyWithValue("other", "bar"))
+
+			data, err := os.ReadFile(newPath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(data)).ToNot(ContainSubstring("secret-token"))
+			restConfig, err := clientcmd.RESTConfigFromKubeConfig(data)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(restConfig.Host).To(Equal("https://foo.example.com"))
+			Expect(restConfig.CAData).To(Equal([]byte("foo")))
+		})
+
+		It("should keep all contexts of the recorded kubeconfigs", func() {
+			dir := GinkgoT().TempDir()
+			kubeconfigPath := filepath.Join(dir, "kubeconfig.yaml")
+			Expect(clientcmd.WriteToFile(clientcmdapi.Config{
+				Clusters: map[string]*clientcmdapi.Cluster{
+					"foo": {Server: "https://foo.example.com"},
+					"bar": {Server: "https://bar.example.com"},
+				},
+				AuthInfos: map[string]*clientcmdapi.AuthInfo{"user": {Token: "secret-token"}},
+				Contexts: map[string]*clientcmdapi.Context{
+					"foo": {Cluster: "foo", AuthInfo: "user"},
+					"bar": {Cluster: "bar", AuthInfo: "user"},
+				},
+				CurrentContext: "foo",
+			}, kubeconfigPath)).To(Succeed())
+
+			s := snapshot.New()
+			Expect(s.AddConfig(&config.DikiConfig{
+				Providers: []config.ProviderConfig{{ID: "foo", Args: map[string]any{"cluster": map[string]any{"kubeconfigPath": kubeconfigPath, "context": "bar"}}}},
+			})).To(Succeed())
+
+			read := readBack(s)
+			c, err := read.DikiConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(read.UseKubeconfigs(c, GinkgoT().TempDir())).To(Succeed())
+			cluster := c.Providers[0].Args.(map[string]any)["cluster"].(map[string]any)
+			Expect(cluster).To(HaveKeyWithValue("context", "bar"))
+
+			rawConfig, err := clientcmd.LoadFromFile(cluster["kubeconfigPath"].(string))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rawConfig.CurrentContext).To(Equal("foo"))
+			restConfig, err := clientcmd.NewNonInteractiveClientConfig(*rawConfig, "bar", &clientcmd.ConfigOverrides{}, nil).ClientConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(restConfig.Host).To(Equal("https://bar.example.com"))
+			Expect(restConfig.BearerToken).To(BeEmpty())
+		})
+
+		It("should record the server and CA of cluster access blocks without a kubeconfig", func() {
+			dir := GinkgoT().TempDir()
+			caFile := filepath.Join(dir, "ca.crt")
+			Expect(os.WriteFile(caFile, []byte("foo"), 0600)).To(Succeed())
+
+			s := snapshot.New()
+			Expect(s.AddConfig(&config.DikiConfig{
+				Providers: []config.ProviderConfig{
+					{
+						ID: "foo",
+						Args: map[string]any{
+							"cluster": map[string]any{
+								"server":    "https://foo.example.com",
+								"caFile":    caFile,
+								"tokenFile": filepath.Join(dir, "token"),
+								"qps":       5,
+							},
+						},
+					},
+				},
+			})).To(Succeed())
+
+			read := readBack(s)
+			c, err := read.DikiConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(read.UseKubeconfigs(c, GinkgoT().TempDir())).To(Succeed())
+
+			cluster := c.Providers[0].Args.(map[string]any)["cluster"].(map[string]any)
+			Expect(cluster).To(HaveLen(2))
+			Expect(cluster).To(HaveKeyWithValue("qps", 5))
+			restConfig, err := clientcmd.BuildConfigFromFlags("", cluster["kubeconfigPath"].(string))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(restConfig.Host).To(Equal("https://foo.example.com"))
+			Expect(restConfig.CAData).To(Equal([]byte("foo")))
+			Expect(restConfig.BearerToken).To(BeEmpty())
+			Expect(restConfig.BearerTokenFile).To(BeEmpty())
+		})
+
+		It("should replace in-cluster configurations with the recorded kubeconfig", func() {
+			s := snapshot.New()
+			Expect(s.AddConfig(&config.DikiConfig{
+				Providers: []config.ProviderConfig{{ID: "foo", Args: map[string]any{"cluster": map[string]any{"server": "https://foo.example.com"}}}},
+			})).To(Succeed())
+
+			read := readBack(s)
+			c := &config.DikiConfig{
+				Providers: []config.ProviderConfig{{ID: "foo", Args: map[string]any{"cluster": map[string]any{"inCluster": true}}}},
+			}
+			Expect(read.UseKubeconfigs(c, GinkgoT().TempDir())).To(Succeed())
+
+			cluster := c.Providers[0].Args.(map[string]any)["cluster"].(map[string]any)
+			Expect(cluster).ToNot(HaveKey("inCluster"))
+			restConfig, err := clientcmd.BuildConfigFromFlags("", cluster["kubeconfigPath"].(string))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(restConfig.Host).To(Equal("https://foo.example.com"))
+		})
+
+		It("should fail for cluster access blocks which are not part of the snapshot", func() {
+			read := readBack(snapshot.New())
+			err := read.UseKubeconfigs(&config.DikiConfig{
+				Providers: []config.ProviderConfig{{ID: "foo", Args: map[string]any{"cluster": map[string]any{"inCluster": true}}}},
+			}, GinkgoT().TempDir())
+			Expect(err).To(MatchError(And(ContainSubstring("providers[foo].args.cluster"), ContainSubstring(snapshot.ErrNotInSnapshot.Error()))))
+		})
+
+		It("should fail for kubeconfigs which are not part of the snapshot", func() {
+			read := readBack(snapshot.New())
+			err := read.UseKubeconfigs(&config.DikiConfig{
+				Providers: []config.ProviderConfig{{ID: "foo", Args: map[string]any{"kubeconfigPath": "/foo"}}},
+			}, GinkgoT().TempDir())
+			Expect(err).To(MatchError(snapshot.ErrNotInSnapshot))
+		})
+	})
+
+	Describe("#NewRoundTripper", func() {
+		var (
+			server   *httptest.Server
+			requests int
+		)
+
+		BeforeEach(func() {
+			requests = 0
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				requests++
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusUnauthorized)
+				_, _ = w.Write([]byte(`{"foo":"bar"}`))
+			}))
+			DeferCleanup(server.Close)
+		})
+
+		get := func(ctx context.Context, c *http.Client) (*http.Response, string, error) {
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/foo?bar=baz", nil)
+			Expect(err).ToNot(HaveOccurred())
+			resp, err := c.Do(req)
+			if err != nil {
+				return nil, "", err
+			}
+			defer resp.Body.Close()
+			body, err := io.ReadAll(resp.Body)
+			Expect(err).ToNot(HaveOccurred())
+			return resp, string(body), nil
+		}
+
+		It("should record responses and serve them from the snapshot", func() {
+			c := &http.Client{Transport: snapshot.NewRoundTripper("foo", http.DefaultTransport)}
+			s := snapshot.New()
+
+			resp, body, err := get(snapshot.ContextWithSnapshot(ctx, s), c)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.StatusCode).To(Equal(http.StatusUnauthorized))
+			Expect(body).To(Equal(`{"foo":"bar"}`))
+			Expect(requests).To(Equal(1))
+
+			resp, body, err = get(snapshot.ContextWithSnapshot(ctx, readBack(s)), c)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.StatusCode).To(Equal(http.StatusUnauthorized))
+			Expect(resp.Header.Get("Content-Type")).To(Equal("application/json"))
+			Expect(body).To(Equal(`{"foo":"bar"}`))
+			Expect(requests).To(Equal(1))
+		})
+
+		It("should not serve requests which are not part of the snapshot", func() {
+			c := &http.Client{Transport: snapshot.NewRoundTripper("foo", http.DefaultTransport)}
+
+			_, _, err := get(snapshot.ContextWithSnapshot(ctx, readBack(snapshot.New())), c)
+			Expect(err).To(MatchError(ContainSubstring("not part of the snapshot")))
+			Expect(requests).To(Equal(0))
+		})
+
+		It("should pass requests without a snapshot in the context", func() {
+			c := &http.Client{Transport: snapshot.NewRoundTripper("foo", http.DefaultTransport)}
+
+			_, _, err := get(ctx, c)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(requests).To(Equal(1))
+		})
+	})
+})
@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package snapshot
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"k8s.io/client-go/rest"
+)
+
+// NewRoundTripper wraps a round tripper so that responses of GET requests are recorded in
+// or served from the [Snapshot] of the request context, if there is one.
+// Upgrade requests, e.g. for command executions, are not recorded.
+func NewRoundTripper(cluster string, rt http.RoundTripper) http.RoundTripper {
+	return &roundTripper{cluster: cluster, rt: rt}
+}
+
+// WrapRESTConfig returns a copy of the config whose clients record their GET requests in
+// or serve them from the [Snapshot] of the request context, if there is one.
+func WrapRESTConfig(config *rest.Config) *rest.Config {
+	config = rest.CopyConfig(config)
+	cluster := config.Host
+	config.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+		return NewRoundTripper(cluster, rt)
+	})
+	return config
+}
+
+type roundTripper struct {
+	cluster string
+	rt      http.RoundTripper
+}
+
+// RoundTrip records or serves responses of GET requests.
+func (srt *roundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	s := FromContext(req.Context())
+	if s == nil || len(req.Header.Get("Upgrade")) > 0 {
+		return srt.rt.RoundTrip(req)
+	}
+
+	url := req.URL.RequestURI()
+	if s.Replay() {
+		if req.Method != http.MethodGet {
+			return nil, fmt.Errorf("%s request %s cannot be served from a snapshot", req.Method, url)
+		}
+		request, ok := s.Request(srt.cluster, req.Method, url)
+		if !ok {
+			return nil, fmt.Errorf("%s request %s is %w", req.Method, url, ErrNotInSnapshot)
+		}
+		if len(request.Error) > 0 {
+			return nil, errors.New(request.Error)
+		}
+		header := http.Header{}
+		if len(request.ContentType) > 0 {
+			header.Set("Content-Type", request.ContentType)
+		}
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", request.StatusCode, http.StatusText(request.StatusCode)),
+			StatusCode:    request.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          io.NopCloser(bytes.NewReader(request.Body)),
+			ContentLength: int64(len(request.Body)),
+			Request:       req,
+		}, nil
+	}
+
+	resp, err := srt.rt.RoundTrip(req)
+	if req.Method != http.MethodGet {
+		return resp, err
+	}
+	if err != nil {
+		s.AddRequest(srt.cluster, Request{Method: req.Method, URL: url, Error: err.Error()})
+		return resp, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if closeErr := resp.Body.Close(); closeErr != nil {
+		err = errors.Join(err, closeErr)
+	}
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	s.AddRequest(srt.cluster, Request{
+		Method:      req.Method,
+		URL:         url,
+		StatusCode:  resp.StatusCode,
+		ContentType: resp.Header.Get("Content-Type"),
+		Body:        body,
+	})
+	return resp, nil
+}